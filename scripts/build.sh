@@ -52,7 +52,7 @@ print_status "Generating Protocol Buffer files..."
 ./scripts/generate-proto.sh
 
 # Build services
-SERVICES=("auth-service" "product-service" "api-gateway" "client-service")
+SERVICES=("auth-service" "product-service" "cart-service" "order-service" "notification-service" "api-gateway" "client-service")
 
 for service in "${SERVICES[@]}"; do
     print_status "Building $service..."
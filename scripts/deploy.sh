@@ -68,7 +68,7 @@ print_success "Deployment completed!"
 # Wait for deployments to be ready
 print_status "Waiting for deployments to be ready..."
 
-DEPLOYMENTS=("postgres" "auth-service" "product-service" "api-gateway" "client-service")
+DEPLOYMENTS=("postgres" "auth-service" "product-service" "cart-service" "order-service" "notification-service" "api-gateway" "client-service")
 
 for deployment in "${DEPLOYMENTS[@]}"; do
     print_status "Waiting for $deployment to be ready..."
@@ -9,4 +9,16 @@ WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'auth_db')\gexec
 SELECT 'CREATE DATABASE product_db'
 WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'product_db')\gexec
 
+-- Create cart_db if it doesn't exist
+SELECT 'CREATE DATABASE cart_db'
+WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'cart_db')\gexec
+
+-- Create order_db if it doesn't exist
+SELECT 'CREATE DATABASE order_db'
+WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'order_db')\gexec
+
+-- Create notification_db if it doesn't exist
+SELECT 'CREATE DATABASE notification_db'
+WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'notification_db')\gexec
+
 -- Note: The default 'postgres' database already exists and is used by the gateway
\ No newline at end of file
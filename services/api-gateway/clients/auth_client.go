@@ -19,12 +19,11 @@ type AuthGrpcClient struct {
 
 //! use secure credentials
 func NewAuthGrpcClient(address string) (*AuthGrpcClient, error) {
-	//! always close a conn with defer(can cause memory leak)
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// The connection stays open for the client's lifetime; Close releases it
+	conn, err := grpc.Dial(address, append(storeOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to auth service: %w", err)
 	}
-	defer conn.Close()
 
 	//creating new authServiceClient and passing the connection
 	client := pb.NewAuthServiceClient(conn)
@@ -88,3 +87,52 @@ func (c *AuthGrpcClient) RefreshToken(ctx context.Context, req *pb.RefreshTokenR
 
 	return c.client.RefreshToken(ctx, req)
 }
+
+func (c *AuthGrpcClient) ResolveStore(ctx context.Context, req *pb.ResolveStoreRequest) (*pb.ResolveStoreResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.client.ResolveStore(ctx, req)
+}
+
+func (c *AuthGrpcClient) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.CreateStoreResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreateStore(ctx, req)
+}
+
+func (c *AuthGrpcClient) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (*pb.UpdateStoreResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateStore(ctx, req)
+}
+
+func (c *AuthGrpcClient) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListStores(ctx, req)
+}
+
+func (c *AuthGrpcClient) ListStoreMembers(ctx context.Context, req *pb.ListStoreMembersRequest) (*pb.ListStoreMembersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListStoreMembers(ctx, req)
+}
+
+func (c *AuthGrpcClient) SetStoreMember(ctx context.Context, req *pb.SetStoreMemberRequest) (*pb.SetStoreMemberResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.SetStoreMember(ctx, req)
+}
+
+func (c *AuthGrpcClient) RemoveStoreMember(ctx context.Context, req *pb.RemoveStoreMemberRequest) (*pb.RemoveStoreMemberResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RemoveStoreMember(ctx, req)
+}
@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/cart"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type CartClient struct {
+	client pb.CartServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewCartClient(address string) (*CartClient, error) {
+	conn, err := grpc.Dial(address, append(storeOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to cart service: %w", err)
+	}
+
+	client := pb.NewCartServiceClient(conn)
+
+	return &CartClient{
+		client: client,
+		conn:   conn,
+	}, nil
+}
+
+func (c *CartClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *CartClient) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.GetCartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetCart(ctx, req)
+}
+
+func (c *CartClient) AddCartItem(ctx context.Context, req *pb.AddCartItemRequest) (*pb.AddCartItemResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.AddCartItem(ctx, req)
+}
+
+func (c *CartClient) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItemRequest) (*pb.UpdateCartItemResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateCartItem(ctx, req)
+}
+
+func (c *CartClient) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItemRequest) (*pb.RemoveCartItemResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RemoveCartItem(ctx, req)
+}
+
+func (c *CartClient) ClearCart(ctx context.Context, req *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ClearCart(ctx, req)
+}
+
+func (c *CartClient) MergeCarts(ctx context.Context, req *pb.MergeCartsRequest) (*pb.MergeCartsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.MergeCarts(ctx, req)
+}
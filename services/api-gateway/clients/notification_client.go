@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/notification"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type NotificationClient struct {
+	client pb.NotificationServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewNotificationClient(address string) (*NotificationClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to notification service: %w", err)
+	}
+
+	client := pb.NewNotificationServiceClient(conn)
+
+	return &NotificationClient{
+		client: client,
+		conn:   conn,
+	}, nil
+}
+
+func (c *NotificationClient) Close() error {
+	return c.conn.Close()
+}
+
+// SendNotification may wait on an SMTP server, so it gets longer than
+// other calls
+func (c *NotificationClient) SendNotification(ctx context.Context, req *pb.SendNotificationRequest) (*pb.SendNotificationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 45*time.Second)
+	defer cancel()
+
+	return c.client.SendNotification(ctx, req)
+}
+
+func (c *NotificationClient) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListNotifications(ctx, req)
+}
+
+func (c *NotificationClient) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.MarkNotificationsRead(ctx, req)
+}
@@ -0,0 +1,108 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/order"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type OrderClient struct {
+	client pb.OrderServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewOrderClient(address string) (*OrderClient, error) {
+	conn, err := grpc.Dial(address, append(storeOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to order service: %w", err)
+	}
+
+	client := pb.NewOrderServiceClient(conn)
+
+	return &OrderClient{
+		client: client,
+		conn:   conn,
+	}, nil
+}
+
+func (c *OrderClient) Close() error {
+	return c.conn.Close()
+}
+
+// Checkout runs the whole checkout saga, so it gets longer than other calls
+func (c *OrderClient) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.CheckoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	return c.client.Checkout(ctx, req)
+}
+
+func (c *OrderClient) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetOrder(ctx, req)
+}
+
+func (c *OrderClient) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListOrders(ctx, req)
+}
+
+// UpdateOrderStatus may refund a payment and put stock back, so it gets
+// longer than other calls
+func (c *OrderClient) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return c.client.UpdateOrderStatus(ctx, req)
+}
+
+func (c *OrderClient) EvaluatePromotions(ctx context.Context, req *pb.EvaluatePromotionsRequest) (*pb.EvaluatePromotionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.EvaluatePromotions(ctx, req)
+}
+
+func (c *OrderClient) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.CreatePromotionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreatePromotion(ctx, req)
+}
+
+func (c *OrderClient) UpdatePromotion(ctx context.Context, req *pb.UpdatePromotionRequest) (*pb.UpdatePromotionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdatePromotion(ctx, req)
+}
+
+func (c *OrderClient) GetPromotion(ctx context.Context, req *pb.GetPromotionRequest) (*pb.GetPromotionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetPromotion(ctx, req)
+}
+
+func (c *OrderClient) ListPromotions(ctx context.Context, req *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListPromotions(ctx, req)
+}
+
+func (c *OrderClient) DeletePromotion(ctx context.Context, req *pb.DeletePromotionRequest) (*pb.DeletePromotionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.DeletePromotion(ctx, req)
+}
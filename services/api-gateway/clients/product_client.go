@@ -17,7 +17,7 @@ type ProductClient struct {
 }
 
 func NewProductClient(address string) (*ProductClient, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address, append(storeOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to product service: %w", err)
 	}
@@ -55,21 +55,11 @@ func (c *ProductClient) UpdateProduct(ctx context.Context, req *pb.UpdateProduct
 	return c.client.UpdateProduct(ctx, req)
 }
 
-//!TODO: Check
-// func (c *ProductClient) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductRequest, error) {
-// 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-// 	defer cancel()
-
-// 	resp, err := c.client.DeleteProduct(ctx, req)
-// 	return req, err
-// }
-
-func (c *ProductClient) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductRequest, error) {
+func (c *ProductClient) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	_, err := c.client.DeleteProduct(ctx, req)
-	return req, err
+	return c.client.DeleteProduct(ctx, req)
 }
 
 func (c *ProductClient) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
@@ -91,4 +81,394 @@ func (c *ProductClient) GetCategories(ctx context.Context, req *pb.GetCategories
 	defer cancel()
 
 	return c.client.GetCategories(ctx, req)
-}
\ No newline at end of file
+}
+
+func (c *ProductClient) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreateCategory(ctx, req)
+}
+
+func (c *ProductClient) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateCategory(ctx, req)
+}
+
+func (c *ProductClient) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.DeleteCategory(ctx, req)
+}
+
+func (c *ProductClient) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.MoveCategoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.MoveCategory(ctx, req)
+}
+
+func (c *ProductClient) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetCategoryTree(ctx, req)
+}
+
+func (c *ProductClient) SetProductOptions(ctx context.Context, req *pb.SetProductOptionsRequest) (*pb.SetProductOptionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.SetProductOptions(ctx, req)
+}
+
+func (c *ProductClient) CreateProductVariant(ctx context.Context, req *pb.CreateProductVariantRequest) (*pb.CreateProductVariantResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreateProductVariant(ctx, req)
+}
+
+func (c *ProductClient) UpdateProductVariant(ctx context.Context, req *pb.UpdateProductVariantRequest) (*pb.UpdateProductVariantResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateProductVariant(ctx, req)
+}
+
+func (c *ProductClient) DeleteProductVariant(ctx context.Context, req *pb.DeleteProductVariantRequest) (*pb.DeleteProductVariantResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.DeleteProductVariant(ctx, req)
+}
+
+func (c *ProductClient) ListProductVariants(ctx context.Context, req *pb.ListProductVariantsRequest) (*pb.ListProductVariantsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListProductVariants(ctx, req)
+}
+
+func (c *ProductClient) SetCategoryAttributes(ctx context.Context, req *pb.SetCategoryAttributesRequest) (*pb.SetCategoryAttributesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.SetCategoryAttributes(ctx, req)
+}
+
+func (c *ProductClient) GetCategoryAttributes(ctx context.Context, req *pb.GetCategoryAttributesRequest) (*pb.GetCategoryAttributesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetCategoryAttributes(ctx, req)
+}
+
+func (c *ProductClient) AddProductImage(ctx context.Context, req *pb.AddProductImageRequest) (*pb.AddProductImageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.AddProductImage(ctx, req)
+}
+
+func (c *ProductClient) ListProductImages(ctx context.Context, req *pb.ListProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListProductImages(ctx, req)
+}
+
+func (c *ProductClient) DeleteProductImage(ctx context.Context, req *pb.DeleteProductImageRequest) (*pb.DeleteProductImageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.DeleteProductImage(ctx, req)
+}
+
+func (c *ProductClient) ReorderProductImages(ctx context.Context, req *pb.ReorderProductImagesRequest) (*pb.ReorderProductImagesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ReorderProductImages(ctx, req)
+}
+
+func (c *ProductClient) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.AdjustStockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.AdjustStock(ctx, req)
+}
+
+func (c *ProductClient) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.ReserveStockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ReserveStock(ctx, req)
+}
+
+func (c *ProductClient) CommitReservation(ctx context.Context, req *pb.CommitReservationRequest) (*pb.CommitReservationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CommitReservation(ctx, req)
+}
+
+func (c *ProductClient) ReleaseReservation(ctx context.Context, req *pb.ReleaseReservationRequest) (*pb.ReleaseReservationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ReleaseReservation(ctx, req)
+}
+
+func (c *ProductClient) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListStockMovements(ctx, req)
+}
+
+// ImportProducts opens the import stream. Imports of large files can take
+// minutes, so the stream is bounded by the caller's context rather than a
+// fixed timeout.
+func (c *ProductClient) ImportProducts(ctx context.Context) (pb.ProductService_ImportProductsClient, error) {
+	return c.client.ImportProducts(ctx)
+}
+
+// ExportProducts opens the export stream, bounded by the caller's context
+func (c *ProductClient) ExportProducts(ctx context.Context, req *pb.ExportProductsRequest) (pb.ProductService_ExportProductsClient, error) {
+	return c.client.ExportProducts(ctx, req)
+}
+
+// WatchProducts opens a change stream that stays open until ctx is done
+func (c *ProductClient) WatchProducts(ctx context.Context, req *pb.WatchProductsRequest) (pb.ProductService_WatchProductsClient, error) {
+	return c.client.WatchProducts(ctx, req)
+}
+
+func (c *ProductClient) ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListLowStockProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListLowStockProducts(ctx, req)
+}
+
+func (c *ProductClient) BatchGetProducts(ctx context.Context, req *pb.BatchGetProductsRequest) (*pb.BatchGetProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.BatchGetProducts(ctx, req)
+}
+
+func (c *ProductClient) BatchUpdateProducts(ctx context.Context, req *pb.BatchUpdateProductsRequest) (*pb.BatchUpdateProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return c.client.BatchUpdateProducts(ctx, req)
+}
+
+func (c *ProductClient) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RestoreProduct(ctx, req)
+}
+
+func (c *ProductClient) ListDeletedProducts(ctx context.Context, req *pb.ListDeletedProductsRequest) (*pb.ListDeletedProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListDeletedProducts(ctx, req)
+}
+
+func (c *ProductClient) PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	return c.client.PurgeDeletedProducts(ctx, req)
+}
+
+func (c *ProductClient) GetProductHistory(ctx context.Context, req *pb.GetProductHistoryRequest) (*pb.GetProductHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetProductHistory(ctx, req)
+}
+
+func (c *ProductClient) RevertProductToRevision(ctx context.Context, req *pb.RevertProductToRevisionRequest) (*pb.RevertProductToRevisionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RevertProductToRevision(ctx, req)
+}
+
+func (c *ProductClient) CreatePriceSchedule(ctx context.Context, req *pb.CreatePriceScheduleRequest) (*pb.CreatePriceScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreatePriceSchedule(ctx, req)
+}
+
+func (c *ProductClient) CancelPriceSchedule(ctx context.Context, req *pb.CancelPriceScheduleRequest) (*pb.CancelPriceScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CancelPriceSchedule(ctx, req)
+}
+
+func (c *ProductClient) ListPriceSchedules(ctx context.Context, req *pb.ListPriceSchedulesRequest) (*pb.ListPriceSchedulesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListPriceSchedules(ctx, req)
+}
+
+func (c *ProductClient) SetProductPrices(ctx context.Context, req *pb.SetProductPricesRequest) (*pb.SetProductPricesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.SetProductPrices(ctx, req)
+}
+
+func (c *ProductClient) CreateProductReview(ctx context.Context, req *pb.CreateProductReviewRequest) (*pb.CreateProductReviewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreateProductReview(ctx, req)
+}
+
+func (c *ProductClient) UpdateProductReview(ctx context.Context, req *pb.UpdateProductReviewRequest) (*pb.UpdateProductReviewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateProductReview(ctx, req)
+}
+
+func (c *ProductClient) DeleteProductReview(ctx context.Context, req *pb.DeleteProductReviewRequest) (*pb.DeleteProductReviewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.DeleteProductReview(ctx, req)
+}
+
+func (c *ProductClient) ListProductReviews(ctx context.Context, req *pb.ListProductReviewsRequest) (*pb.ListProductReviewsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListProductReviews(ctx, req)
+}
+
+func (c *ProductClient) ModerateProductReview(ctx context.Context, req *pb.ModerateProductReviewRequest) (*pb.ModerateProductReviewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ModerateProductReview(ctx, req)
+}
+
+func (c *ProductClient) GetWishlist(ctx context.Context, req *pb.GetWishlistRequest) (*pb.GetWishlistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetWishlist(ctx, req)
+}
+
+func (c *ProductClient) AddToWishlist(ctx context.Context, req *pb.AddToWishlistRequest) (*pb.AddToWishlistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.AddToWishlist(ctx, req)
+}
+
+func (c *ProductClient) RemoveFromWishlist(ctx context.Context, req *pb.RemoveFromWishlistRequest) (*pb.RemoveFromWishlistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RemoveFromWishlist(ctx, req)
+}
+
+func (c *ProductClient) ReorderWishlist(ctx context.Context, req *pb.ReorderWishlistRequest) (*pb.ReorderWishlistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ReorderWishlist(ctx, req)
+}
+
+func (c *ProductClient) ShareWishlist(ctx context.Context, req *pb.ShareWishlistRequest) (*pb.ShareWishlistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ShareWishlist(ctx, req)
+}
+
+func (c *ProductClient) GetSharedWishlist(ctx context.Context, req *pb.GetSharedWishlistRequest) (*pb.GetSharedWishlistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetSharedWishlist(ctx, req)
+}
+
+func (c *ProductClient) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.CreateWebhook(ctx, req)
+}
+
+func (c *ProductClient) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateWebhook(ctx, req)
+}
+
+func (c *ProductClient) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.DeleteWebhook(ctx, req)
+}
+
+func (c *ProductClient) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListWebhooks(ctx, req)
+}
+
+func (c *ProductClient) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.ListWebhookDeliveries(ctx, req)
+}
+
+func (c *ProductClient) RetryWebhookDelivery(ctx context.Context, req *pb.RetryWebhookDeliveryRequest) (*pb.RetryWebhookDeliveryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RetryWebhookDelivery(ctx, req)
+}
+
+// SendTestWebhook waits for the partner's endpoint, so it gets longer than
+// the other calls
+func (c *ProductClient) SendTestWebhook(ctx context.Context, req *pb.SendTestWebhookRequest) (*pb.SendTestWebhookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return c.client.SendTestWebhook(ctx, req)
+}
+
+func (c *ProductClient) RecordProductView(ctx context.Context, req *pb.RecordProductViewRequest) (*pb.RecordProductViewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.RecordProductView(ctx, req)
+}
+
+func (c *ProductClient) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.client.GetRecommendations(ctx, req)
+}
@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// storeMetadataKey carries the ID of the store a call is made for. Services
+// that keep per-store data read it from the incoming metadata.
+const storeMetadataKey = "x-store-id"
+
+type storeKey struct{}
+
+// WithStore returns a context whose gRPC calls are made for the store
+func WithStore(ctx context.Context, storeID string) context.Context {
+	return context.WithValue(ctx, storeKey{}, storeID)
+}
+
+// StoreID returns the store set with WithStore, or "" if there is none
+func StoreID(ctx context.Context) string {
+	storeID, _ := ctx.Value(storeKey{}).(string)
+	return storeID
+}
+
+// storeOptions are the dial options that send the context's store along
+// with every call
+func storeOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(storeUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(storeStreamInterceptor),
+	}
+}
+
+func storeUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withStoreMetadata(ctx), method, req, reply, cc, opts...)
+}
+
+func storeStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withStoreMetadata(ctx), desc, cc, method, opts...)
+}
+
+func withStoreMetadata(ctx context.Context) context.Context {
+	if storeID := StoreID(ctx); storeID != "" {
+		return metadata.AppendToOutgoingContext(ctx, storeMetadataKey, storeID)
+	}
+	return ctx
+}
@@ -2,13 +2,37 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
 	Port           string
 	AuthService    string
 	ProductService string
+	CartService    string
+	OrderService   string
 	AllowedOrigins []string
+
+	NotificationService string
+
+	// Image uploads
+	BlobStore      string // "local" or "s3"
+	MediaDir       string
+	MediaBaseURL   string
+	S3Endpoint     string
+	S3Bucket       string
+	S3Region       string
+	S3AccessKey    string
+	S3SecretKey    string
+	S3PublicURL    string
+	MaxImageSize   int64
+	MaxImageFiles  int
+	ThumbnailSizes []int
+
+	// How often products past their trash retention are purged
+	TrashPurgeInterval time.Duration
 }
 
 func Load() *Config {
@@ -16,10 +40,29 @@ func Load() *Config {
 		Port:           getEnv("PORT", "8080"),
 		AuthService:    getEnv("AUTH_SERVICE", "localhost:8081"),
 		ProductService: getEnv("PRODUCT_SERVICE", "localhost:8082"),
+		CartService:    getEnv("CART_SERVICE", "localhost:8084"),
+		OrderService:   getEnv("ORDER_SERVICE", "localhost:8085"),
 		AllowedOrigins: []string{
 			getEnv("CLIENT_URL", "http://localhost:8083"),
 			"http://localhost:3000", // For development
 		},
+
+		NotificationService: getEnv("NOTIFICATION_SERVICE", "localhost:8086"),
+
+		BlobStore:      getEnv("BLOB_STORE", "local"),
+		MediaDir:       getEnv("MEDIA_DIR", "./uploads"),
+		MediaBaseURL:   getEnv("MEDIA_BASE_URL", "http://localhost:8080/media"),
+		S3Endpoint:     getEnv("S3_ENDPOINT", ""),
+		S3Bucket:       getEnv("S3_BUCKET", ""),
+		S3Region:       getEnv("S3_REGION", "us-east-1"),
+		S3AccessKey:    getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:    getEnv("S3_SECRET_KEY", ""),
+		S3PublicURL:    getEnv("S3_PUBLIC_URL", ""),
+		MaxImageSize:   int64(getEnvInt("MAX_IMAGE_SIZE_MB", 5)) << 20,
+		MaxImageFiles:  getEnvInt("MAX_IMAGE_FILES", 10),
+		ThumbnailSizes: getEnvInts("THUMBNAIL_SIZES", []int{150, 300, 600}),
+
+		TrashPurgeInterval: time.Duration(getEnvInt("TRASH_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
 	}
 }
 
@@ -28,4 +71,30 @@ func getEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvInts parses a comma-separated list such as "150,300,600"
+func getEnvInts(key string, defaultValue []int) []int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var values []int
+	for _, part := range strings.Split(value, ",") {
+		if n, err := strconv.Atoi(strings.TrimSpace(part)); err == nil && n > 0 {
+			values = append(values, n)
+		}
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
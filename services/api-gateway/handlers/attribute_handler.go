@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+func (h *ProductHandler) GetCategoryAttributes(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	req := &pb.GetCategoryAttributesRequest{
+		CategoryId:       vars["id"],
+		IncludeInherited: r.URL.Query().Get("inherited") != "false",
+	}
+
+	resp, err := h.productClient.GetCategoryAttributes(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) SetCategoryAttributes(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var attributesReq struct {
+		Attributes []struct {
+			Key        string   `json:"key"`
+			Label      string   `json:"label"`
+			Type       string   `json:"type"`
+			EnumValues []string `json:"enum_values"`
+			Required   bool     `json:"required"`
+		} `json:"attributes"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&attributesReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.SetCategoryAttributesRequest{
+		Token:      token,
+		CategoryId: vars["id"],
+	}
+	for _, attribute := range attributesReq.Attributes {
+		req.Attributes = append(req.Attributes, &pb.AttributeDefinition{
+			Key:        attribute.Key,
+			Label:      attribute.Label,
+			Type:       attribute.Type,
+			EnumValues: attribute.EnumValues,
+			Required:   attribute.Required,
+		})
+	}
+
+	resp, err := h.productClient.SetCategoryAttributes(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// attributeFilters collects filters such as attr.ram_gb>=16 from the raw query
+// string, since url.Values would split them at the '='
+func attributeFilters(r *http.Request) []string {
+	var filters []string
+	for _, part := range strings.Split(r.URL.RawQuery, "&") {
+		filter, err := url.QueryUnescape(part)
+		if err != nil || !strings.HasPrefix(filter, "attr.") {
+			continue
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// attributesToStrings converts JSON attribute values to the text form the
+// product service parses; null becomes empty, which removes the attribute
+func attributesToStrings(attributes map[string]interface{}) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	values := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		switch v := value.(type) {
+		case nil:
+			values[key] = ""
+		case string:
+			values[key] = v
+		case float64:
+			values[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			values[key] = strconv.FormatBool(v)
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+	return values
+}
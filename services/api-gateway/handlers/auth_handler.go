@@ -148,10 +148,11 @@ func (h *AuthHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Fields left out of the body are left unchanged
 	var updateReq struct {
-		FirstName string `json:"first_name"`
-		LastName  string `json:"last_name"`
-		Username  string `json:"username"`
+		FirstName *string `json:"first_name"`
+		LastName  *string `json:"last_name"`
+		Username  *string `json:"username"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+func (h *ProductHandler) BatchGetProducts(w http.ResponseWriter, r *http.Request) {
+	var batchReq struct {
+		IDs  []string `json:"ids"`
+		SKUs []string `json:"skus"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&batchReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.BatchGetProductsRequest{
+		Ids:  batchReq.IDs,
+		Skus: batchReq.SKUs,
+	}
+
+	resp, err := h.productClient.BatchGetProducts(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) BatchUpdateProducts(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var batchReq struct {
+		Updates []struct {
+			ID            string      `json:"id"`
+			Price         *moneyInput `json:"price"`
+			StockQuantity *int32      `json:"stock_quantity"`
+			IsActive      *bool       `json:"is_active"`
+			Version       *int32      `json:"version"`
+		} `json:"updates"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&batchReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.BatchUpdateProductsRequest{
+		Token:     token,
+		UpdatedBy: user.Id,
+	}
+	for _, update := range batchReq.Updates {
+		req.Updates = append(req.Updates, &pb.ProductPatch{
+			Id:            update.ID,
+			Price:         update.Price.proto(),
+			StockQuantity: update.StockQuantity,
+			IsActive:      update.IsActive,
+			Version:       update.Version,
+		})
+	}
+
+	resp, err := h.productClient.BatchUpdateProducts(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Per-item failures are reported in the results, so a batch that ran is a 200
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/cart"
+)
+
+// Guests are told apart by a cart session, sent in the X-Cart-Session
+// header or, for browsers talking to the API directly, the cart_session
+// cookie. Signed-in users' carts go by their user ID instead.
+const (
+	cartSessionHeader = "X-Cart-Session"
+	cartSessionCookie = "cart_session"
+)
+
+type CartHandler struct {
+	cartClient *clients.CartClient
+}
+
+func NewCartHandler(cartClient *clients.CartClient) *CartHandler {
+	return &CartHandler{
+		cartClient: cartClient,
+	}
+}
+
+type addCartItemRequest struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id"`
+	Quantity  int32  `json:"quantity"`
+}
+
+type updateCartItemRequest struct {
+	Quantity int32 `json:"quantity"`
+}
+
+type mergeCartsRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// cartSession returns the guest's cart session from the request, or "" if
+// it has none
+func cartSession(r *http.Request) string {
+	if session := r.Header.Get(cartSessionHeader); session != "" {
+		return session
+	}
+	if cookie, err := r.Cookie(cartSessionCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// cartOwner identifies the cart a request is about. Guests without a cart
+// session get a new one when create is set; otherwise the owner is nil.
+func cartOwner(w http.ResponseWriter, r *http.Request, create bool) (*pb.CartOwner, error) {
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		return &pb.CartOwner{UserId: user.Id}, nil
+	}
+
+	session := cartSession(r)
+	if session == "" {
+		if !create {
+			return nil, nil
+		}
+
+		buf := make([]byte, 16)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		session = hex.EncodeToString(buf)
+		http.SetCookie(w, &http.Cookie{
+			Name:     cartSessionCookie,
+			Value:    session,
+			Path:     "/",
+			MaxAge:   30 * 24 * 60 * 60,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	// Echoed back so API clients learn the session to send next time
+	w.Header().Set(cartSessionHeader, session)
+	return &pb.CartOwner{SessionId: session}, nil
+}
+
+func writeCartResponse(w http.ResponseWriter, success bool, successStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(successStatus)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetCart returns the caller's cart with current prices and stock; guests
+// without a cart session get an empty cart
+func (h *CartHandler) GetCart(w http.ResponseWriter, r *http.Request) {
+	owner, err := cartOwner(w, r, false)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if owner == nil {
+		writeCartResponse(w, true, http.StatusOK, &pb.GetCartResponse{Cart: &pb.Cart{}})
+		return
+	}
+
+	token, _ := r.Context().Value("token").(string)
+	resp, err := h.cartClient.GetCart(r.Context(), &pb.GetCartRequest{Token: token, Owner: owner})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeCartResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// AddCartItem puts a product in the cart. A guest's first item starts their
+// cart session.
+func (h *CartHandler) AddCartItem(w http.ResponseWriter, r *http.Request) {
+	var itemReq addCartItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&itemReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if itemReq.Quantity == 0 {
+		itemReq.Quantity = 1
+	}
+
+	owner, err := cartOwner(w, r, true)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	token, _ := r.Context().Value("token").(string)
+	req := &pb.AddCartItemRequest{
+		Token:     token,
+		Owner:     owner,
+		ProductId: itemReq.ProductID,
+		VariantId: itemReq.VariantID,
+		Quantity:  itemReq.Quantity,
+	}
+
+	resp, err := h.cartClient.AddCartItem(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeCartResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// UpdateCartItem sets an item's quantity; zero removes it
+func (h *CartHandler) UpdateCartItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemID := vars["itemId"]
+
+	var itemReq updateCartItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&itemReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	owner, err := cartOwner(w, r, false)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if owner == nil {
+		http.Error(w, "Cart item not found", http.StatusNotFound)
+		return
+	}
+
+	token, _ := r.Context().Value("token").(string)
+	req := &pb.UpdateCartItemRequest{
+		Token:    token,
+		Owner:    owner,
+		ItemId:   itemID,
+		Quantity: itemReq.Quantity,
+	}
+
+	resp, err := h.cartClient.UpdateCartItem(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeCartResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+func (h *CartHandler) RemoveCartItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemID := vars["itemId"]
+
+	owner, err := cartOwner(w, r, false)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if owner == nil {
+		http.Error(w, "Cart item not found", http.StatusNotFound)
+		return
+	}
+
+	token, _ := r.Context().Value("token").(string)
+	resp, err := h.cartClient.RemoveCartItem(r.Context(), &pb.RemoveCartItemRequest{Token: token, Owner: owner, ItemId: itemID})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeCartResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+func (h *CartHandler) ClearCart(w http.ResponseWriter, r *http.Request) {
+	owner, err := cartOwner(w, r, false)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if owner == nil {
+		writeCartResponse(w, true, http.StatusOK, &pb.ClearCartResponse{Cart: &pb.Cart{}})
+		return
+	}
+
+	token, _ := r.Context().Value("token").(string)
+	resp, err := h.cartClient.ClearCart(r.Context(), &pb.ClearCartRequest{Token: token, Owner: owner})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeCartResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// MergeCarts moves the guest cart into the signed-in user's cart; clients
+// call it right after login. The session comes from the body, or else from
+// the header or cookie, and the cookie is cleared afterwards.
+func (h *CartHandler) MergeCarts(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var mergeReq mergeCartsRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&mergeReq); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+	if mergeReq.SessionID == "" {
+		mergeReq.SessionID = cartSession(r)
+	}
+	if mergeReq.SessionID == "" {
+		http.Error(w, "Cart session required", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.MergeCartsRequest{
+		Token:     token,
+		SessionId: mergeReq.SessionID,
+		UserId:    user.Id,
+	}
+
+	resp, err := h.cartClient.MergeCarts(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if resp.Response.Success {
+		http.SetCookie(w, &http.Cookie{Name: cartSessionCookie, Value: "", Path: "/", MaxAge: -1})
+	}
+	writeCartResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+func (h *ProductHandler) GetCategoryTree(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	req := &pb.GetCategoryTreeRequest{
+		RootId:          query.Get("root_id"),
+		IncludeInactive: query.Get("include_inactive") == "true",
+	}
+
+	resp, err := h.productClient.GetCategoryTree(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var createReq struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		ParentID    string `json:"parent_id"`
+		Slug        string `json:"slug"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.CreateCategoryRequest{
+		Token:       token,
+		Name:        createReq.Name,
+		Description: createReq.Description,
+		ParentId:    createReq.ParentID,
+		Slug:        createReq.Slug,
+	}
+
+	resp, err := h.productClient.CreateCategory(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	categoryID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var updateReq struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Slug        string `json:"slug"`
+		IsActive    bool   `json:"is_active"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.UpdateCategoryRequest{
+		Token:       token,
+		Id:          categoryID,
+		Name:        updateReq.Name,
+		Description: updateReq.Description,
+		Slug:        updateReq.Slug,
+		IsActive:    updateReq.IsActive,
+	}
+
+	resp, err := h.productClient.UpdateCategory(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	categoryID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	req := &pb.DeleteCategoryRequest{
+		Token: token,
+		Id:    categoryID,
+	}
+
+	resp, err := h.productClient.DeleteCategory(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) MoveCategory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	categoryID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var moveReq struct {
+		ParentID string `json:"parent_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&moveReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.MoveCategoryRequest{
+		Token:    token,
+		Id:       categoryID,
+		ParentId: moveReq.ParentID,
+	}
+
+	resp, err := h.productClient.MoveCategory(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
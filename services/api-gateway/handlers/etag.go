@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// productETag is the entity tag of a product version
+func productETag(version int32) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// ifMatchVersion reads the product version from the If-Match header. ok is
+// false when the request has no If-Match header.
+func ifMatchVersion(r *http.Request) (version int32, ok bool, err error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return 0, false, nil
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	parsed, err := strconv.ParseInt(tag, 10, 32)
+	if err != nil || parsed <= 0 {
+		return 0, true, fmt.Errorf("If-Match must be a single product ETag")
+	}
+	return int32(parsed), true, nil
+}
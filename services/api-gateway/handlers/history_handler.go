@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+// GetProductHistory lists who changed the product and how. Only the
+// product's owner and admins can see it.
+func (h *ProductHandler) GetProductHistory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+	query := r.URL.Query()
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	req := &pb.GetProductHistoryRequest{
+		Token:     token,
+		ProductId: productID,
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+
+	resp, err := h.productClient.GetProductHistory(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RevertProduct puts the product back the way it was after a revision. Like
+// updates, it needs the current version as If-Match or in the body.
+func (h *ProductHandler) RevertProduct(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var revertReq struct {
+		RevisionID string `json:"revision_id"`
+		Version    int32  `json:"version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&revertReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	version, err := requestVersion(r, revertReq.Version)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if version == 0 {
+		http.Error(w, "Product version is required; send it as If-Match or version", http.StatusPreconditionRequired)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	req := &pb.RevertProductToRevisionRequest{
+		Token:      token,
+		ProductId:  productID,
+		RevisionId: revertReq.RevisionID,
+		Version:    version,
+		RevertedBy: user.Id,
+	}
+
+	resp, err := h.productClient.RevertProductToRevision(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Product != nil {
+		w.Header().Set("ETag", productETag(resp.Product.Version))
+	}
+	switch {
+	case resp.Response.Success:
+		w.WriteHeader(http.StatusOK)
+	case resp.Conflict:
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// checkProductOwner allows the store's admins and the user who created the
+// product, returning 200 or the status and message to reject the request
+// with. Products in the trash can't be looked up, so only admins get past
+// that.
+func (h *ProductHandler) checkProductOwner(ctx context.Context, user *authPb.User, productID string) (int, string) {
+	if isStoreAdmin(user) {
+		return http.StatusOK, ""
+	}
+
+	resp, err := h.productClient.GetProduct(ctx, &pb.GetProductRequest{Id: productID})
+	if err != nil {
+		return http.StatusInternalServerError, "Internal server error"
+	}
+	if !resp.Response.Success {
+		return http.StatusNotFound, "Product not found"
+	}
+	if resp.Product.CreatedBy != user.Id {
+		return http.StatusForbidden, "Only the product's owner can do this"
+	}
+
+	return http.StatusOK, ""
+}
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+	"github.com/martbul/playground_microservices/services/api-gateway/media"
+	"github.com/martbul/playground_microservices/services/api-gateway/storage"
+)
+
+type ImageHandler struct {
+	productClient  *clients.ProductClient
+	blobStore      storage.BlobStore
+	maxImageSize   int64
+	maxImageFiles  int
+	thumbnailSizes []int
+}
+
+func NewImageHandler(productClient *clients.ProductClient, blobStore storage.BlobStore, maxImageSize int64, maxImageFiles int, thumbnailSizes []int) *ImageHandler {
+	return &ImageHandler{
+		productClient:  productClient,
+		blobStore:      blobStore,
+		maxImageSize:   maxImageSize,
+		maxImageFiles:  maxImageFiles,
+		thumbnailSizes: thumbnailSizes,
+	}
+}
+
+// UploadImages accepts one or more files in the multipart field "images" and
+// appends them to the product's images in the order they were sent
+func (h *ImageHandler) UploadImages(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	// Cap the whole body, leaving room for multipart headers
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxImageSize*int64(h.maxImageFiles)+1<<20)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeImageError(w, http.StatusRequestEntityTooLarge, "Upload is too large")
+			return
+		}
+		writeImageError(w, http.StatusBadRequest, "Invalid multipart form")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	files := r.MultipartForm.File["images"]
+	if len(files) == 0 {
+		files = r.MultipartForm.File["image"]
+	}
+	if len(files) == 0 {
+		writeImageError(w, http.StatusBadRequest, "No images uploaded; use the \"images\" form field")
+		return
+	}
+	if len(files) > h.maxImageFiles {
+		writeImageError(w, http.StatusBadRequest, fmt.Sprintf("At most %d images can be uploaded at once", h.maxImageFiles))
+		return
+	}
+
+	productResp, err := h.productClient.GetProduct(r.Context(), &pb.GetProductRequest{Id: productID})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !productResp.Response.Success {
+		writeImageError(w, http.StatusNotFound, productResp.Response.Message)
+		return
+	}
+
+	var images []*pb.ProductImage
+	for _, file := range files {
+		image, status, err := h.storeImage(r.Context(), token, productID, file)
+		if err != nil {
+			log.Printf("Image upload for product %s failed (%s): %v", productID, file.Filename, err)
+			writeImageError(w, status, fmt.Sprintf("%s: %v", file.Filename, err))
+			return
+		}
+		images = append(images, image)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(&pb.ListProductImagesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Images uploaded successfully",
+		},
+		Images: images,
+	})
+}
+
+// storeImage validates one upload, writes it and its thumbnails to the blob
+// store and records it with the product service. Blobs are removed again if
+// any later step fails.
+func (h *ImageHandler) storeImage(ctx context.Context, token, productID string, file *multipart.FileHeader) (*pb.ProductImage, int, error) {
+	if file.Size > h.maxImageSize {
+		return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("image exceeds %d MB", h.maxImageSize>>20)
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("failed to read upload")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, h.maxImageSize+1))
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("failed to read upload")
+	}
+	if int64(len(data)) > h.maxImageSize {
+		return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("image exceeds %d MB", h.maxImageSize>>20)
+	}
+
+	img, err := media.Decode(data)
+	if err != nil {
+		if errors.Is(err, media.ErrUnsupportedType) {
+			return nil, http.StatusUnsupportedMediaType, err
+		}
+		return nil, http.StatusBadRequest, err
+	}
+
+	id, err := randomID()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	prefix := fmt.Sprintf("products/%s/%s", productID, id)
+
+	var storedKeys []string
+	cleanup := func() {
+		h.deleteBlobs(context.Background(), storedKeys)
+	}
+
+	originalKey := fmt.Sprintf("%s/original.%s", prefix, img.Extension)
+	url, err := h.blobStore.Put(ctx, originalKey, img.ContentType, data)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to store image")
+	}
+	storedKeys = append(storedKeys, originalKey)
+
+	thumbnails := make(map[string]string, len(h.thumbnailSizes))
+	for _, size := range h.thumbnailSizes {
+		thumb, contentType, extension, err := img.Thumbnail(size)
+		if err != nil {
+			cleanup()
+			return nil, http.StatusInternalServerError, err
+		}
+
+		key := fmt.Sprintf("%s/thumb_%d.%s", prefix, size, extension)
+		thumbURL, err := h.blobStore.Put(ctx, key, contentType, thumb)
+		if err != nil {
+			cleanup()
+			return nil, http.StatusInternalServerError, fmt.Errorf("failed to store thumbnail")
+		}
+		storedKeys = append(storedKeys, key)
+		thumbnails[strconv.Itoa(size)] = thumbURL
+	}
+
+	resp, err := h.productClient.AddProductImage(ctx, &pb.AddProductImageRequest{
+		Token:     token,
+		ProductId: productID,
+		Image: &pb.ProductImage{
+			Url:         url,
+			ContentType: img.ContentType,
+			SizeBytes:   int64(len(data)),
+			Width:       int32(img.Width),
+			Height:      int32(img.Height),
+			Thumbnails:  thumbnails,
+			StorageKeys: storedKeys,
+		},
+	})
+	if err != nil {
+		cleanup()
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to save image")
+	}
+	if !resp.Response.Success {
+		cleanup()
+		return nil, http.StatusBadRequest, errors.New(resp.Response.Message)
+	}
+
+	return resp.Image, http.StatusCreated, nil
+}
+
+func (h *ImageHandler) ListImages(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	resp, err := h.productClient.ListProductImages(r.Context(), &pb.ListProductImagesRequest{
+		ProductId: vars["id"],
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ImageHandler) DeleteImage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := h.productClient.DeleteProductImage(r.Context(), &pb.DeleteProductImageRequest{
+		Token: token,
+		Id:    vars["imageId"],
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if resp.Response.Success && resp.Image != nil {
+		h.deleteBlobs(r.Context(), resp.Image.StorageKeys)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ImageHandler) ReorderImages(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var orderReq struct {
+		ImageIDs []string `json:"image_ids"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&orderReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.productClient.ReorderProductImages(r.Context(), &pb.ReorderProductImagesRequest{
+		Token:     token,
+		ProductId: vars["id"],
+		ImageIds:  orderReq.ImageIDs,
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ImageHandler) deleteBlobs(ctx context.Context, keys []string) {
+	deleteBlobs(ctx, h.blobStore, keys)
+}
+
+// deleteBlobs removes stored files, logging rather than failing since the
+// image records are already gone
+func deleteBlobs(ctx context.Context, blobStore storage.BlobStore, keys []string) {
+	for _, key := range keys {
+		if err := blobStore.Delete(ctx, key); err != nil {
+			log.Printf("Failed to remove blob %s: %v", key, err)
+		}
+	}
+}
+
+func writeImageError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&pb.ListProductImagesResponse{
+		Response: &commonPb.Response{
+			Success: false,
+			Message: message,
+		},
+	})
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate image ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,542 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+// maxImportSize caps the size of an uploaded import file
+const maxImportSize = 50 << 20
+
+// exportColumns are written by CSV exports. Everything up to attributes is
+// read back by imports; the rest is informational and ignored on import.
+// Prices are plain decimals in the currency column's currency.
+var exportColumns = []string{
+	"sku", "name", "description", "price", "currency", "stock_quantity", "category_id", "category",
+	"image_url", "reorder_threshold", "compare_at_price", "attributes",
+	"id", "is_active", "available_quantity", "effective_price", "created_at", "updated_at",
+}
+
+// ImportProducts streams an uploaded CSV or JSON Lines file to the product
+// service. The file is sent either as the "file" field of a multipart form or
+// as the raw request body.
+func (h *ProductHandler) ImportProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var createdBy string
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		createdBy = user.Id
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	source, filename, err := importSource(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer source.Close()
+
+	format := importFormat(query.Get("format"), filename, r.Header.Get("Content-Type"))
+	var reader importReader
+	switch format {
+	case "csv":
+		reader, err = newCSVImportReader(source)
+	case "jsonl":
+		reader = newJSONLImportReader(source)
+	default:
+		err = fmt.Errorf("unknown import format; use format=csv or format=jsonl")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	batchSize, _ := strconv.Atoi(query.Get("batch_size"))
+
+	stream, err := h.productClient.ImportProducts(r.Context())
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = stream.Send(&pb.ImportProductsRequest{
+		Payload: &pb.ImportProductsRequest_Options{
+			Options: &pb.ImportOptions{
+				Token:     token,
+				CreatedBy: createdBy,
+				DryRun:    query.Get("dry_run") == "true",
+				Upsert:    query.Get("upsert") == "true",
+				BatchSize: int32(batchSize),
+			},
+		},
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Rows the gateway cannot parse never reach the product service; they
+	// are merged into its result at the end
+	var parseErrors []*pb.ImportRowError
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			http.Error(w, "Invalid import file: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if record.err != nil {
+			parseErrors = append(parseErrors, &pb.ImportRowError{
+				Row:     record.row,
+				Sku:     record.product.GetSku(),
+				Message: record.err.Error(),
+			})
+			continue
+		}
+
+		err = stream.Send(&pb.ImportProductsRequest{
+			Payload: &pb.ImportProductsRequest_Row{
+				Row: &pb.ImportProductRow{Row: record.row, Product: record.product},
+			},
+		})
+		if err != nil {
+			// The service stopped reading; its reason comes back from CloseAndRecv
+			break
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Printf("Import products error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if resp.Response.Success && len(parseErrors) > 0 {
+		resp.TotalRows += int32(len(parseErrors))
+		resp.Failed += int32(len(parseErrors))
+		resp.Errors = append(resp.Errors, parseErrors...)
+		sort.SliceStable(resp.Errors, func(i, j int) bool { return resp.Errors[i].Row < resp.Errors[j].Row })
+		resp.Response.Message = fmt.Sprintf("%d of %d rows failed", resp.Failed, resp.TotalRows)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ExportProducts streams the catalog as CSV (the default) or JSON Lines.
+// Admins export everything; other users export the products they created.
+func (h *ProductHandler) ExportProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	createdBy := query.Get("created_by")
+	if !isStoreAdmin(user) {
+		createdBy = user.Id
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "jsonl" {
+		http.Error(w, "Unknown export format; use format=csv or format=jsonl", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.ExportProductsRequest{
+		Token:           token,
+		Category:        query.Get("category"),
+		CategoryId:      query.Get("category_id"),
+		IncludeInactive: query.Get("include_inactive") == "true",
+		CreatedBy:       createdBy,
+	}
+
+	stream, err := h.productClient.ExportProducts(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Wait for the first product so errors like an unknown category can
+	// still be reported with a proper status code
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		http.Error(w, "Export failed: "+status.Convert(err).Message(), http.StatusBadRequest)
+		return
+	}
+
+	filename := fmt.Sprintf("products-%s.%s", time.Now().Format("20060102"), format)
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.WriteHeader(http.StatusOK)
+
+	var write func(*pb.Product) error
+	var flush func()
+	if format == "csv" {
+		writer := csv.NewWriter(w)
+		writer.Write(exportColumns)
+		write = func(product *pb.Product) error { return writer.Write(productToCSV(product)) }
+		flush = writer.Flush
+	} else {
+		encoder := json.NewEncoder(w)
+		write = func(product *pb.Product) error { return encoder.Encode(product) }
+		flush = func() {}
+	}
+	flusher, _ := w.(http.Flusher)
+
+	msg, count := first, 0
+	for err == nil {
+		if err := write(msg.Product); err != nil {
+			log.Printf("Export products error: %v", err)
+			return
+		}
+		if count++; count%100 == 0 {
+			flush()
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		msg, err = stream.Recv()
+	}
+	flush()
+	if err != io.EOF {
+		// Headers are already out, so all we can do is cut the download short
+		log.Printf("Export products error: %v", err)
+	}
+}
+
+func productToCSV(product *pb.Product) []string {
+	var threshold string
+	if product.ReorderThreshold != nil {
+		threshold = strconv.Itoa(int(*product.ReorderThreshold))
+	}
+
+	var attributes string
+	if len(product.Attributes) > 0 {
+		encoded, _ := json.Marshal(product.Attributes)
+		attributes = string(encoded)
+	}
+
+	return []string{
+		product.Sku,
+		product.Name,
+		product.Description,
+		formatMoney(product.Price),
+		product.Price.GetCurrencyCode(),
+		strconv.Itoa(int(product.StockQuantity)),
+		product.CategoryId,
+		product.Category,
+		product.ImageUrl,
+		threshold,
+		formatMoney(product.CompareAtPrice),
+		attributes,
+		product.Id,
+		strconv.FormatBool(product.IsActive),
+		strconv.Itoa(int(product.AvailableQuantity)),
+		formatMoney(product.EffectivePrice),
+		product.CreatedAt,
+		product.UpdatedAt,
+	}
+}
+
+// importSource returns the uploaded file and its name, if it has one
+func importSource(r *http.Request) (io.ReadCloser, string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.Body, "", nil
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid multipart form")
+	}
+	// Read the part directly rather than through ParseMultipartForm so a large
+	// file is streamed instead of being buffered to disk first
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, "", fmt.Errorf("no file uploaded")
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid multipart form")
+		}
+		if part.FormName() == "file" {
+			return part, part.FileName(), nil
+		}
+		part.Close()
+	}
+}
+
+// importFormat picks the file format from an explicit query parameter, the
+// file extension or the content type, in that order
+func importFormat(format, filename, contentType string) string {
+	switch strings.ToLower(format) {
+	case "csv":
+		return "csv"
+	case "jsonl", "ndjson":
+		return "jsonl"
+	case "":
+	default:
+		return ""
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return "csv"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return "csv"
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return "jsonl"
+	}
+	return ""
+}
+
+// importRecord is one row of an import file. err is set when the row could
+// not be parsed; the rest of the file can still be imported.
+type importRecord struct {
+	row     int32
+	product *pb.CreateProductRequest
+	err     error
+}
+
+// importReader yields the rows of an import file until io.EOF. Any other
+// error means the file cannot be read any further.
+type importReader interface {
+	Next() (*importRecord, error)
+}
+
+// csvImportReader reads a CSV file with a header row. Besides the columns in
+// exportColumns, "attr.<key>" columns set single attributes.
+type csvImportReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func newCSVImportReader(source io.Reader) (*csvImportReader, error) {
+	reader := csv.NewReader(source)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	// Spreadsheet exports often start with a UTF-8 byte order mark
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"sku", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("the CSV header must include a %q column", required)
+		}
+	}
+
+	return &csvImportReader{reader: reader, columns: columns}, nil
+}
+
+func (c *csvImportReader) Next() (*importRecord, error) {
+	fields, err := c.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &importRecord{row: int32(parseErr.StartLine), err: parseErr.Err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	line, _ := c.reader.FieldPos(0)
+	record := &importRecord{row: int32(line), product: &pb.CreateProductRequest{}}
+	record.err = c.parse(fields, record.product)
+	return record, nil
+}
+
+func (c *csvImportReader) parse(fields []string, product *pb.CreateProductRequest) error {
+	get := func(column string) string {
+		if i, ok := c.columns[column]; ok {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+
+	product.Sku = get("sku")
+	product.Name = get("name")
+	product.Description = get("description")
+	product.CategoryId = get("category_id")
+	product.Category = get("category")
+	product.ImageUrl = get("image_url")
+
+	currency := get("currency")
+	if value := get("price"); value != "" {
+		price, err := parseMoney(value, currency)
+		if err != nil {
+			return fmt.Errorf("invalid price %q", value)
+		}
+		product.Price = price
+	}
+	if value := get("stock_quantity"); value != "" {
+		stock, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid stock_quantity %q", value)
+		}
+		product.StockQuantity = int32(stock)
+	}
+	if value := get("reorder_threshold"); value != "" {
+		threshold, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid reorder_threshold %q", value)
+		}
+		reorderThreshold := int32(threshold)
+		product.ReorderThreshold = &reorderThreshold
+	}
+	if value := get("compare_at_price"); value != "" {
+		compareAtPrice, err := parseMoney(value, currency)
+		if err != nil {
+			return fmt.Errorf("invalid compare_at_price %q", value)
+		}
+		product.CompareAtPrice = compareAtPrice
+	}
+
+	var attributes map[string]interface{}
+	if value := get("attributes"); value != "" {
+		if err := json.Unmarshal([]byte(value), &attributes); err != nil {
+			return fmt.Errorf("attributes must be a JSON object")
+		}
+	}
+	for column, i := range c.columns {
+		key, ok := strings.CutPrefix(column, "attr.")
+		if !ok || key == "" || strings.TrimSpace(fields[i]) == "" {
+			continue
+		}
+		if attributes == nil {
+			attributes = make(map[string]interface{})
+		}
+		attributes[key] = strings.TrimSpace(fields[i])
+	}
+	product.Attributes = attributesToStrings(attributes)
+
+	return nil
+}
+
+// jsonlImportReader reads one JSON object per line, using the same fields as
+// the create product endpoint. Blank lines are skipped.
+type jsonlImportReader struct {
+	reader *bufio.Reader
+	line   int32
+}
+
+func newJSONLImportReader(source io.Reader) *jsonlImportReader {
+	return &jsonlImportReader{reader: bufio.NewReader(source)}
+}
+
+func (j *jsonlImportReader) Next() (*importRecord, error) {
+	for {
+		data, err := j.reader.ReadBytes('\n')
+		if len(data) == 0 && err != nil {
+			return nil, err
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		j.line++
+
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+
+		var row struct {
+			Name             string                 `json:"name"`
+			Description      string                 `json:"description"`
+			Price            *moneyInput            `json:"price"`
+			StockQuantity    int32                  `json:"stock_quantity"`
+			CategoryID       string                 `json:"category_id"`
+			Category         string                 `json:"category"`
+			ImageURL         string                 `json:"image_url"`
+			SKU              string                 `json:"sku"`
+			Attributes       map[string]interface{} `json:"attributes"`
+			ReorderThreshold *int32                 `json:"reorder_threshold"`
+			CompareAtPrice   *moneyInput            `json:"compare_at_price"`
+		}
+		record := &importRecord{row: j.line}
+		if err := json.Unmarshal(data, &row); err != nil {
+			record.err = fmt.Errorf("invalid JSON: %v", err)
+			return record, nil
+		}
+
+		record.product = &pb.CreateProductRequest{
+			Name:             row.Name,
+			Description:      row.Description,
+			Price:            row.Price.proto(),
+			StockQuantity:    row.StockQuantity,
+			CategoryId:       row.CategoryID,
+			Category:         row.Category,
+			ImageUrl:         row.ImageURL,
+			Sku:              row.SKU,
+			Attributes:       attributesToStrings(row.Attributes),
+			ReorderThreshold: row.ReorderThreshold,
+			CompareAtPrice:   row.CompareAtPrice.proto(),
+		}
+		return record, nil
+	}
+}
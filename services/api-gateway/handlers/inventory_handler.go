@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+// AdjustStock changes the product's stock by a delta. Like the other
+// stock endpoints it is limited to the owner and admins.
+func (h *ProductHandler) AdjustStock(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	var adjustReq struct {
+		VariantID string `json:"variant_id"`
+		Delta     int32  `json:"delta"`
+		Reason    string `json:"reason"`
+		Reference string `json:"reference"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&adjustReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.AdjustStockRequest{
+		Token:     token,
+		ProductId: productID,
+		VariantId: adjustReq.VariantID,
+		Delta:     adjustReq.Delta,
+		Reason:    adjustReq.Reason,
+		Reference: adjustReq.Reference,
+	}
+
+	resp, err := h.productClient.AdjustStock(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) ListStockMovements(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+	query := r.URL.Query()
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	req := &pb.ListStockMovementsRequest{
+		Token:     token,
+		ProductId: productID,
+		VariantId: query.Get("variant_id"),
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+
+	resp, err := h.productClient.ListStockMovements(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ReserveStock holds stock for a cart or order. Carts and orders reserve
+// through product-service directly, so here reservations are for admins only.
+func (h *ProductHandler) ReserveStock(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can manage reservations", http.StatusForbidden)
+		return
+	}
+
+	var reserveReq struct {
+		Reference string `json:"reference"`
+		Items     []struct {
+			ProductID string `json:"product_id"`
+			VariantID string `json:"variant_id"`
+			Quantity  int32  `json:"quantity"`
+		} `json:"items"`
+		TTLSeconds int32 `json:"ttl_seconds"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&reserveReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.ReserveStockRequest{
+		Token:      token,
+		Reference:  reserveReq.Reference,
+		TtlSeconds: reserveReq.TTLSeconds,
+	}
+	for _, item := range reserveReq.Items {
+		req.Items = append(req.Items, &pb.ReservationItem{
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	resp, err := h.productClient.ReserveStock(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) CommitReservation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	reservationID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can manage reservations", http.StatusForbidden)
+		return
+	}
+
+	req := &pb.CommitReservationRequest{
+		Token:         token,
+		ReservationId: reservationID,
+	}
+
+	resp, err := h.productClient.CommitReservation(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	reservationID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can manage reservations", http.StatusForbidden)
+		return
+	}
+
+	req := &pb.ReleaseReservationRequest{
+		Token:         token,
+		ReservationId: reservationID,
+	}
+
+	resp, err := h.productClient.ReleaseReservation(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListLowStockProducts lists stock at or below its reorder threshold. Admins
+// see every product; everyone else only sees the products they created.
+func (h *ProductHandler) ListLowStockProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	createdBy := query.Get("created_by")
+	if !isStoreAdmin(user) {
+		createdBy = user.Id
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	req := &pb.ListLowStockProductsRequest{
+		Token:     token,
+		CreatedBy: createdBy,
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+
+	resp, err := h.productClient.ListLowStockProducts(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+// applyProductMergePatch copies the members of a merge patch onto req.
+// Nulls clear the description, image URL, reorder threshold, compare-at
+// price and single attributes; fields every product needs can't be
+// cleared.
+func applyProductMergePatch(req *pb.UpdateProductRequest, patch map[string]json.RawMessage) error {
+	var err error
+	for field, value := range patch {
+		switch field {
+		case "name":
+			req.Name, err = mergePatchValue[string](field, value, false)
+		case "description":
+			req.Description, err = mergePatchValue[string](field, value, true)
+		case "price":
+			var price *moneyInput
+			if price, err = mergePatchValue[moneyInput](field, value, false); err == nil {
+				req.Price = price.proto()
+			}
+		case "stock_quantity":
+			req.StockQuantity, err = mergePatchValue[int32](field, value, false)
+		case "category_id":
+			req.CategoryId, err = mergePatchValue[string](field, value, false)
+		case "category":
+			req.Category, err = mergePatchValue[string](field, value, false)
+		case "image_url":
+			req.ImageUrl, err = mergePatchValue[string](field, value, true)
+		case "sku":
+			req.Sku, err = mergePatchValue[string](field, value, false)
+		case "is_active":
+			req.IsActive, err = mergePatchValue[bool](field, value, false)
+		case "reorder_threshold":
+			if isJSONNull(value) {
+				req.ClearReorderThreshold = true
+			} else {
+				req.ReorderThreshold, err = mergePatchValue[int32](field, value, false)
+			}
+		case "compare_at_price":
+			if isJSONNull(value) {
+				req.ClearCompareAtPrice = true
+			} else {
+				var price *moneyInput
+				if price, err = mergePatchValue[moneyInput](field, value, false); err == nil {
+					req.CompareAtPrice = price.proto()
+				}
+			}
+		case "version":
+			// Not a product field: the version the patch is based on
+			var version *int32
+			version, err = mergePatchValue[int32](field, value, false)
+			if err == nil {
+				req.Version = *version
+			}
+		case "attributes":
+			// Attributes merge key by key, so a null key removes that attribute
+			var attributes *map[string]interface{}
+			attributes, err = mergePatchValue[map[string]interface{}](field, value, false)
+			if err == nil {
+				req.Attributes = attributesToStrings(*attributes)
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", field)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergePatchValue decodes one member of a merge patch. A null member becomes
+// the zero value when the field can be cleared and an error otherwise.
+func mergePatchValue[T any](field string, value json.RawMessage, clearable bool) (*T, error) {
+	if isJSONNull(value) {
+		if !clearable {
+			return nil, fmt.Errorf("%s cannot be removed", field)
+		}
+		var zero T
+		return &zero, nil
+	}
+
+	var v T
+	if err := json.Unmarshal(value, &v); err != nil {
+		return nil, fmt.Errorf("invalid value for %s", field)
+	}
+	return &v, nil
+}
+
+func isJSONNull(value json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(value), []byte("null"))
+}
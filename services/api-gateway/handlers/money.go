@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+)
+
+// moneyInput is a price in a request body. It accepts a number (12.5), a
+// string ("12.50" or "12.50 EUR") or the object responses use. Numbers are
+// read from their text, so no precision is lost on the way. Prices without a
+// currency are in the product's currency.
+type moneyInput struct {
+	money *commonPb.Money
+}
+
+func (m *moneyInput) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		var money struct {
+			CurrencyCode string `json:"currency_code"`
+			Units        int64  `json:"units"`
+			Nanos        int32  `json:"nanos"`
+		}
+		if err := json.Unmarshal(data, &money); err != nil {
+			return err
+		}
+		m.money = &commonPb.Money{CurrencyCode: money.CurrencyCode, Units: money.Units, Nanos: money.Nanos}
+		return nil
+	case bytes.HasPrefix(data, []byte(`"`)):
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		amount, currency, _ := strings.Cut(strings.TrimSpace(text), " ")
+		money, err := parseMoney(amount, currency)
+		if err != nil {
+			return err
+		}
+		m.money = money
+		return nil
+	default:
+		money, err := parseMoney(string(data), "")
+		if err != nil {
+			return err
+		}
+		m.money = money
+		return nil
+	}
+}
+
+// proto returns nil for a price that wasn't sent
+func (m *moneyInput) proto() *commonPb.Money {
+	if m == nil {
+		return nil
+	}
+	return m.money
+}
+
+// priceParam reads an optional price bound from the query string, in the
+// currency given by the currency parameter
+func priceParam(query url.Values, name string) (*commonPb.Money, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	money, err := parseMoney(value, query.Get("currency"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return money, nil
+}
+
+// parseMoney reads a decimal amount such as "12.50" into units and nanos
+func parseMoney(amount, currency string) (*commonPb.Money, error) {
+	text := strings.TrimSpace(amount)
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) || len(fraction) > 9 {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		if units, err = strconv.ParseInt(whole, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid amount %q", amount)
+		}
+	}
+	var nanos int64
+	if fraction != "" {
+		nanos, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+	}
+	if negative {
+		units, nanos = -units, -nanos
+	}
+
+	return &commonPb.Money{
+		CurrencyCode: strings.ToUpper(strings.TrimSpace(currency)),
+		Units:        units,
+		Nanos:        int32(nanos),
+	}, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// formatMoney writes the amount as a plain decimal such as "12.5", the form
+// CSV files carry prices in
+func formatMoney(money *commonPb.Money) string {
+	if money == nil {
+		return ""
+	}
+
+	units, nanos := money.Units, int64(money.Nanos)
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	text := sign + strconv.FormatInt(units, 10)
+	if fraction := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"); fraction != "" {
+		text += "." + fraction
+	}
+	return text
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/notification"
+)
+
+type NotificationHandler struct {
+	notificationClient *clients.NotificationClient
+}
+
+func NewNotificationHandler(notificationClient *clients.NotificationClient) *NotificationHandler {
+	return &NotificationHandler{
+		notificationClient: notificationClient,
+	}
+}
+
+type sendNotificationRequest struct {
+	Type     string            `json:"type"`
+	UserID   string            `json:"user_id"`
+	Email    string            `json:"email"`
+	Locale   string            `json:"locale"`
+	Data     map[string]string `json:"data"`
+	Channels []string          `json:"channels"`
+}
+
+type markNotificationsReadRequest struct {
+	IDs []string `json:"ids"`
+}
+
+func writeNotificationResponse(w http.ResponseWriter, success bool, successStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(successStatus)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListNotifications lists the signed-in user's inbox, newest first, with
+// unread=true for unread notifications only
+func (h *NotificationHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	resp, err := h.notificationClient.ListNotifications(r.Context(), &pb.ListNotificationsRequest{
+		Token:      token,
+		UserId:     user.Id,
+		UnreadOnly: query.Get("unread") == "true",
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeNotificationResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// MarkNotificationsRead marks the given notifications read, or the whole
+// inbox if no IDs are given
+func (h *NotificationHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var markReq markNotificationsReadRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&markReq); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := h.notificationClient.MarkNotificationsRead(r.Context(), &pb.MarkNotificationsReadRequest{
+		Token:  token,
+		UserId: user.Id,
+		Ids:    markReq.IDs,
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeNotificationResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// SendNotification lets admins send a notification to anyone
+func (h *NotificationHandler) SendNotification(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if user.Role != "admin" {
+		http.Error(w, "Only admins can send notifications", http.StatusForbidden)
+		return
+	}
+
+	var sendReq sendNotificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&sendReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.notificationClient.SendNotification(r.Context(), &pb.SendNotificationRequest{
+		Token:    token,
+		Type:     sendReq.Type,
+		UserId:   sendReq.UserID,
+		Email:    sendReq.Email,
+		Locale:   sendReq.Locale,
+		Data:     sendReq.Data,
+		Channels: sendReq.Channels,
+	})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeNotificationResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
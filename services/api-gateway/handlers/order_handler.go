@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/order"
+)
+
+type OrderHandler struct {
+	orderClient *clients.OrderClient
+}
+
+func NewOrderHandler(orderClient *clients.OrderClient) *OrderHandler {
+	return &OrderHandler{
+		orderClient: orderClient,
+	}
+}
+
+type checkoutItemRequest struct {
+	ProductID string          `json:"product_id"`
+	VariantID string          `json:"variant_id"`
+	Quantity  int32           `json:"quantity"`
+	UnitPrice *commonPb.Money `json:"unit_price"`
+}
+
+type checkoutRequest struct {
+	Items          []checkoutItemRequest `json:"items"`
+	CurrencyCode   string                `json:"currency_code"`
+	PaymentToken   string                `json:"payment_token"`
+	PromotionCodes []string              `json:"promotion_codes"`
+}
+
+type orderStatusRequest struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+func checkoutItemsToProto(items []checkoutItemRequest) []*pb.CheckoutItem {
+	protoItems := make([]*pb.CheckoutItem, 0, len(items))
+	for _, item := range items {
+		protoItems = append(protoItems, &pb.CheckoutItem{
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+	return protoItems
+}
+
+func writeOrderResponse(w http.ResponseWriter, success bool, successStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(successStatus)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Checkout places an order for the signed-in user. A failed checkout still
+// returns the cancelled order, with the reason, once one was created.
+func (h *OrderHandler) Checkout(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var checkoutReq checkoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&checkoutReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.CheckoutRequest{
+		Token:          token,
+		UserId:         user.Id,
+		Items:          checkoutItemsToProto(checkoutReq.Items),
+		CurrencyCode:   checkoutReq.CurrencyCode,
+		PaymentToken:   checkoutReq.PaymentToken,
+		PromotionCodes: checkoutReq.PromotionCodes,
+	}
+
+	resp, err := h.orderClient.Checkout(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeOrderResponse(w, resp.Response.Success, http.StatusCreated, resp)
+}
+
+// ListOrders lists the signed-in user's orders, newest first. Admins can
+// pass all=true to list everyone's, optionally narrowed down by user_id.
+func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	req := &pb.ListOrdersRequest{
+		Token:  token,
+		UserId: user.Id,
+		Status: query.Get("status"),
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+	if query.Get("all") == "true" {
+		if user.Role != "admin" {
+			http.Error(w, "Only admins can list all orders", http.StatusForbidden)
+			return
+		}
+		req.UserId = query.Get("user_id")
+	}
+
+	resp, err := h.orderClient.ListOrders(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeOrderResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// GetOrder returns one of the signed-in user's orders; admins can get any
+func (h *OrderHandler) GetOrder(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	orderID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	req := &pb.GetOrderRequest{Token: token, OrderId: orderID}
+	if user.Role != "admin" {
+		req.UserId = user.Id
+	}
+
+	resp, err := h.orderClient.GetOrder(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if !resp.Response.Success {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(resp)
+		return
+	}
+	writeOrderResponse(w, true, http.StatusOK, resp)
+}
+
+// UpdateOrderStatus moves an order on. Admins ship, deliver, cancel and
+// refund orders; buyers can only cancel their own before they ship.
+func (h *OrderHandler) UpdateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	orderID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var statusReq orderStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&statusReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.UpdateOrderStatusRequest{
+		Token:   token,
+		OrderId: orderID,
+		Status:  statusReq.Status,
+		Reason:  statusReq.Reason,
+		Actor:   user.Id,
+	}
+	if user.Role != "admin" {
+		req.UserId = user.Id
+	}
+
+	resp, err := h.orderClient.UpdateOrderStatus(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeOrderResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
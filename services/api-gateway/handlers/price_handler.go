@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+// ListPriceSchedules lists the product's past, running and upcoming sales.
+// Like the other pricing endpoints it is limited to the owner and admins.
+func (h *ProductHandler) ListPriceSchedules(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	req := &pb.ListPriceSchedulesRequest{
+		Token:     token,
+		ProductId: productID,
+	}
+
+	resp, err := h.productClient.ListPriceSchedules(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreatePriceSchedule puts the product on sale between starts_at and
+// ends_at, given as RFC 3339 times. Without starts_at the sale starts now.
+func (h *ProductHandler) CreatePriceSchedule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var scheduleReq struct {
+		SalePrice *moneyInput `json:"sale_price"`
+		StartsAt  string      `json:"starts_at"`
+		EndsAt    string      `json:"ends_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&scheduleReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	req := &pb.CreatePriceScheduleRequest{
+		Token:     token,
+		ProductId: productID,
+		SalePrice: scheduleReq.SalePrice.proto(),
+		StartsAt:  scheduleReq.StartsAt,
+		EndsAt:    scheduleReq.EndsAt,
+		CreatedBy: user.Id,
+	}
+
+	resp, err := h.productClient.CreatePriceSchedule(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CancelPriceSchedule stops a sale that is scheduled or running
+func (h *ProductHandler) CancelPriceSchedule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+	scheduleID := vars["scheduleId"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	req := &pb.CancelPriceScheduleRequest{
+		Token:      token,
+		ProductId:  productID,
+		ScheduleId: scheduleID,
+	}
+
+	resp, err := h.productClient.CancelPriceSchedule(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// SetProductPrices replaces the product's fixed prices in other currencies
+// with the "prices" list, e.g. ["11.50 EUR", "1800 JPY"]
+func (h *ProductHandler) SetProductPrices(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var pricesReq struct {
+		Prices []moneyInput `json:"prices"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&pricesReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if status, message := h.checkProductOwner(r.Context(), user, productID); status != http.StatusOK {
+		http.Error(w, message, status)
+		return
+	}
+
+	req := &pb.SetProductPricesRequest{
+		Token:     token,
+		ProductId: productID,
+	}
+	for _, price := range pricesReq.Prices {
+		req.Prices = append(req.Prices, price.proto())
+	}
+
+	resp, err := h.productClient.SetProductPrices(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
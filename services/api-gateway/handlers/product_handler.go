@@ -2,26 +2,38 @@ package handlers
 
 import (
 	"encoding/json"
+	"mime"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
 	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
 	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
 
 	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	"github.com/martbul/playground_microservices/services/api-gateway/storage"
 )
 
 type ProductHandler struct {
 	productClient *clients.ProductClient
+	blobStore     storage.BlobStore
 }
 
-func NewProductHandler(productClient *clients.ProductClient) *ProductHandler {
+func NewProductHandler(productClient *clients.ProductClient, blobStore storage.BlobStore) *ProductHandler {
 	return &ProductHandler{
 		productClient: productClient,
+		blobStore:     blobStore,
 	}
 }
 
+// isStoreAdmin reports whether the user runs the catalog of the request's
+// store: platform admins run every store's, store owners and admins their
+// own
+func isStoreAdmin(user *authPb.User) bool {
+	return user.Role == "admin" || user.StoreRole != ""
+}
+
 func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 	token, ok := r.Context().Value("token").(string)
 	if !ok {
@@ -30,13 +42,18 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var createReq struct {
-		Name          string  `json:"name"`
-		Description   string  `json:"description"`
-		Price         float64 `json:"price"`
-		StockQuantity int32   `json:"stock_quantity"`
-		Category      string  `json:"category"`
-		ImageURL      string  `json:"image_url"`
-		SKU           string  `json:"sku"`
+		Name          string      `json:"name"`
+		Description   string      `json:"description"`
+		Price         *moneyInput `json:"price"`
+		StockQuantity int32       `json:"stock_quantity"`
+		CategoryID    string      `json:"category_id"`
+		Category      string      `json:"category"`
+		ImageURL      string      `json:"image_url"`
+		SKU           string      `json:"sku"`
+
+		Attributes       map[string]interface{} `json:"attributes"`
+		ReorderThreshold *int32                 `json:"reorder_threshold"`
+		CompareAtPrice   *moneyInput            `json:"compare_at_price"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
@@ -44,15 +61,26 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var createdBy string
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		createdBy = user.Id
+	}
+
 	req := &pb.CreateProductRequest{
 		Token:         token,
 		Name:          createReq.Name,
 		Description:   createReq.Description,
-		Price:         createReq.Price,
+		Price:         createReq.Price.proto(),
 		StockQuantity: createReq.StockQuantity,
+		CategoryId:    createReq.CategoryID,
 		Category:      createReq.Category,
 		ImageUrl:      createReq.ImageURL,
 		Sku:           createReq.SKU,
+		Attributes:    attributesToStrings(createReq.Attributes),
+
+		ReorderThreshold: createReq.ReorderThreshold,
+		CompareAtPrice:   createReq.CompareAtPrice.proto(),
+		CreatedBy:        createdBy,
 	}
 
 	resp, err := h.productClient.CreateProduct(r.Context(), req)
@@ -86,6 +114,8 @@ func (h *ProductHandler) GetProduct(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 	if resp.Response.Success {
+		h.recordProductView(r, resp.Product.Id)
+		w.Header().Set("ETag", productETag(resp.Product.Version))
 		w.WriteHeader(http.StatusOK)
 	} else {
 		w.WriteHeader(http.StatusNotFound)
@@ -103,15 +133,26 @@ func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Fields left out of the body are left unchanged
 	var updateReq struct {
-		Name          string  `json:"name"`
-		Description   string  `json:"description"`
-		Price         float64 `json:"price"`
-		StockQuantity int32   `json:"stock_quantity"`
-		Category      string  `json:"category"`
-		ImageURL      string  `json:"image_url"`
-		SKU           string  `json:"sku"`
-		IsActive      bool    `json:"is_active"`
+		Name          *string     `json:"name"`
+		Description   *string     `json:"description"`
+		Price         *moneyInput `json:"price"`
+		StockQuantity *int32      `json:"stock_quantity"`
+		CategoryID    *string     `json:"category_id"`
+		Category      *string     `json:"category"`
+		ImageURL      *string     `json:"image_url"`
+		SKU           *string     `json:"sku"`
+		IsActive      *bool       `json:"is_active"`
+
+		Attributes            map[string]interface{} `json:"attributes"`
+		ReorderThreshold      *int32                 `json:"reorder_threshold"`
+		ClearReorderThreshold bool                   `json:"clear_reorder_threshold"`
+		CompareAtPrice        *moneyInput            `json:"compare_at_price"`
+		ClearCompareAtPrice   bool                   `json:"clear_compare_at_price"`
+
+		// Version can be sent here instead of in an If-Match header
+		Version int32 `json:"version"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
@@ -119,17 +160,97 @@ func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, err := requestVersion(r, updateReq.Version)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if version == 0 {
+		http.Error(w, "Product version is required; send it as If-Match or version", http.StatusPreconditionRequired)
+		return
+	}
+
 	req := &pb.UpdateProductRequest{
 		Token:         token,
 		Id:            productID,
 		Name:          updateReq.Name,
 		Description:   updateReq.Description,
-		Price:         updateReq.Price,
+		Price:         updateReq.Price.proto(),
 		StockQuantity: updateReq.StockQuantity,
+		CategoryId:    updateReq.CategoryID,
 		Category:      updateReq.Category,
 		ImageUrl:      updateReq.ImageURL,
 		Sku:           updateReq.SKU,
 		IsActive:      updateReq.IsActive,
+		Attributes:    attributesToStrings(updateReq.Attributes),
+
+		ReorderThreshold:      updateReq.ReorderThreshold,
+		ClearReorderThreshold: updateReq.ClearReorderThreshold,
+		CompareAtPrice:        updateReq.CompareAtPrice.proto(),
+		ClearCompareAtPrice:   updateReq.ClearCompareAtPrice,
+
+		Version: version,
+	}
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		req.UpdatedBy = user.Id
+	}
+
+	resp, err := h.productClient.UpdateProduct(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeUpdateProductResponse(w, resp)
+}
+
+// PatchProduct applies a JSON Merge Patch (RFC 7396): only the members in the
+// body change, and a null member clears the field where that is allowed.
+func (h *ProductHandler) PatchProduct(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		if mediaType != "application/merge-patch+json" && mediaType != "application/json" {
+			http.Error(w, "Content-Type must be application/merge-patch+json", http.StatusUnsupportedMediaType)
+			return
+		}
+	}
+
+	var patch map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.UpdateProductRequest{
+		Token: token,
+		Id:    productID,
+	}
+	if err := applyProductMergePatch(req, patch); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	version, err := requestVersion(r, req.Version)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if version == 0 {
+		http.Error(w, "Product version is required; send it as If-Match or version", http.StatusPreconditionRequired)
+		return
+	}
+	req.Version = version
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		req.UpdatedBy = user.Id
 	}
 
 	resp, err := h.productClient.UpdateProduct(r.Context(), req)
@@ -138,10 +259,35 @@ func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	writeUpdateProductResponse(w, resp)
+}
+
+// requestVersion picks the product version from the If-Match header, falling
+// back to the one in the body; 0 means neither was sent
+func requestVersion(r *http.Request, bodyVersion int32) (int32, error) {
+	version, ok, err := ifMatchVersion(r)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return version, nil
+	}
+	return bodyVersion, nil
+}
+
+// writeUpdateProductResponse answers a stale write with 409 Conflict and the
+// current product, and tags successful updates with the new version
+func writeUpdateProductResponse(w http.ResponseWriter, resp *pb.UpdateProductResponse) {
 	w.Header().Set("Content-Type", "application/json")
-	if resp.Response.Success {
+	if resp.Product != nil {
+		w.Header().Set("ETag", productETag(resp.Product.Version))
+	}
+	switch {
+	case resp.Response.Success:
 		w.WriteHeader(http.StatusOK)
-	} else {
+	case resp.Conflict:
+		w.WriteHeader(http.StatusConflict)
+	default:
 		w.WriteHeader(http.StatusBadRequest)
 	}
 	json.NewEncoder(w).Encode(resp)
@@ -157,23 +303,52 @@ func (h *ProductHandler) DeleteProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// DELETE has no body, so the version comes from If-Match or ?version=
+	version, err := requestVersion(r, 0)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if version == 0 {
+		if v, err := strconv.ParseInt(r.URL.Query().Get("version"), 10, 32); err == nil {
+			version = int32(v)
+		}
+	}
+	if version <= 0 {
+		http.Error(w, "Product version is required; send it as If-Match or version", http.StatusPreconditionRequired)
+		return
+	}
+
 	req := &pb.DeleteProductRequest{
-		Token: token,
-		Id:    productID,
+		Token:   token,
+		Id:      productID,
+		Version: version,
+	}
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		req.DeletedBy = user.Id
 	}
 
-	_, err := h.productClient.DeleteProduct(r.Context(), req)
+	// Deleted products go to the trash with their images, so the blobs are
+	// kept until PurgeTrash removes the product for good
+	resp, err := h.productClient.DeleteProduct(r.Context(), req)
 	if err != nil {
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"success": true,
-		"message": "Product deleted successfully",
-	})
+	switch {
+	case resp.Response.Success:
+		w.WriteHeader(http.StatusOK)
+	case resp.Conflict:
+		if resp.Product != nil {
+			w.Header().Set("ETag", productETag(resp.Product.Version))
+		}
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
 }
 
 func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
@@ -191,6 +366,7 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 	}
 
 	category := query.Get("category")
+	categoryID := query.Get("category_id")
 	sortBy := query.Get("sort_by")
 	sortOrder := query.Get("sort_order")
 	activeOnly := query.Get("active_only") == "true"
@@ -202,8 +378,10 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 			SortBy:    sortBy,
 			SortOrder: sortOrder,
 		},
-		Category:   category,
-		ActiveOnly: activeOnly,
+		Category:         category,
+		CategoryId:       categoryID,
+		ActiveOnly:       activeOnly,
+		AttributeFilters: attributeFilters(r),
 	}
 
 	resp, err := h.productClient.ListProducts(r.Context(), req)
@@ -233,8 +411,18 @@ func (h *ProductHandler) SearchProducts(w http.ResponseWriter, r *http.Request)
 	}
 
 	category := query.Get("category")
-	minPrice, _ := strconv.ParseFloat(query.Get("min_price"), 64)
-	maxPrice, _ := strconv.ParseFloat(query.Get("max_price"), 64)
+	categoryID := query.Get("category_id")
+	// Price bounds are in the currency query parameter, or the default currency
+	minPrice, err := priceParam(query, "min_price")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxPrice, err := priceParam(query, "max_price")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	req := &pb.SearchProductsRequest{
 		Query: searchQuery,
@@ -242,9 +430,11 @@ func (h *ProductHandler) SearchProducts(w http.ResponseWriter, r *http.Request)
 			Page:  int32(page),
 			Limit: int32(limit),
 		},
-		Category: category,
-		MinPrice: minPrice,
-		MaxPrice: maxPrice,
+		Category:         category,
+		CategoryId:       categoryID,
+		MinPrice:         minPrice,
+		MaxPrice:         maxPrice,
+		AttributeFilters: attributeFilters(r),
 	}
 
 	resp, err := h.productClient.SearchProducts(r.Context(), req)
@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/order"
+)
+
+// promotionRequest creates or replaces a promotion. Amounts take any form
+// moneyInput does, e.g. "10 EUR"; fixed promotions need a currency.
+type promotionRequest struct {
+	Code           string      `json:"code"`
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	DiscountType   string      `json:"discount_type"`
+	PercentOff     int32       `json:"percent_off"`
+	AmountOff      *moneyInput `json:"amount_off"`
+	MinSpend       *moneyInput `json:"min_spend"`
+	ProductIDs     []string    `json:"product_ids"`
+	CategoryIDs    []string    `json:"category_ids"`
+	MaxUses        int32       `json:"max_uses"`
+	MaxUsesPerUser int32       `json:"max_uses_per_user"`
+	StartsAt       string      `json:"starts_at"`
+	EndsAt         string      `json:"ends_at"`
+	IsActive       *bool       `json:"is_active"`
+}
+
+type evaluatePromotionsRequest struct {
+	Items        []checkoutItemRequest `json:"items"`
+	CurrencyCode string                `json:"currency_code"`
+	Codes        []string              `json:"codes"`
+}
+
+func writePromotionResponse(w http.ResponseWriter, success bool, successStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(successStatus)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// promotionAdmin gets the signed-in user and their token, allowing only
+// admins to manage promotions
+func promotionAdmin(w http.ResponseWriter, r *http.Request) (string, *authPb.User, bool) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return "", nil, false
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return "", nil, false
+	}
+	if user.Role != "admin" {
+		http.Error(w, "Only admins can manage promotions", http.StatusForbidden)
+		return "", nil, false
+	}
+	return token, user, true
+}
+
+// proto leaves a promotion active unless is_active is false
+func (p *promotionRequest) proto() *pb.Promotion {
+	isActive := true
+	if p.IsActive != nil {
+		isActive = *p.IsActive
+	}
+	return &pb.Promotion{
+		Code:           p.Code,
+		Name:           p.Name,
+		Description:    p.Description,
+		DiscountType:   p.DiscountType,
+		PercentOff:     p.PercentOff,
+		AmountOff:      p.AmountOff.proto(),
+		MinSpend:       p.MinSpend.proto(),
+		ProductIds:     p.ProductIDs,
+		CategoryIds:    p.CategoryIDs,
+		MaxUses:        p.MaxUses,
+		MaxUsesPerUser: p.MaxUsesPerUser,
+		StartsAt:       p.StartsAt,
+		EndsAt:         p.EndsAt,
+		IsActive:       isActive,
+	}
+}
+
+// EvaluatePromotions shows what codes would take off the items, priced as
+// checkout would price them, with the reason for each code that doesn't
+// apply
+func (h *OrderHandler) EvaluatePromotions(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var evaluateReq evaluatePromotionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&evaluateReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.EvaluatePromotionsRequest{
+		Token:        token,
+		UserId:       user.Id,
+		Items:        checkoutItemsToProto(evaluateReq.Items),
+		CurrencyCode: evaluateReq.CurrencyCode,
+		Codes:        evaluateReq.Codes,
+	}
+
+	resp, err := h.orderClient.EvaluatePromotions(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePromotionResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// ListPromotions lists promotions newest first. q matches codes and names;
+// active=true leaves out ones that are switched off or have ended.
+func (h *OrderHandler) ListPromotions(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := promotionAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	req := &pb.ListPromotionsRequest{
+		Token:      token,
+		Query:      query.Get("q"),
+		ActiveOnly: query.Get("active") == "true",
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+
+	resp, err := h.orderClient.ListPromotions(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePromotionResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+func (h *OrderHandler) CreatePromotion(w http.ResponseWriter, r *http.Request) {
+	token, user, ok := promotionAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var promotionReq promotionRequest
+	if err := json.NewDecoder(r.Body).Decode(&promotionReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.CreatePromotionRequest{
+		Token:     token,
+		Promotion: promotionReq.proto(),
+		CreatedBy: user.Id,
+	}
+
+	resp, err := h.orderClient.CreatePromotion(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePromotionResponse(w, resp.Response.Success, http.StatusCreated, resp)
+}
+
+func (h *OrderHandler) GetPromotion(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := promotionAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.orderClient.GetPromotion(r.Context(), &pb.GetPromotionRequest{Token: token, Id: mux.Vars(r)["id"]})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if !resp.Response.Success {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(resp)
+		return
+	}
+	writePromotionResponse(w, true, http.StatusOK, resp)
+}
+
+// UpdatePromotion replaces all of a promotion's settings
+func (h *OrderHandler) UpdatePromotion(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := promotionAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var promotionReq promotionRequest
+	if err := json.NewDecoder(r.Body).Decode(&promotionReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	promotion := promotionReq.proto()
+	promotion.Id = mux.Vars(r)["id"]
+
+	resp, err := h.orderClient.UpdatePromotion(r.Context(), &pb.UpdatePromotionRequest{Token: token, Promotion: promotion})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePromotionResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// DeletePromotion removes a promotion; orders that used it keep their copy
+func (h *OrderHandler) DeletePromotion(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := promotionAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.orderClient.DeletePromotion(r.Context(), &pb.DeletePromotionRequest{Token: token, Id: mux.Vars(r)["id"]})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePromotionResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
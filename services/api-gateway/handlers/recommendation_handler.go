@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+func writeRecommendationResponse(w http.ResponseWriter, success bool, failureStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(failureStatus)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// recordProductView counts a view of the product towards recommendations,
+// by the signed-in user or else the guest's cart session. It doesn't hold
+// up the response; a view that fails to record is only logged.
+func (h *ProductHandler) recordProductView(r *http.Request, productID string) {
+	req := &pb.RecordProductViewRequest{
+		ProductId: productID,
+		SessionId: cartSession(r),
+	}
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		req.UserId = user.Id
+		req.SessionId = ""
+	}
+
+	// The request's context ends with the response, so only its store is kept
+	ctx := clients.WithStore(context.Background(), clients.StoreID(r.Context()))
+	go func() {
+		resp, err := h.productClient.RecordProductView(ctx, req)
+		if err != nil {
+			log.Printf("Failed to record view of product %s: %v", productID, err)
+			return
+		}
+		if !resp.Response.Success {
+			log.Printf("Failed to record view of product %s: %s", productID, resp.Response.Message)
+		}
+	}()
+}
+
+// GetProductRecommendations lists products like this one: ones viewed by
+// the same people, then popular ones from its category, then popular ones
+func (h *ProductHandler) GetProductRecommendations(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	req := &pb.GetRecommendationsRequest{
+		ProductId: mux.Vars(r)["id"],
+		Limit:     int32(limit),
+	}
+
+	resp, err := h.productClient.GetRecommendations(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeRecommendationResponse(w, resp.Response.Success, http.StatusNotFound, resp)
+}
+
+// GetRecommendations lists products for the signed-in user from what they
+// viewed lately, or the most popular products for guests
+func (h *ProductHandler) GetRecommendations(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	req := &pb.GetRecommendationsRequest{
+		Limit: int32(limit),
+	}
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		req.UserId = user.Id
+	}
+
+	resp, err := h.productClient.GetRecommendations(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeRecommendationResponse(w, resp.Response.Success, http.StatusBadRequest, resp)
+}
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+type reviewRequest struct {
+	Rating int32  `json:"rating"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// ListProductReviews lists a product's approved reviews, plus the signed-in
+// user's own review while it waits for moderation. Admins can pass status
+// to see pending or rejected reviews.
+func (h *ProductHandler) ListProductReviews(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	h.listReviews(w, r, vars["id"])
+}
+
+// ListReviews is the moderation queue: reviews of every product, filtered
+// by status. Only admins can use it.
+func (h *ProductHandler) ListReviews(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can moderate reviews", http.StatusForbidden)
+		return
+	}
+
+	h.listReviews(w, r, "")
+}
+
+func (h *ProductHandler) listReviews(w http.ResponseWriter, r *http.Request, productID string) {
+	query := r.URL.Query()
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	rating, _ := strconv.Atoi(query.Get("rating"))
+
+	req := &pb.ListProductReviewsRequest{
+		ProductId: productID,
+		Pagination: &commonPb.PaginationRequest{
+			Page:      int32(page),
+			Limit:     int32(limit),
+			SortBy:    query.Get("sort_by"),
+			SortOrder: query.Get("sort_order"),
+		},
+		Rating: int32(rating),
+	}
+	if user, ok := r.Context().Value("user").(*authPb.User); ok {
+		req.ViewerId = user.Id
+		if isStoreAdmin(user) {
+			req.Moderator = true
+			req.Status = query.Get("status")
+		}
+	}
+
+	resp, err := h.productClient.ListProductReviews(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateProductReview adds the signed-in user's review of a product. Each
+// user can review a product once; the review is shown once approved.
+func (h *ProductHandler) CreateProductReview(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var reviewReq reviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&reviewReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.CreateProductReviewRequest{
+		Token:      token,
+		ProductId:  productID,
+		UserId:     user.Id,
+		AuthorName: reviewAuthorName(user),
+		Rating:     reviewReq.Rating,
+		Title:      reviewReq.Title,
+		Body:       reviewReq.Body,
+	}
+
+	resp, err := h.productClient.CreateProductReview(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UpdateProductReview replaces the text and rating of the user's own review
+func (h *ProductHandler) UpdateProductReview(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	reviewID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var reviewReq reviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&reviewReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.UpdateProductReviewRequest{
+		Token:  token,
+		Id:     reviewID,
+		UserId: user.Id,
+		Rating: reviewReq.Rating,
+		Title:  reviewReq.Title,
+		Body:   reviewReq.Body,
+	}
+
+	resp, err := h.productClient.UpdateProductReview(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// DeleteProductReview removes a review; authors can delete their own and
+// admins any
+func (h *ProductHandler) DeleteProductReview(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	reviewID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	req := &pb.DeleteProductReviewRequest{
+		Token:     token,
+		Id:        reviewID,
+		UserId:    user.Id,
+		Moderator: isStoreAdmin(user),
+	}
+
+	resp, err := h.productClient.DeleteProductReview(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ModerateProductReview approves or rejects a review. Only admins can
+// moderate.
+func (h *ProductHandler) ModerateProductReview(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	reviewID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can moderate reviews", http.StatusForbidden)
+		return
+	}
+
+	var moderateReq struct {
+		Status string `json:"status"`
+		Note   string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&moderateReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.ModerateProductReviewRequest{
+		Token:       token,
+		Id:          reviewID,
+		Status:      moderateReq.Status,
+		Note:        moderateReq.Note,
+		ModeratedBy: user.Id,
+	}
+
+	resp, err := h.productClient.ModerateProductReview(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// reviewAuthorName is the name shown with a review: the first name and last
+// initial, or the username when the user hasn't given a name
+func reviewAuthorName(user *authPb.User) string {
+	firstName := strings.TrimSpace(user.FirstName)
+	if firstName == "" {
+		return user.Username
+	}
+	if lastName := []rune(strings.TrimSpace(user.LastName)); len(lastName) > 0 {
+		return firstName + " " + string(lastName[0]) + "."
+	}
+	return firstName
+}
@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+)
+
+func writeStoreResponse(w http.ResponseWriter, success bool, successStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(successStatus)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// platformAdmin gets the signed-in user's token, allowing only admins of the
+// whole platform to manage stores
+func platformAdmin(w http.ResponseWriter, r *http.Request) (string, bool) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return "", false
+	}
+
+	user, ok := r.Context().Value("user").(*pb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return "", false
+	}
+	if user.Role != "admin" {
+		http.Error(w, "Only admins can manage stores", http.StatusForbidden)
+		return "", false
+	}
+	return token, true
+}
+
+// storeOwner gets the signed-in user's token, allowing only owners of the
+// store and platform admins to manage its members. The store in the path
+// need not be the one the request was made for, so the user's role is
+// looked up for it.
+func (h *AuthHandler) storeOwner(w http.ResponseWriter, r *http.Request, storeID string) (string, bool) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return "", false
+	}
+
+	user, ok := r.Context().Value("user").(*pb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return "", false
+	}
+	if user.Role == "admin" {
+		return token, true
+	}
+
+	resp, err := h.authClient.ValidateToken(clients.WithStore(r.Context(), storeID), &pb.ValidateTokenRequest{Token: token})
+	if err != nil {
+		log.Printf("AuthHandler: Store role lookup error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return "", false
+	}
+	if !resp.Response.Success || !resp.Valid || resp.User.StoreRole != "owner" {
+		http.Error(w, "Only the store's owners can manage its members", http.StatusForbidden)
+		return "", false
+	}
+	return token, true
+}
+
+// GetCurrentStore returns the store the request was made for, so a
+// storefront can show its name
+func (h *AuthHandler) GetCurrentStore(w http.ResponseWriter, r *http.Request) {
+	store, ok := r.Context().Value("store").(*pb.Store)
+	if !ok {
+		http.Error(w, "Store not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, true, http.StatusOK, &pb.ResolveStoreResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store resolved successfully",
+		},
+		Store: store,
+	})
+}
+
+func (h *AuthHandler) ListStores(w http.ResponseWriter, r *http.Request) {
+	token, ok := platformAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.authClient.ListStores(r.Context(), &pb.ListStoresRequest{Token: token})
+	if err != nil {
+		log.Printf("AuthHandler: List stores error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// CreateStore opens a new store with the given user as its first owner
+func (h *AuthHandler) CreateStore(w http.ResponseWriter, r *http.Request) {
+	token, ok := platformAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var createReq struct {
+		Slug    string `json:"slug"`
+		Name    string `json:"name"`
+		Domain  string `json:"domain"`
+		OwnerID string `json:"owner_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.authClient.CreateStore(r.Context(), &pb.CreateStoreRequest{
+		Token:   token,
+		Slug:    createReq.Slug,
+		Name:    createReq.Name,
+		Domain:  createReq.Domain,
+		OwnerId: createReq.OwnerID,
+	})
+	if err != nil {
+		log.Printf("AuthHandler: Create store error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, resp.Response.Success, http.StatusCreated, resp)
+}
+
+// UpdateStore changes the fields that are sent
+func (h *AuthHandler) UpdateStore(w http.ResponseWriter, r *http.Request) {
+	token, ok := platformAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var updateReq struct {
+		Name     *string `json:"name"`
+		Domain   *string `json:"domain"`
+		IsActive *bool   `json:"is_active"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.authClient.UpdateStore(r.Context(), &pb.UpdateStoreRequest{
+		Token:    token,
+		StoreId:  mux.Vars(r)["id"],
+		Name:     updateReq.Name,
+		Domain:   updateReq.Domain,
+		IsActive: updateReq.IsActive,
+	})
+	if err != nil {
+		log.Printf("AuthHandler: Update store error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) ListStoreMembers(w http.ResponseWriter, r *http.Request) {
+	storeID := mux.Vars(r)["id"]
+	token, ok := h.storeOwner(w, r, storeID)
+	if !ok {
+		return
+	}
+
+	resp, err := h.authClient.ListStoreMembers(r.Context(), &pb.ListStoreMembersRequest{
+		Token:   token,
+		StoreId: storeID,
+	})
+	if err != nil {
+		log.Printf("AuthHandler: List store members error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// SetStoreMember gives a user, picked by ID or email, a role in the store
+func (h *AuthHandler) SetStoreMember(w http.ResponseWriter, r *http.Request) {
+	storeID := mux.Vars(r)["id"]
+	token, ok := h.storeOwner(w, r, storeID)
+	if !ok {
+		return
+	}
+
+	var memberReq struct {
+		UserID string `json:"user_id"`
+		Email  string `json:"email"`
+		Role   string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&memberReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.authClient.SetStoreMember(r.Context(), &pb.SetStoreMemberRequest{
+		Token:   token,
+		StoreId: storeID,
+		UserId:  memberReq.UserID,
+		Email:   memberReq.Email,
+		Role:    memberReq.Role,
+	})
+	if err != nil {
+		log.Printf("AuthHandler: Set store member error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) RemoveStoreMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	storeID := vars["id"]
+	token, ok := h.storeOwner(w, r, storeID)
+	if !ok {
+		return
+	}
+
+	resp, err := h.authClient.RemoveStoreMember(r.Context(), &pb.RemoveStoreMemberRequest{
+		Token:   token,
+		StoreId: storeID,
+		UserId:  vars["userId"],
+	})
+	if err != nil {
+		log.Printf("AuthHandler: Remove store member error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeStoreResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
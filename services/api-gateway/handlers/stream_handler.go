@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+// streamHeartbeat is how often an idle stream gets a comment line, so
+// proxies don't close it
+const streamHeartbeat = 15 * time.Second
+
+// StreamProducts sends product changes as Server-Sent Events. Each event is
+// named after the change type, e.g. "product.updated" or "stock.changed",
+// and carries the change as JSON. Filter with category_id and product_id,
+// each repeated or comma separated. Changes made while disconnected aren't
+// replayed; clients reload what they show after reconnecting.
+func (h *ProductHandler) StreamProducts(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	req := &pb.WatchProductsRequest{
+		CategoryIds: splitQueryList(query["category_id"]),
+		ProductIds:  splitQueryList(query["product_id"]),
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	stream, err := h.productClient.WatchProducts(ctx, req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// The service sends headers once the watch is open, so a bad filter can
+	// still be reported with a proper status code
+	if md, err := stream.Header(); err != nil || md == nil {
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		http.Error(w, "Watch failed: "+status.Convert(err).Message(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 5000\n\n")
+	flusher.Flush()
+
+	changes := make(chan *pb.ProductChange)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			change, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case changes <- change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case change := <-changes:
+			data, err := json.Marshal(change)
+			if err != nil {
+				log.Printf("Stream products error: %v", err)
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", change.EventId, change.Type, data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case err := <-errs:
+			// The client reconnects on its own once the stream ends
+			if ctx.Err() == nil {
+				log.Printf("Stream products error: %v", err)
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// splitQueryList reads a query parameter given repeatedly, comma
+// separated, or both
+func splitQueryList(values []string) []string {
+	var list []string
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
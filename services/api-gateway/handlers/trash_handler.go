@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	"github.com/martbul/playground_microservices/services/api-gateway/storage"
+)
+
+// ListDeletedProducts lists the trash. Only admins can see it.
+func (h *ProductHandler) ListDeletedProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can view the trash", http.StatusForbidden)
+		return
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	req := &pb.ListDeletedProductsRequest{
+		Token:     token,
+		CreatedBy: query.Get("created_by"),
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+
+	resp, err := h.productClient.ListDeletedProducts(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RestoreProduct takes a product back out of the trash. Only admins can do it.
+func (h *ProductHandler) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productID := vars["id"]
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return
+	}
+	if !isStoreAdmin(user) {
+		http.Error(w, "Only admins can restore products", http.StatusForbidden)
+		return
+	}
+
+	req := &pb.RestoreProductRequest{
+		Token:      token,
+		Id:         productID,
+		RestoredBy: user.Id,
+	}
+
+	resp, err := h.productClient.RestoreProduct(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.Header().Set("ETag", productETag(resp.Product.Version))
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// PurgeTrash periodically asks the product service to remove products whose
+// retention period has passed, then deletes their image blobs, which the
+// product service doesn't store. It runs until ctx is cancelled.
+func PurgeTrash(ctx context.Context, productClient *clients.ProductClient, blobStore storage.BlobStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := productClient.PurgeDeletedProducts(ctx, &pb.PurgeDeletedProductsRequest{})
+		switch {
+		case err != nil:
+			log.Printf("Failed to purge trash: %v", err)
+		case !resp.Response.Success:
+			log.Printf("Failed to purge trash: %s", resp.Response.Message)
+		default:
+			deleteBlobs(ctx, blobStore, resp.StorageKeys)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
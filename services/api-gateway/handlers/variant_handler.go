@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+func (h *ProductHandler) ListProductVariants(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	req := &pb.ListProductVariantsRequest{
+		ProductId:  vars["id"],
+		ActiveOnly: r.URL.Query().Get("active_only") == "true",
+	}
+
+	resp, err := h.productClient.ListProductVariants(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) SetProductOptions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var optionsReq struct {
+		Options []struct {
+			Name   string   `json:"name"`
+			Values []string `json:"values"`
+		} `json:"options"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&optionsReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.SetProductOptionsRequest{
+		Token:     token,
+		ProductId: vars["id"],
+	}
+	for _, option := range optionsReq.Options {
+		req.Options = append(req.Options, &pb.ProductOption{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+
+	resp, err := h.productClient.SetProductOptions(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) CreateProductVariant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	var createReq struct {
+		SKU           string            `json:"sku"`
+		OptionValues  map[string]string `json:"option_values"`
+		Price         *moneyInput       `json:"price"`
+		StockQuantity int32             `json:"stock_quantity"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.CreateProductVariantRequest{
+		Token:         token,
+		ProductId:     vars["id"],
+		Sku:           createReq.SKU,
+		OptionValues:  createReq.OptionValues,
+		Price:         createReq.Price.proto(),
+		StockQuantity: createReq.StockQuantity,
+	}
+
+	resp, err := h.productClient.CreateProductVariant(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) UpdateProductVariant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	// Fields left out of the body are left unchanged; "price": null clears the override
+	var updateReq struct {
+		SKU           *string           `json:"sku"`
+		OptionValues  map[string]string `json:"option_values"`
+		Price         json.RawMessage   `json:"price"`
+		StockQuantity *int32            `json:"stock_quantity"`
+		IsActive      *bool             `json:"is_active"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.UpdateProductVariantRequest{
+		Token:         token,
+		Id:            vars["variantId"],
+		Sku:           updateReq.SKU,
+		OptionValues:  updateReq.OptionValues,
+		StockQuantity: updateReq.StockQuantity,
+		IsActive:      updateReq.IsActive,
+	}
+
+	if len(updateReq.Price) > 0 {
+		if string(updateReq.Price) == "null" {
+			req.ClearPrice = true
+		} else {
+			var price moneyInput
+			if err := json.Unmarshal(updateReq.Price, &price); err != nil {
+				http.Error(w, "Invalid price", http.StatusBadRequest)
+				return
+			}
+			req.Price = price.proto()
+		}
+	}
+
+	resp, err := h.productClient.UpdateProductVariant(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *ProductHandler) DeleteProductVariant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	req := &pb.DeleteProductVariantRequest{
+		Token: token,
+		Id:    vars["variantId"],
+	}
+
+	resp, err := h.productClient.DeleteProductVariant(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Response.Success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+type webhookRequest struct {
+	URL          string   `json:"url"`
+	EventTypes   []string `json:"event_types"`
+	Secret       string   `json:"secret"`
+	IsActive     bool     `json:"is_active"`
+	RotateSecret bool     `json:"rotate_secret"`
+}
+
+func writeWebhookResponse(w http.ResponseWriter, success bool, successStatus int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(successStatus)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// webhookAdmin gets the signed-in user and their token, allowing only
+// admins to manage webhooks
+func webhookAdmin(w http.ResponseWriter, r *http.Request) (string, *authPb.User, bool) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return "", nil, false
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return "", nil, false
+	}
+	if user.Role != "admin" {
+		http.Error(w, "Only admins can manage webhooks", http.StatusForbidden)
+		return "", nil, false
+	}
+	return token, user, true
+}
+
+func (h *ProductHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.productClient.ListWebhooks(r.Context(), &pb.ListWebhooksRequest{Token: token})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// CreateWebhook subscribes a partner URL to catalog events. The response
+// has the signing secret, which is not shown again.
+func (h *ProductHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	token, user, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var webhookReq webhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&webhookReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.CreateWebhookRequest{
+		Token:      token,
+		Url:        webhookReq.URL,
+		EventTypes: webhookReq.EventTypes,
+		Secret:     webhookReq.Secret,
+		CreatedBy:  user.Id,
+	}
+
+	resp, err := h.productClient.CreateWebhook(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusCreated, resp)
+}
+
+// UpdateWebhook replaces a webhook's URL, event types and active flag;
+// rotate_secret gets it a new secret, returned in the response
+func (h *ProductHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var webhookReq webhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&webhookReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.UpdateWebhookRequest{
+		Token:        token,
+		Id:           mux.Vars(r)["id"],
+		Url:          webhookReq.URL,
+		EventTypes:   webhookReq.EventTypes,
+		IsActive:     webhookReq.IsActive,
+		RotateSecret: webhookReq.RotateSecret,
+	}
+
+	resp, err := h.productClient.UpdateWebhook(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+func (h *ProductHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.productClient.DeleteWebhook(r.Context(), &pb.DeleteWebhookRequest{Token: token, Id: mux.Vars(r)["id"]})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// ListWebhookDeliveries is the delivery log, for every webhook or for the
+// one in the path. ?status=dead lists the dead-letter queue.
+func (h *ProductHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	webhookID := mux.Vars(r)["id"]
+	if webhookID == "" {
+		webhookID = query.Get("webhook_id")
+	}
+
+	req := &pb.ListWebhookDeliveriesRequest{
+		Token:     token,
+		WebhookId: webhookID,
+		Status:    query.Get("status"),
+		Pagination: &commonPb.PaginationRequest{
+			Page:  int32(page),
+			Limit: int32(limit),
+		},
+	}
+
+	resp, err := h.productClient.ListWebhookDeliveries(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// RetryWebhookDelivery sends a dead delivery again
+func (h *ProductHandler) RetryWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.productClient.RetryWebhookDelivery(r.Context(), &pb.RetryWebhookDeliveryRequest{Token: token, Id: mux.Vars(r)["id"]})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
+
+// SendTestWebhook sends a webhook.test event and returns the delivery with
+// the endpoint's response code
+func (h *ProductHandler) SendTestWebhook(w http.ResponseWriter, r *http.Request) {
+	token, _, ok := webhookAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.productClient.SendTestWebhook(r.Context(), &pb.SendTestWebhookRequest{Token: token, Id: mux.Vars(r)["id"]})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWebhookResponse(w, resp.Response.Success, http.StatusOK, resp)
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	authPb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/product"
+)
+
+func writeWishlistResponse(w http.ResponseWriter, success bool, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if success {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// wishlistUser gets the signed-in user and their token from the request
+func wishlistUser(w http.ResponseWriter, r *http.Request) (string, *authPb.User, bool) {
+	token, ok := r.Context().Value("token").(string)
+	if !ok {
+		http.Error(w, "Token not found in context", http.StatusInternalServerError)
+		return "", nil, false
+	}
+
+	user, ok := r.Context().Value("user").(*authPb.User)
+	if !ok {
+		http.Error(w, "User not found in context", http.StatusInternalServerError)
+		return "", nil, false
+	}
+
+	return token, user, true
+}
+
+// GetWishlist returns the signed-in user's saved products. Items flagged
+// unavailable or out of stock can't be bought right now.
+func (h *ProductHandler) GetWishlist(w http.ResponseWriter, r *http.Request) {
+	token, user, ok := wishlistUser(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.productClient.GetWishlist(r.Context(), &pb.GetWishlistRequest{Token: token, UserId: user.Id})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWishlistResponse(w, resp.Response.Success, resp)
+}
+
+func (h *ProductHandler) AddToWishlist(w http.ResponseWriter, r *http.Request) {
+	token, user, ok := wishlistUser(w, r)
+	if !ok {
+		return
+	}
+
+	var addReq struct {
+		ProductID string `json:"product_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&addReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.AddToWishlistRequest{
+		Token:     token,
+		UserId:    user.Id,
+		ProductId: addReq.ProductID,
+	}
+
+	resp, err := h.productClient.AddToWishlist(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWishlistResponse(w, resp.Response.Success, resp)
+}
+
+func (h *ProductHandler) RemoveFromWishlist(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	token, user, ok := wishlistUser(w, r)
+	if !ok {
+		return
+	}
+
+	req := &pb.RemoveFromWishlistRequest{
+		Token:     token,
+		UserId:    user.Id,
+		ProductId: vars["productId"],
+	}
+
+	resp, err := h.productClient.RemoveFromWishlist(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWishlistResponse(w, resp.Response.Success, resp)
+}
+
+// ReorderWishlist takes every saved product's ID in the new order
+func (h *ProductHandler) ReorderWishlist(w http.ResponseWriter, r *http.Request) {
+	token, user, ok := wishlistUser(w, r)
+	if !ok {
+		return
+	}
+
+	var reorderReq struct {
+		ProductIDs []string `json:"product_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reorderReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.ReorderWishlistRequest{
+		Token:      token,
+		UserId:     user.Id,
+		ProductIds: reorderReq.ProductIDs,
+	}
+
+	resp, err := h.productClient.ReorderWishlist(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWishlistResponse(w, resp.Response.Success, resp)
+}
+
+// ShareWishlist turns the wishlist's share link on or off; the link is
+// /api/wishlists/shared/{share_token}
+func (h *ProductHandler) ShareWishlist(w http.ResponseWriter, r *http.Request) {
+	token, user, ok := wishlistUser(w, r)
+	if !ok {
+		return
+	}
+
+	var shareReq struct {
+		Shared bool `json:"shared"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&shareReq); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.ShareWishlistRequest{
+		Token:  token,
+		UserId: user.Id,
+		Shared: shareReq.Shared,
+	}
+
+	resp, err := h.productClient.ShareWishlist(r.Context(), req)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeWishlistResponse(w, resp.Response.Success, resp)
+}
+
+// GetSharedWishlist shows a shared wishlist to anyone with its link
+func (h *ProductHandler) GetSharedWishlist(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	resp, err := h.productClient.GetSharedWishlist(r.Context(), &pb.GetSharedWishlistRequest{ShareToken: vars["token"]})
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if !resp.Response.Success {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(resp)
+		return
+	}
+	writeWishlistResponse(w, true, resp)
+}
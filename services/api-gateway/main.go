@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/martbul/playground_microservices/services/api-gateway/handlers"
 	"github.com/martbul/playground_microservices/services/api-gateway/middleware"
 	"github.com/martbul/playground_microservices/services/api-gateway/routes"
+	"github.com/martbul/playground_microservices/services/api-gateway/storage"
 )
 
 func main() {
@@ -29,9 +31,48 @@ func main() {
 	}
 	defer productClient.Close()
 
+	cartClient, err := clients.NewCartClient(cfg.CartService)
+	if err != nil {
+		log.Fatal("Failed to connect to cart service:", err)
+	}
+	defer cartClient.Close()
+
+	orderClient, err := clients.NewOrderClient(cfg.OrderService)
+	if err != nil {
+		log.Fatal("Failed to connect to order service:", err)
+	}
+	defer orderClient.Close()
+
+	notificationClient, err := clients.NewNotificationClient(cfg.NotificationService)
+	if err != nil {
+		log.Fatal("Failed to connect to notification service:", err)
+	}
+	defer notificationClient.Close()
+
+	// Initialize blob storage for product images
+	var blobStore storage.BlobStore
+	var localStore *storage.LocalBlobStore
+	switch cfg.BlobStore {
+	case "s3":
+		blobStore, err = storage.NewS3BlobStore(cfg.S3Endpoint, cfg.S3Bucket, cfg.S3Region, cfg.S3AccessKey, cfg.S3SecretKey, cfg.S3PublicURL)
+	default:
+		localStore, err = storage.NewLocalBlobStore(cfg.MediaDir, cfg.MediaBaseURL)
+		blobStore = localStore
+	}
+	if err != nil {
+		log.Fatal("Failed to initialize blob store:", err)
+	}
+
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authClient)
-	productHandler := handlers.NewProductHandler(productClient)
+	productHandler := handlers.NewProductHandler(productClient, blobStore)
+	cartHandler := handlers.NewCartHandler(cartClient)
+	orderHandler := handlers.NewOrderHandler(orderClient)
+	notificationHandler := handlers.NewNotificationHandler(notificationClient)
+	imageHandler := handlers.NewImageHandler(productClient, blobStore, cfg.MaxImageSize, cfg.MaxImageFiles, cfg.ThumbnailSizes)
+
+	// Permanently remove products that have been in the trash too long
+	go handlers.PurgeTrash(context.Background(), productClient, blobStore, cfg.TrashPurgeInterval)
 
 	// Create router
 	router := mux.NewRouter()
@@ -39,10 +80,26 @@ func main() {
 	// Apply middleware
 	router.Use(middleware.LoggingMiddleware)
 	router.Use(middleware.CORSMiddleware)
+	router.Use(middleware.TenantMiddleware(authClient))
 
 	// Setup routes
 	routes.SetupAuthRoutes(router, authHandler)
-	routes.SetupProductRoutes(router, productHandler, authClient)
+	routes.SetupStoreRoutes(router, authHandler, authClient)
+	routes.SetupProductRoutes(router, productHandler, imageHandler, authClient)
+	routes.SetupInventoryRoutes(router, productHandler, authClient)
+	routes.SetupReviewRoutes(router, productHandler, authClient)
+	routes.SetupWishlistRoutes(router, productHandler, authClient)
+	routes.SetupRecommendationRoutes(router, productHandler, authClient)
+	routes.SetupWebhookRoutes(router, productHandler, authClient)
+	routes.SetupCartRoutes(router, cartHandler, authClient)
+	routes.SetupOrderRoutes(router, orderHandler, authClient)
+	routes.SetupPromotionRoutes(router, orderHandler, authClient)
+	routes.SetupNotificationRoutes(router, notificationHandler, authClient)
+
+	// Serve uploaded images when they are stored on local disk
+	if localStore != nil {
+		router.PathPrefix("/media/").Handler(http.StripPrefix("/media/", localStore.Handler())).Methods("GET")
+	}
 
 	// Health check endpoint
 	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,140 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+// MaxPixels bounds decoded image size so a small, highly compressed upload
+// cannot expand into gigabytes of memory
+const MaxPixels = 40_000_000
+
+var ErrUnsupportedType = errors.New("unsupported image type; use JPEG, PNG or GIF")
+
+// allowedTypes maps sniffed content types to the extension stored blobs get
+var allowedTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// Image is a decoded upload along with what was sniffed from its bytes
+type Image struct {
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+
+	img image.Image
+}
+
+// Decode sniffs the content type from the data itself, ignoring whatever
+// the client claimed, and decodes the image
+func Decode(data []byte) (*Image, error) {
+	contentType := http.DetectContentType(data)
+	extension, ok := allowedTypes[contentType]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxPixels {
+		return nil, fmt.Errorf("image dimensions %dx%d are too large", config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+
+	return &Image{
+		ContentType: contentType,
+		Extension:   extension,
+		Width:       config.Width,
+		Height:      config.Height,
+		img:         img,
+	}, nil
+}
+
+// Thumbnail scales the image down so its longer edge is at most maxEdge and
+// encodes it. JPEGs stay JPEG; everything else becomes PNG to keep
+// transparency. It returns the encoded bytes, content type and extension.
+func (i *Image) Thumbnail(maxEdge int) ([]byte, string, string, error) {
+	width, height := i.Width, i.Height
+	if width > maxEdge || height > maxEdge {
+		if width >= height {
+			height = max(1, height*maxEdge/width)
+			width = maxEdge
+		} else {
+			width = max(1, width*maxEdge/height)
+			height = maxEdge
+		}
+	}
+
+	thumb := resize(i.img, width, height)
+
+	var buf bytes.Buffer
+	if i.ContentType == "image/jpeg" {
+		if err := jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, "", "", fmt.Errorf("failed to encode thumbnail: %w", err)
+		}
+		return buf.Bytes(), "image/jpeg", "jpg", nil
+	}
+
+	if err := png.Encode(&buf, thumb); err != nil {
+		return nil, "", "", fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), "image/png", "png", nil
+}
+
+// resize scales src to width x height by averaging the source pixels that
+// fall into each destination pixel (a box filter), which is good enough for
+// downscaling thumbnails
+func resize(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	srcW, srcH := rgba.Bounds().Dx(), rgba.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * srcH / height
+		y1 := max(y0+1, (y+1)*srcH/height)
+		for x := 0; x < width; x++ {
+			x0 := x * srcW / width
+			x1 := max(x0+1, (x+1)*srcW/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := sy*rgba.Stride + x0*4
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(rgba.Pix[offset])
+					g += uint64(rgba.Pix[offset+1])
+					b += uint64(rgba.Pix[offset+2])
+					a += uint64(rgba.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			d := y*dst.Stride + x*4
+			dst.Pix[d] = uint8(r / n)
+			dst.Pix[d+1] = uint8(g / n)
+			dst.Pix[d+2] = uint8(b / n)
+			dst.Pix[d+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/api-gateway/clients"
+	pb "github.com/martbul/playground_microservices/services/api-gateway/genproto/auth"
+)
+
+// defaultStoreID is the store requests go to when neither the X-Store
+// header nor the host picks one
+const defaultStoreID = "00000000-0000-0000-0000-000000000001"
+
+const (
+	// How long a looked up store is reused, found or not
+	storeCacheTTL = time.Minute
+	// The cache starts over once it holds this many hosts and names, so
+	// requests with made-up ones can't grow it forever
+	storeCacheMaxEntries = 1000
+)
+
+var storeIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+type storeCacheEntry struct {
+	store   *pb.Store // nil if nothing matched
+	expires time.Time
+}
+
+// storeResolver looks stores up in the auth service, remembering the answers
+// for a while since every request needs one
+type storeResolver struct {
+	authClient *clients.AuthGrpcClient
+
+	mu      sync.Mutex
+	entries map[string]storeCacheEntry
+}
+
+// resolve returns the active store matching req, or nil if there is none
+func (s *storeResolver) resolve(ctx context.Context, key string, req *pb.ResolveStoreRequest) (*pb.Store, error) {
+	s.mu.Lock()
+	entry, ok := s.entries[key]
+	s.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.store, nil
+	}
+
+	resp, err := s.authClient.ResolveStore(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var store *pb.Store
+	if resp.Response.Success {
+		store = resp.Store
+	}
+
+	s.mu.Lock()
+	if len(s.entries) >= storeCacheMaxEntries {
+		s.entries = make(map[string]storeCacheEntry)
+	}
+	s.entries[key] = storeCacheEntry{store: store, expires: time.Now().Add(storeCacheTTL)}
+	s.mu.Unlock()
+
+	return store, nil
+}
+
+// TenantMiddleware works out which store a request is for: the one the
+// X-Store header names by ID or slug, else the one served on the request's
+// host, else the default store. The store's ID goes with every gRPC call
+// made with the request's context, and the store itself is in the context
+// under "store".
+func TenantMiddleware(authClient *clients.AuthGrpcClient) func(http.Handler) http.Handler {
+	resolver := &storeResolver{
+		authClient: authClient,
+		entries:    make(map[string]storeCacheEntry),
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Health checks and media files don't belong to a store
+			if !strings.HasPrefix(r.URL.Path, "/api/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var store *pb.Store
+			var err error
+
+			if name := strings.TrimSpace(r.Header.Get("X-Store")); name != "" {
+				req := &pb.ResolveStoreRequest{Slug: name}
+				if storeIDPattern.MatchString(name) {
+					req = &pb.ResolveStoreRequest{Id: name}
+				}
+				store, err = resolver.resolve(r.Context(), "name:"+strings.ToLower(name), req)
+				if err == nil && store == nil {
+					http.Error(w, "Store not found", http.StatusNotFound)
+					return
+				}
+			} else {
+				// Behind a proxy the storefront's host is in X-Forwarded-Host
+				host := r.Host
+				if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+					host = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+				}
+				if host != "" {
+					store, err = resolver.resolve(r.Context(), "host:"+strings.ToLower(host), &pb.ResolveStoreRequest{Domain: host})
+				}
+				if err == nil && store == nil {
+					store, err = resolver.resolve(r.Context(), "name:"+defaultStoreID, &pb.ResolveStoreRequest{Id: defaultStoreID})
+				}
+			}
+			if err != nil || store == nil {
+				log.Printf("Store resolution error: %v", err)
+				http.Error(w, "Store lookup failed", http.StatusServiceUnavailable)
+				return
+			}
+
+			ctx := clients.WithStore(r.Context(), store.Id)
+			ctx = context.WithValue(ctx, "store", store)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
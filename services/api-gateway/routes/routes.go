@@ -16,23 +16,208 @@ func SetupAuthRoutes(router *mux.Router, authHandler *handlers.AuthHandler) {
 	authRouter.HandleFunc("/refresh", authHandler.RefreshToken).Methods("POST")
 }
 
-func SetupProductRoutes(router *mux.Router, productHandler *handlers.ProductHandler, authClient *clients.AuthGrpcClient) {
+func SetupStoreRoutes(router *mux.Router, authHandler *handlers.AuthHandler, authClient *clients.AuthGrpcClient) {
+	// Public routes (no authentication required)
+	router.HandleFunc("/api/stores/current", authHandler.GetCurrentStore).Methods("GET")
+
+	storeRouter := router.PathPrefix("/api/stores").Subrouter()
+	storeRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Admin routes
+	storeRouter.HandleFunc("", authHandler.ListStores).Methods("GET")
+	storeRouter.HandleFunc("", authHandler.CreateStore).Methods("POST")
+	storeRouter.HandleFunc("/{id}", authHandler.UpdateStore).Methods("PATCH")
+
+	// Store owner routes
+	storeRouter.HandleFunc("/{id}/members", authHandler.ListStoreMembers).Methods("GET")
+	storeRouter.HandleFunc("/{id}/members", authHandler.SetStoreMember).Methods("PUT")
+	storeRouter.HandleFunc("/{id}/members/{userId}", authHandler.RemoveStoreMember).Methods("DELETE")
+}
+
+func SetupProductRoutes(router *mux.Router, productHandler *handlers.ProductHandler, imageHandler *handlers.ImageHandler, authClient *clients.AuthGrpcClient) {
+	// Batch routes use a custom method suffix, which can't be a subroute of
+	// /api/products because subroute paths have to start with a slash
+	publicBatchRouter := router.NewRoute().Subrouter()
+	publicBatchRouter.Use(middleware.OptionalAuthMiddleware(authClient))
+	publicBatchRouter.HandleFunc("/api/products:batchGet", productHandler.BatchGetProducts).Methods("POST")
+
+	protectedBatchRouter := router.NewRoute().Subrouter()
+	protectedBatchRouter.Use(middleware.AuthMiddleware(authClient))
+	protectedBatchRouter.HandleFunc("/api/products:batch", productHandler.BatchUpdateProducts).Methods("PATCH")
+
 	productRouter := router.PathPrefix("/api/products").Subrouter()
 
+	// Bulk and trash routes are registered first so the public /{id} route
+	// doesn't take /export or /trash
+	bulkRouter := productRouter.PathPrefix("").Subrouter()
+	bulkRouter.Use(middleware.AuthMiddleware(authClient))
+	bulkRouter.HandleFunc("/import", productHandler.ImportProducts).Methods("POST")
+	bulkRouter.HandleFunc("/export", productHandler.ExportProducts).Methods("GET")
+	bulkRouter.HandleFunc("/trash", productHandler.ListDeletedProducts).Methods("GET")
+
 	// Public routes (no authentication required)
 	publicRouter := productRouter.PathPrefix("").Subrouter()
 	publicRouter.Use(middleware.OptionalAuthMiddleware(authClient))
 	publicRouter.HandleFunc("", productHandler.ListProducts).Methods("GET")
 	publicRouter.HandleFunc("/search", productHandler.SearchProducts).Methods("GET")
+	publicRouter.HandleFunc("/stream", productHandler.StreamProducts).Methods("GET")
 	publicRouter.HandleFunc("/categories", productHandler.GetCategories).Methods("GET")
+	publicRouter.HandleFunc("/categories/tree", productHandler.GetCategoryTree).Methods("GET")
+	publicRouter.HandleFunc("/categories/{id}/attributes", productHandler.GetCategoryAttributes).Methods("GET")
 	publicRouter.HandleFunc("/{id}", productHandler.GetProduct).Methods("GET")
+	publicRouter.HandleFunc("/{id}/variants", productHandler.ListProductVariants).Methods("GET")
+	publicRouter.HandleFunc("/{id}/images", imageHandler.ListImages).Methods("GET")
+	publicRouter.HandleFunc("/{id}/reviews", productHandler.ListProductReviews).Methods("GET")
+	publicRouter.HandleFunc("/{id}/recommendations", productHandler.GetProductRecommendations).Methods("GET")
 
 	// Protected routes (authentication required)
 	protectedRouter := productRouter.PathPrefix("").Subrouter()
 	protectedRouter.Use(middleware.AuthMiddleware(authClient))
 	protectedRouter.HandleFunc("", productHandler.CreateProduct).Methods("POST")
+	protectedRouter.HandleFunc("/categories", productHandler.CreateCategory).Methods("POST")
+	protectedRouter.HandleFunc("/categories/{id}", productHandler.UpdateCategory).Methods("PUT")
+	protectedRouter.HandleFunc("/categories/{id}", productHandler.DeleteCategory).Methods("DELETE")
+	protectedRouter.HandleFunc("/categories/{id}/move", productHandler.MoveCategory).Methods("POST")
+	protectedRouter.HandleFunc("/categories/{id}/attributes", productHandler.SetCategoryAttributes).Methods("PUT")
 	protectedRouter.HandleFunc("/{id}", productHandler.UpdateProduct).Methods("PUT")
+	protectedRouter.HandleFunc("/{id}", productHandler.PatchProduct).Methods("PATCH")
 	protectedRouter.HandleFunc("/{id}", productHandler.DeleteProduct).Methods("DELETE")
+	protectedRouter.HandleFunc("/{id}/restore", productHandler.RestoreProduct).Methods("POST")
+	protectedRouter.HandleFunc("/{id}/history", productHandler.GetProductHistory).Methods("GET")
+	protectedRouter.HandleFunc("/{id}/revert", productHandler.RevertProduct).Methods("POST")
+	protectedRouter.HandleFunc("/{id}/price-schedules", productHandler.ListPriceSchedules).Methods("GET")
+	protectedRouter.HandleFunc("/{id}/price-schedules", productHandler.CreatePriceSchedule).Methods("POST")
+	protectedRouter.HandleFunc("/{id}/price-schedules/{scheduleId}", productHandler.CancelPriceSchedule).Methods("DELETE")
+	protectedRouter.HandleFunc("/{id}/prices", productHandler.SetProductPrices).Methods("PUT")
+	protectedRouter.HandleFunc("/{id}/options", productHandler.SetProductOptions).Methods("PUT")
+	protectedRouter.HandleFunc("/{id}/variants", productHandler.CreateProductVariant).Methods("POST")
+	protectedRouter.HandleFunc("/{id}/variants/{variantId}", productHandler.UpdateProductVariant).Methods("PUT")
+	protectedRouter.HandleFunc("/{id}/variants/{variantId}", productHandler.DeleteProductVariant).Methods("DELETE")
+	protectedRouter.HandleFunc("/{id}/images", imageHandler.UploadImages).Methods("POST")
+	protectedRouter.HandleFunc("/{id}/images/order", imageHandler.ReorderImages).Methods("PUT")
+	protectedRouter.HandleFunc("/{id}/images/{imageId}", imageHandler.DeleteImage).Methods("DELETE")
+	protectedRouter.HandleFunc("/{id}/stock/adjust", productHandler.AdjustStock).Methods("POST")
+	protectedRouter.HandleFunc("/{id}/stock/movements", productHandler.ListStockMovements).Methods("GET")
+	protectedRouter.HandleFunc("/{id}/reviews", productHandler.CreateProductReview).Methods("POST")
+}
+
+func SetupReviewRoutes(router *mux.Router, productHandler *handlers.ProductHandler, authClient *clients.AuthGrpcClient) {
+	reviewRouter := router.PathPrefix("/api/reviews").Subrouter()
+	reviewRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (authentication required); reviews are listed per
+	// product under /api/products/{id}/reviews
+	reviewRouter.HandleFunc("", productHandler.ListReviews).Methods("GET")
+	reviewRouter.HandleFunc("/{id}", productHandler.UpdateProductReview).Methods("PUT")
+	reviewRouter.HandleFunc("/{id}", productHandler.DeleteProductReview).Methods("DELETE")
+	reviewRouter.HandleFunc("/{id}/moderate", productHandler.ModerateProductReview).Methods("POST")
+}
+
+func SetupWishlistRoutes(router *mux.Router, productHandler *handlers.ProductHandler, authClient *clients.AuthGrpcClient) {
+	// Anyone with a share link can view that wishlist
+	router.HandleFunc("/api/wishlists/shared/{token}", productHandler.GetSharedWishlist).Methods("GET")
+
+	wishlistRouter := router.PathPrefix("/api/wishlist").Subrouter()
+	wishlistRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (authentication required)
+	wishlistRouter.HandleFunc("", productHandler.GetWishlist).Methods("GET")
+	wishlistRouter.HandleFunc("/items", productHandler.AddToWishlist).Methods("POST")
+	wishlistRouter.HandleFunc("/items/{productId}", productHandler.RemoveFromWishlist).Methods("DELETE")
+	wishlistRouter.HandleFunc("/order", productHandler.ReorderWishlist).Methods("PUT")
+	wishlistRouter.HandleFunc("/share", productHandler.ShareWishlist).Methods("POST")
+}
+
+func SetupRecommendationRoutes(router *mux.Router, productHandler *handlers.ProductHandler, authClient *clients.AuthGrpcClient) {
+	recommendationRouter := router.PathPrefix("/api/recommendations").Subrouter()
+
+	// Signed-in users get recommendations from their views, guests the
+	// popular products; products have theirs at
+	// /api/products/{id}/recommendations
+	recommendationRouter.Use(middleware.OptionalAuthMiddleware(authClient))
+	recommendationRouter.HandleFunc("", productHandler.GetRecommendations).Methods("GET")
+}
+
+func SetupWebhookRoutes(router *mux.Router, productHandler *handlers.ProductHandler, authClient *clients.AuthGrpcClient) {
+	webhookRouter := router.PathPrefix("/api/webhooks").Subrouter()
+	webhookRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (admins only)
+	webhookRouter.HandleFunc("", productHandler.ListWebhooks).Methods("GET")
+	webhookRouter.HandleFunc("", productHandler.CreateWebhook).Methods("POST")
+	webhookRouter.HandleFunc("/deliveries", productHandler.ListWebhookDeliveries).Methods("GET")
+	webhookRouter.HandleFunc("/deliveries/{id}/retry", productHandler.RetryWebhookDelivery).Methods("POST")
+	webhookRouter.HandleFunc("/{id}", productHandler.UpdateWebhook).Methods("PUT")
+	webhookRouter.HandleFunc("/{id}", productHandler.DeleteWebhook).Methods("DELETE")
+	webhookRouter.HandleFunc("/{id}/deliveries", productHandler.ListWebhookDeliveries).Methods("GET")
+	webhookRouter.HandleFunc("/{id}/test", productHandler.SendTestWebhook).Methods("POST")
+}
+
+func SetupCartRoutes(router *mux.Router, cartHandler *handlers.CartHandler, authClient *clients.AuthGrpcClient) {
+	cartRouter := router.PathPrefix("/api/cart").Subrouter()
+
+	// Guests have carts too, so only merging needs a signed-in user
+	publicRouter := cartRouter.PathPrefix("").Subrouter()
+	publicRouter.Use(middleware.OptionalAuthMiddleware(authClient))
+	publicRouter.HandleFunc("", cartHandler.GetCart).Methods("GET")
+	publicRouter.HandleFunc("", cartHandler.ClearCart).Methods("DELETE")
+	publicRouter.HandleFunc("/items", cartHandler.AddCartItem).Methods("POST")
+	publicRouter.HandleFunc("/items/{itemId}", cartHandler.UpdateCartItem).Methods("PUT")
+	publicRouter.HandleFunc("/items/{itemId}", cartHandler.RemoveCartItem).Methods("DELETE")
+
+	// Protected routes (authentication required)
+	protectedRouter := cartRouter.PathPrefix("").Subrouter()
+	protectedRouter.Use(middleware.AuthMiddleware(authClient))
+	protectedRouter.HandleFunc("/merge", cartHandler.MergeCarts).Methods("POST")
+}
+
+func SetupOrderRoutes(router *mux.Router, orderHandler *handlers.OrderHandler, authClient *clients.AuthGrpcClient) {
+	orderRouter := router.PathPrefix("/api/orders").Subrouter()
+	orderRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (authentication required)
+	orderRouter.HandleFunc("", orderHandler.Checkout).Methods("POST")
+	orderRouter.HandleFunc("", orderHandler.ListOrders).Methods("GET")
+	orderRouter.HandleFunc("/{id}", orderHandler.GetOrder).Methods("GET")
+	orderRouter.HandleFunc("/{id}/status", orderHandler.UpdateOrderStatus).Methods("POST")
+}
+
+func SetupPromotionRoutes(router *mux.Router, orderHandler *handlers.OrderHandler, authClient *clients.AuthGrpcClient) {
+	promotionRouter := router.PathPrefix("/api/promotions").Subrouter()
+	promotionRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (authentication required)
+	promotionRouter.HandleFunc("/evaluate", orderHandler.EvaluatePromotions).Methods("POST")
+
+	// Admin routes
+	promotionRouter.HandleFunc("", orderHandler.ListPromotions).Methods("GET")
+	promotionRouter.HandleFunc("", orderHandler.CreatePromotion).Methods("POST")
+	promotionRouter.HandleFunc("/{id}", orderHandler.GetPromotion).Methods("GET")
+	promotionRouter.HandleFunc("/{id}", orderHandler.UpdatePromotion).Methods("PUT")
+	promotionRouter.HandleFunc("/{id}", orderHandler.DeletePromotion).Methods("DELETE")
+}
+
+func SetupNotificationRoutes(router *mux.Router, notificationHandler *handlers.NotificationHandler, authClient *clients.AuthGrpcClient) {
+	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
+	notificationRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (authentication required)
+	notificationRouter.HandleFunc("", notificationHandler.ListNotifications).Methods("GET")
+	notificationRouter.HandleFunc("/read", notificationHandler.MarkNotificationsRead).Methods("POST")
+
+	// Admin routes
+	notificationRouter.HandleFunc("", notificationHandler.SendNotification).Methods("POST")
+}
+
+func SetupInventoryRoutes(router *mux.Router, productHandler *handlers.ProductHandler, authClient *clients.AuthGrpcClient) {
+	inventoryRouter := router.PathPrefix("/api/inventory").Subrouter()
+	inventoryRouter.Use(middleware.AuthMiddleware(authClient))
+
+	// Protected routes (authentication required)
+	inventoryRouter.HandleFunc("/reservations", productHandler.ReserveStock).Methods("POST")
+	inventoryRouter.HandleFunc("/reservations/{id}/commit", productHandler.CommitReservation).Methods("POST")
+	inventoryRouter.HandleFunc("/reservations/{id}/release", productHandler.ReleaseReservation).Methods("POST")
+	inventoryRouter.HandleFunc("/low-stock", productHandler.ListLowStockProducts).Methods("GET")
 }
 
 func SetupProtectedAuthRoutes(router *mux.Router, authHandler *handlers.AuthHandler, authClient *clients.AuthGrpcClient) {
@@ -0,0 +1,15 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidKey is returned for keys that are empty or would escape the store
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// BlobStore stores uploaded files and returns the URL they are served from
+type BlobStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	Delete(ctx context.Context, key string) error
+}
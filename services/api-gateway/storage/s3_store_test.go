@@ -0,0 +1,321 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/martbul/playground_microservices/services/api-gateway/media"
+)
+
+const (
+	testAccessKey = "minioadmin"
+	testSecretKey = "minioadmin-secret"
+	testBucket    = "media"
+	testRegion    = "us-east-1"
+)
+
+type fakeObject struct {
+	contentType string
+	data        []byte
+}
+
+// fakeS3 is a MinIO-style S3 server for one bucket. Like the real thing it
+// rejects requests whose Signature Version 4 doesn't check out or whose body
+// doesn't match the signed payload hash, and serves objects back with GET.
+type fakeS3 struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	objects map[string]fakeObject
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	fake := &fakeS3{t: t, objects: make(map[string]fakeObject)}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != testBucket || key == "" {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	// Objects are public-read, as the gateway serves them straight from here
+	if r.Method == http.MethodGet {
+		f.mu.Lock()
+		object, ok := f.objects[key]
+		f.mu.Unlock()
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Write(object.data)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "IncompleteBody", http.StatusBadRequest)
+		return
+	}
+	if err := f.verify(r, body); err != nil {
+		http.Error(w, "SignatureDoesNotMatch: "+err.Error(), http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = fakeObject{contentType: r.Header.Get("Content-Type"), data: body}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// verify checks the request's Signature Version 4 the way S3 does, from the
+// Authorization header alone
+func (f *fakeS3) verify(r *http.Request, body []byte) error {
+	auth, ok := strings.CutPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ")
+	if !ok {
+		return errors.New("missing signature")
+	}
+	fields := make(map[string]string)
+	for _, field := range strings.Split(auth, ", ") {
+		name, value, _ := strings.Cut(field, "=")
+		fields[name] = value
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[0] != testAccessKey {
+		return fmt.Errorf("unknown credential %q", fields["Credential"])
+	}
+	date, region := credential[1], credential[2]
+
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+	if r.Header.Get("X-Amz-Content-Sha256") != payloadHash {
+		return errors.New("payload hash mismatch")
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	if !sort.StringsAreSorted(signedHeaders) {
+		return errors.New("signed headers are not sorted")
+	}
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := r.Method + "\n" + r.URL.EscapedPath() + "\n" + r.URL.RawQuery + "\n" +
+		canonicalHeaders.String() + "\n" + fields["SignedHeaders"] + "\n" + payloadHash
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + r.Header.Get("X-Amz-Date") + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := []byte("AWS4" + testSecretKey)
+	for _, part := range []string{date, region, "s3", "aws4_request", stringToSign} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	if !hmac.Equal([]byte(hex.EncodeToString(key)), []byte(fields["Signature"])) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func (f *fakeS3) object(key string) (fakeObject, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	object, ok := f.objects[key]
+	return object, ok
+}
+
+func newTestS3Store(t *testing.T, endpoint, secretKey string) *S3BlobStore {
+	store, err := NewS3BlobStore(endpoint, testBucket, testRegion, testAccessKey, secretKey, "")
+	if err != nil {
+		t.Fatalf("NewS3BlobStore: %v", err)
+	}
+	return store
+}
+
+// get fetches a blob from the URL Put returned
+func get(t *testing.T, url string) (int, string, []byte) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	return resp.StatusCode, resp.Header.Get("Content-Type"), body
+}
+
+func TestS3BlobStorePutGetDelete(t *testing.T) {
+	fake, server := newFakeS3(t)
+	store := newTestS3Store(t, server.URL, testSecretKey)
+	ctx := context.Background()
+
+	key := "products/42/abc/original file+1.png"
+	data := []byte("not really a png")
+
+	url, err := store.Put(ctx, key, "image/png", data)
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if want := server.URL + "/" + testBucket + "/products/42/abc/original%20file%2B1.png"; url != want {
+		t.Errorf("Put returned %q, want %q", url, want)
+	}
+
+	object, ok := fake.object(key)
+	if !ok {
+		t.Fatalf("object %q was not stored", key)
+	}
+	if object.contentType != "image/png" || !bytes.Equal(object.data, data) {
+		t.Errorf("stored %q %q, want image/png %q", object.contentType, object.data, data)
+	}
+
+	status, contentType, body := get(t, url)
+	if status != http.StatusOK || contentType != "image/png" || !bytes.Equal(body, data) {
+		t.Errorf("GET returned %d %q %q, want 200 image/png %q", status, contentType, body, data)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok := fake.object(key); ok {
+		t.Errorf("object %q is still stored after Delete", key)
+	}
+	if status, _, _ := get(t, url); status != http.StatusNotFound {
+		t.Errorf("GET after Delete returned %d, want 404", status)
+	}
+
+	// Deleting what isn't there succeeds, as with S3
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing key: %v", err)
+	}
+}
+
+func TestS3BlobStoreRejectsInvalidKeys(t *testing.T) {
+	_, server := newFakeS3(t)
+	store := newTestS3Store(t, server.URL, testSecretKey)
+
+	for _, key := range []string{"", "/absolute.png"} {
+		if _, err := store.Put(context.Background(), key, "image/png", []byte("x")); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) returned %v, want ErrInvalidKey", key, err)
+		}
+		if err := store.Delete(context.Background(), key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Delete(%q) returned %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+func TestS3BlobStoreReportsErrors(t *testing.T) {
+	fake, server := newFakeS3(t)
+	store := newTestS3Store(t, server.URL, "wrong-secret")
+
+	_, err := store.Put(context.Background(), "a.png", "image/png", []byte("x"))
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("Put with a bad secret returned %v, want a 403 error", err)
+	}
+	if _, ok := fake.object("a.png"); ok {
+		t.Error("object was stored despite the bad signature")
+	}
+}
+
+func TestS3BlobStoreThumbnails(t *testing.T) {
+	fake, server := newFakeS3(t)
+	store := newTestS3Store(t, server.URL, testSecretKey)
+
+	// A 400x200 image with a transparent right half
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 200; x++ {
+			src.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatalf("encode source: %v", err)
+	}
+
+	img, err := media.Decode(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	sizes := map[int]image.Point{
+		100: {100, 50},
+		300: {300, 150},
+		800: {400, 200}, // never scaled up
+	}
+	for size, want := range sizes {
+		thumb, contentType, extension, err := img.Thumbnail(size)
+		if err != nil {
+			t.Fatalf("Thumbnail(%d): %v", size, err)
+		}
+		if contentType != "image/png" || extension != "png" {
+			t.Errorf("Thumbnail(%d) is %s .%s, want image/png .png", size, contentType, extension)
+		}
+
+		key := fmt.Sprintf("products/42/abc/thumb_%d.%s", size, extension)
+		url, err := store.Put(context.Background(), key, contentType, thumb)
+		if err != nil {
+			t.Fatalf("Put thumbnail %d: %v", size, err)
+		}
+		if _, ok := fake.object(key); !ok {
+			t.Fatalf("thumbnail %q was not stored", key)
+		}
+
+		status, _, body := get(t, url)
+		if status != http.StatusOK {
+			t.Fatalf("GET thumbnail %d returned %d", size, status)
+		}
+		decoded, err := png.Decode(bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("decode thumbnail %d: %v", size, err)
+		}
+		if got := decoded.Bounds().Size(); got != want {
+			t.Errorf("thumbnail %d is %v, want %v", size, got, want)
+		}
+
+		// Transparency survives: the left edge is red, the right edge clear
+		if _, _, _, a := decoded.At(0, 0).RGBA(); a == 0 {
+			t.Errorf("thumbnail %d lost the opaque half", size)
+		}
+		if _, _, _, a := decoded.At(want.X-1, 0).RGBA(); a != 0 {
+			t.Errorf("thumbnail %d lost transparency", size)
+		}
+	}
+}
@@ -12,6 +12,12 @@ type Config struct {
 	JWTSecret           string
 	JWTExpirationHours  int
 	RefreshTokenExpDays int
+
+	// Domain events; the bus is one of memory, postgres and nats
+	EventBus           string
+	NATSURL            string
+	OutboxPollInterval time.Duration
+	OutboxRetention    time.Duration
 }
 
 //!TODO: Addreal postgress DB
@@ -22,6 +28,11 @@ func Load() *Config {
 		JWTSecret:           getEnv("JWT_SECRET", "your-super-secret-jwt-key"),
 		JWTExpirationHours:  getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
 		RefreshTokenExpDays: getEnvAsInt("REFRESH_TOKEN_EXP_DAYS", 30),
+
+		EventBus:           getEnv("EVENT_BUS", "memory"),
+		NATSURL:            getEnv("NATS_URL", ""),
+		OutboxPollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", 500*time.Millisecond),
+		OutboxRetention:    getEnvAsDuration("OUTBOX_RETENTION", 72*time.Hour),
 	}
 }
 
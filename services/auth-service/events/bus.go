@@ -0,0 +1,55 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/auth-service/config"
+	pb "github.com/martbul/playground_microservices/services/auth-service/genproto/events"
+)
+
+// Handler receives an event. Delivery is at least once, so handlers must
+// cope with seeing the same envelope ID twice. Errors are logged; the bus
+// does not redeliver.
+type Handler func(ctx context.Context, envelope *pb.Envelope) error
+
+// Bus carries envelopes from the outbox relay to subscribers
+type Bus interface {
+	Publish(ctx context.Context, envelope *pb.Envelope) error
+	// Subscribe calls handler for events of the given types, or of every
+	// type if none are given, until ctx is done
+	Subscribe(ctx context.Context, handler Handler, types ...string) error
+	Close() error
+}
+
+// New builds the bus named in cfg.EventBus
+func New(cfg *config.Config, db *sql.DB) (Bus, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.EventBus)) {
+	case "", "memory":
+		return NewMemoryBus(), nil
+	case "postgres":
+		return NewPostgresBus(db, cfg.DatabaseURL), nil
+	case "nats":
+		if cfg.NATSURL == "" {
+			return nil, fmt.Errorf("NATS_URL is required for the nats event bus")
+		}
+		return DialNATS(cfg.NATSURL)
+	default:
+		return nil, fmt.Errorf("unknown event bus %q", cfg.EventBus)
+	}
+}
+
+// wants reports whether a subscription to types covers eventType
+func wants(types []string, eventType string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
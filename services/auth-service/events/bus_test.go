@@ -0,0 +1,270 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/auth-service/genproto/events"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// How long to wait for an event that should arrive, and for one that
+// shouldn't before deciding it won't
+const (
+	deliveryTimeout = 5 * time.Second
+	quietPeriod     = 300 * time.Millisecond
+)
+
+func TestMemoryBus(t *testing.T) {
+	testBusContract(t, func(t *testing.T) Bus {
+		return NewMemoryBus()
+	})
+}
+
+func TestNATSBus(t *testing.T) {
+	srv, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: server.RANDOM_PORT, NoLog: true, NoSigs: true})
+	if err != nil {
+		t.Fatalf("failed to create NATS server: %v", err)
+	}
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+	if !srv.ReadyForConnections(deliveryTimeout) {
+		t.Fatal("NATS server did not start")
+	}
+
+	t.Run("DialNATS", func(t *testing.T) {
+		testBusContract(t, func(t *testing.T) Bus {
+			bus, err := DialNATS(srv.ClientURL())
+			if err != nil {
+				t.Fatalf("DialNATS: %v", err)
+			}
+			return bus
+		})
+	})
+
+	t.Run("NewNATSBus", func(t *testing.T) {
+		testBusContract(t, func(t *testing.T) Bus {
+			conn, err := nats.Connect(srv.ClientURL())
+			if err != nil {
+				t.Fatalf("failed to connect to NATS: %v", err)
+			}
+			return NewNATSBus(conn)
+		})
+	})
+
+	t.Run("MalformedMessage", func(t *testing.T) {
+		bus, err := DialNATS(srv.ClientURL())
+		if err != nil {
+			t.Fatalf("DialNATS: %v", err)
+		}
+		defer bus.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		received := subscribe(t, ctx, bus)
+
+		if err := bus.conn.Publish(natsSubjectPrefix+"user.registered", []byte("not a protobuf \xff\xff")); err != nil {
+			t.Fatalf("failed to publish: %v", err)
+		}
+		envelope := testEnvelope("user.registered")
+		if err := bus.Publish(context.Background(), envelope); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+		expectEnvelope(t, received, envelope)
+	})
+}
+
+// TestPostgresBus needs a database; set TEST_DATABASE_URL to run it
+func TestPostgresBus(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	testBusContract(t, func(t *testing.T) Bus {
+		return NewPostgresBus(db, databaseURL)
+	})
+}
+
+// testBusContract checks what every bus promises: subscribers get the
+// envelopes they asked for, unchanged, and stop getting them once their
+// context is done
+func testBusContract(t *testing.T, newBus func(t *testing.T) Bus) {
+	t.Run("DeliversToEverySubscriber", func(t *testing.T) {
+		bus := newBus(t)
+		defer bus.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		first := subscribe(t, ctx, bus)
+		second := subscribe(t, ctx, bus)
+
+		envelope := testEnvelope("user.registered")
+		if err := bus.Publish(context.Background(), envelope); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+		expectEnvelope(t, first, envelope)
+		expectEnvelope(t, second, envelope)
+	})
+
+	t.Run("FiltersByType", func(t *testing.T) {
+		bus := newBus(t)
+		defer bus.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		logins := subscribe(t, ctx, bus, "user.logged_in")
+		users := subscribe(t, ctx, bus, "user.registered", "user.updated")
+		all := subscribe(t, ctx, bus)
+
+		created := testEnvelope("user.registered")
+		changed := testEnvelope("user.logged_in")
+		updated := testEnvelope("user.updated")
+		for _, envelope := range []*pb.Envelope{created, changed, updated} {
+			if err := bus.Publish(context.Background(), envelope); err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+		}
+
+		expectEnvelope(t, logins, changed)
+		expectNothing(t, logins)
+
+		// A subscription to several types may see them in any order
+		got := map[string]bool{}
+		for i := 0; i < 2; i++ {
+			select {
+			case envelope := <-users:
+				got[envelope.Id] = true
+			case <-time.After(deliveryTimeout):
+				t.Fatalf("got %d of 2 user events", i)
+			}
+		}
+		if !got[created.Id] || !got[updated.Id] {
+			t.Errorf("user subscription got %v, want %s and %s", got, created.Id, updated.Id)
+		}
+		expectNothing(t, users)
+
+		for i := 0; i < 3; i++ {
+			select {
+			case <-all:
+			case <-time.After(deliveryTimeout):
+				t.Fatalf("subscription to every type got %d of 3 events", i)
+			}
+		}
+	})
+
+	t.Run("StopsWhenContextIsDone", func(t *testing.T) {
+		bus := newBus(t)
+		defer bus.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := subscribe(t, ctx, bus)
+		keep, cancelKeep := context.WithCancel(context.Background())
+		defer cancelKeep()
+		kept := subscribe(t, keep, bus)
+
+		cancel()
+		// Unsubscribing happens in the background once ctx is done
+		time.Sleep(quietPeriod)
+
+		envelope := testEnvelope("user.deleted")
+		if err := bus.Publish(context.Background(), envelope); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+		expectEnvelope(t, kept, envelope)
+		expectNothing(t, stopped)
+	})
+
+	t.Run("HandlerErrorsDontStopDelivery", func(t *testing.T) {
+		bus := newBus(t)
+		defer bus.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		received := make(chan *pb.Envelope, 10)
+		err := bus.Subscribe(ctx, func(ctx context.Context, envelope *pb.Envelope) error {
+			if strings.HasPrefix(envelope.Id, runPrefix) {
+				received <- envelope
+			}
+			return fmt.Errorf("handler failed")
+		})
+		if err != nil {
+			t.Fatalf("Subscribe: %v", err)
+		}
+
+		first, second := testEnvelope("user.updated"), testEnvelope("user.updated")
+		for _, envelope := range []*pb.Envelope{first, second} {
+			if err := bus.Publish(context.Background(), envelope); err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+		}
+		expectEnvelope(t, received, first)
+		expectEnvelope(t, received, second)
+	})
+}
+
+// runPrefix starts the ID of every envelope this run sends. Other test runs
+// may share the Postgres channel, so what they send is ignored.
+var (
+	runPrefix     = fmt.Sprintf("%08x-", uint32(time.Now().UnixNano()))
+	envelopeCount int
+)
+
+func testEnvelope(eventType string) *pb.Envelope {
+	envelopeCount++
+	id := fmt.Sprintf("%s0000-4000-8000-%012d", runPrefix, envelopeCount)
+	return NewEnvelope(id, eventType, 1, "aggregate-"+id, time.Now(), []byte("payload "+id))
+}
+
+// subscribe returns a channel that receives what the bus delivers
+func subscribe(t *testing.T, ctx context.Context, bus Bus, types ...string) <-chan *pb.Envelope {
+	t.Helper()
+	received := make(chan *pb.Envelope, 10)
+	err := bus.Subscribe(ctx, func(ctx context.Context, envelope *pb.Envelope) error {
+		if strings.HasPrefix(envelope.Id, runPrefix) {
+			received <- envelope
+		}
+		return nil
+	}, types...)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	return received
+}
+
+func expectEnvelope(t *testing.T, received <-chan *pb.Envelope, want *pb.Envelope) {
+	t.Helper()
+	select {
+	case got := <-received:
+		if !proto.Equal(got, want) {
+			t.Errorf("got envelope %v, want %v", got, want)
+		}
+	case <-time.After(deliveryTimeout):
+		t.Fatalf("envelope %s was not delivered", want.Id)
+	}
+}
+
+func expectNothing(t *testing.T, received <-chan *pb.Envelope) {
+	t.Helper()
+	select {
+	case got := <-received:
+		t.Errorf("got unexpected envelope %s (%s)", got.Id, got.Type)
+	case <-time.After(quietPeriod):
+	}
+}
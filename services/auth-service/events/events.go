@@ -0,0 +1,54 @@
+package events
+
+import (
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/auth-service/genproto/events"
+	"github.com/martbul/playground_microservices/services/auth-service/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// Source names this service in the envelopes it sends
+const Source = "auth-service"
+
+// Event types. Each payload's schema version is in the Event that carries
+// it; a breaking change to a payload bumps its version.
+const (
+	TypeUserRegistered = "user.registered"
+)
+
+// Event is a domain event before it is put in an envelope
+type Event struct {
+	Type        string
+	Version     int32
+	AggregateID string
+	Payload     proto.Message
+}
+
+func UserRegistered(user *models.User) *Event {
+	return &Event{
+		Type:        TypeUserRegistered,
+		Version:     1,
+		AggregateID: user.ID,
+		Payload: &pb.UserRegistered{
+			UserId:    user.ID,
+			Email:     user.Email,
+			Username:  user.Username,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		},
+	}
+}
+
+// NewEnvelope wraps an already encoded payload
+func NewEnvelope(id, eventType string, version int32, aggregateID string, occurredAt time.Time, payload []byte) *pb.Envelope {
+	return &pb.Envelope{
+		Id:          id,
+		Type:        eventType,
+		Version:     version,
+		Source:      Source,
+		AggregateId: aggregateID,
+		OccurredAt:  occurredAt.UTC().Format(time.RFC3339Nano),
+		Payload:     payload,
+	}
+}
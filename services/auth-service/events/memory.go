@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	pb "github.com/martbul/playground_microservices/services/auth-service/genproto/events"
+)
+
+// MemoryBus delivers events to subscribers in this process before Publish
+// returns. It is the default when the service runs on its own.
+type MemoryBus struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[int]memorySubscription
+}
+
+type memorySubscription struct {
+	handler Handler
+	types   []string
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{subs: make(map[int]memorySubscription)}
+}
+
+func (b *MemoryBus) Publish(ctx context.Context, envelope *pb.Envelope) error {
+	b.mu.RLock()
+	var handlers []Handler
+	for _, sub := range b.subs {
+		if wants(sub.types, envelope.Type) {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, envelope); err != nil {
+			log.Printf("Event handler failed for %s %s: %v", envelope.Type, envelope.Id, err)
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBus) Subscribe(ctx context.Context, handler Handler, types ...string) error {
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.subs[id] = memorySubscription{handler: handler, types: types}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, id)
+		b.mu.Unlock()
+	}()
+	return nil
+}
+
+func (b *MemoryBus) Close() error {
+	b.mu.Lock()
+	b.subs = make(map[int]memorySubscription)
+	b.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/martbul/playground_microservices/services/auth-service/genproto/events"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// natsSubjectPrefix is followed by the event type, e.g. "events.product.created"
+const natsSubjectPrefix = "events."
+
+// NATSBus publishes each event on a subject named after its type
+type NATSBus struct {
+	conn *nats.Conn
+}
+
+// DialNATS connects to the NATS server at url
+func DialNATS(url string) (*NATSBus, error) {
+	conn, err := nats.Connect(url, nats.Name(Source), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	return NewNATSBus(conn), nil
+}
+
+// NewNATSBus uses an existing connection, such as one to an embedded server
+func NewNATSBus(conn *nats.Conn) *NATSBus {
+	return &NATSBus{conn: conn}
+}
+
+func (b *NATSBus) Publish(ctx context.Context, envelope *pb.Envelope) error {
+	data, err := proto.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	if err := b.conn.Publish(natsSubjectPrefix+envelope.Type, data); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	// The relay marks events published once this returns, so make sure the
+	// server has them
+	if err := b.conn.Flush(); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+func (b *NATSBus) Subscribe(ctx context.Context, handler Handler, types ...string) error {
+	subjects := []string{natsSubjectPrefix + ">"}
+	if len(types) > 0 {
+		subjects = subjects[:0]
+		for _, t := range types {
+			subjects = append(subjects, natsSubjectPrefix+t)
+		}
+	}
+
+	deliver := func(msg *nats.Msg) {
+		envelope := &pb.Envelope{}
+		if err := proto.Unmarshal(msg.Data, envelope); err != nil {
+			log.Printf("Ignoring malformed event on %s: %v", msg.Subject, err)
+			return
+		}
+		if err := handler(ctx, envelope); err != nil {
+			log.Printf("Event handler failed for %s %s: %v", envelope.Type, envelope.Id, err)
+		}
+	}
+
+	subs := make([]*nats.Subscription, 0, len(subjects))
+	for _, subject := range subjects {
+		sub, err := b.conn.Subscribe(subject, deliver)
+		if err != nil {
+			for _, s := range subs {
+				s.Unsubscribe()
+			}
+			return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+		}
+		subs = append(subs, sub)
+	}
+	// Events published after Subscribe returns must reach the handler
+	if err := b.conn.Flush(); err != nil {
+		return fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+	}()
+	return nil
+}
+
+// Close closes the connection, including one passed to NewNATSBus
+func (b *NATSBus) Close() error {
+	b.conn.Close()
+	return nil
+}
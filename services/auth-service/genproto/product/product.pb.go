@@ -22,23 +22,40 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Product model
+// Product model. Prices are exact amounts in the product's currency.
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Sku           string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
-	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StockQuantity     int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category          string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl          string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Sku               string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	IsActive          bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CategoryId        string                 `protobuf:"bytes,13,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Options           []*ProductOption       `protobuf:"bytes,14,rep,name=options,proto3" json:"options,omitempty"`
+	Variants          []*ProductVariant      `protobuf:"bytes,15,rep,name=variants,proto3" json:"variants,omitempty"`
+	Attributes        map[string]string      `protobuf:"bytes,16,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // typed per the category schema, rendered as text
+	Images            []*ProductImage        `protobuf:"bytes,17,rep,name=images,proto3" json:"images,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,18,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`       // held by active reservations
+	AvailableQuantity int32                  `protobuf:"varint,19,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`    // stock_quantity - reserved_quantity
+	ReorderThreshold  *int32                 `protobuf:"varint,20,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"` // low-stock alerts fire when available stock drops to this
+	Version           int32                  `protobuf:"varint,21,opt,name=version,proto3" json:"version,omitempty"`                                                 // bumped on every edit, not on stock movements
+	DeletedAt         string                 `protobuf:"bytes,22,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                             // set while the product is in the trash
+	ActiveSale        *PriceSchedule         `protobuf:"bytes,25,opt,name=active_sale,json=activeSale,proto3" json:"active_sale,omitempty"`                          // the sale running now, if any
+	Price             *common.Money          `protobuf:"bytes,26,opt,name=price,proto3" json:"price,omitempty"`
+	CompareAtPrice    *common.Money          `protobuf:"bytes,27,opt,name=compare_at_price,json=compareAtPrice,proto3" json:"compare_at_price,omitempty"` // original price, shown struck through; unset if none
+	EffectivePrice    *common.Money          `protobuf:"bytes,28,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`   // the sale price while a sale runs, otherwise price
+	Prices            []*common.Money        `protobuf:"bytes,29,rep,name=prices,proto3" json:"prices,omitempty"`                                         // fixed prices in other currencies
+	AverageRating     float64                `protobuf:"fixed64,30,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`    // mean rating of approved reviews, 0 without any
+	ReviewCount       int32                  `protobuf:"varint,31,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`           // number of approved reviews
+	StoreId           string                 `protobuf:"bytes,32,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                        // the store selling the product
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -92,13 +109,6 @@ func (x *Product) GetDescription() string {
 	return ""
 }
 
-func (x *Product) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
 func (x *Product) GetStockQuantity() int32 {
 	if x != nil {
 		return x.StockQuantity
@@ -155,32 +165,162 @@ func (x *Product) GetCreatedBy() string {
 	return ""
 }
 
-// Category model
-type Category struct {
+func (x *Product) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Product) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Product) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Product) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Product) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetReorderThreshold() int32 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Product) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Product) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Product) GetActiveSale() *PriceSchedule {
+	if x != nil {
+		return x.ActiveSale
+	}
+	return nil
+}
+
+func (x *Product) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Product) GetCompareAtPrice() *common.Money {
+	if x != nil {
+		return x.CompareAtPrice
+	}
+	return nil
+}
+
+func (x *Product) GetEffectivePrice() *common.Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *Product) GetPrices() []*common.Money {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *Product) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *Product) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *Product) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+// A time-boxed sale price. Times are RFC 3339.
+type PriceSchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // scheduled, active, ended or cancelled
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SalePrice     *common.Money          `protobuf:"bytes,10,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
+func (x *PriceSchedule) Reset() {
+	*x = PriceSchedule{}
 	mi := &file_product_product_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *PriceSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*PriceSchedule) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
+func (x *PriceSchedule) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,75 +332,108 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceSchedule.ProtoReflect.Descriptor instead.
+func (*PriceSchedule) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Category) GetId() string {
+func (x *PriceSchedule) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Category) GetName() string {
+func (x *PriceSchedule) GetProductId() string {
 	if x != nil {
-		return x.Name
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *Category) GetDescription() string {
+func (x *PriceSchedule) GetStartsAt() string {
 	if x != nil {
-		return x.Description
+		return x.StartsAt
 	}
 	return ""
 }
 
-func (x *Category) GetParentId() string {
+func (x *PriceSchedule) GetEndsAt() string {
 	if x != nil {
-		return x.ParentId
+		return x.EndsAt
 	}
 	return ""
 }
 
-func (x *Category) GetIsActive() bool {
+func (x *PriceSchedule) GetStatus() string {
 	if x != nil {
-		return x.IsActive
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-// Create product
-type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Sku           string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PriceSchedule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
+func (x *PriceSchedule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetSalePrice() *common.Money {
+	if x != nil {
+		return x.SalePrice
+	}
+	return nil
+}
+
+// A customer's review of a product; each user reviews a product at most once.
+// Only approved reviews are shown publicly and counted in the product's rating.
+type ProductReview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthorName     string                 `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Rating         int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"` // 1 to 5
+	Title          string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Body           string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // pending, approved or rejected
+	ModeratedBy    string                 `protobuf:"bytes,9,opt,name=moderated_by,json=moderatedBy,proto3" json:"moderated_by,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,10,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"` // why a review was rejected
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductReview) Reset() {
+	*x = ProductReview{}
 	mi := &file_product_product_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *ProductReview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*ProductReview) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductReview) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -272,89 +445,123 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductReview.ProtoReflect.Descriptor instead.
+func (*ProductReview) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateProductRequest) GetToken() string {
+func (x *ProductReview) GetId() string {
 	if x != nil {
-		return x.Token
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *ProductReview) GetProductId() string {
 	if x != nil {
-		return x.Name
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *ProductReview) GetUserId() string {
 	if x != nil {
-		return x.Description
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetPrice() float64 {
+func (x *ProductReview) GetAuthorName() string {
 	if x != nil {
-		return x.Price
+		return x.AuthorName
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateProductRequest) GetStockQuantity() int32 {
+func (x *ProductReview) GetRating() int32 {
 	if x != nil {
-		return x.StockQuantity
+		return x.Rating
 	}
 	return 0
 }
 
-func (x *CreateProductRequest) GetCategory() string {
+func (x *ProductReview) GetTitle() string {
 	if x != nil {
-		return x.Category
+		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetImageUrl() string {
+func (x *ProductReview) GetBody() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Body
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetSku() string {
+func (x *ProductReview) GetStatus() string {
 	if x != nil {
-		return x.Sku
+		return x.Status
 	}
 	return ""
 }
 
-type CreateProductResponse struct {
+func (x *ProductReview) GetModeratedBy() string {
+	if x != nil {
+		return x.ModeratedBy
+	}
+	return ""
+}
+
+func (x *ProductReview) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+func (x *ProductReview) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductReview) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// A product a user saved for later. available is false once the product is
+// deactivated or deleted, and product is left out once it is deleted.
+type WishlistItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Available     bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	InStock       bool                   `protobuf:"varint,5,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	AddedAt       string                 `protobuf:"bytes,6,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
 	mi := &file_product_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *WishlistItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*WishlistItem) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,47 +573,78 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateProductResponse) GetResponse() *common.Response {
+func (x *WishlistItem) GetProductId() string {
 	if x != nil {
-		return x.Response
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateProductResponse) GetProduct() *Product {
+func (x *WishlistItem) GetProduct() *Product {
 	if x != nil {
 		return x.Product
 	}
 	return nil
 }
 
-// Get product
-type GetProductRequest struct {
+func (x *WishlistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *WishlistItem) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *WishlistItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+// A user's saved products in their chosen order. share_token is set while
+// the wishlist can be viewed by anyone with the link.
+type Wishlist struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*WishlistItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *Wishlist) Reset() {
+	*x = Wishlist{}
 	mi := &file_product_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *Wishlist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*Wishlist) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+func (x *Wishlist) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,40 +656,63 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Wishlist.ProtoReflect.Descriptor instead.
+func (*Wishlist) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetProductRequest) GetId() string {
+func (x *Wishlist) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type GetProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *Wishlist) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
+func (x *Wishlist) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// A partner endpoint that is sent catalog events. secret signs the payloads
+// and is only returned when it is created or rotated. An empty event_types
+// subscribes to every event.
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
 	mi := &file_product_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+func (x *Webhook) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,56 +724,101 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetProductResponse) GetResponse() *common.Response {
+func (x *Webhook) GetId() string {
 	if x != nil {
-		return x.Response
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *Webhook) GetUrl() string {
 	if x != nil {
-		return x.Product
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
 	}
 	return nil
 }
 
-// Update product
-type UpdateProductRequest struct {
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Webhook) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// One event sent to one webhook. status is pending (waiting for its next
+// attempt), succeeded or dead (out of attempts; retry it to send it again).
+type WebhookDelivery struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int32                  `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Sku           string                 `protobuf:"bytes,9,opt,name=sku,proto3" json:"sku,omitempty"`
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AttemptCount  int32                  `protobuf:"varint,6,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	ResponseCode  int32                  `protobuf:"varint,7,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"` // of the latest attempt; 0 if it got no response
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt string                 `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt   string                 `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Attempts      []*WebhookAttempt      `protobuf:"bytes,12,rep,name=attempts,proto3" json:"attempts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
 	mi := &file_product_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,103 +830,119 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateProductRequest) GetToken() string {
+func (x *WebhookDelivery) GetId() string {
 	if x != nil {
-		return x.Token
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetId() string {
+func (x *WebhookDelivery) GetWebhookId() string {
 	if x != nil {
-		return x.Id
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *WebhookDelivery) GetEventId() string {
 	if x != nil {
-		return x.Name
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
+func (x *WebhookDelivery) GetEventType() string {
 	if x != nil {
-		return x.Description
+		return x.EventType
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetPrice() float64 {
+func (x *WebhookDelivery) GetStatus() string {
 	if x != nil {
-		return x.Price
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetStockQuantity() int32 {
+func (x *WebhookDelivery) GetResponseCode() int32 {
 	if x != nil {
-		return x.StockQuantity
+		return x.ResponseCode
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetCategory() string {
+func (x *WebhookDelivery) GetLastError() string {
 	if x != nil {
-		return x.Category
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetImageUrl() string {
+func (x *WebhookDelivery) GetNextAttemptAt() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.NextAttemptAt
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetSku() string {
+func (x *WebhookDelivery) GetDeliveredAt() string {
 	if x != nil {
-		return x.Sku
+		return x.DeliveredAt
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetIsActive() bool {
+func (x *WebhookDelivery) GetCreatedAt() string {
 	if x != nil {
-		return x.IsActive
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-type UpdateProductResponse struct {
+func (x *WebhookDelivery) GetAttempts() []*WebhookAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type WebhookAttempt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	ResponseCode  int32                  `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int32                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	AttemptedAt   string                 `protobuf:"bytes,4,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
 	mi := &file_product_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *WebhookAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*WebhookAttempt) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,48 +954,71 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateProductResponse) GetResponse() *common.Response {
+func (x *WebhookAttempt) GetResponseCode() int32 {
 	if x != nil {
-		return x.Response
+		return x.ResponseCode
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateProductResponse) GetProduct() *Product {
+func (x *WebhookAttempt) GetError() string {
 	if x != nil {
-		return x.Product
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-// Delete product
-type DeleteProductRequest struct {
+func (x *WebhookAttempt) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetAttemptedAt() string {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return ""
+}
+
+// Uploaded product image; the first image by position is the primary one
+type ProductImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	Thumbnails    map[string]string      `protobuf:"bytes,9,rep,name=thumbnails,proto3" json:"thumbnails,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // thumbnail URLs keyed by max edge in pixels
+	StorageKeys   []string               `protobuf:"bytes,10,rep,name=storage_keys,json=storageKeys,proto3" json:"storage_keys,omitempty"`                                                     // blob keys of the original and its thumbnails
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
 	mi := &file_product_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *ProductImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*ProductImage) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,46 +1030,113 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteProductRequest) GetToken() string {
+func (x *ProductImage) GetId() string {
 	if x != nil {
-		return x.Token
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteProductRequest) GetId() string {
+func (x *ProductImage) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-type DeleteProductResponse struct {
+func (x *ProductImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ProductImage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ProductImage) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProductImage) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProductImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductImage) GetThumbnails() map[string]string {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+func (x *ProductImage) GetStorageKeys() []string {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+func (x *ProductImage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Product option, e.g. "Size" with values S, M, L
+type ProductOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
+func (x *ProductOption) Reset() {
+	*x = ProductOption{}
 	mi := &file_product_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ProductOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ProductOption) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+func (x *ProductOption) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -736,42 +1148,72 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductOption.ProtoReflect.Descriptor instead.
+func (*ProductOption) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteProductResponse) GetResponse() *common.Response {
+func (x *ProductOption) GetId() string {
 	if x != nil {
-		return x.Response
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductOption) GetValues() []string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-// List products
-type ListProductsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Category      string                    `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	ActiveOnly    bool                      `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProductOption) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
+// Product variant: one combination of option values with its own SKU and stock
+type ProductVariant struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku               string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	OptionValues      map[string]string      `protobuf:"bytes,4,rep,name=option_values,json=optionValues,proto3" json:"option_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StockQuantity     int32                  `protobuf:"varint,7,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	IsActive          bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,11,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,12,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	Price             *common.Money          `protobuf:"bytes,13,opt,name=price,proto3" json:"price,omitempty"` // override; unset means the product price applies
+	EffectivePrice    *common.Money          `protobuf:"bytes,14,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProductVariant) Reset() {
+	*x = ProductVariant{}
 	mi := &file_product_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ProductVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ProductVariant) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductVariant) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,56 +1225,8486 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductVariant.ProtoReflect.Descriptor instead.
+func (*ProductVariant) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListProductsRequest) GetPagination() *common.PaginationRequest {
+func (x *ProductVariant) GetId() string {
 	if x != nil {
-		return x.Pagination
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProductsRequest) GetCategory() string {
+func (x *ProductVariant) GetProductId() string {
 	if x != nil {
-		return x.Category
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetActiveOnly() bool {
+func (x *ProductVariant) GetSku() string {
 	if x != nil {
-		return x.ActiveOnly
+		return x.Sku
 	}
-	return false
+	return ""
 }
 
-type ListProductsResponse struct {
+func (x *ProductVariant) GetOptionValues() map[string]string {
+	if x != nil {
+		return x.OptionValues
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ProductVariant) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetEffectivePrice() *common.Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+// One recorded change to a product. Stock changes are not included; they
+// are in the stock ledger.
+type ProductRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // product version after the change
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`    // create, update, delete, restore or revert
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`      // ID of the user who made the change
+	Changes       []*FieldChange         `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	RevertedTo    string                 `protobuf:"bytes,7,opt,name=reverted_to,json=revertedTo,proto3" json:"reverted_to,omitempty"` // for reverts, the revision that was restored
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRevision) Reset() {
+	*x = ProductRevision{}
+	mi := &file_product_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRevision) ProtoMessage() {}
+
+func (x *ProductRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRevision.ProtoReflect.Descriptor instead.
+func (*ProductRevision) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProductRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductRevision) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductRevision) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ProductRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProductRevision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ProductRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ProductRevision) GetRevertedTo() string {
+	if x != nil {
+		return x.RevertedTo
+	}
+	return ""
+}
+
+func (x *ProductRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Values are JSON so they keep their type; an empty value means unset
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_product_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// One entry in the stock ledger; delta is negative when stock leaves
+type StockMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	QuantityAfter int32                  `protobuf:"varint,5,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference     string                 `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	ReservationId string                 `protobuf:"bytes,8,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_product_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StockMovement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockMovement) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetQuantityAfter() int32 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StockMovement) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// A product or variant whose available stock is at or below its reorder threshold
+type LowStockItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Sku               string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	VariantId         string                 `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	StockQuantity     int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,6,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,7,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	ReorderThreshold  int32                  `protobuf:"varint,8,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LowStockItem) Reset() {
+	*x = LowStockItem{}
+	mi := &file_product_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockItem) ProtoMessage() {}
+
+func (x *LowStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockItem.ProtoReflect.Descriptor instead.
+func (*LowStockItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LowStockItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetReorderThreshold() int32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// Quantity of a product (or one of its variants) held by a reservation
+type ReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_product_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReservationItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// A time-limited hold on stock for a cart or order
+type StockReservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // active, committed, released or expired
+	Items         []*ReservationItem     `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReservation) Reset() {
+	*x = StockReservation{}
+	mi := &file_product_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservation) ProtoMessage() {}
+
+func (x *StockReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservation.ProtoReflect.Descriptor instead.
+func (*StockReservation) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StockReservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockReservation) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StockReservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockReservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StockReservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *StockReservation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockReservation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Category model
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Slug          string                 `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StoreId       string                 `protobuf:"bytes,9,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_product_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Category) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Category) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+// Category tree node
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_product_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// Create product
+type CreateProductRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StockQuantity    int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category         string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Sku              string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	CategoryId       string                 `protobuf:"bytes,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes       map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReorderThreshold *int32                 `protobuf:"varint,11,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // ID of the authenticated user, set by the gateway
+	Price            *common.Money          `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`                          // its currency becomes the product's currency
+	CompareAtPrice   *common.Money          `protobuf:"bytes,15,opt,name=compare_at_price,json=compareAtPrice,proto3" json:"compare_at_price,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_product_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateProductRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetReorderThreshold() int32 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetCompareAtPrice() *common.Money {
+	if x != nil {
+		return x.CompareAtPrice
+	}
+	return nil
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_product_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateProductResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Get product
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_product_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetProductResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Update product
+// Only the fields that are set are changed, so an empty description or a
+// zero price is applied rather than ignored.
+type UpdateProductRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Token                 string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id                    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description           *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	StockQuantity         *int32                 `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3,oneof" json:"stock_quantity,omitempty"`
+	Category              *string                `protobuf:"bytes,7,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	ImageUrl              *string                `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Sku                   *string                `protobuf:"bytes,9,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	IsActive              *bool                  `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	CategoryId            *string                `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Attributes            map[string]string      `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // merged into existing attributes; an empty value removes the key
+	ReorderThreshold      *int32                 `protobuf:"varint,13,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"`
+	ClearReorderThreshold bool                   `protobuf:"varint,14,opt,name=clear_reorder_threshold,json=clearReorderThreshold,proto3" json:"clear_reorder_threshold,omitempty"` // stop low-stock alerts for the product
+	Version               int32                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`                                                            // version the edit is based on; required
+	UpdatedBy             string                 `protobuf:"bytes,16,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`                                        // ID of the authenticated user, set by the gateway
+	ClearCompareAtPrice   bool                   `protobuf:"varint,18,opt,name=clear_compare_at_price,json=clearCompareAtPrice,proto3" json:"clear_compare_at_price,omitempty"`
+	Price                 *common.Money          `protobuf:"bytes,19,opt,name=price,proto3" json:"price,omitempty"` // in the product's currency
+	CompareAtPrice        *common.Money          `protobuf:"bytes,20,opt,name=compare_at_price,json=compareAtPrice,proto3" json:"compare_at_price,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateProductRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetStockQuantity() int32 {
+	if x != nil && x.StockQuantity != nil {
+		return *x.StockQuantity
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetReorderThreshold() int32 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetClearReorderThreshold() bool {
+	if x != nil {
+		return x.ClearReorderThreshold
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetClearCompareAtPrice() bool {
+	if x != nil {
+		return x.ClearCompareAtPrice
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetCompareAtPrice() *common.Money {
+	if x != nil {
+		return x.CompareAtPrice
+	}
+	return nil
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Conflict      bool                   `protobuf:"varint,3,opt,name=conflict,proto3" json:"conflict,omitempty"` // the product changed since the given version; product holds the current one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_product_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateProductResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *UpdateProductResponse) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+// Delete product
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                     // version the delete is based on; required
+	DeletedBy     string                 `protobuf:"bytes,4,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // ID of the authenticated user, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_product_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProductRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteProductRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DeleteProductRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Conflict      bool                   `protobuf:"varint,2,opt,name=conflict,proto3" json:"conflict,omitempty"` // the product changed since the given version
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`    // the current product when there was a conflict
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_product_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteProductResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DeleteProductResponse) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+func (x *DeleteProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// List products
+type ListProductsRequest struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination       *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Category         string                    `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	ActiveOnly       bool                      `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	CategoryId       string                    `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AttributeFilters []string                  `protobuf:"bytes,5,rep,name=attribute_filters,json=attributeFilters,proto3" json:"attribute_filters,omitempty"` // e.g. "attr.ram_gb>=16"
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListProductsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetAttributeFilters() []string {
+	if x != nil {
+		return x.AttributeFilters
+	}
+	return nil
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Products      []*Product                 `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Trash: deleted products are kept for a retention period before being purged
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,3,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"` // ID of the authenticated user, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_product_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestoreProductRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RestoreProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreProductRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_product_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductResponse) ProtoMessage() {}
+
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RestoreProductResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RestoreProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListDeletedProductsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Token         string                    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedBy     string                    `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // empty lists every deleted product
+	Pagination    *common.PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedProductsRequest) Reset() {
+	*x = ListDeletedProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedProductsRequest) ProtoMessage() {}
+
+func (x *ListDeletedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDeletedProductsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListDeletedProductsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListDeletedProductsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListDeletedProductsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Products      []*Product                 `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedProductsResponse) Reset() {
+	*x = ListDeletedProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedProductsResponse) ProtoMessage() {}
+
+func (x *ListDeletedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDeletedProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListDeletedProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListDeletedProductsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Permanently removes products that have been in the trash longer than the
+// retention period
+type PurgeDeletedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedProductsRequest) Reset() {
+	*x = PurgeDeletedProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedProductsRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedProductsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PurgeDeletedProductsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PurgeDeletedProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Purged        int32                  `protobuf:"varint,2,opt,name=purged,proto3" json:"purged,omitempty"`
+	StorageKeys   []string               `protobuf:"bytes,3,rep,name=storage_keys,json=storageKeys,proto3" json:"storage_keys,omitempty"` // blobs of the purged products' images, for the caller to delete
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedProductsResponse) Reset() {
+	*x = PurgeDeletedProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedProductsResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedProductsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PurgeDeletedProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *PurgeDeletedProductsResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+func (x *PurgeDeletedProductsResponse) GetStorageKeys() []string {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+// Change history, newest first
+type GetProductHistoryRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Token         string                    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                    `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductHistoryRequest) Reset() {
+	*x = GetProductHistoryRequest{}
+	mi := &file_product_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryRequest) ProtoMessage() {}
+
+func (x *GetProductHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetProductHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetProductHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductHistoryRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetProductHistoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Revisions     []*ProductRevision         `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductHistoryResponse) Reset() {
+	*x = GetProductHistoryResponse{}
+	mi := &file_product_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryResponse) ProtoMessage() {}
+
+func (x *GetProductHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetProductHistoryResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetProductHistoryResponse) GetRevisions() []*ProductRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetProductHistoryResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Puts the product's fields back the way they were after the given revision;
+// stock is left alone
+type RevertProductToRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                        // version the revert is based on; required
+	RevertedBy    string                 `protobuf:"bytes,5,opt,name=reverted_by,json=revertedBy,proto3" json:"reverted_by,omitempty"` // ID of the authenticated user, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertProductToRevisionRequest) Reset() {
+	*x = RevertProductToRevisionRequest{}
+	mi := &file_product_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertProductToRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertProductToRevisionRequest) ProtoMessage() {}
+
+func (x *RevertProductToRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertProductToRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RevertProductToRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevertProductToRevisionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevertProductToRevisionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RevertProductToRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *RevertProductToRevisionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RevertProductToRevisionRequest) GetRevertedBy() string {
+	if x != nil {
+		return x.RevertedBy
+	}
+	return ""
+}
+
+type RevertProductToRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Conflict      bool                   `protobuf:"varint,3,opt,name=conflict,proto3" json:"conflict,omitempty"` // the product changed since the given version; product holds the current one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertProductToRevisionResponse) Reset() {
+	*x = RevertProductToRevisionResponse{}
+	mi := &file_product_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertProductToRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertProductToRevisionResponse) ProtoMessage() {}
+
+func (x *RevertProductToRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertProductToRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RevertProductToRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevertProductToRevisionResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RevertProductToRevisionResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *RevertProductToRevisionResponse) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+// Price schedules
+type CreatePriceScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // defaults to now
+	EndsAt        string                 `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // ID of the authenticated user, set by the gateway
+	SalePrice     *common.Money          `protobuf:"bytes,7,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceScheduleRequest) Reset() {
+	*x = CreatePriceScheduleRequest{}
+	mi := &file_product_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceScheduleRequest) ProtoMessage() {}
+
+func (x *CreatePriceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePriceScheduleRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreatePriceScheduleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreatePriceScheduleRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreatePriceScheduleRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CreatePriceScheduleRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreatePriceScheduleRequest) GetSalePrice() *common.Money {
+	if x != nil {
+		return x.SalePrice
+	}
+	return nil
+}
+
+type CreatePriceScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Schedule      *PriceSchedule         `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceScheduleResponse) Reset() {
+	*x = CreatePriceScheduleResponse{}
+	mi := &file_product_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceScheduleResponse) ProtoMessage() {}
+
+func (x *CreatePriceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreatePriceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreatePriceScheduleResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreatePriceScheduleResponse) GetSchedule() *PriceSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type CancelPriceScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceScheduleRequest) Reset() {
+	*x = CancelPriceScheduleRequest{}
+	mi := &file_product_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceScheduleRequest) ProtoMessage() {}
+
+func (x *CancelPriceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelPriceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelPriceScheduleRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CancelPriceScheduleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CancelPriceScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type CancelPriceScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Schedule      *PriceSchedule         `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceScheduleResponse) Reset() {
+	*x = CancelPriceScheduleResponse{}
+	mi := &file_product_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceScheduleResponse) ProtoMessage() {}
+
+func (x *CancelPriceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CancelPriceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelPriceScheduleResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CancelPriceScheduleResponse) GetSchedule() *PriceSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListPriceSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceSchedulesRequest) Reset() {
+	*x = ListPriceSchedulesRequest{}
+	mi := &file_product_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceSchedulesRequest) ProtoMessage() {}
+
+func (x *ListPriceSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListPriceSchedulesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListPriceSchedulesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListPriceSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Schedules     []*PriceSchedule       `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceSchedulesResponse) Reset() {
+	*x = ListPriceSchedulesResponse{}
+	mi := &file_product_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceSchedulesResponse) ProtoMessage() {}
+
+func (x *ListPriceSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListPriceSchedulesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListPriceSchedulesResponse) GetSchedules() []*PriceSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+// Replace the product's price list. Each entry fixes the price in one
+// currency other than the product's own; currencies left out are dropped.
+type SetProductPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Prices        []*common.Money        `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPricesRequest) Reset() {
+	*x = SetProductPricesRequest{}
+	mi := &file_product_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPricesRequest) ProtoMessage() {}
+
+func (x *SetProductPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPricesRequest.ProtoReflect.Descriptor instead.
+func (*SetProductPricesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetProductPricesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetProductPricesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductPricesRequest) GetPrices() []*common.Money {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type SetProductPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPricesResponse) Reset() {
+	*x = SetProductPricesResponse{}
+	mi := &file_product_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPricesResponse) ProtoMessage() {}
+
+func (x *SetProductPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPricesResponse.ProtoReflect.Descriptor instead.
+func (*SetProductPricesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetProductPricesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SetProductPricesResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Reviews. New and edited reviews wait for moderation.
+type CreateProductReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // ID of the authenticated user, set by the gateway
+	AuthorName    string                 `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"` // name shown with the review, set by the gateway
+	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductReviewRequest) Reset() {
+	*x = CreateProductReviewRequest{}
+	mi := &file_product_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductReviewRequest) ProtoMessage() {}
+
+func (x *CreateProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateProductReviewRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateProductReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CreateProductReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Review        *ProductReview         `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductReviewResponse) Reset() {
+	*x = CreateProductReviewResponse{}
+	mi := &file_product_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductReviewResponse) ProtoMessage() {}
+
+func (x *CreateProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateProductReviewResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreateProductReviewResponse) GetReview() *ProductReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Only the review's author can edit it
+type UpdateProductReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID of the authenticated user, set by the gateway
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductReviewRequest) Reset() {
+	*x = UpdateProductReviewRequest{}
+	mi := &file_product_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductReviewRequest) ProtoMessage() {}
+
+func (x *UpdateProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateProductReviewRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateProductReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProductReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateProductReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateProductReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateProductReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Review        *ProductReview         `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductReviewResponse) Reset() {
+	*x = UpdateProductReviewResponse{}
+	mi := &file_product_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductReviewResponse) ProtoMessage() {}
+
+func (x *UpdateProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateProductReviewResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UpdateProductReviewResponse) GetReview() *ProductReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type DeleteProductReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID of the authenticated user, set by the gateway
+	Moderator     bool                   `protobuf:"varint,4,opt,name=moderator,proto3" json:"moderator,omitempty"`        // moderators can delete any review, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductReviewRequest) Reset() {
+	*x = DeleteProductReviewRequest{}
+	mi := &file_product_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductReviewRequest) ProtoMessage() {}
+
+func (x *DeleteProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteProductReviewRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteProductReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteProductReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteProductReviewRequest) GetModerator() bool {
+	if x != nil {
+		return x.Moderator
+	}
+	return false
+}
+
+type DeleteProductReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductReviewResponse) Reset() {
+	*x = DeleteProductReviewResponse{}
+	mi := &file_product_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductReviewResponse) ProtoMessage() {}
+
+func (x *DeleteProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteProductReviewResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Lists a product's approved reviews, plus the viewer's own review whatever
+// its status. Moderators can list by any status and across all products.
+// Pagination sorts by created_at (the default, newest first) or rating.
+type ListProductReviewsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ProductId     string                    `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ViewerId      string                    `protobuf:"bytes,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // ID of the authenticated user, if any
+	Moderator     bool                      `protobuf:"varint,4,opt,name=moderator,proto3" json:"moderator,omitempty"`              // set by the gateway
+	Status        string                    `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                     // moderators only; empty lists every status
+	Rating        int32                     `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`                    // only reviews with this rating; 0 for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductReviewsRequest) Reset() {
+	*x = ListProductReviewsRequest{}
+	mi := &file_product_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductReviewsRequest) ProtoMessage() {}
+
+func (x *ListProductReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListProductReviewsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductReviewsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductReviewsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListProductReviewsRequest) GetModerator() bool {
+	if x != nil {
+		return x.Moderator
+	}
+	return false
+}
+
+func (x *ListProductReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductReviewsRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type ListProductReviewsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Reviews       []*ProductReview           `protobuf:"bytes,2,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductReviewsResponse) Reset() {
+	*x = ListProductReviewsResponse{}
+	mi := &file_product_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductReviewsResponse) ProtoMessage() {}
+
+func (x *ListProductReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListProductReviewsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListProductReviewsResponse) GetReviews() []*ProductReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListProductReviewsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ModerateProductReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                              // approved or rejected
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`                                  // shown to the author when a review is rejected
+	ModeratedBy   string                 `protobuf:"bytes,5,opt,name=moderated_by,json=moderatedBy,proto3" json:"moderated_by,omitempty"` // ID of the authenticated user, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateProductReviewRequest) Reset() {
+	*x = ModerateProductReviewRequest{}
+	mi := &file_product_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductReviewRequest) ProtoMessage() {}
+
+func (x *ModerateProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ModerateProductReviewRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ModerateProductReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateProductReviewRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateProductReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerateProductReviewRequest) GetModeratedBy() string {
+	if x != nil {
+		return x.ModeratedBy
+	}
+	return ""
+}
+
+type ModerateProductReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Review        *ProductReview         `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateProductReviewResponse) Reset() {
+	*x = ModerateProductReviewResponse{}
+	mi := &file_product_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductReviewResponse) ProtoMessage() {}
+
+func (x *ModerateProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*ModerateProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ModerateProductReviewResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ModerateProductReviewResponse) GetReview() *ProductReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Wishlists. user_id is the ID of the authenticated user, set by the gateway.
+type GetWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistRequest) Reset() {
+	*x = GetWishlistRequest{}
+	mi := &file_product_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistRequest) ProtoMessage() {}
+
+func (x *GetWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetWishlistRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Wishlist      *Wishlist              `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistResponse) Reset() {
+	*x = GetWishlistResponse{}
+	mi := &file_product_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistResponse) ProtoMessage() {}
+
+func (x *GetWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetWishlistResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+type AddToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistRequest) Reset() {
+	*x = AddToWishlistRequest{}
+	mi := &file_product_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistRequest) ProtoMessage() {}
+
+func (x *AddToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddToWishlistRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddToWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddToWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type AddToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Wishlist      *Wishlist              `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistResponse) Reset() {
+	*x = AddToWishlistResponse{}
+	mi := &file_product_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistResponse) ProtoMessage() {}
+
+func (x *AddToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddToWishlistResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AddToWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+type RemoveFromWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistRequest) Reset() {
+	*x = RemoveFromWishlistRequest{}
+	mi := &file_product_product_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveFromWishlistRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveFromWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFromWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RemoveFromWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Wishlist      *Wishlist              `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistResponse) Reset() {
+	*x = RemoveFromWishlistResponse{}
+	mi := &file_product_product_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RemoveFromWishlistResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RemoveFromWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+type ReorderWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,3,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // every saved product, in the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWishlistRequest) Reset() {
+	*x = ReorderWishlistRequest{}
+	mi := &file_product_product_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWishlistRequest) ProtoMessage() {}
+
+func (x *ReorderWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ReorderWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReorderWishlistRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReorderWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderWishlistRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ReorderWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Wishlist      *Wishlist              `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWishlistResponse) Reset() {
+	*x = ReorderWishlistResponse{}
+	mi := &file_product_product_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWishlistResponse) ProtoMessage() {}
+
+func (x *ReorderWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ReorderWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReorderWishlistResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ReorderWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+// Turns the share link on or off; turning it on again gives a new link
+type ShareWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Shared        bool                   `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareWishlistRequest) Reset() {
+	*x = ShareWishlistRequest{}
+	mi := &file_product_product_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareWishlistRequest) ProtoMessage() {}
+
+func (x *ShareWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ShareWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ShareWishlistRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareWishlistRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type ShareWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Wishlist      *Wishlist              `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareWishlistResponse) Reset() {
+	*x = ShareWishlistResponse{}
+	mi := &file_product_product_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareWishlistResponse) ProtoMessage() {}
+
+func (x *ShareWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ShareWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ShareWishlistResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ShareWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+// Anyone with the share token can view the wishlist; the owner's user_id
+// is left out
+type GetSharedWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedWishlistRequest) Reset() {
+	*x = GetSharedWishlistRequest{}
+	mi := &file_product_product_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedWishlistRequest) ProtoMessage() {}
+
+func (x *GetSharedWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetSharedWishlistRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type GetSharedWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Wishlist      *Wishlist              `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedWishlistResponse) Reset() {
+	*x = GetSharedWishlistResponse{}
+	mi := &file_product_product_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedWishlistResponse) ProtoMessage() {}
+
+func (x *GetSharedWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedWishlistResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetSharedWishlistResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetSharedWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+// Search products
+type SearchProductsRequest struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Query            string                    `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Pagination       *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Category         string                    `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	CategoryId       string                    `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AttributeFilters []string                  `protobuf:"bytes,7,rep,name=attribute_filters,json=attributeFilters,proto3" json:"attribute_filters,omitempty"`
+	MinPrice         *common.Money             `protobuf:"bytes,8,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // price bounds only match products priced in their currency
+	MaxPrice         *common.Money             `protobuf:"bytes,9,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetAttributeFilters() []string {
+	if x != nil {
+		return x.AttributeFilters
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetMinPrice() *common.Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetMaxPrice() *common.Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Products      []*Product                 `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SearchProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Get categories
+type GetCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_product_product_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{71}
+}
+
+type GetCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_product_product_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetCategoriesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Create category
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateCategoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateCategoryResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Update category
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Slug          string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateCategoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateCategoryResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Delete category
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteCategoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteCategoryResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Move category under a new parent (empty parent_id moves it to the root)
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MoveCategoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MoveCategoryResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *MoveCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Get category tree
+type GetCategoryTreeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RootId          string                 `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_product_product_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetCategoryTreeRequest) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+func (x *GetCategoryTreeRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Roots         []*CategoryNode        `protobuf:"bytes,2,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_product_product_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCategoryTreeResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetCategoryTreeResponse) GetRoots() []*CategoryNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+// Replace a product's option set
+type SetProductOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Options       []*ProductOption       `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductOptionsRequest) Reset() {
+	*x = SetProductOptionsRequest{}
+	mi := &file_product_product_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductOptionsRequest) ProtoMessage() {}
+
+func (x *SetProductOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetProductOptionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetProductOptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductOptionsRequest) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SetProductOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Options       []*ProductOption       `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductOptionsResponse) Reset() {
+	*x = SetProductOptionsResponse{}
+	mi := &file_product_product_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductOptionsResponse) ProtoMessage() {}
+
+func (x *SetProductOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductOptionsResponse.ProtoReflect.Descriptor instead.
+func (*SetProductOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetProductOptionsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SetProductOptionsResponse) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Create product variant
+type CreateProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	OptionValues  map[string]string      `protobuf:"bytes,4,rep,name=option_values,json=optionValues,proto3" json:"option_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StockQuantity int32                  `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantRequest) Reset() {
+	*x = CreateProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantRequest) ProtoMessage() {}
+
+func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateProductVariantRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetOptionValues() map[string]string {
+	if x != nil {
+		return x.OptionValues
+	}
+	return nil
+}
+
+func (x *CreateProductVariantRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type CreateProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Variant       *ProductVariant        `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantResponse) Reset() {
+	*x = CreateProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantResponse) ProtoMessage() {}
+
+func (x *CreateProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateProductVariantResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreateProductVariantResponse) GetVariant() *ProductVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+// Update product variant; unset optional fields are left unchanged
+type UpdateProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           *string                `protobuf:"bytes,3,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	OptionValues  map[string]string      `protobuf:"bytes,4,rep,name=option_values,json=optionValues,proto3" json:"option_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ClearPrice    bool                   `protobuf:"varint,6,opt,name=clear_price,json=clearPrice,proto3" json:"clear_price,omitempty"`
+	StockQuantity *int32                 `protobuf:"varint,7,opt,name=stock_quantity,json=stockQuantity,proto3,oneof" json:"stock_quantity,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductVariantRequest) Reset() {
+	*x = UpdateProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductVariantRequest) ProtoMessage() {}
+
+func (x *UpdateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateProductVariantRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateProductVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductVariantRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductVariantRequest) GetOptionValues() map[string]string {
+	if x != nil {
+		return x.OptionValues
+	}
+	return nil
+}
+
+func (x *UpdateProductVariantRequest) GetClearPrice() bool {
+	if x != nil {
+		return x.ClearPrice
+	}
+	return false
+}
+
+func (x *UpdateProductVariantRequest) GetStockQuantity() int32 {
+	if x != nil && x.StockQuantity != nil {
+		return *x.StockQuantity
+	}
+	return 0
+}
+
+func (x *UpdateProductVariantRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateProductVariantRequest) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type UpdateProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Variant       *ProductVariant        `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductVariantResponse) Reset() {
+	*x = UpdateProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductVariantResponse) ProtoMessage() {}
+
+func (x *UpdateProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateProductVariantResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UpdateProductVariantResponse) GetVariant() *ProductVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+// Delete product variant
+type DeleteProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantRequest) Reset() {
+	*x = DeleteProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantRequest) ProtoMessage() {}
+
+func (x *DeleteProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteProductVariantRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteProductVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantResponse) Reset() {
+	*x = DeleteProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantResponse) ProtoMessage() {}
+
+func (x *DeleteProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteProductVariantResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// List product variants
+type ListProductVariantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductVariantsRequest) Reset() {
+	*x = ListProductVariantsRequest{}
+	mi := &file_product_product_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductVariantsRequest) ProtoMessage() {}
+
+func (x *ListProductVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductVariantsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListProductVariantsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductVariantsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListProductVariantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Options       []*ProductOption       `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	Variants      []*ProductVariant      `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductVariantsResponse) Reset() {
+	*x = ListProductVariantsResponse{}
+	mi := &file_product_product_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductVariantsResponse) ProtoMessage() {}
+
+func (x *ListProductVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductVariantsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListProductVariantsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListProductVariantsResponse) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ListProductVariantsResponse) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Attribute definition in a category's schema
+type AttributeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"` // string, number, bool or enum
+	EnumValues    []string               `protobuf:"bytes,6,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	Required      bool                   `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_product_product_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AttributeDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// Replace the attribute definitions declared directly on a category
+type SetCategoryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes    []*AttributeDefinition `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAttributesRequest) Reset() {
+	*x = SetCategoryAttributesRequest{}
+	mi := &file_product_product_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *SetCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetCategoryAttributesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetCategoryAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SetCategoryAttributesRequest) GetAttributes() []*AttributeDefinition {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type SetCategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Attributes    []*AttributeDefinition `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAttributesResponse) Reset() {
+	*x = SetCategoryAttributesResponse{}
+	mi := &file_product_product_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesResponse) ProtoMessage() {}
+
+func (x *SetCategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SetCategoryAttributesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SetCategoryAttributesResponse) GetAttributes() []*AttributeDefinition {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Get a category's attribute schema
+type GetCategoryAttributesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId       string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeInherited bool                   `protobuf:"varint,2,opt,name=include_inherited,json=includeInherited,proto3" json:"include_inherited,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCategoryAttributesRequest) Reset() {
+	*x = GetCategoryAttributesRequest{}
+	mi := &file_product_product_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *GetCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetCategoryAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetCategoryAttributesRequest) GetIncludeInherited() bool {
+	if x != nil {
+		return x.IncludeInherited
+	}
+	return false
+}
+
+type GetCategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Attributes    []*AttributeDefinition `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryAttributesResponse) Reset() {
+	*x = GetCategoryAttributesResponse{}
+	mi := &file_product_product_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryAttributesResponse) ProtoMessage() {}
+
+func (x *GetCategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetCategoryAttributesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetCategoryAttributesResponse) GetAttributes() []*AttributeDefinition {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Record an image the gateway has stored; it is appended after existing images
+type AddProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Image         *ProductImage          `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductImageRequest) Reset() {
+	*x = AddProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductImageRequest) ProtoMessage() {}
+
+func (x *AddProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductImageRequest.ProtoReflect.Descriptor instead.
+func (*AddProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AddProductImageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddProductImageRequest) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type AddProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Image         *ProductImage          `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductImageResponse) Reset() {
+	*x = AddProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductImageResponse) ProtoMessage() {}
+
+func (x *AddProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductImageResponse.ProtoReflect.Descriptor instead.
+func (*AddProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AddProductImageResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AddProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+// List product images in display order
+type ListProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesRequest) Reset() {
+	*x = ListProductImagesRequest{}
+	mi := &file_product_product_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesRequest) ProtoMessage() {}
+
+func (x *ListProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListProductImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Images        []*ProductImage        `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesResponse) Reset() {
+	*x = ListProductImagesResponse{}
+	mi := &file_product_product_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesResponse) ProtoMessage() {}
+
+func (x *ListProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListProductImagesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// Delete product image; the deleted image is returned so its blobs can be removed
+type DeleteProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageRequest) Reset() {
+	*x = DeleteProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageRequest) ProtoMessage() {}
+
+func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteProductImageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Image         *ProductImage          `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageResponse) Reset() {
+	*x = DeleteProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageResponse) ProtoMessage() {}
+
+func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteProductImageResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DeleteProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+// Reorder product images; image_ids must list every image of the product
+type ReorderProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,3,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_product_product_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ReorderProductImagesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReorderProductImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReorderProductImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type ReorderProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Images        []*ProductImage        `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_product_product_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesResponse) ProtoMessage() {}
+
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ReorderProductImagesResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ReorderProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// Add or remove on-hand stock; the change is recorded in the stock ledger
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_product_product_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AdjustStockRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type AdjustStockResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Response          *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	StockQuantity     int32                  `protobuf:"varint,2,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,3,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,4,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	Movement          *StockMovement         `protobuf:"bytes,5,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_product_product_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AdjustStockResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *AdjustStockResponse) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *AdjustStockResponse) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *AdjustStockResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+// Hold stock for every item or none of them; ttl_seconds of 0 uses the default
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_product_product_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReserveStockRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Reservation   *StockReservation      `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_product_product_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReserveStockResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ReserveStockResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// Turn a reservation into a sale, removing its items from on-hand stock
+type CommitReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ReservationId string                 `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_product_product_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CommitReservationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CommitReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type CommitReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Reservation   *StockReservation      `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_product_product_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CommitReservationResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CommitReservationResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// Give reserved stock back; releasing a released or expired reservation is a no-op
+type ReleaseReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ReservationId string                 `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_product_product_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ReleaseReservationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReleaseReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Reservation   *StockReservation      `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_product_product_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ReleaseReservationResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ReleaseReservationResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// List a product's stock ledger, newest first
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Token         string                    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ProductId     string                    `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                    `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_product_product_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListStockMovementsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Movements     []*StockMovement           `protobuf:"bytes,2,rep,name=movements,proto3" json:"movements,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_product_product_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListStockMovementsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// List stock at or below its reorder threshold, lowest availability first;
+// created_by limits the result to one owner's products
+type ListLowStockProductsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Token         string                    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedBy     string                    `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockProductsRequest) Reset() {
+	*x = ListLowStockProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockProductsRequest) ProtoMessage() {}
+
+func (x *ListLowStockProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListLowStockProductsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListLowStockProductsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListLowStockProductsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListLowStockProductsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Items         []*LowStockItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockProductsResponse) Reset() {
+	*x = ListLowStockProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockProductsResponse) ProtoMessage() {}
+
+func (x *ListLowStockProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListLowStockProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListLowStockProductsResponse) GetItems() []*LowStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListLowStockProductsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Bulk import. The first message carries the options, every following one a
+// row; the response arrives once the client closes the stream.
+type ImportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportProductsRequest_Options
+	//	*ImportProductsRequest_Row
+	Payload       isImportProductsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ImportProductsRequest) GetPayload() isImportProductsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetRow() *ImportProductRow {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsRequest_Row); ok {
+			return x.Row
+		}
+	}
+	return nil
+}
+
+type isImportProductsRequest_Payload interface {
+	isImportProductsRequest_Payload()
+}
+
+type ImportProductsRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportProductsRequest_Row struct {
+	Row *ImportProductRow `protobuf:"bytes,2,opt,name=row,proto3,oneof"`
+}
+
+func (*ImportProductsRequest_Options) isImportProductsRequest_Payload() {}
+
+func (*ImportProductsRequest_Row) isImportProductsRequest_Payload() {}
+
+type ImportOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`          // validate every row without writing anything
+	Upsert        bool                   `protobuf:"varint,4,opt,name=upsert,proto3" json:"upsert,omitempty"`                        // update products whose SKU already exists instead of failing the row
+	BatchSize     int32                  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // rows written per transaction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_product_product_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ImportOptions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *ImportOptions) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// row is the line number in the source file, used in error reports
+type ImportProductRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Product       *CreateProductRequest  `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductRow) Reset() {
+	*x = ImportProductRow{}
+	mi := &file_product_product_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductRow) ProtoMessage() {}
+
+func (x *ImportProductRow) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductRow.ProtoReflect.Descriptor instead.
+func (*ImportProductRow) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ImportProductRow) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportProductRow) GetProduct() *CreateProductRequest {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_product_product_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	TotalRows     int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	DryRun        bool                   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ImportProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Bulk export, one product per message
+type ExportProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Category        string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	CategoryId      string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,4,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ExportProductsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ExportProductsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ExportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsResponse) Reset() {
+	*x = ExportProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsResponse) ProtoMessage() {}
+
+func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ExportProductsResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Fetch up to 100 products at once by ID and/or SKU. Products come back in
+// request order; IDs and SKUs that match nothing are listed in not_found.
+type BatchGetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Skus          []string               `protobuf:"bytes,2,rep,name=skus,proto3" json:"skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsRequest) Reset() {
+	*x = BatchGetProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsRequest) ProtoMessage() {}
+
+func (x *BatchGetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *BatchGetProductsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetProductsRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+type BatchGetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	NotFound      []string               `protobuf:"bytes,3,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsResponse) Reset() {
+	*x = BatchGetProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsResponse) ProtoMessage() {}
+
+func (x *BatchGetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *BatchGetProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BatchGetProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BatchGetProductsResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+// A partial update; unset fields are left as they are
+type ProductPatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StockQuantity *int32                 `protobuf:"varint,3,opt,name=stock_quantity,json=stockQuantity,proto3,oneof" json:"stock_quantity,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Version       *int32                 `protobuf:"varint,6,opt,name=version,proto3,oneof" json:"version,omitempty"` // version the patch is based on; if set and stale, the item is a conflict
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPatch) Reset() {
+	*x = ProductPatch{}
+	mi := &file_product_product_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPatch) ProtoMessage() {}
+
+func (x *ProductPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPatch.ProtoReflect.Descriptor instead.
+func (*ProductPatch) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ProductPatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetStockQuantity() int32 {
+	if x != nil && x.StockQuantity != nil {
+		return *x.StockQuantity
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ProductPatch) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ProductPatch) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Apply up to 100 patches in one transaction. An item that fails is rolled
+// back on its own and reported in its result; the others still apply.
+type BatchUpdateProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Updates       []*ProductPatch        `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // ID of the authenticated user, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateProductsRequest) Reset() {
+	*x = BatchUpdateProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateProductsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *BatchUpdateProductsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchUpdateProductsRequest) GetUpdates() []*ProductPatch {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *BatchUpdateProductsRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type ProductUpdateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Product       *Product               `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`    // the updated product, or the current one when there was a conflict
+	Conflict      bool                   `protobuf:"varint,5,opt,name=conflict,proto3" json:"conflict,omitempty"` // the product changed since the patch's version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductUpdateResult) Reset() {
+	*x = ProductUpdateResult{}
+	mi := &file_product_product_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductUpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductUpdateResult) ProtoMessage() {}
+
+func (x *ProductUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductUpdateResult.ProtoReflect.Descriptor instead.
+func (*ProductUpdateResult) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ProductUpdateResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductUpdateResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProductUpdateResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProductUpdateResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductUpdateResult) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+type BatchUpdateProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Results       []*ProductUpdateResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateProductsResponse) Reset() {
+	*x = BatchUpdateProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateProductsResponse) ProtoMessage() {}
+
+func (x *BatchUpdateProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *BatchUpdateProductsResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BatchUpdateProductsResponse) GetResults() []*ProductUpdateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdateProductsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BatchUpdateProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// Streams product changes as they happen. Without filters every active
+// product is watched; with both, a change matching either is sent.
+type WatchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryIds   []string               `protobuf:"bytes,1,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProductsRequest) Reset() {
+	*x = WatchProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductsRequest) ProtoMessage() {}
+
+func (x *WatchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProductsRequest.ProtoReflect.Descriptor instead.
+func (*WatchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *WatchProductsRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *WatchProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+// One change to a product. type is the event type, e.g. "product.updated"
+// or "stock.changed". product is its state after the change and is unset
+// when removed is true, i.e. it was deleted, deactivated or moved out of the
+// categories watched.
+type ProductChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	Removed       bool                   `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductChange) Reset() {
+	*x = ProductChange{}
+	mi := &file_product_product_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductChange) ProtoMessage() {}
+
+func (x *ProductChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductChange.ProtoReflect.Descriptor instead.
+func (*ProductChange) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ProductChange) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ProductChange) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductChange) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductChange) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductChange) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *ProductChange) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// Webhooks, managed by admins. The gateway checks the role.
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`                        // generated when empty
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // ID of the authenticated user, set by the gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_product_product_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *CreateWebhookRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Webhook       *Webhook               `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_product_product_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CreateWebhookResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	RotateSecret  bool                   `protobuf:"varint,6,opt,name=rotate_secret,json=rotateSecret,proto3" json:"rotate_secret,omitempty"` // replaces the secret; the new one is returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_product_product_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *UpdateWebhookRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetRotateSecret() bool {
+	if x != nil {
+		return x.RotateSecret
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Webhook       *Webhook               `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_product_product_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UpdateWebhookResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_product_product_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteWebhookRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_product_product_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DeleteWebhookResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_product_product_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListWebhooksRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Webhooks      []*Webhook             `protobuf:"bytes,2,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_product_product_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListWebhooksResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// The delivery log, newest first. status dead lists the dead-letter queue.
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Token         string                    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	WebhookId     string                    `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Status        string                    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_product_product_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Products      []*Product                 `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Deliveries    []*WebhookDelivery         `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_product_product_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,59 +9715,270 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *ListProductsResponse) GetResponse() *common.Response {
+func (x *ListWebhookDeliveriesResponse) GetResponse() *common.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Products
+		return x.Deliveries
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetPagination() *common.PaginationResponse {
+func (x *ListWebhookDeliveriesResponse) GetPagination() *common.PaginationResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// Search products
-type SearchProductsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Query         string                    `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Category      string                    `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	MinPrice      float64                   `protobuf:"fixed64,4,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	MaxPrice      float64                   `protobuf:"fixed64,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+// Sends a dead delivery again, with a fresh set of attempts
+type RetryWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsRequest) Reset() {
-	*x = SearchProductsRequest{}
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_product_product_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsRequest) String() string {
+func (x *RetryWebhookDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsRequest) ProtoMessage() {}
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
 
-func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RetryWebhookDeliveryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryResponse) Reset() {
+	*x = RetryWebhookDeliveryResponse{}
+	mi := &file_product_product_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RetryWebhookDeliveryResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RetryWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// Sends a webhook.test event right away and returns how it went. Test
+// events are tried once.
+type SendTestWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestWebhookRequest) Reset() {
+	*x = SendTestWebhookRequest{}
+	mi := &file_product_product_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestWebhookRequest) ProtoMessage() {}
+
+func (x *SendTestWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SendTestWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SendTestWebhookRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SendTestWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SendTestWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestWebhookResponse) Reset() {
+	*x = SendTestWebhookResponse{}
+	mi := &file_product_product_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestWebhookResponse) ProtoMessage() {}
+
+func (x *SendTestWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestWebhookResponse.ProtoReflect.Descriptor instead.
+func (*SendTestWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *SendTestWebhookResponse) GetResponse() *common.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SendTestWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// Records that someone looked at a product, for recommendations. The viewer
+// is user_id when signed in, else session_id; views without either only
+// count towards popularity.
+type RecordProductViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductViewRequest) Reset() {
+	*x = RecordProductViewRequest{}
+	mi := &file_product_product_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductViewRequest) ProtoMessage() {}
+
+func (x *RecordProductViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,70 +9989,102 @@ func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
-func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RecordProductViewRequest.ProtoReflect.Descriptor instead.
+func (*RecordProductViewRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *SearchProductsRequest) GetQuery() string {
+func (x *RecordProductViewRequest) GetProductId() string {
 	if x != nil {
-		return x.Query
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *SearchProductsRequest) GetPagination() *common.PaginationRequest {
+func (x *RecordProductViewRequest) GetUserId() string {
 	if x != nil {
-		return x.Pagination
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchProductsRequest) GetCategory() string {
+func (x *RecordProductViewRequest) GetSessionId() string {
 	if x != nil {
-		return x.Category
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SearchProductsRequest) GetMinPrice() float64 {
+type RecordProductViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductViewResponse) Reset() {
+	*x = RecordProductViewResponse{}
+	mi := &file_product_product_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductViewResponse) ProtoMessage() {}
+
+func (x *RecordProductViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[148]
 	if x != nil {
-		return x.MinPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductViewResponse.ProtoReflect.Descriptor instead.
+func (*RecordProductViewResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *SearchProductsRequest) GetMaxPrice() float64 {
+func (x *RecordProductViewResponse) GetResponse() *common.Response {
 	if x != nil {
-		return x.MaxPrice
+		return x.Response
 	}
-	return 0
+	return nil
 }
 
-type SearchProductsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Response      *common.Response           `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Products      []*Product                 `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
-	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// Recommends products like product_id, or for user_id from what they viewed
+// lately; with neither, the most popular ones. limit defaults to 8.
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsResponse) Reset() {
-	*x = SearchProductsResponse{}
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_product_product_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsResponse) String() string {
+func (x *GetRecommendationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsResponse) ProtoMessage() {}
+func (*GetRecommendationsRequest) ProtoMessage() {}
 
-func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,54 +10095,56 @@ func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
-func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *SearchProductsResponse) GetResponse() *common.Response {
+func (x *GetRecommendationsRequest) GetProductId() string {
 	if x != nil {
-		return x.Response
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchProductsResponse) GetProducts() []*Product {
+func (x *GetRecommendationsRequest) GetUserId() string {
 	if x != nil {
-		return x.Products
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchProductsResponse) GetPagination() *common.PaginationResponse {
+func (x *GetRecommendationsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// Get categories
-type GetCategoriesRequest struct {
+// reason is also_viewed, popular_in_category or popular
+type Recommendation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoriesRequest) Reset() {
-	*x = GetCategoriesRequest{}
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_product_product_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoriesRequest) String() string {
+func (x *Recommendation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesRequest) ProtoMessage() {}
+func (*Recommendation) ProtoMessage() {}
 
-func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,34 +10155,48 @@ func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{150}
 }
 
-type GetCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Categories    []*Category            `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Recommendation) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
 }
 
-func (x *GetCategoriesResponse) Reset() {
-	*x = GetCategoriesResponse{}
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *Recommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Response        *common.Response       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Recommendations []*Recommendation      `protobuf:"bytes,2,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_product_product_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoriesResponse) String() string {
+func (x *GetRecommendationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesResponse) ProtoMessage() {}
+func (*GetRecommendationsResponse) ProtoMessage() {}
 
-func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,21 +10207,21 @@ func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *GetCategoriesResponse) GetResponse() *common.Response {
+func (x *GetRecommendationsResponse) GetResponse() *common.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *GetCategoriesResponse) GetCategories() []*Category {
+func (x *GetRecommendationsResponse) GetRecommendations() []*Recommendation {
 	if x != nil {
-		return x.Categories
+		return x.Recommendations
 	}
 	return nil
 }
@@ -1099,12 +10230,11 @@ var File_product_product_proto protoreflect.FileDescriptor
 
 const file_product_product_proto_rawDesc = "" +
 	"\n" +
-	"\x15product/product.proto\x12\aproduct\x1a\x13common/common.proto\"\xd1\x02\n" +
+	"\x15product/product.proto\x12\aproduct\x1a\x13common/common.proto\"\xbd\t\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
 	"\x0estock_quantity\x18\x05 \x01(\x05R\rstockQuantity\x12\x1a\n" +
 	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x1b\n" +
 	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x10\n" +
@@ -1116,22 +10246,264 @@ const file_product_product_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\v \x01(\tR\tupdatedAt\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\f \x01(\tR\tcreatedBy\"\x8a\x01\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12\x1f\n" +
+	"\vcategory_id\x18\r \x01(\tR\n" +
+	"categoryId\x120\n" +
+	"\aoptions\x18\x0e \x03(\v2\x16.product.ProductOptionR\aoptions\x123\n" +
+	"\bvariants\x18\x0f \x03(\v2\x17.product.ProductVariantR\bvariants\x12@\n" +
+	"\n" +
+	"attributes\x18\x10 \x03(\v2 .product.Product.AttributesEntryR\n" +
+	"attributes\x12-\n" +
+	"\x06images\x18\x11 \x03(\v2\x15.product.ProductImageR\x06images\x12+\n" +
+	"\x11reserved_quantity\x18\x12 \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\x13 \x01(\x05R\x11availableQuantity\x120\n" +
+	"\x11reorder_threshold\x18\x14 \x01(\x05H\x00R\x10reorderThreshold\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\x15 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x16 \x01(\tR\tdeletedAt\x127\n" +
+	"\vactive_sale\x18\x19 \x01(\v2\x16.product.PriceScheduleR\n" +
+	"activeSale\x12#\n" +
+	"\x05price\x18\x1a \x01(\v2\r.common.MoneyR\x05price\x127\n" +
+	"\x10compare_at_price\x18\x1b \x01(\v2\r.common.MoneyR\x0ecompareAtPrice\x126\n" +
+	"\x0feffective_price\x18\x1c \x01(\v2\r.common.MoneyR\x0eeffectivePrice\x12%\n" +
+	"\x06prices\x18\x1d \x03(\v2\r.common.MoneyR\x06prices\x12%\n" +
+	"\x0eaverage_rating\x18\x1e \x01(\x01R\raverageRating\x12!\n" +
+	"\freview_count\x18\x1f \x01(\x05R\vreviewCount\x12\x19\n" +
+	"\bstore_id\x18  \x01(\tR\astoreId\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x14\n" +
+	"\x12_reorder_thresholdJ\x04\b\x04\x10\x05J\x04\b\x17\x10\x18J\x04\b\x18\x10\x19\"\x9d\x02\n" +
+	"\rPriceSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12,\n" +
+	"\n" +
+	"sale_price\x18\n" +
+	" \x01(\v2\r.common.MoneyR\tsalePriceJ\x04\b\x03\x10\x04\"\xdc\x02\n" +
+	"\rProductReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12!\n" +
+	"\fmoderated_by\x18\t \x01(\tR\vmoderatedBy\x12'\n" +
+	"\x0fmoderation_note\x18\n" +
+	" \x01(\tR\x0emoderationNote\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"\xc9\x01\n" +
+	"\fWishlistItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\x12\x19\n" +
+	"\bin_stock\x18\x05 \x01(\bR\ainStock\x12\x19\n" +
+	"\badded_at\x18\x06 \x01(\tR\aaddedAt\"q\n" +
+	"\bWishlist\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.product.WishlistItemR\x05items\x12\x1f\n" +
+	"\vshare_token\x18\x03 \x01(\tR\n" +
+	"shareToken\"\xde\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x9a\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rattempt_count\x18\x06 \x01(\x05R\fattemptCount\x12#\n" +
+	"\rresponse_code\x18\a \x01(\x05R\fresponseCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12&\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\tR\rnextAttemptAt\x12!\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\tR\vdeliveredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x123\n" +
+	"\battempts\x18\f \x03(\v2\x17.product.WebhookAttemptR\battempts\"\x8f\x01\n" +
+	"\x0eWebhookAttempt\x12#\n" +
+	"\rresponse_code\x18\x01 \x01(\x05R\fresponseCode\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x05R\n" +
+	"durationMs\x12!\n" +
+	"\fattempted_at\x18\x04 \x01(\tR\vattemptedAt\"\xa3\x03\n" +
+	"\fProductImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\x12E\n" +
+	"\n" +
+	"thumbnails\x18\t \x03(\v2%.product.ProductImage.ThumbnailsEntryR\n" +
+	"thumbnails\x12!\n" +
+	"\fstorage_keys\x18\n" +
+	" \x03(\tR\vstorageKeys\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x1a=\n" +
+	"\x0fThumbnailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\rProductOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"\xa9\x04\n" +
+	"\x0eProductVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12N\n" +
+	"\roption_values\x18\x04 \x03(\v2).product.ProductVariant.OptionValuesEntryR\foptionValues\x12%\n" +
+	"\x0estock_quantity\x18\a \x01(\x05R\rstockQuantity\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12+\n" +
+	"\x11reserved_quantity\x18\v \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\f \x01(\x05R\x11availableQuantity\x12#\n" +
+	"\x05price\x18\r \x01(\v2\r.common.MoneyR\x05price\x126\n" +
+	"\x0feffective_price\x18\x0e \x01(\v2\r.common.MoneyR\x0eeffectivePrice\x1a?\n" +
+	"\x11OptionValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xf8\x01\n" +
+	"\x0fProductRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12.\n" +
+	"\achanges\x18\x06 \x03(\v2\x14.product.FieldChangeR\achanges\x12\x1f\n" +
+	"\vreverted_to\x18\a \x01(\tR\n" +
+	"revertedTo\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"Q\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xb5\x02\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x05R\x05delta\x12%\n" +
+	"\x0equantity_after\x18\x05 \x01(\x05R\rquantityAfter\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\a \x01(\tR\treference\x12%\n" +
+	"\x0ereservation_id\x18\b \x01(\tR\rreservationId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"\xd0\x02\n" +
+	"\fLowStockItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\x12%\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x05R\rstockQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\x06 \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\a \x01(\x05R\x11availableQuantity\x12+\n" +
+	"\x11reorder_threshold\x18\b \x01(\x05R\x10reorderThreshold\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\"k\n" +
+	"\x0fReservationItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xe5\x01\n" +
+	"\x10StockReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12.\n" +
+	"\x05items\x18\x04 \x03(\v2\x18.product.ReservationItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xf7\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
 	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x1b\n" +
-	"\tis_active\x18\x05 \x01(\bR\bisActive\"\xea\x01\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04slug\x18\x06 \x01(\tR\x04slug\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12\x19\n" +
+	"\bstore_id\x18\t \x01(\tR\astoreId\"p\n" +
+	"\fCategoryNode\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.product.CategoryR\bcategory\x121\n" +
+	"\bchildren\x18\x02 \x03(\v2\x15.product.CategoryNodeR\bchildren\"\xd4\x04\n" +
 	"\x14CreateProductRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
 	"\x0estock_quantity\x18\x05 \x01(\x05R\rstockQuantity\x12\x1a\n" +
 	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x1b\n" +
 	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x10\n" +
-	"\x03sku\x18\b \x01(\tR\x03sku\"q\n" +
+	"\x03sku\x18\b \x01(\tR\x03sku\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\tR\n" +
+	"categoryId\x12M\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2-.product.CreateProductRequest.AttributesEntryR\n" +
+	"attributes\x120\n" +
+	"\x11reorder_threshold\x18\v \x01(\x05H\x00R\x10reorderThreshold\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12#\n" +
+	"\x05price\x18\x0e \x01(\v2\r.common.MoneyR\x05price\x127\n" +
+	"\x10compare_at_price\x18\x0f \x01(\v2\r.common.MoneyR\x0ecompareAtPrice\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x14\n" +
+	"\x12_reorder_thresholdJ\x04\b\x04\x10\x05J\x04\b\r\x10\x0e\"q\n" +
 	"\x15CreateProductResponse\x12,\n" +
 	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
 	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\"#\n" +
@@ -1139,48 +10511,274 @@ const file_product_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"n\n" +
 	"\x12GetProductResponse\x12,\n" +
 	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
-	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\"\x97\x02\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\"\x9d\a\n" +
 	"\x14UpdateProductRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12%\n" +
-	"\x0estock_quantity\x18\x06 \x01(\x05R\rstockQuantity\x12\x1a\n" +
-	"\bcategory\x18\a \x01(\tR\bcategory\x12\x1b\n" +
-	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x10\n" +
-	"\x03sku\x18\t \x01(\tR\x03sku\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12*\n" +
+	"\x0estock_quantity\x18\x06 \x01(\x05H\x02R\rstockQuantity\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\a \x01(\tH\x03R\bcategory\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\b \x01(\tH\x04R\bimageUrl\x88\x01\x01\x12\x15\n" +
+	"\x03sku\x18\t \x01(\tH\x05R\x03sku\x88\x01\x01\x12 \n" +
 	"\tis_active\x18\n" +
-	" \x01(\bR\bisActive\"q\n" +
+	" \x01(\bH\x06R\bisActive\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\v \x01(\tH\aR\n" +
+	"categoryId\x88\x01\x01\x12M\n" +
+	"\n" +
+	"attributes\x18\f \x03(\v2-.product.UpdateProductRequest.AttributesEntryR\n" +
+	"attributes\x120\n" +
+	"\x11reorder_threshold\x18\r \x01(\x05H\bR\x10reorderThreshold\x88\x01\x01\x126\n" +
+	"\x17clear_reorder_threshold\x18\x0e \x01(\bR\x15clearReorderThreshold\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x10 \x01(\tR\tupdatedBy\x123\n" +
+	"\x16clear_compare_at_price\x18\x12 \x01(\bR\x13clearCompareAtPrice\x12#\n" +
+	"\x05price\x18\x13 \x01(\v2\r.common.MoneyR\x05price\x127\n" +
+	"\x10compare_at_price\x18\x14 \x01(\v2\r.common.MoneyR\x0ecompareAtPrice\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_stock_quantityB\v\n" +
+	"\t_categoryB\f\n" +
+	"\n" +
+	"_image_urlB\x06\n" +
+	"\x04_skuB\f\n" +
+	"\n" +
+	"_is_activeB\x0e\n" +
+	"\f_category_idB\x14\n" +
+	"\x12_reorder_thresholdJ\x04\b\x05\x10\x06J\x04\b\x11\x10\x12\"\x8d\x01\n" +
 	"\x15UpdateProductResponse\x12,\n" +
 	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
-	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\"<\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\x12\x1a\n" +
+	"\bconflict\x18\x03 \x01(\bR\bconflict\"u\n" +
 	"\x14DeleteProductRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"E\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x04 \x01(\tR\tdeletedBy\"\x8d\x01\n" +
 	"\x15DeleteProductResponse\x12,\n" +
-	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\"\x8d\x01\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12\x1a\n" +
+	"\bconflict\x18\x02 \x01(\bR\bconflict\x12*\n" +
+	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"\xdb\x01\n" +
 	"\x13ListProductsRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
 	"pagination\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1f\n" +
 	"\vactive_only\x18\x03 \x01(\bR\n" +
-	"activeOnly\"\xae\x01\n" +
+	"activeOnly\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x12+\n" +
+	"\x11attribute_filters\x18\x05 \x03(\tR\x10attributeFilters\"\xae\x01\n" +
 	"\x14ListProductsResponse\x12,\n" +
 	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12,\n" +
 	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts\x12:\n" +
 	"\n" +
 	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
-	"pagination\"\xbe\x01\n" +
+	"pagination\"^\n" +
+	"\x15RestoreProductRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x03 \x01(\tR\n" +
+	"restoredBy\"r\n" +
+	"\x16RestoreProductResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\"\x8c\x01\n" +
+	"\x1aListDeletedProductsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xb5\x01\n" +
+	"\x1bListDeletedProductsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12,\n" +
+	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\"3\n" +
+	"\x1bPurgeDeletedProductsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x87\x01\n" +
+	"\x1cPurgeDeletedProductsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12\x16\n" +
+	"\x06purged\x18\x02 \x01(\x05R\x06purged\x12!\n" +
+	"\fstorage_keys\x18\x03 \x03(\tR\vstorageKeys\"\x8a\x01\n" +
+	"\x18GetProductHistoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x129\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xbd\x01\n" +
+	"\x19GetProductHistoryResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x126\n" +
+	"\trevisions\x18\x02 \x03(\v2\x18.product.ProductRevisionR\trevisions\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\"\xb1\x01\n" +
+	"\x1eRevertProductToRevisionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vrevision_id\x18\x03 \x01(\tR\n" +
+	"revisionId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x1f\n" +
+	"\vreverted_by\x18\x05 \x01(\tR\n" +
+	"revertedBy\"\x97\x01\n" +
+	"\x1fRevertProductToRevisionResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\x12\x1a\n" +
+	"\bconflict\x18\x03 \x01(\bR\bconflict\"\xda\x01\n" +
+	"\x1aCreatePriceScheduleRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\tR\x06endsAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12,\n" +
+	"\n" +
+	"sale_price\x18\a \x01(\v2\r.common.MoneyR\tsalePriceJ\x04\b\x03\x10\x04\"\x7f\n" +
+	"\x1bCreatePriceScheduleResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x122\n" +
+	"\bschedule\x18\x02 \x01(\v2\x16.product.PriceScheduleR\bschedule\"r\n" +
+	"\x1aCancelPriceScheduleRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\"\x7f\n" +
+	"\x1bCancelPriceScheduleResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x122\n" +
+	"\bschedule\x18\x02 \x01(\v2\x16.product.PriceScheduleR\bschedule\"P\n" +
+	"\x19ListPriceSchedulesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x80\x01\n" +
+	"\x1aListPriceSchedulesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x124\n" +
+	"\tschedules\x18\x02 \x03(\v2\x16.product.PriceScheduleR\tschedules\"u\n" +
+	"\x17SetProductPricesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12%\n" +
+	"\x06prices\x18\x03 \x03(\v2\r.common.MoneyR\x06prices\"t\n" +
+	"\x18SetProductPricesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\"\xcd\x01\n" +
+	"\x1aCreateProductReviewRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\"{\n" +
+	"\x1bCreateProductReviewResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12.\n" +
+	"\x06review\x18\x02 \x01(\v2\x16.product.ProductReviewR\x06review\"\x9d\x01\n" +
+	"\x1aUpdateProductReviewRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\"{\n" +
+	"\x1bUpdateProductReviewResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12.\n" +
+	"\x06review\x18\x02 \x01(\v2\x16.product.ProductReviewR\x06review\"y\n" +
+	"\x1aDeleteProductReviewRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tmoderator\x18\x04 \x01(\bR\tmoderator\"K\n" +
+	"\x1bDeleteProductReviewResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\"\xe0\x01\n" +
+	"\x19ListProductReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\x12\x1b\n" +
+	"\tviewer_id\x18\x03 \x01(\tR\bviewerId\x12\x1c\n" +
+	"\tmoderator\x18\x04 \x01(\bR\tmoderator\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06rating\x18\x06 \x01(\x05R\x06rating\"\xb8\x01\n" +
+	"\x1aListProductReviewsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x120\n" +
+	"\areviews\x18\x02 \x03(\v2\x16.product.ProductReviewR\areviews\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\"\x93\x01\n" +
+	"\x1cModerateProductReviewRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12!\n" +
+	"\fmoderated_by\x18\x05 \x01(\tR\vmoderatedBy\"}\n" +
+	"\x1dModerateProductReviewResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12.\n" +
+	"\x06review\x18\x02 \x01(\v2\x16.product.ProductReviewR\x06review\"C\n" +
+	"\x12GetWishlistRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"r\n" +
+	"\x13GetWishlistResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x11.product.WishlistR\bwishlist\"d\n" +
+	"\x14AddToWishlistRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\"t\n" +
+	"\x15AddToWishlistResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x11.product.WishlistR\bwishlist\"i\n" +
+	"\x19RemoveFromWishlistRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\"y\n" +
+	"\x1aRemoveFromWishlistResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x11.product.WishlistR\bwishlist\"h\n" +
+	"\x16ReorderWishlistRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\tR\n" +
+	"productIds\"v\n" +
+	"\x17ReorderWishlistResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x11.product.WishlistR\bwishlist\"]\n" +
+	"\x14ShareWishlistRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06shared\x18\x03 \x01(\bR\x06shared\"t\n" +
+	"\x15ShareWishlistResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x11.product.WishlistR\bwishlist\";\n" +
+	"\x18GetSharedWishlistRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\"x\n" +
+	"\x19GetSharedWishlistResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x11.product.WishlistR\bwishlist\"\xb6\x02\n" +
 	"\x15SearchProductsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x129\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
 	"pagination\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1b\n" +
-	"\tmin_price\x18\x04 \x01(\x01R\bminPrice\x12\x1b\n" +
-	"\tmax_price\x18\x05 \x01(\x01R\bmaxPrice\"\xb0\x01\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12+\n" +
+	"\x11attribute_filters\x18\a \x03(\tR\x10attributeFilters\x12*\n" +
+	"\tmin_price\x18\b \x01(\v2\r.common.MoneyR\bminPrice\x12*\n" +
+	"\tmax_price\x18\t \x01(\v2\r.common.MoneyR\bmaxPriceJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"\xb0\x01\n" +
 	"\x16SearchProductsResponse\x12,\n" +
 	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12,\n" +
 	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts\x12:\n" +
@@ -1192,16 +10790,453 @@ const file_product_product_proto_rawDesc = "" +
 	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x121\n" +
 	"\n" +
 	"categories\x18\x02 \x03(\v2\x11.product.CategoryR\n" +
-	"categories2\xff\x04\n" +
+	"categories\"\x94\x01\n" +
+	"\x15CreateCategoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04slug\x18\x05 \x01(\tR\x04slug\"u\n" +
+	"\x16CreateCategoryResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bcategory\x18\x02 \x01(\v2\x11.product.CategoryR\bcategory\"\xa4\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04slug\x18\x05 \x01(\tR\x04slug\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\"u\n" +
+	"\x16UpdateCategoryResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bcategory\x18\x02 \x01(\v2\x11.product.CategoryR\bcategory\"=\n" +
+	"\x15DeleteCategoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"F\n" +
+	"\x16DeleteCategoryResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\"X\n" +
+	"\x13MoveCategoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\"s\n" +
+	"\x14MoveCategoryResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\bcategory\x18\x02 \x01(\v2\x11.product.CategoryR\bcategory\"\\\n" +
+	"\x16GetCategoryTreeRequest\x12\x17\n" +
+	"\aroot_id\x18\x01 \x01(\tR\x06rootId\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"t\n" +
+	"\x17GetCategoryTreeResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12+\n" +
+	"\x05roots\x18\x02 \x03(\v2\x15.product.CategoryNodeR\x05roots\"\x81\x01\n" +
+	"\x18SetProductOptionsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x120\n" +
+	"\aoptions\x18\x03 \x03(\v2\x16.product.ProductOptionR\aoptions\"{\n" +
+	"\x19SetProductOptionsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x120\n" +
+	"\aoptions\x18\x02 \x03(\v2\x16.product.ProductOptionR\aoptions\"\xd4\x02\n" +
+	"\x1bCreateProductVariantRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12[\n" +
+	"\roption_values\x18\x04 \x03(\v26.product.CreateProductVariantRequest.OptionValuesEntryR\foptionValues\x12%\n" +
+	"\x0estock_quantity\x18\x06 \x01(\x05R\rstockQuantity\x12#\n" +
+	"\x05price\x18\a \x01(\v2\r.common.MoneyR\x05price\x1a?\n" +
+	"\x11OptionValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06\"\x7f\n" +
+	"\x1cCreateProductVariantResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x121\n" +
+	"\avariant\x18\x02 \x01(\v2\x17.product.ProductVariantR\avariant\"\xbb\x03\n" +
+	"\x1bUpdateProductVariantRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x15\n" +
+	"\x03sku\x18\x03 \x01(\tH\x00R\x03sku\x88\x01\x01\x12[\n" +
+	"\roption_values\x18\x04 \x03(\v26.product.UpdateProductVariantRequest.OptionValuesEntryR\foptionValues\x12\x1f\n" +
+	"\vclear_price\x18\x06 \x01(\bR\n" +
+	"clearPrice\x12*\n" +
+	"\x0estock_quantity\x18\a \x01(\x05H\x01R\rstockQuantity\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\b \x01(\bH\x02R\bisActive\x88\x01\x01\x12#\n" +
+	"\x05price\x18\t \x01(\v2\r.common.MoneyR\x05price\x1a?\n" +
+	"\x11OptionValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x06\n" +
+	"\x04_skuB\x11\n" +
+	"\x0f_stock_quantityB\f\n" +
+	"\n" +
+	"_is_activeJ\x04\b\x05\x10\x06\"\x7f\n" +
+	"\x1cUpdateProductVariantResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x121\n" +
+	"\avariant\x18\x02 \x01(\v2\x17.product.ProductVariantR\avariant\"C\n" +
+	"\x1bDeleteProductVariantRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"L\n" +
+	"\x1cDeleteProductVariantResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\"\\\n" +
+	"\x1aListProductVariantsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"\xb2\x01\n" +
+	"\x1bListProductVariantsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x120\n" +
+	"\aoptions\x18\x02 \x03(\v2\x16.product.ProductOptionR\aoptions\x123\n" +
+	"\bvariants\x18\x03 \x03(\v2\x17.product.ProductVariantR\bvariants\"\xdb\x01\n" +
+	"\x13AttributeDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1f\n" +
+	"\venum_values\x18\x06 \x03(\tR\n" +
+	"enumValues\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\"\x93\x01\n" +
+	"\x1cSetCategoryAttributesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12<\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2\x1c.product.AttributeDefinitionR\n" +
+	"attributes\"\x8b\x01\n" +
+	"\x1dSetCategoryAttributesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12<\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1c.product.AttributeDefinitionR\n" +
+	"attributes\"l\n" +
+	"\x1cGetCategoryAttributesRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12+\n" +
+	"\x11include_inherited\x18\x02 \x01(\bR\x10includeInherited\"\x8b\x01\n" +
+	"\x1dGetCategoryAttributesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12<\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1c.product.AttributeDefinitionR\n" +
+	"attributes\"z\n" +
+	"\x16AddProductImageRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12+\n" +
+	"\x05image\x18\x03 \x01(\v2\x15.product.ProductImageR\x05image\"t\n" +
+	"\x17AddProductImageResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12+\n" +
+	"\x05image\x18\x02 \x01(\v2\x15.product.ProductImageR\x05image\"9\n" +
+	"\x18ListProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"x\n" +
+	"\x19ListProductImagesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\x06images\x18\x02 \x03(\v2\x15.product.ProductImageR\x06images\"A\n" +
+	"\x19DeleteProductImageRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"w\n" +
+	"\x1aDeleteProductImageResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12+\n" +
+	"\x05image\x18\x02 \x01(\v2\x15.product.ProductImageR\x05image\"o\n" +
+	"\x1bReorderProductImagesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\timage_ids\x18\x03 \x03(\tR\bimageIds\"{\n" +
+	"\x1cReorderProductImagesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12-\n" +
+	"\x06images\x18\x02 \x03(\v2\x15.product.ProductImageR\x06images\"\xb4\x01\n" +
+	"\x12AdjustStockRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\"\xfa\x01\n" +
+	"\x13AdjustStockResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12%\n" +
+	"\x0estock_quantity\x18\x02 \x01(\x05R\rstockQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\x03 \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\x04 \x01(\x05R\x11availableQuantity\x122\n" +
+	"\bmovement\x18\x05 \x01(\v2\x16.product.StockMovementR\bmovement\"\x9a\x01\n" +
+	"\x13ReserveStockRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12.\n" +
+	"\x05items\x18\x03 \x03(\v2\x18.product.ReservationItemR\x05items\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"\x81\x01\n" +
+	"\x14ReserveStockResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12;\n" +
+	"\vreservation\x18\x02 \x01(\v2\x19.product.StockReservationR\vreservation\"W\n" +
+	"\x18CommitReservationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
+	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\"\x86\x01\n" +
+	"\x19CommitReservationResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12;\n" +
+	"\vreservation\x18\x02 \x01(\v2\x19.product.StockReservationR\vreservation\"X\n" +
+	"\x19ReleaseReservationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
+	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\"\x87\x01\n" +
+	"\x1aReleaseReservationResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12;\n" +
+	"\vreservation\x18\x02 \x01(\v2\x19.product.StockReservationR\vreservation\"\xaa\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x129\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xbc\x01\n" +
+	"\x1aListStockMovementsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x124\n" +
+	"\tmovements\x18\x02 \x03(\v2\x16.product.StockMovementR\tmovements\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\"\x8d\x01\n" +
+	"\x1bListLowStockProductsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xb5\x01\n" +
+	"\x1cListLowStockProductsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.product.LowStockItemR\x05items\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\"\x85\x01\n" +
+	"\x15ImportProductsRequest\x122\n" +
+	"\aoptions\x18\x01 \x01(\v2\x16.product.ImportOptionsH\x00R\aoptions\x12-\n" +
+	"\x03row\x18\x02 \x01(\v2\x19.product.ImportProductRowH\x00R\x03rowB\t\n" +
+	"\apayload\"\x94\x01\n" +
+	"\rImportOptions\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06upsert\x18\x04 \x01(\bR\x06upsert\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\"]\n" +
+	"\x10ImportProductRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x127\n" +
+	"\aproduct\x18\x02 \x01(\v2\x1d.product.CreateProductRequestR\aproduct\"N\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xfb\x01\n" +
+	"\x16ImportProductsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12/\n" +
+	"\x06errors\x18\x06 \x03(\v2\x17.product.ImportRowErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\"\xb4\x01\n" +
+	"\x15ExportProductsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12)\n" +
+	"\x10include_inactive\x18\x04 \x01(\bR\x0fincludeInactive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"D\n" +
+	"\x16ExportProductsResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"?\n" +
+	"\x17BatchGetProductsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x12\n" +
+	"\x04skus\x18\x02 \x03(\tR\x04skus\"\x93\x01\n" +
+	"\x18BatchGetProductsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12,\n" +
+	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts\x12\x1b\n" +
+	"\tnot_found\x18\x03 \x03(\tR\bnotFound\"\xe3\x01\n" +
+	"\fProductPatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x0estock_quantity\x18\x03 \x01(\x05H\x00R\rstockQuantity\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x04 \x01(\bH\x01R\bisActive\x88\x01\x01\x12#\n" +
+	"\x05price\x18\x05 \x01(\v2\r.common.MoneyR\x05price\x12\x1d\n" +
+	"\aversion\x18\x06 \x01(\x05H\x02R\aversion\x88\x01\x01B\x11\n" +
+	"\x0f_stock_quantityB\f\n" +
+	"\n" +
+	"_is_activeB\n" +
+	"\n" +
+	"\b_versionJ\x04\b\x02\x10\x03\"\x82\x01\n" +
+	"\x1aBatchUpdateProductsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12/\n" +
+	"\aupdates\x18\x02 \x03(\v2\x15.product.ProductPatchR\aupdates\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"\xa1\x01\n" +
+	"\x13ProductUpdateResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12*\n" +
+	"\aproduct\x18\x04 \x01(\v2\x10.product.ProductR\aproduct\x12\x1a\n" +
+	"\bconflict\x18\x05 \x01(\bR\bconflict\"\xb5\x01\n" +
+	"\x1bBatchUpdateProductsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x126\n" +
+	"\aresults\x18\x02 \x03(\v2\x1c.product.ProductUpdateResultR\aresults\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"Z\n" +
+	"\x14WatchProductsRequest\x12!\n" +
+	"\fcategory_ids\x18\x01 \x03(\tR\vcategoryIds\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\tR\n" +
+	"productIds\"\xc4\x01\n" +
+	"\rProductChange\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12*\n" +
+	"\aproduct\x18\x04 \x01(\v2\x10.product.ProductR\aproduct\x12\x18\n" +
+	"\aremoved\x18\x05 \x01(\bR\aremoved\x12\x1f\n" +
+	"\voccurred_at\x18\x06 \x01(\tR\n" +
+	"occurredAt\"\x96\x01\n" +
+	"\x14CreateWebhookRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"q\n" +
+	"\x15CreateWebhookResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
+	"\awebhook\x18\x02 \x01(\v2\x10.product.WebhookR\awebhook\"\xb1\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12#\n" +
+	"\rrotate_secret\x18\x06 \x01(\bR\frotateSecret\"q\n" +
+	"\x15UpdateWebhookResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12*\n" +
+	"\awebhook\x18\x02 \x01(\v2\x10.product.WebhookR\awebhook\"<\n" +
+	"\x14DeleteWebhookRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"E\n" +
+	"\x15DeleteWebhookResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\"+\n" +
+	"\x13ListWebhooksRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"r\n" +
+	"\x14ListWebhooksResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12,\n" +
+	"\bwebhooks\x18\x02 \x03(\v2\x10.product.WebhookR\bwebhooks\"\xa6\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xc3\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x02 \x03(\v2\x18.product.WebhookDeliveryR\n" +
+	"deliveries\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\"C\n" +
+	"\x1bRetryWebhookDeliveryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x82\x01\n" +
+	"\x1cRetryWebhookDeliveryResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x124\n" +
+	"\bdelivery\x18\x02 \x01(\v2\x18.product.WebhookDeliveryR\bdelivery\">\n" +
+	"\x16SendTestWebhookRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"}\n" +
+	"\x17SendTestWebhookResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x124\n" +
+	"\bdelivery\x18\x02 \x01(\v2\x18.product.WebhookDeliveryR\bdelivery\"q\n" +
+	"\x18RecordProductViewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\"I\n" +
+	"\x19RecordProductViewResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\"i\n" +
+	"\x19GetRecommendationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"T\n" +
+	"\x0eRecommendation\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x1aGetRecommendationsResponse\x12,\n" +
+	"\bresponse\x18\x01 \x01(\v2\x10.common.ResponseR\bresponse\x12A\n" +
+	"\x0frecommendations\x18\x02 \x03(\v2\x17.product.RecommendationR\x0frecommendations2\xf6,\n" +
 	"\x0eProductService\x12N\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12E\n" +
 	"\n" +
 	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12N\n" +
 	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\x12N\n" +
-	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12K\n" +
+	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12Q\n" +
+	"\x0eRestoreProduct\x12\x1e.product.RestoreProductRequest\x1a\x1f.product.RestoreProductResponse\x12`\n" +
+	"\x13ListDeletedProducts\x12#.product.ListDeletedProductsRequest\x1a$.product.ListDeletedProductsResponse\x12c\n" +
+	"\x14PurgeDeletedProducts\x12$.product.PurgeDeletedProductsRequest\x1a%.product.PurgeDeletedProductsResponse\x12Z\n" +
+	"\x11GetProductHistory\x12!.product.GetProductHistoryRequest\x1a\".product.GetProductHistoryResponse\x12l\n" +
+	"\x17RevertProductToRevision\x12'.product.RevertProductToRevisionRequest\x1a(.product.RevertProductToRevisionResponse\x12`\n" +
+	"\x13CreatePriceSchedule\x12#.product.CreatePriceScheduleRequest\x1a$.product.CreatePriceScheduleResponse\x12`\n" +
+	"\x13CancelPriceSchedule\x12#.product.CancelPriceScheduleRequest\x1a$.product.CancelPriceScheduleResponse\x12]\n" +
+	"\x12ListPriceSchedules\x12\".product.ListPriceSchedulesRequest\x1a#.product.ListPriceSchedulesResponse\x12W\n" +
+	"\x10SetProductPrices\x12 .product.SetProductPricesRequest\x1a!.product.SetProductPricesResponse\x12`\n" +
+	"\x13CreateProductReview\x12#.product.CreateProductReviewRequest\x1a$.product.CreateProductReviewResponse\x12`\n" +
+	"\x13UpdateProductReview\x12#.product.UpdateProductReviewRequest\x1a$.product.UpdateProductReviewResponse\x12`\n" +
+	"\x13DeleteProductReview\x12#.product.DeleteProductReviewRequest\x1a$.product.DeleteProductReviewResponse\x12]\n" +
+	"\x12ListProductReviews\x12\".product.ListProductReviewsRequest\x1a#.product.ListProductReviewsResponse\x12f\n" +
+	"\x15ModerateProductReview\x12%.product.ModerateProductReviewRequest\x1a&.product.ModerateProductReviewResponse\x12H\n" +
+	"\vGetWishlist\x12\x1b.product.GetWishlistRequest\x1a\x1c.product.GetWishlistResponse\x12N\n" +
+	"\rAddToWishlist\x12\x1d.product.AddToWishlistRequest\x1a\x1e.product.AddToWishlistResponse\x12]\n" +
+	"\x12RemoveFromWishlist\x12\".product.RemoveFromWishlistRequest\x1a#.product.RemoveFromWishlistResponse\x12T\n" +
+	"\x0fReorderWishlist\x12\x1f.product.ReorderWishlistRequest\x1a .product.ReorderWishlistResponse\x12N\n" +
+	"\rShareWishlist\x12\x1d.product.ShareWishlistRequest\x1a\x1e.product.ShareWishlistResponse\x12Z\n" +
+	"\x11GetSharedWishlist\x12!.product.GetSharedWishlistRequest\x1a\".product.GetSharedWishlistResponse\x12N\n" +
+	"\rCreateWebhook\x12\x1d.product.CreateWebhookRequest\x1a\x1e.product.CreateWebhookResponse\x12N\n" +
+	"\rUpdateWebhook\x12\x1d.product.UpdateWebhookRequest\x1a\x1e.product.UpdateWebhookResponse\x12N\n" +
+	"\rDeleteWebhook\x12\x1d.product.DeleteWebhookRequest\x1a\x1e.product.DeleteWebhookResponse\x12K\n" +
+	"\fListWebhooks\x12\x1c.product.ListWebhooksRequest\x1a\x1d.product.ListWebhooksResponse\x12f\n" +
+	"\x15ListWebhookDeliveries\x12%.product.ListWebhookDeliveriesRequest\x1a&.product.ListWebhookDeliveriesResponse\x12c\n" +
+	"\x14RetryWebhookDelivery\x12$.product.RetryWebhookDeliveryRequest\x1a%.product.RetryWebhookDeliveryResponse\x12T\n" +
+	"\x0fSendTestWebhook\x12\x1f.product.SendTestWebhookRequest\x1a .product.SendTestWebhookResponse\x12K\n" +
 	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12Q\n" +
 	"\x0eSearchProducts\x12\x1e.product.SearchProductsRequest\x1a\x1f.product.SearchProductsResponse\x12N\n" +
-	"\rGetCategories\x12\x1d.product.GetCategoriesRequest\x1a\x1e.product.GetCategoriesResponse\x12F\n" +
+	"\rGetCategories\x12\x1d.product.GetCategoriesRequest\x1a\x1e.product.GetCategoriesResponse\x12Q\n" +
+	"\x0eCreateCategory\x12\x1e.product.CreateCategoryRequest\x1a\x1f.product.CreateCategoryResponse\x12Q\n" +
+	"\x0eUpdateCategory\x12\x1e.product.UpdateCategoryRequest\x1a\x1f.product.UpdateCategoryResponse\x12Q\n" +
+	"\x0eDeleteCategory\x12\x1e.product.DeleteCategoryRequest\x1a\x1f.product.DeleteCategoryResponse\x12K\n" +
+	"\fMoveCategory\x12\x1c.product.MoveCategoryRequest\x1a\x1d.product.MoveCategoryResponse\x12T\n" +
+	"\x0fGetCategoryTree\x12\x1f.product.GetCategoryTreeRequest\x1a .product.GetCategoryTreeResponse\x12Z\n" +
+	"\x11SetProductOptions\x12!.product.SetProductOptionsRequest\x1a\".product.SetProductOptionsResponse\x12c\n" +
+	"\x14CreateProductVariant\x12$.product.CreateProductVariantRequest\x1a%.product.CreateProductVariantResponse\x12c\n" +
+	"\x14UpdateProductVariant\x12$.product.UpdateProductVariantRequest\x1a%.product.UpdateProductVariantResponse\x12c\n" +
+	"\x14DeleteProductVariant\x12$.product.DeleteProductVariantRequest\x1a%.product.DeleteProductVariantResponse\x12`\n" +
+	"\x13ListProductVariants\x12#.product.ListProductVariantsRequest\x1a$.product.ListProductVariantsResponse\x12f\n" +
+	"\x15SetCategoryAttributes\x12%.product.SetCategoryAttributesRequest\x1a&.product.SetCategoryAttributesResponse\x12f\n" +
+	"\x15GetCategoryAttributes\x12%.product.GetCategoryAttributesRequest\x1a&.product.GetCategoryAttributesResponse\x12T\n" +
+	"\x0fAddProductImage\x12\x1f.product.AddProductImageRequest\x1a .product.AddProductImageResponse\x12Z\n" +
+	"\x11ListProductImages\x12!.product.ListProductImagesRequest\x1a\".product.ListProductImagesResponse\x12]\n" +
+	"\x12DeleteProductImage\x12\".product.DeleteProductImageRequest\x1a#.product.DeleteProductImageResponse\x12c\n" +
+	"\x14ReorderProductImages\x12$.product.ReorderProductImagesRequest\x1a%.product.ReorderProductImagesResponse\x12H\n" +
+	"\vAdjustStock\x12\x1b.product.AdjustStockRequest\x1a\x1c.product.AdjustStockResponse\x12K\n" +
+	"\fReserveStock\x12\x1c.product.ReserveStockRequest\x1a\x1d.product.ReserveStockResponse\x12Z\n" +
+	"\x11CommitReservation\x12!.product.CommitReservationRequest\x1a\".product.CommitReservationResponse\x12]\n" +
+	"\x12ReleaseReservation\x12\".product.ReleaseReservationRequest\x1a#.product.ReleaseReservationResponse\x12]\n" +
+	"\x12ListStockMovements\x12\".product.ListStockMovementsRequest\x1a#.product.ListStockMovementsResponse\x12c\n" +
+	"\x14ListLowStockProducts\x12$.product.ListLowStockProductsRequest\x1a%.product.ListLowStockProductsResponse\x12S\n" +
+	"\x0eImportProducts\x12\x1e.product.ImportProductsRequest\x1a\x1f.product.ImportProductsResponse(\x01\x12S\n" +
+	"\x0eExportProducts\x12\x1e.product.ExportProductsRequest\x1a\x1f.product.ExportProductsResponse0\x01\x12W\n" +
+	"\x10BatchGetProducts\x12 .product.BatchGetProductsRequest\x1a!.product.BatchGetProductsResponse\x12`\n" +
+	"\x13BatchUpdateProducts\x12#.product.BatchUpdateProductsRequest\x1a$.product.BatchUpdateProductsResponse\x12H\n" +
+	"\rWatchProducts\x12\x1d.product.WatchProductsRequest\x1a\x16.product.ProductChange0\x01\x12Z\n" +
+	"\x11RecordProductView\x12!.product.RecordProductViewRequest\x1a\".product.RecordProductViewResponse\x12]\n" +
+	"\x12GetRecommendations\x12\".product.GetRecommendationsRequest\x1a#.product.GetRecommendationsResponse\x12F\n" +
 	"\vHealthCheck\x12\x1a.common.HealthCheckRequest\x1a\x1b.common.HealthCheckResponseB;Z9github.com/martbul/playground_microservices/proto/productb\x06proto3"
 
 var (
@@ -1216,69 +11251,489 @@ func file_product_product_proto_rawDescGZIP() []byte {
 	return file_product_product_proto_rawDescData
 }
 
-var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 159)
 var file_product_product_proto_goTypes = []any{
-	(*Product)(nil),                    // 0: product.Product
-	(*Category)(nil),                   // 1: product.Category
-	(*CreateProductRequest)(nil),       // 2: product.CreateProductRequest
-	(*CreateProductResponse)(nil),      // 3: product.CreateProductResponse
-	(*GetProductRequest)(nil),          // 4: product.GetProductRequest
-	(*GetProductResponse)(nil),         // 5: product.GetProductResponse
-	(*UpdateProductRequest)(nil),       // 6: product.UpdateProductRequest
-	(*UpdateProductResponse)(nil),      // 7: product.UpdateProductResponse
-	(*DeleteProductRequest)(nil),       // 8: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),      // 9: product.DeleteProductResponse
-	(*ListProductsRequest)(nil),        // 10: product.ListProductsRequest
-	(*ListProductsResponse)(nil),       // 11: product.ListProductsResponse
-	(*SearchProductsRequest)(nil),      // 12: product.SearchProductsRequest
-	(*SearchProductsResponse)(nil),     // 13: product.SearchProductsResponse
-	(*GetCategoriesRequest)(nil),       // 14: product.GetCategoriesRequest
-	(*GetCategoriesResponse)(nil),      // 15: product.GetCategoriesResponse
-	(*common.Response)(nil),            // 16: common.Response
-	(*common.PaginationRequest)(nil),   // 17: common.PaginationRequest
-	(*common.PaginationResponse)(nil),  // 18: common.PaginationResponse
-	(*common.HealthCheckRequest)(nil),  // 19: common.HealthCheckRequest
-	(*common.HealthCheckResponse)(nil), // 20: common.HealthCheckResponse
+	(*Product)(nil),                         // 0: product.Product
+	(*PriceSchedule)(nil),                   // 1: product.PriceSchedule
+	(*ProductReview)(nil),                   // 2: product.ProductReview
+	(*WishlistItem)(nil),                    // 3: product.WishlistItem
+	(*Wishlist)(nil),                        // 4: product.Wishlist
+	(*Webhook)(nil),                         // 5: product.Webhook
+	(*WebhookDelivery)(nil),                 // 6: product.WebhookDelivery
+	(*WebhookAttempt)(nil),                  // 7: product.WebhookAttempt
+	(*ProductImage)(nil),                    // 8: product.ProductImage
+	(*ProductOption)(nil),                   // 9: product.ProductOption
+	(*ProductVariant)(nil),                  // 10: product.ProductVariant
+	(*ProductRevision)(nil),                 // 11: product.ProductRevision
+	(*FieldChange)(nil),                     // 12: product.FieldChange
+	(*StockMovement)(nil),                   // 13: product.StockMovement
+	(*LowStockItem)(nil),                    // 14: product.LowStockItem
+	(*ReservationItem)(nil),                 // 15: product.ReservationItem
+	(*StockReservation)(nil),                // 16: product.StockReservation
+	(*Category)(nil),                        // 17: product.Category
+	(*CategoryNode)(nil),                    // 18: product.CategoryNode
+	(*CreateProductRequest)(nil),            // 19: product.CreateProductRequest
+	(*CreateProductResponse)(nil),           // 20: product.CreateProductResponse
+	(*GetProductRequest)(nil),               // 21: product.GetProductRequest
+	(*GetProductResponse)(nil),              // 22: product.GetProductResponse
+	(*UpdateProductRequest)(nil),            // 23: product.UpdateProductRequest
+	(*UpdateProductResponse)(nil),           // 24: product.UpdateProductResponse
+	(*DeleteProductRequest)(nil),            // 25: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),           // 26: product.DeleteProductResponse
+	(*ListProductsRequest)(nil),             // 27: product.ListProductsRequest
+	(*ListProductsResponse)(nil),            // 28: product.ListProductsResponse
+	(*RestoreProductRequest)(nil),           // 29: product.RestoreProductRequest
+	(*RestoreProductResponse)(nil),          // 30: product.RestoreProductResponse
+	(*ListDeletedProductsRequest)(nil),      // 31: product.ListDeletedProductsRequest
+	(*ListDeletedProductsResponse)(nil),     // 32: product.ListDeletedProductsResponse
+	(*PurgeDeletedProductsRequest)(nil),     // 33: product.PurgeDeletedProductsRequest
+	(*PurgeDeletedProductsResponse)(nil),    // 34: product.PurgeDeletedProductsResponse
+	(*GetProductHistoryRequest)(nil),        // 35: product.GetProductHistoryRequest
+	(*GetProductHistoryResponse)(nil),       // 36: product.GetProductHistoryResponse
+	(*RevertProductToRevisionRequest)(nil),  // 37: product.RevertProductToRevisionRequest
+	(*RevertProductToRevisionResponse)(nil), // 38: product.RevertProductToRevisionResponse
+	(*CreatePriceScheduleRequest)(nil),      // 39: product.CreatePriceScheduleRequest
+	(*CreatePriceScheduleResponse)(nil),     // 40: product.CreatePriceScheduleResponse
+	(*CancelPriceScheduleRequest)(nil),      // 41: product.CancelPriceScheduleRequest
+	(*CancelPriceScheduleResponse)(nil),     // 42: product.CancelPriceScheduleResponse
+	(*ListPriceSchedulesRequest)(nil),       // 43: product.ListPriceSchedulesRequest
+	(*ListPriceSchedulesResponse)(nil),      // 44: product.ListPriceSchedulesResponse
+	(*SetProductPricesRequest)(nil),         // 45: product.SetProductPricesRequest
+	(*SetProductPricesResponse)(nil),        // 46: product.SetProductPricesResponse
+	(*CreateProductReviewRequest)(nil),      // 47: product.CreateProductReviewRequest
+	(*CreateProductReviewResponse)(nil),     // 48: product.CreateProductReviewResponse
+	(*UpdateProductReviewRequest)(nil),      // 49: product.UpdateProductReviewRequest
+	(*UpdateProductReviewResponse)(nil),     // 50: product.UpdateProductReviewResponse
+	(*DeleteProductReviewRequest)(nil),      // 51: product.DeleteProductReviewRequest
+	(*DeleteProductReviewResponse)(nil),     // 52: product.DeleteProductReviewResponse
+	(*ListProductReviewsRequest)(nil),       // 53: product.ListProductReviewsRequest
+	(*ListProductReviewsResponse)(nil),      // 54: product.ListProductReviewsResponse
+	(*ModerateProductReviewRequest)(nil),    // 55: product.ModerateProductReviewRequest
+	(*ModerateProductReviewResponse)(nil),   // 56: product.ModerateProductReviewResponse
+	(*GetWishlistRequest)(nil),              // 57: product.GetWishlistRequest
+	(*GetWishlistResponse)(nil),             // 58: product.GetWishlistResponse
+	(*AddToWishlistRequest)(nil),            // 59: product.AddToWishlistRequest
+	(*AddToWishlistResponse)(nil),           // 60: product.AddToWishlistResponse
+	(*RemoveFromWishlistRequest)(nil),       // 61: product.RemoveFromWishlistRequest
+	(*RemoveFromWishlistResponse)(nil),      // 62: product.RemoveFromWishlistResponse
+	(*ReorderWishlistRequest)(nil),          // 63: product.ReorderWishlistRequest
+	(*ReorderWishlistResponse)(nil),         // 64: product.ReorderWishlistResponse
+	(*ShareWishlistRequest)(nil),            // 65: product.ShareWishlistRequest
+	(*ShareWishlistResponse)(nil),           // 66: product.ShareWishlistResponse
+	(*GetSharedWishlistRequest)(nil),        // 67: product.GetSharedWishlistRequest
+	(*GetSharedWishlistResponse)(nil),       // 68: product.GetSharedWishlistResponse
+	(*SearchProductsRequest)(nil),           // 69: product.SearchProductsRequest
+	(*SearchProductsResponse)(nil),          // 70: product.SearchProductsResponse
+	(*GetCategoriesRequest)(nil),            // 71: product.GetCategoriesRequest
+	(*GetCategoriesResponse)(nil),           // 72: product.GetCategoriesResponse
+	(*CreateCategoryRequest)(nil),           // 73: product.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),          // 74: product.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),           // 75: product.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),          // 76: product.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),           // 77: product.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),          // 78: product.DeleteCategoryResponse
+	(*MoveCategoryRequest)(nil),             // 79: product.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),            // 80: product.MoveCategoryResponse
+	(*GetCategoryTreeRequest)(nil),          // 81: product.GetCategoryTreeRequest
+	(*GetCategoryTreeResponse)(nil),         // 82: product.GetCategoryTreeResponse
+	(*SetProductOptionsRequest)(nil),        // 83: product.SetProductOptionsRequest
+	(*SetProductOptionsResponse)(nil),       // 84: product.SetProductOptionsResponse
+	(*CreateProductVariantRequest)(nil),     // 85: product.CreateProductVariantRequest
+	(*CreateProductVariantResponse)(nil),    // 86: product.CreateProductVariantResponse
+	(*UpdateProductVariantRequest)(nil),     // 87: product.UpdateProductVariantRequest
+	(*UpdateProductVariantResponse)(nil),    // 88: product.UpdateProductVariantResponse
+	(*DeleteProductVariantRequest)(nil),     // 89: product.DeleteProductVariantRequest
+	(*DeleteProductVariantResponse)(nil),    // 90: product.DeleteProductVariantResponse
+	(*ListProductVariantsRequest)(nil),      // 91: product.ListProductVariantsRequest
+	(*ListProductVariantsResponse)(nil),     // 92: product.ListProductVariantsResponse
+	(*AttributeDefinition)(nil),             // 93: product.AttributeDefinition
+	(*SetCategoryAttributesRequest)(nil),    // 94: product.SetCategoryAttributesRequest
+	(*SetCategoryAttributesResponse)(nil),   // 95: product.SetCategoryAttributesResponse
+	(*GetCategoryAttributesRequest)(nil),    // 96: product.GetCategoryAttributesRequest
+	(*GetCategoryAttributesResponse)(nil),   // 97: product.GetCategoryAttributesResponse
+	(*AddProductImageRequest)(nil),          // 98: product.AddProductImageRequest
+	(*AddProductImageResponse)(nil),         // 99: product.AddProductImageResponse
+	(*ListProductImagesRequest)(nil),        // 100: product.ListProductImagesRequest
+	(*ListProductImagesResponse)(nil),       // 101: product.ListProductImagesResponse
+	(*DeleteProductImageRequest)(nil),       // 102: product.DeleteProductImageRequest
+	(*DeleteProductImageResponse)(nil),      // 103: product.DeleteProductImageResponse
+	(*ReorderProductImagesRequest)(nil),     // 104: product.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil),    // 105: product.ReorderProductImagesResponse
+	(*AdjustStockRequest)(nil),              // 106: product.AdjustStockRequest
+	(*AdjustStockResponse)(nil),             // 107: product.AdjustStockResponse
+	(*ReserveStockRequest)(nil),             // 108: product.ReserveStockRequest
+	(*ReserveStockResponse)(nil),            // 109: product.ReserveStockResponse
+	(*CommitReservationRequest)(nil),        // 110: product.CommitReservationRequest
+	(*CommitReservationResponse)(nil),       // 111: product.CommitReservationResponse
+	(*ReleaseReservationRequest)(nil),       // 112: product.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil),      // 113: product.ReleaseReservationResponse
+	(*ListStockMovementsRequest)(nil),       // 114: product.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),      // 115: product.ListStockMovementsResponse
+	(*ListLowStockProductsRequest)(nil),     // 116: product.ListLowStockProductsRequest
+	(*ListLowStockProductsResponse)(nil),    // 117: product.ListLowStockProductsResponse
+	(*ImportProductsRequest)(nil),           // 118: product.ImportProductsRequest
+	(*ImportOptions)(nil),                   // 119: product.ImportOptions
+	(*ImportProductRow)(nil),                // 120: product.ImportProductRow
+	(*ImportRowError)(nil),                  // 121: product.ImportRowError
+	(*ImportProductsResponse)(nil),          // 122: product.ImportProductsResponse
+	(*ExportProductsRequest)(nil),           // 123: product.ExportProductsRequest
+	(*ExportProductsResponse)(nil),          // 124: product.ExportProductsResponse
+	(*BatchGetProductsRequest)(nil),         // 125: product.BatchGetProductsRequest
+	(*BatchGetProductsResponse)(nil),        // 126: product.BatchGetProductsResponse
+	(*ProductPatch)(nil),                    // 127: product.ProductPatch
+	(*BatchUpdateProductsRequest)(nil),      // 128: product.BatchUpdateProductsRequest
+	(*ProductUpdateResult)(nil),             // 129: product.ProductUpdateResult
+	(*BatchUpdateProductsResponse)(nil),     // 130: product.BatchUpdateProductsResponse
+	(*WatchProductsRequest)(nil),            // 131: product.WatchProductsRequest
+	(*ProductChange)(nil),                   // 132: product.ProductChange
+	(*CreateWebhookRequest)(nil),            // 133: product.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),           // 134: product.CreateWebhookResponse
+	(*UpdateWebhookRequest)(nil),            // 135: product.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),           // 136: product.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),            // 137: product.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),           // 138: product.DeleteWebhookResponse
+	(*ListWebhooksRequest)(nil),             // 139: product.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),            // 140: product.ListWebhooksResponse
+	(*ListWebhookDeliveriesRequest)(nil),    // 141: product.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),   // 142: product.ListWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),     // 143: product.RetryWebhookDeliveryRequest
+	(*RetryWebhookDeliveryResponse)(nil),    // 144: product.RetryWebhookDeliveryResponse
+	(*SendTestWebhookRequest)(nil),          // 145: product.SendTestWebhookRequest
+	(*SendTestWebhookResponse)(nil),         // 146: product.SendTestWebhookResponse
+	(*RecordProductViewRequest)(nil),        // 147: product.RecordProductViewRequest
+	(*RecordProductViewResponse)(nil),       // 148: product.RecordProductViewResponse
+	(*GetRecommendationsRequest)(nil),       // 149: product.GetRecommendationsRequest
+	(*Recommendation)(nil),                  // 150: product.Recommendation
+	(*GetRecommendationsResponse)(nil),      // 151: product.GetRecommendationsResponse
+	nil,                                     // 152: product.Product.AttributesEntry
+	nil,                                     // 153: product.ProductImage.ThumbnailsEntry
+	nil,                                     // 154: product.ProductVariant.OptionValuesEntry
+	nil,                                     // 155: product.CreateProductRequest.AttributesEntry
+	nil,                                     // 156: product.UpdateProductRequest.AttributesEntry
+	nil,                                     // 157: product.CreateProductVariantRequest.OptionValuesEntry
+	nil,                                     // 158: product.UpdateProductVariantRequest.OptionValuesEntry
+	(*common.Money)(nil),                    // 159: common.Money
+	(*common.Response)(nil),                 // 160: common.Response
+	(*common.PaginationRequest)(nil),        // 161: common.PaginationRequest
+	(*common.PaginationResponse)(nil),       // 162: common.PaginationResponse
+	(*common.HealthCheckRequest)(nil),       // 163: common.HealthCheckRequest
+	(*common.HealthCheckResponse)(nil),      // 164: common.HealthCheckResponse
 }
 var file_product_product_proto_depIdxs = []int32{
-	16, // 0: product.CreateProductResponse.response:type_name -> common.Response
-	0,  // 1: product.CreateProductResponse.product:type_name -> product.Product
-	16, // 2: product.GetProductResponse.response:type_name -> common.Response
-	0,  // 3: product.GetProductResponse.product:type_name -> product.Product
-	16, // 4: product.UpdateProductResponse.response:type_name -> common.Response
-	0,  // 5: product.UpdateProductResponse.product:type_name -> product.Product
-	16, // 6: product.DeleteProductResponse.response:type_name -> common.Response
-	17, // 7: product.ListProductsRequest.pagination:type_name -> common.PaginationRequest
-	16, // 8: product.ListProductsResponse.response:type_name -> common.Response
-	0,  // 9: product.ListProductsResponse.products:type_name -> product.Product
-	18, // 10: product.ListProductsResponse.pagination:type_name -> common.PaginationResponse
-	17, // 11: product.SearchProductsRequest.pagination:type_name -> common.PaginationRequest
-	16, // 12: product.SearchProductsResponse.response:type_name -> common.Response
-	0,  // 13: product.SearchProductsResponse.products:type_name -> product.Product
-	18, // 14: product.SearchProductsResponse.pagination:type_name -> common.PaginationResponse
-	16, // 15: product.GetCategoriesResponse.response:type_name -> common.Response
-	1,  // 16: product.GetCategoriesResponse.categories:type_name -> product.Category
-	2,  // 17: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	4,  // 18: product.ProductService.GetProduct:input_type -> product.GetProductRequest
-	6,  // 19: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	8,  // 20: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	10, // 21: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
-	12, // 22: product.ProductService.SearchProducts:input_type -> product.SearchProductsRequest
-	14, // 23: product.ProductService.GetCategories:input_type -> product.GetCategoriesRequest
-	19, // 24: product.ProductService.HealthCheck:input_type -> common.HealthCheckRequest
-	3,  // 25: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	5,  // 26: product.ProductService.GetProduct:output_type -> product.GetProductResponse
-	7,  // 27: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
-	9,  // 28: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	11, // 29: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
-	13, // 30: product.ProductService.SearchProducts:output_type -> product.SearchProductsResponse
-	15, // 31: product.ProductService.GetCategories:output_type -> product.GetCategoriesResponse
-	20, // 32: product.ProductService.HealthCheck:output_type -> common.HealthCheckResponse
-	25, // [25:33] is the sub-list for method output_type
-	17, // [17:25] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	9,   // 0: product.Product.options:type_name -> product.ProductOption
+	10,  // 1: product.Product.variants:type_name -> product.ProductVariant
+	152, // 2: product.Product.attributes:type_name -> product.Product.AttributesEntry
+	8,   // 3: product.Product.images:type_name -> product.ProductImage
+	1,   // 4: product.Product.active_sale:type_name -> product.PriceSchedule
+	159, // 5: product.Product.price:type_name -> common.Money
+	159, // 6: product.Product.compare_at_price:type_name -> common.Money
+	159, // 7: product.Product.effective_price:type_name -> common.Money
+	159, // 8: product.Product.prices:type_name -> common.Money
+	159, // 9: product.PriceSchedule.sale_price:type_name -> common.Money
+	0,   // 10: product.WishlistItem.product:type_name -> product.Product
+	3,   // 11: product.Wishlist.items:type_name -> product.WishlistItem
+	7,   // 12: product.WebhookDelivery.attempts:type_name -> product.WebhookAttempt
+	153, // 13: product.ProductImage.thumbnails:type_name -> product.ProductImage.ThumbnailsEntry
+	154, // 14: product.ProductVariant.option_values:type_name -> product.ProductVariant.OptionValuesEntry
+	159, // 15: product.ProductVariant.price:type_name -> common.Money
+	159, // 16: product.ProductVariant.effective_price:type_name -> common.Money
+	12,  // 17: product.ProductRevision.changes:type_name -> product.FieldChange
+	15,  // 18: product.StockReservation.items:type_name -> product.ReservationItem
+	17,  // 19: product.CategoryNode.category:type_name -> product.Category
+	18,  // 20: product.CategoryNode.children:type_name -> product.CategoryNode
+	155, // 21: product.CreateProductRequest.attributes:type_name -> product.CreateProductRequest.AttributesEntry
+	159, // 22: product.CreateProductRequest.price:type_name -> common.Money
+	159, // 23: product.CreateProductRequest.compare_at_price:type_name -> common.Money
+	160, // 24: product.CreateProductResponse.response:type_name -> common.Response
+	0,   // 25: product.CreateProductResponse.product:type_name -> product.Product
+	160, // 26: product.GetProductResponse.response:type_name -> common.Response
+	0,   // 27: product.GetProductResponse.product:type_name -> product.Product
+	156, // 28: product.UpdateProductRequest.attributes:type_name -> product.UpdateProductRequest.AttributesEntry
+	159, // 29: product.UpdateProductRequest.price:type_name -> common.Money
+	159, // 30: product.UpdateProductRequest.compare_at_price:type_name -> common.Money
+	160, // 31: product.UpdateProductResponse.response:type_name -> common.Response
+	0,   // 32: product.UpdateProductResponse.product:type_name -> product.Product
+	160, // 33: product.DeleteProductResponse.response:type_name -> common.Response
+	0,   // 34: product.DeleteProductResponse.product:type_name -> product.Product
+	161, // 35: product.ListProductsRequest.pagination:type_name -> common.PaginationRequest
+	160, // 36: product.ListProductsResponse.response:type_name -> common.Response
+	0,   // 37: product.ListProductsResponse.products:type_name -> product.Product
+	162, // 38: product.ListProductsResponse.pagination:type_name -> common.PaginationResponse
+	160, // 39: product.RestoreProductResponse.response:type_name -> common.Response
+	0,   // 40: product.RestoreProductResponse.product:type_name -> product.Product
+	161, // 41: product.ListDeletedProductsRequest.pagination:type_name -> common.PaginationRequest
+	160, // 42: product.ListDeletedProductsResponse.response:type_name -> common.Response
+	0,   // 43: product.ListDeletedProductsResponse.products:type_name -> product.Product
+	162, // 44: product.ListDeletedProductsResponse.pagination:type_name -> common.PaginationResponse
+	160, // 45: product.PurgeDeletedProductsResponse.response:type_name -> common.Response
+	161, // 46: product.GetProductHistoryRequest.pagination:type_name -> common.PaginationRequest
+	160, // 47: product.GetProductHistoryResponse.response:type_name -> common.Response
+	11,  // 48: product.GetProductHistoryResponse.revisions:type_name -> product.ProductRevision
+	162, // 49: product.GetProductHistoryResponse.pagination:type_name -> common.PaginationResponse
+	160, // 50: product.RevertProductToRevisionResponse.response:type_name -> common.Response
+	0,   // 51: product.RevertProductToRevisionResponse.product:type_name -> product.Product
+	159, // 52: product.CreatePriceScheduleRequest.sale_price:type_name -> common.Money
+	160, // 53: product.CreatePriceScheduleResponse.response:type_name -> common.Response
+	1,   // 54: product.CreatePriceScheduleResponse.schedule:type_name -> product.PriceSchedule
+	160, // 55: product.CancelPriceScheduleResponse.response:type_name -> common.Response
+	1,   // 56: product.CancelPriceScheduleResponse.schedule:type_name -> product.PriceSchedule
+	160, // 57: product.ListPriceSchedulesResponse.response:type_name -> common.Response
+	1,   // 58: product.ListPriceSchedulesResponse.schedules:type_name -> product.PriceSchedule
+	159, // 59: product.SetProductPricesRequest.prices:type_name -> common.Money
+	160, // 60: product.SetProductPricesResponse.response:type_name -> common.Response
+	0,   // 61: product.SetProductPricesResponse.product:type_name -> product.Product
+	160, // 62: product.CreateProductReviewResponse.response:type_name -> common.Response
+	2,   // 63: product.CreateProductReviewResponse.review:type_name -> product.ProductReview
+	160, // 64: product.UpdateProductReviewResponse.response:type_name -> common.Response
+	2,   // 65: product.UpdateProductReviewResponse.review:type_name -> product.ProductReview
+	160, // 66: product.DeleteProductReviewResponse.response:type_name -> common.Response
+	161, // 67: product.ListProductReviewsRequest.pagination:type_name -> common.PaginationRequest
+	160, // 68: product.ListProductReviewsResponse.response:type_name -> common.Response
+	2,   // 69: product.ListProductReviewsResponse.reviews:type_name -> product.ProductReview
+	162, // 70: product.ListProductReviewsResponse.pagination:type_name -> common.PaginationResponse
+	160, // 71: product.ModerateProductReviewResponse.response:type_name -> common.Response
+	2,   // 72: product.ModerateProductReviewResponse.review:type_name -> product.ProductReview
+	160, // 73: product.GetWishlistResponse.response:type_name -> common.Response
+	4,   // 74: product.GetWishlistResponse.wishlist:type_name -> product.Wishlist
+	160, // 75: product.AddToWishlistResponse.response:type_name -> common.Response
+	4,   // 76: product.AddToWishlistResponse.wishlist:type_name -> product.Wishlist
+	160, // 77: product.RemoveFromWishlistResponse.response:type_name -> common.Response
+	4,   // 78: product.RemoveFromWishlistResponse.wishlist:type_name -> product.Wishlist
+	160, // 79: product.ReorderWishlistResponse.response:type_name -> common.Response
+	4,   // 80: product.ReorderWishlistResponse.wishlist:type_name -> product.Wishlist
+	160, // 81: product.ShareWishlistResponse.response:type_name -> common.Response
+	4,   // 82: product.ShareWishlistResponse.wishlist:type_name -> product.Wishlist
+	160, // 83: product.GetSharedWishlistResponse.response:type_name -> common.Response
+	4,   // 84: product.GetSharedWishlistResponse.wishlist:type_name -> product.Wishlist
+	161, // 85: product.SearchProductsRequest.pagination:type_name -> common.PaginationRequest
+	159, // 86: product.SearchProductsRequest.min_price:type_name -> common.Money
+	159, // 87: product.SearchProductsRequest.max_price:type_name -> common.Money
+	160, // 88: product.SearchProductsResponse.response:type_name -> common.Response
+	0,   // 89: product.SearchProductsResponse.products:type_name -> product.Product
+	162, // 90: product.SearchProductsResponse.pagination:type_name -> common.PaginationResponse
+	160, // 91: product.GetCategoriesResponse.response:type_name -> common.Response
+	17,  // 92: product.GetCategoriesResponse.categories:type_name -> product.Category
+	160, // 93: product.CreateCategoryResponse.response:type_name -> common.Response
+	17,  // 94: product.CreateCategoryResponse.category:type_name -> product.Category
+	160, // 95: product.UpdateCategoryResponse.response:type_name -> common.Response
+	17,  // 96: product.UpdateCategoryResponse.category:type_name -> product.Category
+	160, // 97: product.DeleteCategoryResponse.response:type_name -> common.Response
+	160, // 98: product.MoveCategoryResponse.response:type_name -> common.Response
+	17,  // 99: product.MoveCategoryResponse.category:type_name -> product.Category
+	160, // 100: product.GetCategoryTreeResponse.response:type_name -> common.Response
+	18,  // 101: product.GetCategoryTreeResponse.roots:type_name -> product.CategoryNode
+	9,   // 102: product.SetProductOptionsRequest.options:type_name -> product.ProductOption
+	160, // 103: product.SetProductOptionsResponse.response:type_name -> common.Response
+	9,   // 104: product.SetProductOptionsResponse.options:type_name -> product.ProductOption
+	157, // 105: product.CreateProductVariantRequest.option_values:type_name -> product.CreateProductVariantRequest.OptionValuesEntry
+	159, // 106: product.CreateProductVariantRequest.price:type_name -> common.Money
+	160, // 107: product.CreateProductVariantResponse.response:type_name -> common.Response
+	10,  // 108: product.CreateProductVariantResponse.variant:type_name -> product.ProductVariant
+	158, // 109: product.UpdateProductVariantRequest.option_values:type_name -> product.UpdateProductVariantRequest.OptionValuesEntry
+	159, // 110: product.UpdateProductVariantRequest.price:type_name -> common.Money
+	160, // 111: product.UpdateProductVariantResponse.response:type_name -> common.Response
+	10,  // 112: product.UpdateProductVariantResponse.variant:type_name -> product.ProductVariant
+	160, // 113: product.DeleteProductVariantResponse.response:type_name -> common.Response
+	160, // 114: product.ListProductVariantsResponse.response:type_name -> common.Response
+	9,   // 115: product.ListProductVariantsResponse.options:type_name -> product.ProductOption
+	10,  // 116: product.ListProductVariantsResponse.variants:type_name -> product.ProductVariant
+	93,  // 117: product.SetCategoryAttributesRequest.attributes:type_name -> product.AttributeDefinition
+	160, // 118: product.SetCategoryAttributesResponse.response:type_name -> common.Response
+	93,  // 119: product.SetCategoryAttributesResponse.attributes:type_name -> product.AttributeDefinition
+	160, // 120: product.GetCategoryAttributesResponse.response:type_name -> common.Response
+	93,  // 121: product.GetCategoryAttributesResponse.attributes:type_name -> product.AttributeDefinition
+	8,   // 122: product.AddProductImageRequest.image:type_name -> product.ProductImage
+	160, // 123: product.AddProductImageResponse.response:type_name -> common.Response
+	8,   // 124: product.AddProductImageResponse.image:type_name -> product.ProductImage
+	160, // 125: product.ListProductImagesResponse.response:type_name -> common.Response
+	8,   // 126: product.ListProductImagesResponse.images:type_name -> product.ProductImage
+	160, // 127: product.DeleteProductImageResponse.response:type_name -> common.Response
+	8,   // 128: product.DeleteProductImageResponse.image:type_name -> product.ProductImage
+	160, // 129: product.ReorderProductImagesResponse.response:type_name -> common.Response
+	8,   // 130: product.ReorderProductImagesResponse.images:type_name -> product.ProductImage
+	160, // 131: product.AdjustStockResponse.response:type_name -> common.Response
+	13,  // 132: product.AdjustStockResponse.movement:type_name -> product.StockMovement
+	15,  // 133: product.ReserveStockRequest.items:type_name -> product.ReservationItem
+	160, // 134: product.ReserveStockResponse.response:type_name -> common.Response
+	16,  // 135: product.ReserveStockResponse.reservation:type_name -> product.StockReservation
+	160, // 136: product.CommitReservationResponse.response:type_name -> common.Response
+	16,  // 137: product.CommitReservationResponse.reservation:type_name -> product.StockReservation
+	160, // 138: product.ReleaseReservationResponse.response:type_name -> common.Response
+	16,  // 139: product.ReleaseReservationResponse.reservation:type_name -> product.StockReservation
+	161, // 140: product.ListStockMovementsRequest.pagination:type_name -> common.PaginationRequest
+	160, // 141: product.ListStockMovementsResponse.response:type_name -> common.Response
+	13,  // 142: product.ListStockMovementsResponse.movements:type_name -> product.StockMovement
+	162, // 143: product.ListStockMovementsResponse.pagination:type_name -> common.PaginationResponse
+	161, // 144: product.ListLowStockProductsRequest.pagination:type_name -> common.PaginationRequest
+	160, // 145: product.ListLowStockProductsResponse.response:type_name -> common.Response
+	14,  // 146: product.ListLowStockProductsResponse.items:type_name -> product.LowStockItem
+	162, // 147: product.ListLowStockProductsResponse.pagination:type_name -> common.PaginationResponse
+	119, // 148: product.ImportProductsRequest.options:type_name -> product.ImportOptions
+	120, // 149: product.ImportProductsRequest.row:type_name -> product.ImportProductRow
+	19,  // 150: product.ImportProductRow.product:type_name -> product.CreateProductRequest
+	160, // 151: product.ImportProductsResponse.response:type_name -> common.Response
+	121, // 152: product.ImportProductsResponse.errors:type_name -> product.ImportRowError
+	0,   // 153: product.ExportProductsResponse.product:type_name -> product.Product
+	160, // 154: product.BatchGetProductsResponse.response:type_name -> common.Response
+	0,   // 155: product.BatchGetProductsResponse.products:type_name -> product.Product
+	159, // 156: product.ProductPatch.price:type_name -> common.Money
+	127, // 157: product.BatchUpdateProductsRequest.updates:type_name -> product.ProductPatch
+	0,   // 158: product.ProductUpdateResult.product:type_name -> product.Product
+	160, // 159: product.BatchUpdateProductsResponse.response:type_name -> common.Response
+	129, // 160: product.BatchUpdateProductsResponse.results:type_name -> product.ProductUpdateResult
+	0,   // 161: product.ProductChange.product:type_name -> product.Product
+	160, // 162: product.CreateWebhookResponse.response:type_name -> common.Response
+	5,   // 163: product.CreateWebhookResponse.webhook:type_name -> product.Webhook
+	160, // 164: product.UpdateWebhookResponse.response:type_name -> common.Response
+	5,   // 165: product.UpdateWebhookResponse.webhook:type_name -> product.Webhook
+	160, // 166: product.DeleteWebhookResponse.response:type_name -> common.Response
+	160, // 167: product.ListWebhooksResponse.response:type_name -> common.Response
+	5,   // 168: product.ListWebhooksResponse.webhooks:type_name -> product.Webhook
+	161, // 169: product.ListWebhookDeliveriesRequest.pagination:type_name -> common.PaginationRequest
+	160, // 170: product.ListWebhookDeliveriesResponse.response:type_name -> common.Response
+	6,   // 171: product.ListWebhookDeliveriesResponse.deliveries:type_name -> product.WebhookDelivery
+	162, // 172: product.ListWebhookDeliveriesResponse.pagination:type_name -> common.PaginationResponse
+	160, // 173: product.RetryWebhookDeliveryResponse.response:type_name -> common.Response
+	6,   // 174: product.RetryWebhookDeliveryResponse.delivery:type_name -> product.WebhookDelivery
+	160, // 175: product.SendTestWebhookResponse.response:type_name -> common.Response
+	6,   // 176: product.SendTestWebhookResponse.delivery:type_name -> product.WebhookDelivery
+	160, // 177: product.RecordProductViewResponse.response:type_name -> common.Response
+	0,   // 178: product.Recommendation.product:type_name -> product.Product
+	160, // 179: product.GetRecommendationsResponse.response:type_name -> common.Response
+	150, // 180: product.GetRecommendationsResponse.recommendations:type_name -> product.Recommendation
+	19,  // 181: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	21,  // 182: product.ProductService.GetProduct:input_type -> product.GetProductRequest
+	23,  // 183: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	25,  // 184: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	29,  // 185: product.ProductService.RestoreProduct:input_type -> product.RestoreProductRequest
+	31,  // 186: product.ProductService.ListDeletedProducts:input_type -> product.ListDeletedProductsRequest
+	33,  // 187: product.ProductService.PurgeDeletedProducts:input_type -> product.PurgeDeletedProductsRequest
+	35,  // 188: product.ProductService.GetProductHistory:input_type -> product.GetProductHistoryRequest
+	37,  // 189: product.ProductService.RevertProductToRevision:input_type -> product.RevertProductToRevisionRequest
+	39,  // 190: product.ProductService.CreatePriceSchedule:input_type -> product.CreatePriceScheduleRequest
+	41,  // 191: product.ProductService.CancelPriceSchedule:input_type -> product.CancelPriceScheduleRequest
+	43,  // 192: product.ProductService.ListPriceSchedules:input_type -> product.ListPriceSchedulesRequest
+	45,  // 193: product.ProductService.SetProductPrices:input_type -> product.SetProductPricesRequest
+	47,  // 194: product.ProductService.CreateProductReview:input_type -> product.CreateProductReviewRequest
+	49,  // 195: product.ProductService.UpdateProductReview:input_type -> product.UpdateProductReviewRequest
+	51,  // 196: product.ProductService.DeleteProductReview:input_type -> product.DeleteProductReviewRequest
+	53,  // 197: product.ProductService.ListProductReviews:input_type -> product.ListProductReviewsRequest
+	55,  // 198: product.ProductService.ModerateProductReview:input_type -> product.ModerateProductReviewRequest
+	57,  // 199: product.ProductService.GetWishlist:input_type -> product.GetWishlistRequest
+	59,  // 200: product.ProductService.AddToWishlist:input_type -> product.AddToWishlistRequest
+	61,  // 201: product.ProductService.RemoveFromWishlist:input_type -> product.RemoveFromWishlistRequest
+	63,  // 202: product.ProductService.ReorderWishlist:input_type -> product.ReorderWishlistRequest
+	65,  // 203: product.ProductService.ShareWishlist:input_type -> product.ShareWishlistRequest
+	67,  // 204: product.ProductService.GetSharedWishlist:input_type -> product.GetSharedWishlistRequest
+	133, // 205: product.ProductService.CreateWebhook:input_type -> product.CreateWebhookRequest
+	135, // 206: product.ProductService.UpdateWebhook:input_type -> product.UpdateWebhookRequest
+	137, // 207: product.ProductService.DeleteWebhook:input_type -> product.DeleteWebhookRequest
+	139, // 208: product.ProductService.ListWebhooks:input_type -> product.ListWebhooksRequest
+	141, // 209: product.ProductService.ListWebhookDeliveries:input_type -> product.ListWebhookDeliveriesRequest
+	143, // 210: product.ProductService.RetryWebhookDelivery:input_type -> product.RetryWebhookDeliveryRequest
+	145, // 211: product.ProductService.SendTestWebhook:input_type -> product.SendTestWebhookRequest
+	27,  // 212: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
+	69,  // 213: product.ProductService.SearchProducts:input_type -> product.SearchProductsRequest
+	71,  // 214: product.ProductService.GetCategories:input_type -> product.GetCategoriesRequest
+	73,  // 215: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
+	75,  // 216: product.ProductService.UpdateCategory:input_type -> product.UpdateCategoryRequest
+	77,  // 217: product.ProductService.DeleteCategory:input_type -> product.DeleteCategoryRequest
+	79,  // 218: product.ProductService.MoveCategory:input_type -> product.MoveCategoryRequest
+	81,  // 219: product.ProductService.GetCategoryTree:input_type -> product.GetCategoryTreeRequest
+	83,  // 220: product.ProductService.SetProductOptions:input_type -> product.SetProductOptionsRequest
+	85,  // 221: product.ProductService.CreateProductVariant:input_type -> product.CreateProductVariantRequest
+	87,  // 222: product.ProductService.UpdateProductVariant:input_type -> product.UpdateProductVariantRequest
+	89,  // 223: product.ProductService.DeleteProductVariant:input_type -> product.DeleteProductVariantRequest
+	91,  // 224: product.ProductService.ListProductVariants:input_type -> product.ListProductVariantsRequest
+	94,  // 225: product.ProductService.SetCategoryAttributes:input_type -> product.SetCategoryAttributesRequest
+	96,  // 226: product.ProductService.GetCategoryAttributes:input_type -> product.GetCategoryAttributesRequest
+	98,  // 227: product.ProductService.AddProductImage:input_type -> product.AddProductImageRequest
+	100, // 228: product.ProductService.ListProductImages:input_type -> product.ListProductImagesRequest
+	102, // 229: product.ProductService.DeleteProductImage:input_type -> product.DeleteProductImageRequest
+	104, // 230: product.ProductService.ReorderProductImages:input_type -> product.ReorderProductImagesRequest
+	106, // 231: product.ProductService.AdjustStock:input_type -> product.AdjustStockRequest
+	108, // 232: product.ProductService.ReserveStock:input_type -> product.ReserveStockRequest
+	110, // 233: product.ProductService.CommitReservation:input_type -> product.CommitReservationRequest
+	112, // 234: product.ProductService.ReleaseReservation:input_type -> product.ReleaseReservationRequest
+	114, // 235: product.ProductService.ListStockMovements:input_type -> product.ListStockMovementsRequest
+	116, // 236: product.ProductService.ListLowStockProducts:input_type -> product.ListLowStockProductsRequest
+	118, // 237: product.ProductService.ImportProducts:input_type -> product.ImportProductsRequest
+	123, // 238: product.ProductService.ExportProducts:input_type -> product.ExportProductsRequest
+	125, // 239: product.ProductService.BatchGetProducts:input_type -> product.BatchGetProductsRequest
+	128, // 240: product.ProductService.BatchUpdateProducts:input_type -> product.BatchUpdateProductsRequest
+	131, // 241: product.ProductService.WatchProducts:input_type -> product.WatchProductsRequest
+	147, // 242: product.ProductService.RecordProductView:input_type -> product.RecordProductViewRequest
+	149, // 243: product.ProductService.GetRecommendations:input_type -> product.GetRecommendationsRequest
+	163, // 244: product.ProductService.HealthCheck:input_type -> common.HealthCheckRequest
+	20,  // 245: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	22,  // 246: product.ProductService.GetProduct:output_type -> product.GetProductResponse
+	24,  // 247: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
+	26,  // 248: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	30,  // 249: product.ProductService.RestoreProduct:output_type -> product.RestoreProductResponse
+	32,  // 250: product.ProductService.ListDeletedProducts:output_type -> product.ListDeletedProductsResponse
+	34,  // 251: product.ProductService.PurgeDeletedProducts:output_type -> product.PurgeDeletedProductsResponse
+	36,  // 252: product.ProductService.GetProductHistory:output_type -> product.GetProductHistoryResponse
+	38,  // 253: product.ProductService.RevertProductToRevision:output_type -> product.RevertProductToRevisionResponse
+	40,  // 254: product.ProductService.CreatePriceSchedule:output_type -> product.CreatePriceScheduleResponse
+	42,  // 255: product.ProductService.CancelPriceSchedule:output_type -> product.CancelPriceScheduleResponse
+	44,  // 256: product.ProductService.ListPriceSchedules:output_type -> product.ListPriceSchedulesResponse
+	46,  // 257: product.ProductService.SetProductPrices:output_type -> product.SetProductPricesResponse
+	48,  // 258: product.ProductService.CreateProductReview:output_type -> product.CreateProductReviewResponse
+	50,  // 259: product.ProductService.UpdateProductReview:output_type -> product.UpdateProductReviewResponse
+	52,  // 260: product.ProductService.DeleteProductReview:output_type -> product.DeleteProductReviewResponse
+	54,  // 261: product.ProductService.ListProductReviews:output_type -> product.ListProductReviewsResponse
+	56,  // 262: product.ProductService.ModerateProductReview:output_type -> product.ModerateProductReviewResponse
+	58,  // 263: product.ProductService.GetWishlist:output_type -> product.GetWishlistResponse
+	60,  // 264: product.ProductService.AddToWishlist:output_type -> product.AddToWishlistResponse
+	62,  // 265: product.ProductService.RemoveFromWishlist:output_type -> product.RemoveFromWishlistResponse
+	64,  // 266: product.ProductService.ReorderWishlist:output_type -> product.ReorderWishlistResponse
+	66,  // 267: product.ProductService.ShareWishlist:output_type -> product.ShareWishlistResponse
+	68,  // 268: product.ProductService.GetSharedWishlist:output_type -> product.GetSharedWishlistResponse
+	134, // 269: product.ProductService.CreateWebhook:output_type -> product.CreateWebhookResponse
+	136, // 270: product.ProductService.UpdateWebhook:output_type -> product.UpdateWebhookResponse
+	138, // 271: product.ProductService.DeleteWebhook:output_type -> product.DeleteWebhookResponse
+	140, // 272: product.ProductService.ListWebhooks:output_type -> product.ListWebhooksResponse
+	142, // 273: product.ProductService.ListWebhookDeliveries:output_type -> product.ListWebhookDeliveriesResponse
+	144, // 274: product.ProductService.RetryWebhookDelivery:output_type -> product.RetryWebhookDeliveryResponse
+	146, // 275: product.ProductService.SendTestWebhook:output_type -> product.SendTestWebhookResponse
+	28,  // 276: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
+	70,  // 277: product.ProductService.SearchProducts:output_type -> product.SearchProductsResponse
+	72,  // 278: product.ProductService.GetCategories:output_type -> product.GetCategoriesResponse
+	74,  // 279: product.ProductService.CreateCategory:output_type -> product.CreateCategoryResponse
+	76,  // 280: product.ProductService.UpdateCategory:output_type -> product.UpdateCategoryResponse
+	78,  // 281: product.ProductService.DeleteCategory:output_type -> product.DeleteCategoryResponse
+	80,  // 282: product.ProductService.MoveCategory:output_type -> product.MoveCategoryResponse
+	82,  // 283: product.ProductService.GetCategoryTree:output_type -> product.GetCategoryTreeResponse
+	84,  // 284: product.ProductService.SetProductOptions:output_type -> product.SetProductOptionsResponse
+	86,  // 285: product.ProductService.CreateProductVariant:output_type -> product.CreateProductVariantResponse
+	88,  // 286: product.ProductService.UpdateProductVariant:output_type -> product.UpdateProductVariantResponse
+	90,  // 287: product.ProductService.DeleteProductVariant:output_type -> product.DeleteProductVariantResponse
+	92,  // 288: product.ProductService.ListProductVariants:output_type -> product.ListProductVariantsResponse
+	95,  // 289: product.ProductService.SetCategoryAttributes:output_type -> product.SetCategoryAttributesResponse
+	97,  // 290: product.ProductService.GetCategoryAttributes:output_type -> product.GetCategoryAttributesResponse
+	99,  // 291: product.ProductService.AddProductImage:output_type -> product.AddProductImageResponse
+	101, // 292: product.ProductService.ListProductImages:output_type -> product.ListProductImagesResponse
+	103, // 293: product.ProductService.DeleteProductImage:output_type -> product.DeleteProductImageResponse
+	105, // 294: product.ProductService.ReorderProductImages:output_type -> product.ReorderProductImagesResponse
+	107, // 295: product.ProductService.AdjustStock:output_type -> product.AdjustStockResponse
+	109, // 296: product.ProductService.ReserveStock:output_type -> product.ReserveStockResponse
+	111, // 297: product.ProductService.CommitReservation:output_type -> product.CommitReservationResponse
+	113, // 298: product.ProductService.ReleaseReservation:output_type -> product.ReleaseReservationResponse
+	115, // 299: product.ProductService.ListStockMovements:output_type -> product.ListStockMovementsResponse
+	117, // 300: product.ProductService.ListLowStockProducts:output_type -> product.ListLowStockProductsResponse
+	122, // 301: product.ProductService.ImportProducts:output_type -> product.ImportProductsResponse
+	124, // 302: product.ProductService.ExportProducts:output_type -> product.ExportProductsResponse
+	126, // 303: product.ProductService.BatchGetProducts:output_type -> product.BatchGetProductsResponse
+	130, // 304: product.ProductService.BatchUpdateProducts:output_type -> product.BatchUpdateProductsResponse
+	132, // 305: product.ProductService.WatchProducts:output_type -> product.ProductChange
+	148, // 306: product.ProductService.RecordProductView:output_type -> product.RecordProductViewResponse
+	151, // 307: product.ProductService.GetRecommendations:output_type -> product.GetRecommendationsResponse
+	164, // 308: product.ProductService.HealthCheck:output_type -> common.HealthCheckResponse
+	245, // [245:309] is the sub-list for method output_type
+	181, // [181:245] is the sub-list for method input_type
+	181, // [181:181] is the sub-list for extension type_name
+	181, // [181:181] is the sub-list for extension extendee
+	0,   // [0:181] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -1286,13 +11741,22 @@ func file_product_product_proto_init() {
 	if File_product_product_proto != nil {
 		return
 	}
+	file_product_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[19].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[23].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[87].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[118].OneofWrappers = []any{
+		(*ImportProductsRequest_Options)(nil),
+		(*ImportProductsRequest_Row)(nil),
+	}
+	file_product_product_proto_msgTypes[127].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_proto_rawDesc), len(file_product_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   159,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
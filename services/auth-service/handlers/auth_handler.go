@@ -16,12 +16,14 @@ import (
 type AuthGrpcHandler struct {
 	//this has receiver methods
 	pb.UnimplementedAuthServiceServer //embedig a auto generated struct into the server
-	authService service.AuthService
+	authService  service.AuthService
+	storeService service.StoreService
 }
 
-func NewAuthGrpcHandler(authService service.AuthService) *AuthGrpcHandler {
+func NewAuthGrpcHandler(authService service.AuthService, storeService service.StoreService) *AuthGrpcHandler {
 	return &AuthGrpcHandler{
-		authService: authService,
+		authService:  authService,
+		storeService: storeService,
 	}
 }
 
@@ -107,6 +109,20 @@ func (h *AuthGrpcHandler) ValidateToken(ctx context.Context, req *pb.ValidateTok
 		}, nil
 	}
 
+	// The user's role is looked up in the store the request was made in
+	user.StoreID = storeFromContext(ctx)
+	user.StoreRole, err = h.storeService.MemberRole(user.StoreID, user.ID)
+	if err != nil {
+		log.Printf("Store role lookup error: %v", err)
+		return &pb.ValidateTokenResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Failed to get store role",
+			},
+			Valid: false,
+		}, nil
+	}
+
 	return &pb.ValidateTokenResponse{
 		Response: &commonPb.Response{
 			Success: true,
@@ -276,5 +292,7 @@ func (h *AuthGrpcHandler) userToProto(user *models.User) *pb.User {
 		IsActive:  user.IsActive,
 		CreatedAt: user.CreatedAt.Format(time.RFC3339),
 		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+		StoreId:   user.StoreID,
+		StoreRole: user.StoreRole,
 	}
 }
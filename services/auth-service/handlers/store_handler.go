@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/auth-service/genproto/auth"
+	commonPb "github.com/martbul/playground_microservices/services/auth-service/genproto/common"
+	"github.com/martbul/playground_microservices/services/auth-service/models"
+	"google.golang.org/grpc/metadata"
+)
+
+// storeMetadataKey carries the ID of the store a call is made for
+const storeMetadataKey = "x-store-id"
+
+// storeFromContext returns the store the call was made for, or the default
+// store if the caller didn't say
+func storeFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(storeMetadataKey); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return models.DefaultStoreID
+}
+
+func (h *AuthGrpcHandler) ResolveStore(ctx context.Context, req *pb.ResolveStoreRequest) (*pb.ResolveStoreResponse, error) {
+	store, err := h.storeService.ResolveStore(req.Id, req.Slug, req.Domain)
+	if err != nil {
+		return &pb.ResolveStoreResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ResolveStoreResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store resolved successfully",
+		},
+		Store: storeToProto(store),
+	}, nil
+}
+
+func (h *AuthGrpcHandler) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.CreateStoreResponse, error) {
+	log.Printf("Create store request for slug: %s", req.Slug)
+
+	// Validate token first
+	if _, err := h.authService.ValidateToken(req.Token); err != nil {
+		log.Printf("Token validation error: %v", err)
+		return &pb.CreateStoreResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Invalid token",
+			},
+		}, nil
+	}
+
+	store, err := h.storeService.CreateStore(&models.CreateStoreRequest{
+		Slug:    req.Slug,
+		Name:    req.Name,
+		Domain:  req.Domain,
+		OwnerID: req.OwnerId,
+	})
+	if err != nil {
+		log.Printf("Create store error: %v", err)
+		return &pb.CreateStoreResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreateStoreResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store created successfully",
+		},
+		Store: storeToProto(store),
+	}, nil
+}
+
+func (h *AuthGrpcHandler) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (*pb.UpdateStoreResponse, error) {
+	log.Printf("Update store request for ID: %s", req.StoreId)
+
+	// Validate token first
+	if _, err := h.authService.ValidateToken(req.Token); err != nil {
+		log.Printf("Token validation error: %v", err)
+		return &pb.UpdateStoreResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Invalid token",
+			},
+		}, nil
+	}
+
+	store, err := h.storeService.UpdateStore(req.StoreId, &models.UpdateStoreRequest{
+		Name:     req.Name,
+		Domain:   req.Domain,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		log.Printf("Update store error: %v", err)
+		return &pb.UpdateStoreResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateStoreResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store updated successfully",
+		},
+		Store: storeToProto(store),
+	}, nil
+}
+
+func (h *AuthGrpcHandler) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
+	// Validate token first
+	if _, err := h.authService.ValidateToken(req.Token); err != nil {
+		log.Printf("Token validation error: %v", err)
+		return &pb.ListStoresResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Invalid token",
+			},
+		}, nil
+	}
+
+	stores, err := h.storeService.ListStores()
+	if err != nil {
+		log.Printf("List stores error: %v", err)
+		return &pb.ListStoresResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoStores := make([]*pb.Store, 0, len(stores))
+	for _, store := range stores {
+		protoStores = append(protoStores, storeToProto(store))
+	}
+
+	return &pb.ListStoresResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Stores retrieved successfully",
+		},
+		Stores: protoStores,
+	}, nil
+}
+
+func (h *AuthGrpcHandler) ListStoreMembers(ctx context.Context, req *pb.ListStoreMembersRequest) (*pb.ListStoreMembersResponse, error) {
+	// Validate token first
+	if _, err := h.authService.ValidateToken(req.Token); err != nil {
+		log.Printf("Token validation error: %v", err)
+		return &pb.ListStoreMembersResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Invalid token",
+			},
+		}, nil
+	}
+
+	members, err := h.storeService.ListMembers(req.StoreId)
+	if err != nil {
+		log.Printf("List store members error: %v", err)
+		return &pb.ListStoreMembersResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoMembers := make([]*pb.StoreMember, 0, len(members))
+	for _, member := range members {
+		protoMembers = append(protoMembers, storeMemberToProto(member))
+	}
+
+	return &pb.ListStoreMembersResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store members retrieved successfully",
+		},
+		Members: protoMembers,
+	}, nil
+}
+
+func (h *AuthGrpcHandler) SetStoreMember(ctx context.Context, req *pb.SetStoreMemberRequest) (*pb.SetStoreMemberResponse, error) {
+	log.Printf("Set store member request for store ID: %s", req.StoreId)
+
+	// Validate token first
+	if _, err := h.authService.ValidateToken(req.Token); err != nil {
+		log.Printf("Token validation error: %v", err)
+		return &pb.SetStoreMemberResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Invalid token",
+			},
+		}, nil
+	}
+
+	member, err := h.storeService.SetMember(req.StoreId, req.UserId, req.Email, req.Role)
+	if err != nil {
+		log.Printf("Set store member error: %v", err)
+		return &pb.SetStoreMemberResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.SetStoreMemberResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store member saved successfully",
+		},
+		Member: storeMemberToProto(member),
+	}, nil
+}
+
+func (h *AuthGrpcHandler) RemoveStoreMember(ctx context.Context, req *pb.RemoveStoreMemberRequest) (*pb.RemoveStoreMemberResponse, error) {
+	log.Printf("Remove store member request for store ID: %s", req.StoreId)
+
+	// Validate token first
+	if _, err := h.authService.ValidateToken(req.Token); err != nil {
+		log.Printf("Token validation error: %v", err)
+		return &pb.RemoveStoreMemberResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: "Invalid token",
+			},
+		}, nil
+	}
+
+	if err := h.storeService.RemoveMember(req.StoreId, req.UserId); err != nil {
+		log.Printf("Remove store member error: %v", err)
+		return &pb.RemoveStoreMemberResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.RemoveStoreMemberResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Store member removed successfully",
+		},
+	}, nil
+}
+
+func storeToProto(store *models.Store) *pb.Store {
+	return &pb.Store{
+		Id:        store.ID,
+		Slug:      store.Slug,
+		Name:      store.Name,
+		Domain:    store.Domain,
+		IsActive:  store.IsActive,
+		CreatedAt: store.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: store.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func storeMemberToProto(member *models.StoreMember) *pb.StoreMember {
+	return &pb.StoreMember{
+		StoreId:   member.StoreID,
+		UserId:    member.UserID,
+		Email:     member.Email,
+		Username:  member.Username,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt.Format(time.RFC3339),
+	}
+}
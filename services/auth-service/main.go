@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"github.com/martbul/playground_microservices/services/auth-service/config"
+	"github.com/martbul/playground_microservices/services/auth-service/events"
 	"github.com/martbul/playground_microservices/services/auth-service/handlers"
 	"github.com/martbul/playground_microservices/services/auth-service/repository"
 	"github.com/martbul/playground_microservices/services/auth-service/service"
@@ -39,13 +42,26 @@ func main() {
 
 	// Initialize repository
 	userRepo := repository.NewUserRepository(db)
+	storeRepo := repository.NewStoreRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+
+	eventBus, err := events.New(cfg, db)
+	if err != nil {
+		log.Fatal("Failed to configure event bus:", err)
+	}
+	defer eventBus.Close()
 
 	// Initialize service
 	authService := service.NewAuthService(userRepo, cfg.JWTSecret)
+	storeService := service.NewStoreService(storeRepo, userRepo)
+	outboxRelay := service.NewOutboxRelay(outboxRepo, eventBus, cfg.OutboxRetention)
+
+	// Events are committed with their changes; this publishes them
+	go relayEvents(outboxRelay, cfg.OutboxPollInterval)
 
 	// Initialize handler
 	//The authHandler is the implementation of the grpc service
-	authHandler := handlers.NewAuthGrpcHandler(authService)
+	authHandler := handlers.NewAuthGrpcHandler(authService, storeService)
 
 	// Create gRPC server
 	server := grpc.NewServer()
@@ -65,6 +81,32 @@ func main() {
 	}
 }
 
+func relayEvents(outboxRelay service.OutboxRelay, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	cleanup := time.NewTicker(time.Hour)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := outboxRelay.Relay(context.Background()); err != nil {
+				log.Printf("Failed to relay events: %v", err)
+			}
+		case <-cleanup.C:
+			deleted, err := outboxRelay.Cleanup()
+			if err != nil {
+				log.Printf("Failed to clean up published events: %v", err)
+				continue
+			}
+			if deleted > 0 {
+				log.Printf("Deleted %d published events", deleted)
+			}
+		}
+	}
+}
+
 func runMigrations(db *sql.DB) error {
 	query := `
 	CREATE TABLE IF NOT EXISTS users (
@@ -88,9 +130,57 @@ func runMigrations(db *sql.DB) error {
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 	);
 
+	-- Domain events waiting to be published, written with the change they
+	-- describe; id orders them, event_id is what consumers deduplicate on
+	CREATE TABLE IF NOT EXISTS outbox_events (
+		id BIGSERIAL PRIMARY KEY,
+		event_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+		type VARCHAR(100) NOT NULL,
+		version INTEGER NOT NULL,
+		aggregate_id VARCHAR(255) NOT NULL,
+		payload BYTEA NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		last_error TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		published_at TIMESTAMP
+	);
+
+	-- Storefronts sharing the deployment. The default store holds everything
+	-- from before there were several.
+	CREATE TABLE IF NOT EXISTS stores (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		slug VARCHAR(50) UNIQUE NOT NULL,
+		name VARCHAR(100) NOT NULL,
+		domain VARCHAR(255) UNIQUE,
+		is_active BOOLEAN NOT NULL DEFAULT true,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	INSERT INTO stores (id, slug, name)
+	VALUES ('00000000-0000-0000-0000-000000000001', 'default', 'Default Store')
+	ON CONFLICT (id) DO NOTHING;
+
+	-- Who runs each store; users.role stays the platform-wide role
+	CREATE TABLE IF NOT EXISTS store_members (
+		store_id UUID NOT NULL REFERENCES stores(id) ON DELETE CASCADE,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin')),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (store_id, user_id)
+	);
+
+	-- Platform admins ran the single store before, so they own the default one
+	INSERT INTO store_members (store_id, user_id, role)
+	SELECT '00000000-0000-0000-0000-000000000001', id, 'owner' FROM users WHERE role = 'admin'
+	ON CONFLICT (store_id, user_id) DO NOTHING;
+
 	CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
 	CREATE INDEX IF NOT EXISTS idx_users_username ON users(username);
 	CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+	CREATE INDEX IF NOT EXISTS idx_store_members_user_id ON store_members(user_id);
+	CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+	CREATE INDEX IF NOT EXISTS idx_outbox_events_published ON outbox_events(published_at) WHERE published_at IS NOT NULL;
 	`
 
 	_, err := db.Exec(query)
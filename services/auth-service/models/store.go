@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+)
+
+// DefaultStoreID is the store that existed before there were several, and
+// the one requests without a store belong to
+const DefaultStoreID = "00000000-0000-0000-0000-000000000001"
+
+// Roles a user can have in a store. Owners manage the store's members as
+// well as its catalog; admins only the catalog.
+const (
+	StoreRoleOwner = "owner"
+	StoreRoleAdmin = "admin"
+)
+
+type Store struct {
+	ID        string    `json:"id" db:"id"`
+	Slug      string    `json:"slug" db:"slug"`
+	Name      string    `json:"name" db:"name"`
+	Domain    string    `json:"domain" db:"domain"`
+	IsActive  bool      `json:"is_active" db:"is_active"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type StoreMember struct {
+	StoreID   string    `json:"store_id" db:"store_id"`
+	UserID    string    `json:"user_id" db:"user_id"`
+	Email     string    `json:"email" db:"email"`
+	Username  string    `json:"username" db:"username"`
+	Role      string    `json:"role" db:"role"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+type CreateStoreRequest struct {
+	Slug    string `json:"slug"`
+	Name    string `json:"name"`
+	Domain  string `json:"domain"`
+	OwnerID string `json:"owner_id"`
+}
+
+// UpdateStoreRequest changes only the fields that are set
+type UpdateStoreRequest struct {
+	Name     *string `json:"name"`
+	Domain   *string `json:"domain"`
+	IsActive *bool   `json:"is_active"`
+}
@@ -15,6 +15,10 @@ type User struct {
 	IsActive     bool      `json:"is_active" db:"is_active"`
 	CreatedAt    time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+
+	// The store the user was looked up for, and their role in it
+	StoreID   string `json:"store_id,omitempty" db:"-"`
+	StoreRole string `json:"store_role,omitempty" db:"-"`
 }
 
 type RefreshToken struct {
@@ -38,10 +42,11 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+// UpdateProfileRequest changes only the fields that are set
 type UpdateProfileRequest struct {
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	Username  string `json:"username"`
+	FirstName *string `json:"first_name"`
+	LastName  *string `json:"last_name"`
+	Username  *string `json:"username"`
 }
 
 type ChangePasswordRequest struct {
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/auth-service/events"
+	"github.com/martbul/playground_microservices/services/auth-service/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// OutboxRepository hands the events recorded alongside user changes to
+// the relay
+type OutboxRepository interface {
+	PublishPending(limit int, publish func(*models.OutboxEvent) error) (int, error)
+	DeletePublished(before time.Time) (int64, error)
+}
+
+type outboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// recordEvent adds an event to the outbox in the caller's transaction, so it
+// is published if and only if the change commits
+func recordEvent(q execer, event *events.Event) error {
+	payload, err := proto.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event.Type, err)
+	}
+
+	_, err = q.Exec(
+		`INSERT INTO outbox_events (type, version, aggregate_id, payload) VALUES ($1, $2, $3, $4)`,
+		event.Type, event.Version, event.AggregateID, payload,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record %s event: %w", event.Type, err)
+	}
+	return nil
+}
+
+// PublishPending passes up to limit unpublished events to publish, oldest
+// first. It stops at the first one publish fails on, so events are never
+// published out of order, and records the failure against it. Rows are
+// locked while this runs, so several relays can share the outbox.
+func (r *outboxRepository) PublishPending(limit int, publish func(*models.OutboxEvent) error) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Skipping locked rows lets another relay carry on with later events
+	// meanwhile; ordering is per relay, which holds with one instance
+	rows, err := tx.Query(`
+		SELECT id, event_id, type, version, aggregate_id, payload, attempts, created_at
+		FROM outbox_events
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending events: %w", err)
+	}
+
+	var pending []*models.OutboxEvent
+	for rows.Next() {
+		event := &models.OutboxEvent{}
+		if err := rows.Scan(&event.ID, &event.EventID, &event.Type, &event.Version, &event.AggregateID, &event.Payload, &event.Attempts, &event.CreatedAt); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan pending event: %w", err)
+		}
+		pending = append(pending, event)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to get pending events: %w", err)
+	}
+
+	published := 0
+	var publishErr error
+	for _, event := range pending {
+		if publishErr = publish(event); publishErr != nil {
+			_, err := tx.Exec(`UPDATE outbox_events SET attempts = attempts + 1, last_error = $1 WHERE id = $2`, publishErr.Error(), event.ID)
+			if err != nil {
+				return 0, fmt.Errorf("failed to record publish failure: %w", err)
+			}
+			break
+		}
+		if _, err := tx.Exec(`UPDATE outbox_events SET published_at = CURRENT_TIMESTAMP, last_error = NULL WHERE id = $1`, event.ID); err != nil {
+			return 0, fmt.Errorf("failed to mark event published: %w", err)
+		}
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit published events: %w", err)
+	}
+
+	if publishErr != nil {
+		return published, fmt.Errorf("failed to publish event: %w", publishErr)
+	}
+	return published, nil
+}
+
+// DeletePublished removes events published before the given time
+func (r *outboxRepository) DeletePublished(before time.Time) (int64, error) {
+	result, err := r.db.Exec(`DELETE FROM outbox_events WHERE published_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published events: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/auth-service/models"
+)
+
+// ErrLastOwner is returned when a change would leave a store without an owner
+var ErrLastOwner = errors.New("a store must keep at least one owner")
+
+type StoreRepository interface {
+	Create(store *models.Store, ownerID string) error
+	GetByID(id string) (*models.Store, error)
+	GetBySlug(slug string) (*models.Store, error)
+	GetByDomain(domain string) (*models.Store, error)
+	Update(store *models.Store) error
+	List() ([]*models.Store, error)
+	GetMemberRole(storeID, userID string) (string, error)
+	ListMembers(storeID string) ([]*models.StoreMember, error)
+	SetMember(storeID, userID, role string) (*models.StoreMember, error)
+	RemoveMember(storeID, userID string) (bool, error)
+}
+
+type storeRepository struct {
+	db *sql.DB
+}
+
+func NewStoreRepository(db *sql.DB) StoreRepository {
+	return &storeRepository{db: db}
+}
+
+const storeSelect = `
+	SELECT id, slug, name, COALESCE(domain, ''), is_active, created_at, updated_at
+	FROM stores
+`
+
+const memberSelect = `
+	SELECT m.store_id, m.user_id, u.email, u.username, m.role, m.created_at
+	FROM store_members m
+	JOIN users u ON u.id = m.user_id
+`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanStore(row rowScanner) (*models.Store, error) {
+	store := &models.Store{}
+	err := row.Scan(
+		&store.ID,
+		&store.Slug,
+		&store.Name,
+		&store.Domain,
+		&store.IsActive,
+		&store.CreatedAt,
+		&store.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func scanMember(row rowScanner) (*models.StoreMember, error) {
+	member := &models.StoreMember{}
+	err := row.Scan(
+		&member.StoreID,
+		&member.UserID,
+		&member.Email,
+		&member.Username,
+		&member.Role,
+		&member.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// Create adds the store with ownerID as its first owner
+func (r *storeRepository) Create(store *models.Store, ownerID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO stores (slug, name, domain, is_active)
+		VALUES ($1, $2, NULLIF($3, ''), $4)
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRow(query, store.Slug, store.Name, store.Domain, store.IsActive).Scan(&store.ID, &store.CreatedAt, &store.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create store: %w", err)
+	}
+
+	_, err = tx.Exec(`INSERT INTO store_members (store_id, user_id, role) VALUES ($1, $2, $3)`, store.ID, ownerID, models.StoreRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to add store owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit store: %w", err)
+	}
+	return nil
+}
+
+func (r *storeRepository) GetByID(id string) (*models.Store, error) {
+	return r.get(`WHERE id = $1`, id)
+}
+
+func (r *storeRepository) GetBySlug(slug string) (*models.Store, error) {
+	return r.get(`WHERE slug = $1`, slug)
+}
+
+func (r *storeRepository) GetByDomain(domain string) (*models.Store, error) {
+	return r.get(`WHERE domain = $1`, domain)
+}
+
+func (r *storeRepository) get(where string, arg string) (*models.Store, error) {
+	store, err := scanStore(r.db.QueryRow(storeSelect+where, arg))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+	return store, nil
+}
+
+func (r *storeRepository) Update(store *models.Store) error {
+	query := `
+		UPDATE stores
+		SET name = $1, domain = NULLIF($2, ''), is_active = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+		RETURNING updated_at
+	`
+	err := r.db.QueryRow(query, store.Name, store.Domain, store.IsActive, store.ID).Scan(&store.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update store: %w", err)
+	}
+	return nil
+}
+
+func (r *storeRepository) List() ([]*models.Store, error) {
+	rows, err := r.db.Query(storeSelect + ` ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stores: %w", err)
+	}
+	defer rows.Close()
+
+	stores := []*models.Store{}
+	for rows.Next() {
+		store, err := scanStore(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan store: %w", err)
+		}
+		stores = append(stores, store)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list stores: %w", err)
+	}
+	return stores, nil
+}
+
+// GetMemberRole returns the user's role in the store, or "" if they have none
+func (r *storeRepository) GetMemberRole(storeID, userID string) (string, error) {
+	var role string
+	err := r.db.QueryRow(`SELECT role FROM store_members WHERE store_id = $1 AND user_id = $2`, storeID, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get store role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *storeRepository) ListMembers(storeID string) ([]*models.StoreMember, error) {
+	rows, err := r.db.Query(memberSelect+` WHERE m.store_id = $1 ORDER BY m.role DESC, u.username`, storeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list store members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []*models.StoreMember{}
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan store member: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list store members: %w", err)
+	}
+	return members, nil
+}
+
+// SetMember adds the user to the store or changes their role. Demoting the
+// last owner fails with ErrLastOwner.
+func (r *storeRepository) SetMember(storeID, userID, role string) (*models.StoreMember, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if role != models.StoreRoleOwner {
+		if err := checkOtherOwners(tx, storeID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		INSERT INTO store_members (store_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (store_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`
+	if _, err := tx.Exec(query, storeID, userID, role); err != nil {
+		return nil, fmt.Errorf("failed to set store member: %w", err)
+	}
+
+	member, err := scanMember(tx.QueryRow(memberSelect+` WHERE m.store_id = $1 AND m.user_id = $2`, storeID, userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store member: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit store member: %w", err)
+	}
+	return member, nil
+}
+
+// RemoveMember takes the user out of the store and reports whether they were
+// in it. Removing the last owner fails with ErrLastOwner.
+func (r *storeRepository) RemoveMember(storeID, userID string) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkOtherOwners(tx, storeID, userID); err != nil {
+		return false, err
+	}
+
+	result, err := tx.Exec(`DELETE FROM store_members WHERE store_id = $1 AND user_id = $2`, storeID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove store member: %w", err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove store member: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit store member: %w", err)
+	}
+	return removed > 0, nil
+}
+
+// checkOtherOwners fails with ErrLastOwner if the user is the store's only
+// owner. The store's members are locked so two owners can't demote each
+// other at the same time.
+func checkOtherOwners(tx *sql.Tx, storeID, userID string) error {
+	rows, err := tx.Query(`SELECT user_id, role FROM store_members WHERE store_id = $1 FOR UPDATE`, storeID)
+	if err != nil {
+		return fmt.Errorf("failed to lock store members: %w", err)
+	}
+	defer rows.Close()
+
+	isOwner, otherOwners := false, 0
+	for rows.Next() {
+		var memberID, role string
+		if err := rows.Scan(&memberID, &role); err != nil {
+			return fmt.Errorf("failed to scan store member: %w", err)
+		}
+		if role != models.StoreRoleOwner {
+			continue
+		}
+		if memberID == userID {
+			isOwner = true
+		} else {
+			otherOwners++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to lock store members: %w", err)
+	}
+
+	if isOwner && otherOwners == 0 {
+		return ErrLastOwner
+	}
+	return nil
+}
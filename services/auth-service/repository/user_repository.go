@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/martbul/playground_microservices/services/auth-service/events"
 	"github.com/martbul/playground_microservices/services/auth-service/models"
 )
 
@@ -36,7 +37,13 @@ func (r *userRepository) Create(user *models.User) error {
 		RETURNING id, created_at, updated_at
 	`
 	
-	err := r.db.QueryRow(
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(
 		query,
 		user.Email,
 		user.Username,
@@ -50,6 +57,14 @@ func (r *userRepository) Create(user *models.User) error {
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
 	}
+
+	if err := recordEvent(tx, events.UserRegistered(user)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit user: %w", err)
+	}
 	
 	return nil
 }
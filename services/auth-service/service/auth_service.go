@@ -170,23 +170,32 @@ func (s *authService) UpdateProfile(userID string, req *models.UpdateProfileRequ
 		return nil, fmt.Errorf("user not found")
 	}
 
-	// Update fields if provided
-	if req.FirstName != "" {
-		user.FirstName = req.FirstName
+	// Update the fields that were sent
+	if req.FirstName != nil {
+		if *req.FirstName == "" {
+			return nil, fmt.Errorf("first name is required")
+		}
+		user.FirstName = *req.FirstName
 	}
-	if req.LastName != "" {
-		user.LastName = req.LastName
+	if req.LastName != nil {
+		if *req.LastName == "" {
+			return nil, fmt.Errorf("last name is required")
+		}
+		user.LastName = *req.LastName
 	}
-	if req.Username != "" && req.Username != user.Username {
+	if req.Username != nil && *req.Username != user.Username {
+		if *req.Username == "" {
+			return nil, fmt.Errorf("username is required")
+		}
 		// Check if username is already taken
-		existingUser, err := s.userRepo.GetByUsername(req.Username)
+		existingUser, err := s.userRepo.GetByUsername(*req.Username)
 		if err != nil {
 			return nil, fmt.Errorf("failed to check existing username: %w", err)
 		}
 		if existingUser != nil && existingUser.ID != userID {
 			return nil, fmt.Errorf("username already taken")
 		}
-		user.Username = req.Username
+		user.Username = *req.Username
 	}
 
 	if err := s.userRepo.Update(user); err != nil {
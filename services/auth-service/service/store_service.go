@@ -0,0 +1,289 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/auth-service/models"
+	"github.com/martbul/playground_microservices/services/auth-service/repository"
+)
+
+var (
+	uuidPattern   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	slugPattern   = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	domainPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+)
+
+type StoreService interface {
+	ResolveStore(id, slug, domain string) (*models.Store, error)
+	CreateStore(req *models.CreateStoreRequest) (*models.Store, error)
+	UpdateStore(id string, req *models.UpdateStoreRequest) (*models.Store, error)
+	ListStores() ([]*models.Store, error)
+	MemberRole(storeID, userID string) (string, error)
+	ListMembers(storeID string) ([]*models.StoreMember, error)
+	SetMember(storeID, userID, email, role string) (*models.StoreMember, error)
+	RemoveMember(storeID, userID string) error
+}
+
+type storeService struct {
+	storeRepo repository.StoreRepository
+	userRepo  repository.UserRepository
+}
+
+func NewStoreService(storeRepo repository.StoreRepository, userRepo repository.UserRepository) StoreService {
+	return &storeService{
+		storeRepo: storeRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// ResolveStore finds an active store by ID, slug or the domain it is served
+// on. Inactive stores are reported as not found.
+func (s *storeService) ResolveStore(id, slug, domain string) (*models.Store, error) {
+	var store *models.Store
+	var err error
+	switch {
+	case id != "":
+		if !uuidPattern.MatchString(id) {
+			return nil, fmt.Errorf("store not found")
+		}
+		store, err = s.storeRepo.GetByID(strings.ToLower(id))
+	case slug != "":
+		store, err = s.storeRepo.GetBySlug(strings.ToLower(slug))
+	case domain != "":
+		store, err = s.storeRepo.GetByDomain(normalizeDomain(domain))
+	default:
+		return nil, fmt.Errorf("store ID, slug or domain is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if store == nil || !store.IsActive {
+		return nil, fmt.Errorf("store not found")
+	}
+
+	return store, nil
+}
+
+func (s *storeService) CreateStore(req *models.CreateStoreRequest) (*models.Store, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("store name is required")
+	}
+	if len(name) > 100 {
+		return nil, fmt.Errorf("store name must be at most 100 characters")
+	}
+
+	slug := strings.ToLower(strings.TrimSpace(req.Slug))
+	if !slugPattern.MatchString(slug) || len(slug) > 50 {
+		return nil, fmt.Errorf("slug must be lowercase letters, digits and hyphens, at most 50 characters")
+	}
+	// Stores are picked by ID or slug, so a slug can't look like an ID
+	if uuidPattern.MatchString(slug) {
+		return nil, fmt.Errorf("slug cannot be a UUID")
+	}
+	existing, err := s.storeRepo.GetBySlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("slug already taken")
+	}
+
+	domain, err := s.checkDomain(req.Domain, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if !uuidPattern.MatchString(req.OwnerID) {
+		return nil, fmt.Errorf("owner not found")
+	}
+	owner, err := s.userRepo.GetByID(req.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+	if owner == nil {
+		return nil, fmt.Errorf("owner not found")
+	}
+
+	store := &models.Store{
+		Slug:     slug,
+		Name:     name,
+		Domain:   domain,
+		IsActive: true,
+	}
+	if err := s.storeRepo.Create(store, owner.ID); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *storeService) UpdateStore(id string, req *models.UpdateStoreRequest) (*models.Store, error) {
+	store, err := s.getStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the fields that were sent
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("store name is required")
+		}
+		if len(name) > 100 {
+			return nil, fmt.Errorf("store name must be at most 100 characters")
+		}
+		store.Name = name
+	}
+	if req.Domain != nil {
+		domain, err := s.checkDomain(*req.Domain, store.ID)
+		if err != nil {
+			return nil, err
+		}
+		store.Domain = domain
+	}
+	if req.IsActive != nil {
+		if !*req.IsActive && store.ID == models.DefaultStoreID {
+			return nil, fmt.Errorf("the default store cannot be deactivated")
+		}
+		store.IsActive = *req.IsActive
+	}
+
+	if err := s.storeRepo.Update(store); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *storeService) ListStores() ([]*models.Store, error) {
+	return s.storeRepo.List()
+}
+
+// MemberRole returns the user's role in the store, or "" if they have none
+func (s *storeService) MemberRole(storeID, userID string) (string, error) {
+	if !uuidPattern.MatchString(storeID) {
+		return "", nil
+	}
+	return s.storeRepo.GetMemberRole(strings.ToLower(storeID), userID)
+}
+
+func (s *storeService) ListMembers(storeID string) ([]*models.StoreMember, error) {
+	store, err := s.getStore(storeID)
+	if err != nil {
+		return nil, err
+	}
+	return s.storeRepo.ListMembers(store.ID)
+}
+
+// SetMember gives the user, found by ID or else by email, a role in the store
+func (s *storeService) SetMember(storeID, userID, email, role string) (*models.StoreMember, error) {
+	store, err := s.getStore(storeID)
+	if err != nil {
+		return nil, err
+	}
+
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role != models.StoreRoleOwner && role != models.StoreRoleAdmin {
+		return nil, fmt.Errorf("role must be %s or %s", models.StoreRoleOwner, models.StoreRoleAdmin)
+	}
+
+	var user *models.User
+	switch {
+	case userID != "":
+		if !uuidPattern.MatchString(userID) {
+			return nil, fmt.Errorf("user not found")
+		}
+		user, err = s.userRepo.GetByID(userID)
+	case email != "":
+		user, err = s.userRepo.GetByEmail(strings.ToLower(strings.TrimSpace(email)))
+	default:
+		return nil, fmt.Errorf("user ID or email is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	member, err := s.storeRepo.SetMember(store.ID, user.ID, role)
+	if errors.Is(err, repository.ErrLastOwner) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set store member: %w", err)
+	}
+	return member, nil
+}
+
+func (s *storeService) RemoveMember(storeID, userID string) error {
+	store, err := s.getStore(storeID)
+	if err != nil {
+		return err
+	}
+	if !uuidPattern.MatchString(userID) {
+		return fmt.Errorf("member not found")
+	}
+
+	removed, err := s.storeRepo.RemoveMember(store.ID, strings.ToLower(userID))
+	if errors.Is(err, repository.ErrLastOwner) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove store member: %w", err)
+	}
+	if !removed {
+		return fmt.Errorf("member not found")
+	}
+	return nil
+}
+
+// getStore finds a store by ID, active or not
+func (s *storeService) getStore(id string) (*models.Store, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("store not found")
+	}
+	store, err := s.storeRepo.GetByID(strings.ToLower(id))
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, fmt.Errorf("store not found")
+	}
+	return store, nil
+}
+
+// checkDomain normalizes a storefront domain and makes sure no other store
+// uses it. An empty domain is allowed; the store is then only reachable by
+// ID or slug.
+func (s *storeService) checkDomain(domain, storeID string) (string, error) {
+	domain = normalizeDomain(domain)
+	if domain == "" {
+		return "", nil
+	}
+	if !domainPattern.MatchString(domain) || len(domain) > 255 {
+		return "", fmt.Errorf("invalid domain")
+	}
+
+	existing, err := s.storeRepo.GetByDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil && existing.ID != storeID {
+		return "", fmt.Errorf("domain already used by another store")
+	}
+	return domain, nil
+}
+
+// normalizeDomain lowercases a host and drops its port and trailing dot
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if i := strings.LastIndex(domain, ":"); i >= 0 && !strings.Contains(domain[i:], "]") {
+		domain = domain[:i]
+	}
+	return strings.TrimSuffix(domain, ".")
+}
@@ -0,0 +1,75 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/cart-service/genproto/product"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ProductClient looks up the products in carts, for their current prices
+// and stock
+type ProductClient struct {
+	client pb.ProductServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewProductClient(address string) (*ProductClient, error) {
+	conn, err := grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(forwardStore),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to product service: %w", err)
+	}
+
+	return &ProductClient{
+		client: pb.NewProductServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *ProductClient) Close() error {
+	return c.conn.Close()
+}
+
+// BatchGetProducts returns the products found by ID; deleted and unknown
+// products are left out
+func (c *ProductClient) BatchGetProducts(ctx context.Context, ids []string) (map[string]*pb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.BatchGetProducts(ctx, &pb.BatchGetProductsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("failed to get products: %s", resp.Response.Message)
+	}
+
+	products := make(map[string]*pb.Product, len(resp.Products))
+	for _, product := range resp.Products {
+		products[product.Id] = product
+	}
+	return products, nil
+}
+
+// ListProductVariants returns all of a product's variants, inactive ones
+// included
+func (c *ProductClient) ListProductVariants(ctx context.Context, productID string) ([]*pb.ProductVariant, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListProductVariants(ctx, &pb.ListProductVariantsRequest{ProductId: productID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product variants: %w", err)
+	}
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("failed to get product variants: %s", resp.Response.Message)
+	}
+	return resp.Variants, nil
+}
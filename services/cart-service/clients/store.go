@@ -0,0 +1,32 @@
+package clients
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// storeMetadataKey carries the ID of the store a call is made for, as set by
+// the gateway
+const storeMetadataKey = "x-store-id"
+
+// forwardStore passes the store of the call being served on to
+// product-service, so products are looked up in the same store
+func forwardStore(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if storeID := IncomingStore(ctx); storeID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, storeMetadataKey, storeID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// IncomingStore returns the store of the call being served, or "" if the
+// caller named none
+func IncomingStore(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(storeMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/cart-service/clients"
+	pb "github.com/martbul/playground_microservices/services/cart-service/genproto/cart"
+	commonPb "github.com/martbul/playground_microservices/services/cart-service/genproto/common"
+	"github.com/martbul/playground_microservices/services/cart-service/models"
+	"github.com/martbul/playground_microservices/services/cart-service/service"
+)
+
+type CartGrpcHandler struct {
+	pb.UnimplementedCartServiceServer
+	cartService service.CartService
+}
+
+func NewCartGrpcHandler(cartService service.CartService) *CartGrpcHandler {
+	return &CartGrpcHandler{
+		cartService: cartService,
+	}
+}
+
+func (h *CartGrpcHandler) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.GetCartResponse, error) {
+	// TODO: Validate token; until then trust the gateway's user ID
+	cart, err := h.cartService.GetCart(ctx, ownerFromProto(ctx, req.Owner))
+	if err != nil {
+		log.Printf("Get cart error: %v", err)
+		return &pb.GetCartResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.GetCartResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Cart retrieved successfully",
+		},
+		Cart: cartToProto(cart),
+	}, nil
+}
+
+func (h *CartGrpcHandler) AddCartItem(ctx context.Context, req *pb.AddCartItemRequest) (*pb.AddCartItemResponse, error) {
+	log.Printf("Add cart item request: %s", req.ProductId)
+
+	// TODO: Validate token
+	cart, err := h.cartService.AddItem(ctx, ownerFromProto(ctx, req.Owner), req.ProductId, req.VariantId, req.Quantity)
+	if err != nil {
+		log.Printf("Add cart item error: %v", err)
+		return &pb.AddCartItemResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.AddCartItemResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Item added to cart",
+		},
+		Cart: cartToProto(cart),
+	}, nil
+}
+
+func (h *CartGrpcHandler) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItemRequest) (*pb.UpdateCartItemResponse, error) {
+	log.Printf("Update cart item request: %s", req.ItemId)
+
+	// TODO: Validate token
+	cart, err := h.cartService.UpdateItem(ctx, ownerFromProto(ctx, req.Owner), req.ItemId, req.Quantity)
+	if err != nil {
+		log.Printf("Update cart item error: %v", err)
+		return &pb.UpdateCartItemResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateCartItemResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Cart updated",
+		},
+		Cart: cartToProto(cart),
+	}, nil
+}
+
+func (h *CartGrpcHandler) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItemRequest) (*pb.RemoveCartItemResponse, error) {
+	log.Printf("Remove cart item request: %s", req.ItemId)
+
+	// TODO: Validate token
+	cart, err := h.cartService.RemoveItem(ctx, ownerFromProto(ctx, req.Owner), req.ItemId)
+	if err != nil {
+		log.Printf("Remove cart item error: %v", err)
+		return &pb.RemoveCartItemResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.RemoveCartItemResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Item removed from cart",
+		},
+		Cart: cartToProto(cart),
+	}, nil
+}
+
+func (h *CartGrpcHandler) ClearCart(ctx context.Context, req *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	// TODO: Validate token
+	cart, err := h.cartService.ClearCart(ctx, ownerFromProto(ctx, req.Owner))
+	if err != nil {
+		log.Printf("Clear cart error: %v", err)
+		return &pb.ClearCartResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ClearCartResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Cart cleared",
+		},
+		Cart: cartToProto(cart),
+	}, nil
+}
+
+func (h *CartGrpcHandler) MergeCarts(ctx context.Context, req *pb.MergeCartsRequest) (*pb.MergeCartsResponse, error) {
+	log.Printf("Merge carts request: user %s", req.UserId)
+
+	// TODO: Validate token
+	cart, merged, err := h.cartService.MergeCarts(ctx, clients.IncomingStore(ctx), req.SessionId, req.UserId)
+	if err != nil {
+		log.Printf("Merge carts error: %v", err)
+		return &pb.MergeCartsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.MergeCartsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Carts merged",
+		},
+		Cart:        cartToProto(cart),
+		MergedItems: int32(merged),
+	}, nil
+}
+
+func (h *CartGrpcHandler) HealthCheck(ctx context.Context, req *commonPb.HealthCheckRequest) (*commonPb.HealthCheckResponse, error) {
+	return &commonPb.HealthCheckResponse{
+		Status:    "healthy",
+		Service:   "cart-service",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ownerFromProto names the owner's cart in the store the call is for
+func ownerFromProto(ctx context.Context, owner *pb.CartOwner) models.CartOwner {
+	return models.CartOwner{
+		StoreID:   clients.IncomingStore(ctx),
+		UserID:    owner.GetUserId(),
+		SessionID: owner.GetSessionId(),
+	}
+}
+
+// cartToProto adds up the cart. Items with a problem show their current
+// details but count towards neither the subtotal nor checkout.
+func cartToProto(cart *models.Cart) *pb.Cart {
+	protoCart := &pb.Cart{
+		Id:           cart.ID,
+		UserId:       cart.UserID,
+		SessionId:    cart.SessionID,
+		CurrencyCode: cart.Currency,
+		Items:        make([]*pb.CartItem, 0, len(cart.Items)),
+	}
+	if !cart.CreatedAt.IsZero() {
+		protoCart.CreatedAt = cart.CreatedAt.Format(time.RFC3339)
+		protoCart.UpdatedAt = cart.UpdatedAt.Format(time.RFC3339)
+	}
+
+	subtotal := models.Money{Currency: cart.Currency}
+	ready := len(cart.Items) > 0
+	for _, item := range cart.Items {
+		protoItem := &pb.CartItem{
+			Id:        item.ID,
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  item.Quantity,
+			AddedAt:   item.CreatedAt.Format(time.RFC3339),
+		}
+		protoCart.ItemCount += item.Quantity
+
+		if details := item.Details; details != nil {
+			protoItem.Name = details.Name
+			protoItem.Sku = details.SKU
+			protoItem.ImageUrl = details.ImageURL
+			protoItem.OptionValues = details.OptionValues
+			protoItem.AvailableQuantity = details.AvailableQuantity
+			protoItem.Problem = details.Problem
+
+			if details.Price.Currency != "" {
+				lineTotal := details.Price.Times(item.Quantity)
+				protoItem.UnitPrice = moneyToProto(details.Price)
+				protoItem.LineTotal = moneyToProto(lineTotal)
+				if details.Price.Cmp(item.UnitPrice) != 0 {
+					protoItem.PreviousUnitPrice = moneyToProto(item.UnitPrice)
+				}
+				if details.Problem == "" {
+					subtotal = subtotal.Add(lineTotal)
+				}
+			}
+		}
+		if protoItem.Problem != "" {
+			ready = false
+		}
+
+		protoCart.Items = append(protoCart.Items, protoItem)
+	}
+
+	protoCart.Subtotal = moneyToProto(subtotal)
+	protoCart.CheckoutReady = ready
+	return protoCart
+}
+
+func moneyToProto(money models.Money) *commonPb.Money {
+	return &commonPb.Money{
+		CurrencyCode: money.Currency,
+		Units:        money.Units,
+		Nanos:        money.Nanos,
+	}
+}
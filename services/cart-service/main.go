@@ -0,0 +1,133 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/cart-service/clients"
+	"github.com/martbul/playground_microservices/services/cart-service/config"
+	pb "github.com/martbul/playground_microservices/services/cart-service/genproto/cart"
+	"github.com/martbul/playground_microservices/services/cart-service/handlers"
+	"github.com/martbul/playground_microservices/services/cart-service/repository"
+	"github.com/martbul/playground_microservices/services/cart-service/service"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Connect to database
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer db.Close()
+
+	// Test database connection
+	if err := db.Ping(); err != nil {
+		log.Fatal("Failed to ping database:", err)
+	}
+
+	// Run migrations
+	if err := runMigrations(db); err != nil {
+		log.Fatal("Failed to run migrations:", err)
+	}
+
+	// Prices and stock are checked against product-service
+	productClient, err := clients.NewProductClient(cfg.ProductService)
+	if err != nil {
+		log.Fatal("Failed to create product client:", err)
+	}
+	defer productClient.Close()
+
+	// Initialize repository
+	cartRepo := repository.NewCartRepository(db)
+
+	// Initialize service
+	cartService := service.NewCartService(cartRepo, productClient, cfg.MaxItemQuantity, cfg.GuestCartTTL)
+
+	go deleteStaleGuestCarts(cartService, cfg.GuestCartSweepPeriod)
+
+	// Initialize handler
+	cartHandler := handlers.NewCartGrpcHandler(cartService)
+
+	// Create gRPC server
+	server := grpc.NewServer()
+	pb.RegisterCartServiceServer(server, cartHandler)
+
+	// Start listening
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Port))
+	if err != nil {
+		log.Fatal("Failed to listen:", err)
+	}
+
+	log.Printf("Cart service starting on port %s", cfg.Port)
+	if err := server.Serve(lis); err != nil {
+		log.Fatal("Failed to serve:", err)
+	}
+}
+
+func deleteStaleGuestCarts(cartService service.CartService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := cartService.DeleteStaleGuestCarts()
+		if err != nil {
+			log.Printf("Failed to delete stale guest carts: %v", err)
+			continue
+		}
+		if deleted > 0 {
+			log.Printf("Deleted %d stale guest carts", deleted)
+		}
+	}
+}
+
+func runMigrations(db *sql.DB) error {
+	query := `
+	-- A cart belongs to a signed-in user or to a guest session
+	CREATE TABLE IF NOT EXISTS carts (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+		user_id UUID,
+		session_id VARCHAR(128),
+		currency CHAR(3),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		CHECK ((user_id IS NULL) <> (session_id IS NULL))
+	);
+
+	-- Products live in product-service, so product and variant IDs aren't
+	-- foreign keys. unit_price is the price when the item was last changed.
+	CREATE TABLE IF NOT EXISTS cart_items (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		cart_id UUID NOT NULL REFERENCES carts(id) ON DELETE CASCADE,
+		product_id UUID NOT NULL,
+		variant_id UUID,
+		quantity INTEGER NOT NULL CHECK (quantity > 0),
+		unit_price DECIMAL(12,3) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE NULLS NOT DISTINCT (cart_id, product_id, variant_id)
+	);
+
+	-- Each store has its own carts, so a user or guest has one cart per
+	-- store. Carts from before there were stores belong to the default one.
+	ALTER TABLE carts ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+	ALTER TABLE carts DROP CONSTRAINT IF EXISTS carts_user_id_key;
+	ALTER TABLE carts DROP CONSTRAINT IF EXISTS carts_session_id_key;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_carts_store_user ON carts(store_id, user_id);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_carts_store_session ON carts(store_id, session_id);
+
+	CREATE INDEX IF NOT EXISTS idx_carts_guest_updated_at ON carts(updated_at) WHERE session_id IS NOT NULL;
+	`
+
+	_, err := db.Exec(query)
+	return err
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+)
+
+// Cart belongs to either a signed-in user or a guest session, never both,
+// and holds products of one store
+type Cart struct {
+	ID        string      `json:"id" db:"id"`
+	StoreID   string      `json:"store_id" db:"store_id"`
+	UserID    string      `json:"user_id" db:"user_id"`
+	SessionID string      `json:"session_id" db:"session_id"`
+	Currency  string      `json:"currency" db:"currency"`
+	Items     []*CartItem `json:"items"`
+	CreatedAt time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// CartItem is a product, or one variant of it, in a cart. UnitPrice is the
+// price when the item was added or last changed; the current price and
+// stock come from product-service.
+type CartItem struct {
+	ID        string    `json:"id" db:"id"`
+	CartID    string    `json:"cart_id" db:"cart_id"`
+	ProductID string    `json:"product_id" db:"product_id"`
+	VariantID string    `json:"variant_id" db:"variant_id"`
+	Quantity  int32     `json:"quantity" db:"quantity"`
+	UnitPrice Money     `json:"unit_price" db:"unit_price"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// Filled in from product-service when the cart is read
+	Details *ItemDetails `json:"details,omitempty" db:"-"`
+}
+
+// ItemDetails is what product-service currently says about a cart item
+type ItemDetails struct {
+	Name              string
+	SKU               string
+	ImageURL          string
+	OptionValues      map[string]string
+	Price             Money
+	AvailableQuantity int32
+	// Problem says why the item can't be bought as it is; empty if it can
+	Problem string
+}
+
+// CartOwner identifies a cart by user ID or, for guests, by session ID,
+// within the store the cart is for
+type CartOwner struct {
+	StoreID   string
+	UserID    string
+	SessionID string
+}
+
+func (o CartOwner) IsGuest() bool {
+	return o.UserID == ""
+}
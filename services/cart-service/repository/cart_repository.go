@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/cart-service/models"
+)
+
+// ErrItemNotFound is returned for an item that isn't in the owner's cart
+var ErrItemNotFound = errors.New("cart item not found")
+
+type CartRepository interface {
+	GetByOwner(owner models.CartOwner) (*models.Cart, error)
+	GetOrCreate(owner models.CartOwner) (*models.Cart, error)
+	SetCurrency(cartID, currency string) error
+	SaveItem(cartID string, item *models.CartItem) error
+	RemoveItem(cartID, itemID string) error
+	Clear(cartID string) error
+	AssignToUser(cartID, userID string) error
+	Merge(guestCartID, userCartID, currency string, items []*models.CartItem) error
+	DeleteGuestCartsBefore(before time.Time) (int64, error)
+}
+
+type cartRepository struct {
+	db *sql.DB
+}
+
+func NewCartRepository(db *sql.DB) CartRepository {
+	return &cartRepository{db: db}
+}
+
+const cartSelect = `
+	SELECT id, store_id, COALESCE(user_id::text, ''), COALESCE(session_id, ''), COALESCE(currency, ''), created_at, updated_at
+	FROM carts
+`
+
+// GetByOwner returns the owner's cart in their store with its items, or nil
+// if they don't have one there yet
+func (r *cartRepository) GetByOwner(owner models.CartOwner) (*models.Cart, error) {
+	var row *sql.Row
+	if owner.IsGuest() {
+		row = r.db.QueryRow(cartSelect+` WHERE store_id = $1 AND session_id = $2`, owner.StoreID, owner.SessionID)
+	} else {
+		row = r.db.QueryRow(cartSelect+` WHERE store_id = $1 AND user_id = $2`, owner.StoreID, owner.UserID)
+	}
+
+	cart := &models.Cart{}
+	err := row.Scan(&cart.ID, &cart.StoreID, &cart.UserID, &cart.SessionID, &cart.Currency, &cart.CreatedAt, &cart.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get cart: %w", err)
+	}
+
+	if cart.Items, err = r.listItems(cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// GetOrCreate returns the owner's cart in their store, creating an empty
+// one if needed
+func (r *cartRepository) GetOrCreate(owner models.CartOwner) (*models.Cart, error) {
+	var query string
+	var key string
+	if owner.IsGuest() {
+		query, key = `INSERT INTO carts (store_id, session_id) VALUES ($1, $2) ON CONFLICT (store_id, session_id) DO NOTHING`, owner.SessionID
+	} else {
+		query, key = `INSERT INTO carts (store_id, user_id) VALUES ($1, $2) ON CONFLICT (store_id, user_id) DO NOTHING`, owner.UserID
+	}
+	if _, err := r.db.Exec(query, owner.StoreID, key); err != nil {
+		return nil, fmt.Errorf("failed to create cart: %w", err)
+	}
+
+	return r.GetByOwner(owner)
+}
+
+func (r *cartRepository) listItems(cart *models.Cart) ([]*models.CartItem, error) {
+	rows, err := r.db.Query(`
+		SELECT id, cart_id, product_id, COALESCE(variant_id::text, ''), quantity, unit_price, created_at, updated_at
+		FROM cart_items
+		WHERE cart_id = $1
+		ORDER BY created_at, id
+	`, cart.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cart items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.CartItem
+	for rows.Next() {
+		item := &models.CartItem{}
+		err := rows.Scan(
+			&item.ID,
+			&item.CartID,
+			&item.ProductID,
+			&item.VariantID,
+			&item.Quantity,
+			&item.UnitPrice,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan cart item: %w", err)
+		}
+		item.UnitPrice.Currency = cart.Currency
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// SetCurrency fixes the currency of a cart's prices; it is set by the first
+// item added
+func (r *cartRepository) SetCurrency(cartID, currency string) error {
+	_, err := r.db.Exec(`UPDATE carts SET currency = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`, currency, cartID)
+	if err != nil {
+		return fmt.Errorf("failed to set cart currency: %w", err)
+	}
+	return nil
+}
+
+// SaveItem adds the item to the cart, or sets the quantity and price of the
+// line already holding that product and variant
+func (r *cartRepository) SaveItem(cartID string, item *models.CartItem) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := saveItem(tx, cartID, item); err != nil {
+		return err
+	}
+	if err := touchCart(tx, cartID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func saveItem(tx *sql.Tx, cartID string, item *models.CartItem) error {
+	err := tx.QueryRow(`
+		INSERT INTO cart_items (cart_id, product_id, variant_id, quantity, unit_price)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5)
+		ON CONFLICT (cart_id, product_id, variant_id)
+		DO UPDATE SET quantity = EXCLUDED.quantity, unit_price = EXCLUDED.unit_price, updated_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at, updated_at
+	`, cartID, item.ProductID, item.VariantID, item.Quantity, item.UnitPrice).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save cart item: %w", err)
+	}
+	item.CartID = cartID
+	return nil
+}
+
+// touchCart marks the cart as used, which keeps guest carts from expiring
+func touchCart(tx *sql.Tx, cartID string) error {
+	if _, err := tx.Exec(`UPDATE carts SET updated_at = CURRENT_TIMESTAMP WHERE id = $1`, cartID); err != nil {
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+	return nil
+}
+
+func (r *cartRepository) RemoveItem(cartID, itemID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`DELETE FROM cart_items WHERE id = $1 AND cart_id = $2`, itemID, cartID)
+	if err != nil {
+		return fmt.Errorf("failed to remove cart item: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrItemNotFound
+	}
+	if err := touchCart(tx, cartID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Clear empties the cart. The currency is reset too, so the next item added
+// can be in any currency.
+func (r *cartRepository) Clear(cartID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM cart_items WHERE cart_id = $1`, cartID); err != nil {
+		return fmt.Errorf("failed to clear cart: %w", err)
+	}
+	if _, err := tx.Exec(`UPDATE carts SET currency = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = $1`, cartID); err != nil {
+		return fmt.Errorf("failed to clear cart: %w", err)
+	}
+	return tx.Commit()
+}
+
+// AssignToUser turns a guest cart into the user's cart, for users who didn't
+// have one in its store yet
+func (r *cartRepository) AssignToUser(cartID, userID string) error {
+	_, err := r.db.Exec(`
+		UPDATE carts SET user_id = $1, session_id = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2
+	`, userID, cartID)
+	if err != nil {
+		return fmt.Errorf("failed to assign cart to user: %w", err)
+	}
+	return nil
+}
+
+// Merge saves the merged items into the user's cart and deletes the guest
+// cart, all in one transaction
+func (r *cartRepository) Merge(guestCartID, userCartID, currency string, items []*models.CartItem) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE carts SET currency = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`, currency, userCartID); err != nil {
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+	for _, item := range items {
+		if err := saveItem(tx, userCartID, item); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec(`DELETE FROM carts WHERE id = $1`, guestCartID); err != nil {
+		return fmt.Errorf("failed to delete guest cart: %w", err)
+	}
+	return tx.Commit()
+}
+
+// DeleteGuestCartsBefore deletes guest carts last used before the given time
+func (r *cartRepository) DeleteGuestCartsBefore(before time.Time) (int64, error) {
+	result, err := r.db.Exec(`DELETE FROM carts WHERE session_id IS NOT NULL AND updated_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale guest carts: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/cart-service/genproto/common"
+	productPb "github.com/martbul/playground_microservices/services/cart-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/cart-service/models"
+	"github.com/martbul/playground_microservices/services/cart-service/repository"
+)
+
+// maxCartLines keeps a cart within what product-service looks up in one batch
+const maxCartLines = 100
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+type CartService interface {
+	GetCart(ctx context.Context, owner models.CartOwner) (*models.Cart, error)
+	AddItem(ctx context.Context, owner models.CartOwner, productID, variantID string, quantity int32) (*models.Cart, error)
+	UpdateItem(ctx context.Context, owner models.CartOwner, itemID string, quantity int32) (*models.Cart, error)
+	RemoveItem(ctx context.Context, owner models.CartOwner, itemID string) (*models.Cart, error)
+	ClearCart(ctx context.Context, owner models.CartOwner) (*models.Cart, error)
+	MergeCarts(ctx context.Context, storeID, sessionID, userID string) (*models.Cart, int, error)
+	DeleteStaleGuestCarts() (int64, error)
+}
+
+// ProductCatalog is what the cart service needs from product-service;
+// clients.ProductClient calls the real one
+type ProductCatalog interface {
+	BatchGetProducts(ctx context.Context, ids []string) (map[string]*productPb.Product, error)
+	ListProductVariants(ctx context.Context, productID string) ([]*productPb.ProductVariant, error)
+}
+
+type cartService struct {
+	cartRepo      repository.CartRepository
+	productClient ProductCatalog
+	maxQuantity   int32
+	guestCartTTL  time.Duration
+}
+
+func NewCartService(cartRepo repository.CartRepository, productClient ProductCatalog, maxQuantity int32, guestCartTTL time.Duration) CartService {
+	return &cartService{
+		cartRepo:      cartRepo,
+		productClient: productClient,
+		maxQuantity:   maxQuantity,
+		guestCartTTL:  guestCartTTL,
+	}
+}
+
+func validateOwner(owner models.CartOwner) error {
+	if owner.StoreID == "" {
+		return fmt.Errorf("store is required")
+	}
+	if owner.UserID == "" && owner.SessionID == "" {
+		return fmt.Errorf("user ID or session ID is required")
+	}
+	if len(owner.SessionID) > 128 {
+		return fmt.Errorf("session ID is too long")
+	}
+	return nil
+}
+
+// GetCart returns the owner's cart with current prices and stock. Owners
+// without a cart get an empty one, which isn't stored until they add to it.
+func (s *cartService) GetCart(ctx context.Context, owner models.CartOwner) (*models.Cart, error) {
+	if err := validateOwner(owner); err != nil {
+		return nil, err
+	}
+
+	cart, err := s.cartRepo.GetByOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil {
+		return &models.Cart{StoreID: owner.StoreID, UserID: owner.UserID, SessionID: owner.SessionID}, nil
+	}
+
+	if err := s.describe(ctx, cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// AddItem puts a product in the cart, or adds to the quantity already there.
+// The product has to be for sale in the cart's currency with enough stock.
+func (s *cartService) AddItem(ctx context.Context, owner models.CartOwner, productID, variantID string, quantity int32) (*models.Cart, error) {
+	if err := validateOwner(owner); err != nil {
+		return nil, err
+	}
+	if !uuidPattern.MatchString(productID) {
+		return nil, fmt.Errorf("product not found")
+	}
+	if variantID != "" && !uuidPattern.MatchString(variantID) {
+		return nil, fmt.Errorf("variant not found")
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("quantity must be at least 1")
+	}
+	productID, variantID = strings.ToLower(productID), strings.ToLower(variantID)
+
+	cart, err := s.cartRepo.GetOrCreate(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *models.CartItem
+	for _, item := range cart.Items {
+		if item.ProductID == productID && item.VariantID == variantID {
+			existing = item
+		}
+	}
+	if existing == nil && len(cart.Items) >= maxCartLines {
+		return nil, fmt.Errorf("a cart can hold at most %d different items", maxCartLines)
+	}
+
+	item := &models.CartItem{ProductID: productID, VariantID: variantID, Quantity: quantity}
+	if existing != nil {
+		item.Quantity += existing.Quantity
+	}
+
+	entries, err := s.lookup(ctx, []*models.CartItem{item})
+	if err != nil {
+		return nil, err
+	}
+	entry := entries[productID]
+	if entry != nil && entry.hasVariants() && variantID == "" {
+		return nil, fmt.Errorf("choose an option of this product")
+	}
+
+	currency := cart.Currency
+	if currency == "" && entry != nil {
+		currency = entry.product.EffectivePrice.GetCurrencyCode()
+	}
+	details := s.details(entry, item, currency)
+	if details.Problem != "" {
+		return nil, errors.New(details.Problem)
+	}
+
+	item.UnitPrice = details.Price
+	if cart.Currency == "" {
+		if err := s.cartRepo.SetCurrency(cart.ID, currency); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.cartRepo.SaveItem(cart.ID, item); err != nil {
+		return nil, err
+	}
+
+	return s.GetCart(ctx, owner)
+}
+
+// UpdateItem sets an item's quantity, removing it at zero. The item's price
+// is brought up to date as well.
+func (s *cartService) UpdateItem(ctx context.Context, owner models.CartOwner, itemID string, quantity int32) (*models.Cart, error) {
+	if quantity == 0 {
+		return s.RemoveItem(ctx, owner, itemID)
+	}
+	if quantity < 0 {
+		return nil, fmt.Errorf("quantity cannot be negative")
+	}
+
+	cart, item, err := s.findItem(owner, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *item
+	updated.Quantity = quantity
+
+	entries, err := s.lookup(ctx, []*models.CartItem{&updated})
+	if err != nil {
+		return nil, err
+	}
+	details := s.details(entries[item.ProductID], &updated, cart.Currency)
+	if details.Problem != "" {
+		return nil, errors.New(details.Problem)
+	}
+
+	updated.UnitPrice = details.Price
+	if err := s.cartRepo.SaveItem(cart.ID, &updated); err != nil {
+		return nil, err
+	}
+
+	return s.GetCart(ctx, owner)
+}
+
+func (s *cartService) RemoveItem(ctx context.Context, owner models.CartOwner, itemID string) (*models.Cart, error) {
+	cart, item, err := s.findItem(owner, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cart.Items) == 1 {
+		// Emptying the cart frees it up for another currency
+		err = s.cartRepo.Clear(cart.ID)
+	} else {
+		err = s.cartRepo.RemoveItem(cart.ID, item.ID)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrItemNotFound) {
+			return nil, fmt.Errorf("cart item not found")
+		}
+		return nil, err
+	}
+
+	return s.GetCart(ctx, owner)
+}
+
+func (s *cartService) ClearCart(ctx context.Context, owner models.CartOwner) (*models.Cart, error) {
+	if err := validateOwner(owner); err != nil {
+		return nil, err
+	}
+
+	cart, err := s.cartRepo.GetByOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+	if cart != nil {
+		if err := s.cartRepo.Clear(cart.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetCart(ctx, owner)
+}
+
+func (s *cartService) findItem(owner models.CartOwner, itemID string) (*models.Cart, *models.CartItem, error) {
+	if err := validateOwner(owner); err != nil {
+		return nil, nil, err
+	}
+
+	cart, err := s.cartRepo.GetByOwner(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cart != nil {
+		for _, item := range cart.Items {
+			if item.ID == strings.ToLower(itemID) {
+				return cart, item, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("cart item not found")
+}
+
+// MergeCarts moves a guest's cart in the store into the user's cart there
+// when they sign in. Items in both carts have their quantities added up, but
+// never beyond the stock; guest items that can't be bought in the user's
+// cart currency stay behind and are dropped with the guest cart.
+func (s *cartService) MergeCarts(ctx context.Context, storeID, sessionID, userID string) (*models.Cart, int, error) {
+	if storeID == "" {
+		return nil, 0, fmt.Errorf("store is required")
+	}
+	if sessionID == "" || userID == "" {
+		return nil, 0, fmt.Errorf("session ID and user ID are required")
+	}
+	userOwner := models.CartOwner{StoreID: storeID, UserID: userID}
+
+	guestCart, err := s.cartRepo.GetByOwner(models.CartOwner{StoreID: storeID, SessionID: sessionID})
+	if err != nil {
+		return nil, 0, err
+	}
+	if guestCart == nil || len(guestCart.Items) == 0 {
+		cart, err := s.GetCart(ctx, userOwner)
+		return cart, 0, err
+	}
+
+	userCart, err := s.cartRepo.GetByOwner(userOwner)
+	if err != nil {
+		return nil, 0, err
+	}
+	if userCart == nil {
+		// Nothing to merge with, so the guest cart simply changes hands
+		if err := s.cartRepo.AssignToUser(guestCart.ID, userID); err != nil {
+			return nil, 0, err
+		}
+		cart, err := s.GetCart(ctx, userOwner)
+		return cart, len(guestCart.Items), err
+	}
+
+	currency := userCart.Currency
+	if currency == "" {
+		currency = guestCart.Currency
+	}
+
+	userItems := make(map[string]*models.CartItem)
+	for _, item := range userCart.Items {
+		userItems[item.ProductID+"/"+item.VariantID] = item
+	}
+
+	merged := make([]*models.CartItem, 0, len(guestCart.Items))
+	for _, guestItem := range guestCart.Items {
+		item := &models.CartItem{ProductID: guestItem.ProductID, VariantID: guestItem.VariantID, Quantity: guestItem.Quantity}
+		if existing, ok := userItems[item.ProductID+"/"+item.VariantID]; ok {
+			item.Quantity += existing.Quantity
+		} else if len(userCart.Items)+len(merged) >= maxCartLines {
+			continue
+		}
+		merged = append(merged, item)
+	}
+
+	entries, err := s.lookup(ctx, merged)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]*models.CartItem, 0, len(merged))
+	for _, item := range merged {
+		entry := entries[item.ProductID]
+		details := s.details(entry, item, currency)
+		if details.Problem != "" && details.AvailableQuantity > 0 && details.AvailableQuantity < item.Quantity {
+			// Take as many as there are rather than leaving the item out
+			item.Quantity = details.AvailableQuantity
+			details = s.details(entry, item, currency)
+		}
+		if details.Problem != "" {
+			continue
+		}
+		item.UnitPrice = details.Price
+		items = append(items, item)
+	}
+
+	if err := s.cartRepo.Merge(guestCart.ID, userCart.ID, currency, items); err != nil {
+		return nil, 0, err
+	}
+
+	cart, err := s.GetCart(ctx, userOwner)
+	return cart, len(items), err
+}
+
+// DeleteStaleGuestCarts deletes guest carts nobody has used within the TTL
+func (s *cartService) DeleteStaleGuestCarts() (int64, error) {
+	return s.cartRepo.DeleteGuestCartsBefore(time.Now().Add(-s.guestCartTTL))
+}
+
+// catalogEntry is a product as product-service has it now, with its
+// variants if a cart item needs them
+type catalogEntry struct {
+	product  *productPb.Product
+	variants map[string]*productPb.ProductVariant
+}
+
+func (e *catalogEntry) hasVariants() bool {
+	return len(e.variants) > 0
+}
+
+// lookup fetches the products of the items from product-service, in one
+// batch plus a variant lookup per product that has variants in the cart
+func (s *cartService) lookup(ctx context.Context, items []*models.CartItem) (map[string]*catalogEntry, error) {
+	entries := make(map[string]*catalogEntry)
+	if len(items) == 0 {
+		return entries, nil
+	}
+
+	var ids []string
+	for _, item := range items {
+		if _, ok := entries[item.ProductID]; !ok {
+			entries[item.ProductID] = nil
+			ids = append(ids, item.ProductID)
+		}
+	}
+
+	products, err := s.productClient.BatchGetProducts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for id := range entries {
+		if product, ok := products[id]; ok {
+			entries[id] = &catalogEntry{product: product}
+		} else {
+			delete(entries, id)
+		}
+	}
+
+	for _, item := range items {
+		entry := entries[item.ProductID]
+		if entry == nil || entry.variants != nil {
+			continue
+		}
+		// Products added without a variant are checked too, in case options
+		// were added since
+		variants, err := s.productClient.ListProductVariants(ctx, item.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		entry.variants = make(map[string]*productPb.ProductVariant, len(variants))
+		for _, variant := range variants {
+			entry.variants[variant.Id] = variant
+		}
+	}
+
+	return entries, nil
+}
+
+// describe fills in every item's current details from product-service
+func (s *cartService) describe(ctx context.Context, cart *models.Cart) error {
+	entries, err := s.lookup(ctx, cart.Items)
+	if err != nil {
+		return err
+	}
+	for _, item := range cart.Items {
+		item.Details = s.details(entries[item.ProductID], item, cart.Currency)
+	}
+	return nil
+}
+
+// details works out an item's current price and stock, and whether it can be
+// bought as it is. entry is nil for products that are gone.
+func (s *cartService) details(entry *catalogEntry, item *models.CartItem, currency string) *models.ItemDetails {
+	if entry == nil {
+		return &models.ItemDetails{Problem: "This product is no longer available"}
+	}
+
+	product := entry.product
+	details := &models.ItemDetails{
+		Name:              product.Name,
+		SKU:               product.Sku,
+		ImageURL:          product.ImageUrl,
+		AvailableQuantity: product.AvailableQuantity,
+	}
+	if !product.IsActive {
+		details.Problem = "This product is no longer available"
+		return details
+	}
+
+	var variant *productPb.ProductVariant
+	if item.VariantID != "" {
+		variant = entry.variants[item.VariantID]
+		if variant == nil || !variant.IsActive {
+			details.Problem = "This option is no longer available"
+			return details
+		}
+		details.SKU = variant.Sku
+		details.OptionValues = variant.OptionValues
+		details.AvailableQuantity = variant.AvailableQuantity
+	} else if entry.hasVariants() {
+		details.Problem = "Choose an option of this product"
+		return details
+	}
+
+	price, ok := priceIn(product, variant, currency)
+	if !ok {
+		details.Problem = fmt.Sprintf("Not sold in %s", currency)
+		return details
+	}
+	details.Price = price
+
+	switch {
+	case details.AvailableQuantity <= 0:
+		details.Problem = "Out of stock"
+	case item.Quantity > details.AvailableQuantity:
+		details.Problem = fmt.Sprintf("Only %d left in stock", details.AvailableQuantity)
+	case item.Quantity > s.maxQuantity:
+		details.Problem = fmt.Sprintf("At most %d of an item can be ordered", s.maxQuantity)
+	}
+	return details
+}
+
+// priceIn is what the product or variant sells for now in the currency. A
+// variant with its own price sells for that, which is only set in the
+// product's currency; the price list holds the product's price, so it
+// doesn't apply to such a variant. Everything else sells for the product's
+// effective price in its own currency, otherwise its price list entry.
+func priceIn(product *productPb.Product, variant *productPb.ProductVariant, currency string) (models.Money, bool) {
+	if variant != nil && variant.Price != nil {
+		if variant.Price.CurrencyCode != currency {
+			return models.Money{}, false
+		}
+		return moneyFromProto(variant.Price), true
+	}
+
+	if product.EffectivePrice.GetCurrencyCode() == currency {
+		return moneyFromProto(product.EffectivePrice), true
+	}
+
+	for _, price := range product.Prices {
+		if price.CurrencyCode == currency {
+			return moneyFromProto(price), true
+		}
+	}
+	return models.Money{}, false
+}
+
+func moneyFromProto(money *commonPb.Money) models.Money {
+	return models.Money{Currency: money.CurrencyCode, Units: money.Units, Nanos: money.Nanos}
+}
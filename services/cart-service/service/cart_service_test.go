@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/cart-service/genproto/common"
+	productPb "github.com/martbul/playground_microservices/services/cart-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/cart-service/models"
+	"github.com/martbul/playground_microservices/services/cart-service/repository"
+)
+
+const (
+	mergeStore   = "00000000-0000-4000-8000-00000000000a"
+	otherStore   = "00000000-0000-4000-8000-00000000000b"
+	mergeUser    = "00000000-0000-4000-8000-0000000000b1"
+	mergeSession = "guest-session"
+
+	lamp  = "00000000-0000-4000-8000-0000000000c1"
+	desk  = "00000000-0000-4000-8000-0000000000c2"
+	chair = "00000000-0000-4000-8000-0000000000c3"
+)
+
+// memoryCarts is a cart repository holding carts in memory
+type memoryCarts struct {
+	repository.CartRepository
+	carts  map[string]*models.Cart
+	nextID int
+}
+
+func (r *memoryCarts) add(owner models.CartOwner, items map[string]int32) {
+	r.nextID++
+	cart := &models.Cart{ID: fmt.Sprintf("cart-%d", r.nextID), StoreID: owner.StoreID, UserID: owner.UserID, SessionID: owner.SessionID, Currency: "USD"}
+	for productID, quantity := range items {
+		cart.Items = append(cart.Items, &models.CartItem{CartID: cart.ID, ProductID: productID, Quantity: quantity})
+	}
+	r.carts[cart.ID] = cart
+}
+
+func (r *memoryCarts) GetByOwner(owner models.CartOwner) (*models.Cart, error) {
+	for _, cart := range r.carts {
+		if cart.StoreID != owner.StoreID {
+			continue
+		}
+		if owner.UserID != "" && cart.UserID == owner.UserID || owner.UserID == "" && cart.SessionID == owner.SessionID {
+			return cart, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryCarts) AssignToUser(cartID, userID string) error {
+	cart := r.carts[cartID]
+	cart.UserID, cart.SessionID = userID, ""
+	return nil
+}
+
+func (r *memoryCarts) Merge(guestCartID, userCartID, currency string, items []*models.CartItem) error {
+	cart := r.carts[userCartID]
+	cart.Currency = currency
+	for _, item := range items {
+		saved := false
+		for _, existing := range cart.Items {
+			if existing.ProductID == item.ProductID && existing.VariantID == item.VariantID {
+				existing.Quantity, existing.UnitPrice = item.Quantity, item.UnitPrice
+				saved = true
+			}
+		}
+		if !saved {
+			item.CartID = userCartID
+			cart.Items = append(cart.Items, item)
+		}
+	}
+	delete(r.carts, guestCartID)
+	return nil
+}
+
+// stockedCatalog is product-service selling products without variants, with
+// the given stock of each
+type stockedCatalog map[string]int32
+
+func (c stockedCatalog) BatchGetProducts(ctx context.Context, ids []string) (map[string]*productPb.Product, error) {
+	products := make(map[string]*productPb.Product)
+	for _, id := range ids {
+		if stock, ok := c[id]; ok {
+			products[id] = &productPb.Product{
+				Id:                id,
+				IsActive:          true,
+				EffectivePrice:    &commonPb.Money{CurrencyCode: "USD", Units: 10},
+				AvailableQuantity: stock,
+			}
+		}
+	}
+	return products, nil
+}
+
+func (c stockedCatalog) ListProductVariants(ctx context.Context, productID string) ([]*productPb.ProductVariant, error) {
+	return nil, nil
+}
+
+func TestMergeCarts(t *testing.T) {
+	guest := models.CartOwner{StoreID: mergeStore, SessionID: mergeSession}
+	user := models.CartOwner{StoreID: mergeStore, UserID: mergeUser}
+
+	for _, test := range []struct {
+		name string
+		// The guest's cart, and the user's if they have one
+		guestItems map[string]int32
+		guestStore string
+		userItems  map[string]int32
+		stock      stockedCatalog
+		want       map[string]int32
+		wantMerged int
+	}{
+		{
+			name:       "SumsQuantities",
+			guestItems: map[string]int32{lamp: 2, desk: 1},
+			userItems:  map[string]int32{lamp: 3},
+			stock:      stockedCatalog{lamp: 50, desk: 50},
+			want:       map[string]int32{lamp: 5, desk: 1},
+			wantMerged: 2,
+		},
+		{
+			name:       "CapsAtStock",
+			guestItems: map[string]int32{lamp: 4, chair: 1},
+			userItems:  map[string]int32{lamp: 3},
+			stock:      stockedCatalog{lamp: 5, chair: 0},
+			want:       map[string]int32{lamp: 5},
+			wantMerged: 1,
+		},
+		{
+			name:       "IntoEmptyUserCart",
+			guestItems: map[string]int32{desk: 2},
+			userItems:  map[string]int32{},
+			stock:      stockedCatalog{desk: 50},
+			want:       map[string]int32{desk: 2},
+			wantMerged: 1,
+		},
+		{
+			name:       "UserWithoutCart",
+			guestItems: map[string]int32{lamp: 1, desk: 1},
+			stock:      stockedCatalog{lamp: 50, desk: 50},
+			want:       map[string]int32{lamp: 1, desk: 1},
+			wantMerged: 2,
+		},
+		{
+			name:       "GuestCartOfAnotherStore",
+			guestItems: map[string]int32{lamp: 1},
+			guestStore: otherStore,
+			userItems:  map[string]int32{desk: 1},
+			stock:      stockedCatalog{lamp: 50, desk: 50},
+			want:       map[string]int32{desk: 1},
+			wantMerged: 0,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			carts := &memoryCarts{carts: make(map[string]*models.Cart)}
+			guestOwner := guest
+			if test.guestStore != "" {
+				guestOwner.StoreID = test.guestStore
+			}
+			carts.add(guestOwner, test.guestItems)
+			if test.userItems != nil {
+				carts.add(user, test.userItems)
+			}
+			s := NewCartService(carts, test.stock, 10, time.Hour)
+
+			cart, merged, err := s.MergeCarts(context.Background(), mergeStore, mergeSession, mergeUser)
+			if err != nil {
+				t.Fatalf("MergeCarts: %v", err)
+			}
+			if merged != test.wantMerged {
+				t.Errorf("merged %d items, want %d", merged, test.wantMerged)
+			}
+
+			got := make(map[string]int32)
+			for _, item := range cart.Items {
+				got[item.ProductID] = item.Quantity
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("user's cart holds %v, want %v", got, test.want)
+			}
+			if cart.StoreID != mergeStore || cart.UserID != mergeUser {
+				t.Errorf("got the cart of %s in store %s, want the user's in %s", cart.UserID, cart.StoreID, mergeStore)
+			}
+
+			// The guest cart is gone, unless it was in another store
+			left, _ := carts.GetByOwner(guestOwner)
+			if test.guestStore == "" && left != nil {
+				t.Error("the guest cart is still there")
+			}
+			if test.guestStore != "" && left == nil {
+				t.Error("the guest cart of another store is gone")
+			}
+		})
+	}
+}
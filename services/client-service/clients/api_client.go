@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -46,21 +50,377 @@ type User struct {
 	FirstName string    `json:"first_name"`
 	LastName  string    `json:"last_name"`
 	IsActive  bool      `json:"is_active"`
+	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+func (u User) IsAdmin() bool {
+	return u.Role == "admin"
+}
+
 type Product struct {
 	ID            string    `json:"id"`
 	Name          string    `json:"name"`
 	Description   string    `json:"description"`
-	Price         float64   `json:"price"`
+	Price         Money     `json:"price"`
+	CategoryID    string    `json:"category_id"`
 	Category      string    `json:"category"`
 	ImageURL      string    `json:"image_url"`
 	StockQuantity int32     `json:"stock_quantity"`
 	IsActive      bool      `json:"is_active"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+	CreatedBy     string    `json:"created_by"`
+
+	Options    []ProductOption   `json:"options,omitempty"`
+	Variants   []ProductVariant  `json:"variants,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Images     []ProductImage    `json:"images,omitempty"`
+
+	// AvailableQuantity is stock not held by carts or pending orders
+	ReservedQuantity  int32  `json:"reserved_quantity"`
+	AvailableQuantity int32  `json:"available_quantity"`
+	ReorderThreshold  *int32 `json:"reorder_threshold,omitempty"`
+
+	// Version goes up with every edit and guards updates and deletes
+	Version int32 `json:"version"`
+
+	// DeletedAt is set while the product is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// EffectivePrice is what the product sells for now, with any sale applied
+	CompareAtPrice *Money         `json:"compare_at_price,omitempty"`
+	EffectivePrice Money          `json:"effective_price"`
+	ActiveSale     *PriceSchedule `json:"active_sale,omitempty"`
+
+	// Prices fixes the price in currencies other than the product's own
+	Prices []Money `json:"prices,omitempty"`
+
+	// AverageRating and ReviewCount cover approved reviews only
+	AverageRating float64 `json:"average_rating"`
+	ReviewCount   int32   `json:"review_count"`
+
+	// Display is what to show in the visitor's currency; handlers fill it in
+	Display DisplayPrice `json:"-"`
+}
+
+// DisplayPrice is a product's price in the currency it is shown in
+type DisplayPrice struct {
+	Price    Money
+	Original Money // shown struck through; zero when there is none
+	// Approximate is set when the price was converted at an exchange rate
+	Approximate bool
+}
+
+// OriginalPrice is the price to show struck through next to the effective
+// price, or zero when there is none
+func (p Product) OriginalPrice() Money {
+	if p.CompareAtPrice != nil && p.CompareAtPrice.Cmp(p.EffectivePrice) > 0 {
+		return *p.CompareAtPrice
+	}
+	if p.Price.Cmp(p.EffectivePrice) > 0 {
+		return p.Price
+	}
+	return Money{CurrencyCode: p.Price.CurrencyCode}
+}
+
+// ListPrice is the fixed price in the given currency, if the product has one
+func (p Product) ListPrice(currency string) (Money, bool) {
+	for _, price := range p.Prices {
+		if price.CurrencyCode == currency {
+			return price, true
+		}
+	}
+	return Money{}, false
+}
+
+// PriceSchedule is a time-boxed sale price
+type PriceSchedule struct {
+	ID        string    `json:"id"`
+	ProductID string    `json:"product_id"`
+	SalePrice Money     `json:"sale_price"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Status    string    `json:"status"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PriceScheduleResponse struct {
+	Response Response       `json:"response"`
+	Schedule *PriceSchedule `json:"schedule"`
+}
+
+type PriceScheduleListResponse struct {
+	Response  Response         `json:"response"`
+	Schedules []*PriceSchedule `json:"schedules"`
+}
+
+// Review is a customer's review of a product. Only approved reviews are
+// public; authors also see their own while it waits for moderation.
+type Review struct {
+	ID             string    `json:"id"`
+	ProductID      string    `json:"product_id"`
+	UserID         string    `json:"user_id"`
+	AuthorName     string    `json:"author_name"`
+	Rating         int32     `json:"rating"`
+	Title          string    `json:"title"`
+	Body           string    `json:"body"`
+	Status         string    `json:"status"`
+	ModerationNote string    `json:"moderation_note"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type ReviewRequest struct {
+	Rating int32  `json:"rating"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// ReviewListParams pages through a product's reviews; SortBy is created_at
+// or rating
+type ReviewListParams struct {
+	Page      int
+	Limit     int
+	SortBy    string
+	SortOrder string
+	Rating    int
+}
+
+type ReviewResponse struct {
+	Response Response `json:"response"`
+	Review   *Review  `json:"review"`
+}
+
+type ReviewListResponse struct {
+	Response   Response   `json:"response"`
+	Reviews    []Review   `json:"reviews"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// CartItem is a product in the cart with its current price and stock.
+// Problem says why it can't be bought as it is.
+type CartItem struct {
+	ID                string            `json:"id"`
+	ProductID         string            `json:"product_id"`
+	VariantID         string            `json:"variant_id"`
+	Name              string            `json:"name"`
+	SKU               string            `json:"sku"`
+	ImageURL          string            `json:"image_url"`
+	OptionValues      map[string]string `json:"option_values"`
+	Quantity          int32             `json:"quantity"`
+	UnitPrice         *Money            `json:"unit_price"`
+	LineTotal         *Money            `json:"line_total"`
+	PreviousUnitPrice *Money            `json:"previous_unit_price"`
+	AvailableQuantity int32             `json:"available_quantity"`
+	Problem           string            `json:"problem"`
+}
+
+// Cart is a signed-in user's cart, or a guest's by their cart session
+type Cart struct {
+	ID            string     `json:"id"`
+	CurrencyCode  string     `json:"currency_code"`
+	Items         []CartItem `json:"items"`
+	ItemCount     int32      `json:"item_count"`
+	Subtotal      *Money     `json:"subtotal"`
+	CheckoutReady bool       `json:"checkout_ready"`
+}
+
+type CartResponse struct {
+	Response Response `json:"response"`
+	Cart     *Cart    `json:"cart"`
+}
+
+// CartOwner says whose cart a call is about: the user's when Token is set,
+// otherwise the guest's with the given session
+type CartOwner struct {
+	Token   string
+	Session string
+}
+
+// OrderItem is a product as it was bought
+type OrderItem struct {
+	ID           string            `json:"id"`
+	ProductID    string            `json:"product_id"`
+	VariantID    string            `json:"variant_id"`
+	Name         string            `json:"name"`
+	SKU          string            `json:"sku"`
+	OptionValues map[string]string `json:"option_values"`
+	Quantity     int32             `json:"quantity"`
+	UnitPrice    *Money            `json:"unit_price"`
+	LineTotal    *Money            `json:"line_total"`
+}
+
+// OrderStatusChange is one step in an order's status history
+type OrderStatusChange struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason"`
+	Actor      string    `json:"actor"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// AppliedPromotion is a promotion code applied to a cart or order
+type AppliedPromotion struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Discount    *Money `json:"discount"`
+	Explanation string `json:"explanation"`
+}
+
+// RejectedPromotion is a code that can't be used, and why
+type RejectedPromotion struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// PromotionEvaluation is what promotion codes take off a cart
+type PromotionEvaluation struct {
+	Response      Response            `json:"response"`
+	Subtotal      *Money              `json:"subtotal"`
+	DiscountTotal *Money              `json:"discount_total"`
+	Total         *Money              `json:"total"`
+	Applied       []AppliedPromotion  `json:"applied"`
+	Rejected      []RejectedPromotion `json:"rejected"`
+}
+
+// Order is a placed order. FailureReason says why checkout cancelled it.
+// Total is Subtotal less DiscountTotal, what Promotions took off.
+type Order struct {
+	ID              string              `json:"id"`
+	UserID          string              `json:"user_id"`
+	Status          string              `json:"status"`
+	CurrencyCode    string              `json:"currency_code"`
+	Items           []OrderItem         `json:"items"`
+	ItemCount       int32               `json:"item_count"`
+	Subtotal        *Money              `json:"subtotal"`
+	DiscountTotal   *Money              `json:"discount_total"`
+	Promotions      []AppliedPromotion  `json:"promotions"`
+	Total           *Money              `json:"total"`
+	PaymentProvider string              `json:"payment_provider"`
+	PaymentStatus   string              `json:"payment_status"`
+	FailureReason   string              `json:"failure_reason"`
+	History         []OrderStatusChange `json:"history"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// CanCancel reports whether the buyer can still cancel the order
+func (o Order) CanCancel() bool {
+	return o.Status == "paid"
+}
+
+type OrderResponse struct {
+	Response Response `json:"response"`
+	Order    *Order   `json:"order"`
+}
+
+type OrderListResponse struct {
+	Response   Response   `json:"response"`
+	Orders     []Order    `json:"orders"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// WishlistItem is a saved product. Available is false once the product is
+// deactivated or deleted; Product is nil once it is deleted.
+type WishlistItem struct {
+	ProductID string    `json:"product_id"`
+	Product   *Product  `json:"product"`
+	Position  int32     `json:"position"`
+	Available bool      `json:"available"`
+	InStock   bool      `json:"in_stock"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+// Wishlist is a user's saved products in their order. ShareToken is set
+// while the wishlist is shared by link.
+type Wishlist struct {
+	UserID     string         `json:"user_id"`
+	Items      []WishlistItem `json:"items"`
+	ShareToken string         `json:"share_token"`
+}
+
+type WishlistResponse struct {
+	Response Response  `json:"response"`
+	Wishlist *Wishlist `json:"wishlist"`
+}
+
+// Recommendation is a recommended product. Reason is also_viewed,
+// popular_in_category or popular.
+type Recommendation struct {
+	Product Product `json:"product"`
+	Reason  string  `json:"reason"`
+}
+
+type RecommendationsResponse struct {
+	Response        Response         `json:"response"`
+	Recommendations []Recommendation `json:"recommendations"`
+}
+
+// Notification is an entry in the user's inbox. ReadAt is empty while it
+// is unread.
+type Notification struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Link      string    `json:"link"`
+	ReadAt    string    `json:"read_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (n Notification) Unread() bool {
+	return n.ReadAt == ""
+}
+
+type NotificationListResponse struct {
+	Response      Response       `json:"response"`
+	Notifications []Notification `json:"notifications"`
+	Pagination    Pagination     `json:"pagination"`
+	UnreadCount   int32          `json:"unread_count"`
+}
+
+type MarkNotificationsReadResponse struct {
+	Response    Response `json:"response"`
+	Marked      int32    `json:"marked"`
+	UnreadCount int32    `json:"unread_count"`
+}
+
+type ProductOption struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Values   []string `json:"values"`
+	Position int32    `json:"position"`
+}
+
+// ProductVariant is one purchasable option combination; Price is only set
+// when it overrides the product price
+type ProductVariant struct {
+	ID             string            `json:"id"`
+	SKU            string            `json:"sku"`
+	OptionValues   map[string]string `json:"option_values"`
+	Price          *Money            `json:"price,omitempty"`
+	EffectivePrice Money             `json:"effective_price"`
+	StockQuantity  int32             `json:"stock_quantity"`
+	IsActive       bool              `json:"is_active"`
+
+	AvailableQuantity int32 `json:"available_quantity"`
+
+	// DisplayPrice is the effective price formatted in the visitor's currency
+	DisplayPrice string `json:"display_price,omitempty"`
+}
+
+// ProductImage is an uploaded image; Thumbnails maps the max edge in pixels
+// to the resized image URL
+type ProductImage struct {
+	ID         string            `json:"id"`
+	URL        string            `json:"url"`
+	Width      int32             `json:"width"`
+	Height     int32             `json:"height"`
+	Position   int32             `json:"position"`
+	Thumbnails map[string]string `json:"thumbnails,omitempty"`
 }
 
 // Auth request/response types
@@ -115,14 +475,17 @@ type ProductListParams struct {
 	Page     int    `json:"page"`
 	Limit    int    `json:"limit"`
 	Category string `json:"category,omitempty"`
+	// SortBy is one of name, price, created_at, average_rating or review_count
+	SortBy    string `json:"sort_by,omitempty"`
+	SortOrder string `json:"sort_order,omitempty"`
 }
 
 type ProductSearchParams struct {
-	Query    string  `json:"query"`
-	Page     int     `json:"page"`
-	Limit    int     `json:"limit"`
-	MinPrice float64 `json:"min_price,omitempty"`
-	MaxPrice float64 `json:"max_price,omitempty"`
+	Query    string `json:"query"`
+	Page     int    `json:"page"`
+	Limit    int    `json:"limit"`
+	MinPrice *Money `json:"min_price,omitempty"`
+	MaxPrice *Money `json:"max_price,omitempty"`
 }
 
 type ProductListResponse struct {
@@ -132,29 +495,159 @@ type ProductListResponse struct {
 }
 
 
+// ProductRevision is one entry in a product's change history; Actor is the
+// ID of the user who made the change
+type ProductRevision struct {
+	ID         string        `json:"id"`
+	ProductID  string        `json:"product_id"`
+	Version    int32         `json:"version"`
+	Action     string        `json:"action"`
+	Actor      string        `json:"actor"`
+	Changes    []FieldChange `json:"changes"`
+	RevertedTo string        `json:"reverted_to"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// FieldChange values are JSON; an empty value means the field was unset
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type ProductHistoryResponse struct {
+	Response   Response          `json:"response"`
+	Revisions  []ProductRevision `json:"revisions"`
+	Pagination Pagination        `json:"pagination"`
+}
+
 type ProductResponse struct {
 	Response Response `json:"response"`
 	Product  Product  `json:"product"`
+	// Conflict means the product changed since the version sent; Product
+	// then holds the current one
+	Conflict bool `json:"conflict"`
+}
+
+type DeleteProductResponse struct {
+	Response Response `json:"response"`
+	Conflict bool     `json:"conflict"`
+	Product  *Product `json:"product"`
 }
 
 
+type ProductImagesResponse struct {
+	Response Response       `json:"response"`
+	Images   []ProductImage `json:"images"`
+}
+
 type CreateProductRequest struct {
-	Name          string  `json:"name"`
-	Description   string  `json:"description"`
-	Price         float64 `json:"price"`
-	Category      string  `json:"category"`
-	ImageURL      string  `json:"image_url"`
-	StockQuantity int32   `json:"stock_quantity"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Price         Money  `json:"price"`
+	CategoryID    string `json:"category_id"`
+	ImageURL      string `json:"image_url"`
+	StockQuantity int32  `json:"stock_quantity"`
+
+	ReorderThreshold *int32 `json:"reorder_threshold,omitempty"`
+	CompareAtPrice   *Money `json:"compare_at_price,omitempty"`
 }
 
+// UpdateProductRequest only changes the fields that are sent. Nil pointers
+// are left out, while a pointer to an empty value clears the field.
 type UpdateProductRequest struct {
 	Name          string  `json:"name,omitempty"`
-	Description   string  `json:"description,omitempty"`
-	Price         float64 `json:"price,omitempty"`
-	Category      string  `json:"category,omitempty"`
-	ImageURL      string  `json:"image_url,omitempty"`
-	StockQuantity int32   `json:"stock_quantity,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Price         *Money  `json:"price,omitempty"`
+	CategoryID    string  `json:"category_id,omitempty"`
+	ImageURL      *string `json:"image_url,omitempty"`
+	StockQuantity *int32  `json:"stock_quantity,omitempty"`
 	IsActive      *bool   `json:"is_active,omitempty"`
+
+	ReorderThreshold      *int32 `json:"reorder_threshold,omitempty"`
+	ClearReorderThreshold bool   `json:"clear_reorder_threshold,omitempty"`
+
+	CompareAtPrice      *Money `json:"compare_at_price,omitempty"`
+	ClearCompareAtPrice bool   `json:"clear_compare_at_price,omitempty"`
+
+	// Version is the version the edit is based on
+	Version int32 `json:"version"`
+}
+
+type ImportOptions struct {
+	DryRun bool
+	Upsert bool
+}
+
+type ImportRowError struct {
+	Row     int32  `json:"row"`
+	SKU     string `json:"sku"`
+	Message string `json:"message"`
+}
+
+type ImportResponse struct {
+	Response  Response         `json:"response"`
+	TotalRows int32            `json:"total_rows"`
+	Created   int32            `json:"created"`
+	Updated   int32            `json:"updated"`
+	Failed    int32            `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
+	DryRun    bool             `json:"dry_run"`
+}
+
+// LowStockItem is a product or variant at or below its reorder threshold
+type LowStockItem struct {
+	ProductID         string `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	SKU               string `json:"sku"`
+	VariantID         string `json:"variant_id"`
+	StockQuantity     int32  `json:"stock_quantity"`
+	ReservedQuantity  int32  `json:"reserved_quantity"`
+	AvailableQuantity int32  `json:"available_quantity"`
+	ReorderThreshold  int32  `json:"reorder_threshold"`
+}
+
+type LowStockResponse struct {
+	Response   Response       `json:"response"`
+	Items      []LowStockItem `json:"items"`
+	Pagination Pagination     `json:"pagination"`
+}
+
+type BatchGetProductsRequest struct {
+	IDs  []string `json:"ids,omitempty"`
+	SKUs []string `json:"skus,omitempty"`
+}
+
+type BatchGetProductsResponse struct {
+	Response Response  `json:"response"`
+	Products []Product `json:"products"`
+	NotFound []string  `json:"not_found"`
+}
+
+// ProductPatch is one item of a batch update; nil fields are left unchanged
+type ProductPatch struct {
+	ID            string `json:"id"`
+	Price         *Money `json:"price,omitempty"`
+	StockQuantity *int32 `json:"stock_quantity,omitempty"`
+	IsActive      *bool  `json:"is_active,omitempty"`
+}
+
+type BatchUpdateProductsRequest struct {
+	Updates []ProductPatch `json:"updates"`
+}
+
+type ProductUpdateResult struct {
+	ID      string   `json:"id"`
+	Success bool     `json:"success"`
+	Message string   `json:"message"`
+	Product *Product `json:"product"`
+}
+
+type BatchUpdateProductsResponse struct {
+	Response Response              `json:"response"`
+	Results  []ProductUpdateResult `json:"results"`
+	Updated  int32                 `json:"updated"`
+	Failed   int32                 `json:"failed"`
 }
 
 type CategoriesResponse struct {
@@ -165,11 +658,22 @@ type CategoriesResponse struct {
 type Category struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
+	Slug        string `json:"slug"`
 	Description string `json:"description"`
 	ParentID    string `json:"parent_id"`
 	IsActive    bool   `json:"is_active"`
 }
 
+type CategoryNode struct {
+	Category Category       `json:"category"`
+	Children []CategoryNode `json:"children"`
+}
+
+type CategoryTreeResponse struct {
+	Response Response       `json:"response"`
+	Roots    []CategoryNode `json:"roots"`
+}
+
 
 type Pagination struct {
 	Page       int32 `json:"page"`
@@ -181,6 +685,26 @@ type Pagination struct {
 }
 
 
+// APIError is an error status from the gateway
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error: status %d, body: %s", e.StatusCode, e.Body)
+}
+
+// Message returns the message of the response the gateway sent with the
+// error, or "" if it sent a plain error
+func (e *APIError) Message() string {
+	var body APIResponse
+	if err := json.Unmarshal([]byte(e.Body), &body); err != nil {
+		return ""
+	}
+	return body.Response.Message
+}
+
 func (c *APIClient) post(ctx context.Context, path string, body interface{}, result interface{}) (interface{}, error) {
 	return c.doRequest(ctx, "POST", path, body, result, "")
 }
@@ -201,11 +725,35 @@ func (c *APIClient) putWithAuth(ctx context.Context, path string, body interface
 	return c.doRequest(ctx, "PUT", path, body, result, token)
 }
 
+func (c *APIClient) patchWithAuth(ctx context.Context, path string, body interface{}, result interface{}, token string) (interface{}, error) {
+	return c.doRequest(ctx, "PATCH", path, body, result, token)
+}
+
 func (c *APIClient) deleteWithAuth(ctx context.Context, path string, result interface{}, token string) (interface{}, error) {
 	return c.doRequest(ctx, "DELETE", path, nil, result, token)
 }
 
+type storefrontHostKey struct{}
+
+// WithStorefrontHost returns a context whose API calls are made for the
+// store served on host, so one client can front several stores
+func WithStorefrontHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, storefrontHostKey{}, host)
+}
+
+// setStorefrontHost tells the gateway which host the shopper visited, which
+// it picks the store by
+func setStorefrontHost(req *http.Request) {
+	if host, ok := req.Context().Value(storefrontHostKey{}).(string); ok && host != "" {
+		req.Header.Set("X-Forwarded-Host", host)
+	}
+}
+
 func (c *APIClient) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}, token string) (interface{}, error) {
+	return c.doRequestWithHeader(ctx, method, path, body, result, token, nil)
+}
+
+func (c *APIClient) doRequestWithHeader(ctx context.Context, method, path string, body interface{}, result interface{}, token string, header http.Header) (interface{}, error) {
 	fullURL := c.baseURL + path
 
 	var bodyReader io.Reader
@@ -222,7 +770,11 @@ func (c *APIClient) doRequest(ctx context.Context, method, path string, body int
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for key, values := range header {
+		req.Header[key] = values
+	}
 	req.Header.Set("Content-Type", "application/json")
+	setStorefrontHost(req)
 	if token != "" {
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
@@ -238,8 +790,9 @@ func (c *APIClient) doRequest(ctx context.Context, method, path string, body int
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	// A conflict carries the current state in a regular response body
+	if resp.StatusCode >= 400 && resp.StatusCode != http.StatusConflict {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	if result != nil {
@@ -297,6 +850,10 @@ func (c *APIClient) ListProducts(ctx context.Context, params ProductListParams)
 	if params.Category != "" {
 		query.Set("category", params.Category)
 	}
+	if params.SortBy != "" {
+		query.Set("sort_by", params.SortBy)
+		query.Set("sort_order", params.SortOrder)
+	}
 
 	path := "/api/products"
 	if len(query) > 0 {
@@ -319,11 +876,12 @@ func (c *APIClient) SearchProducts(ctx context.Context, params ProductSearchPara
 	if params.Limit > 0 {
 		query.Set("limit", strconv.Itoa(params.Limit))
 	}
-	if params.MinPrice > 0 {
-		query.Set("min_price", fmt.Sprintf("%.2f", params.MinPrice))
-	}
-	if params.MaxPrice > 0 {
-		query.Set("max_price", fmt.Sprintf("%.2f", params.MaxPrice))
+	// The gateway takes both bounds in one currency
+	for name, bound := range map[string]*Money{"min_price": params.MinPrice, "max_price": params.MaxPrice} {
+		if bound != nil {
+			query.Set(name, bound.Amount())
+			query.Set("currency", bound.CurrencyCode)
+		}
 	}
 
 	path := "/api/products/search?" + query.Encode()
@@ -339,6 +897,32 @@ func (c *APIClient) GetProduct(ctx context.Context, id string) (*ProductResponse
 	return result, err
 }
 
+// ViewProduct gets a product for its page, telling the gateway who is
+// looking so the view counts towards their recommendations
+func (c *APIClient) ViewProduct(ctx context.Context, owner CartOwner, id string) (*ProductResponse, error) {
+	result := &ProductResponse{}
+	header := http.Header{}
+	if owner.Token == "" && owner.Session != "" {
+		header.Set("X-Cart-Session", owner.Session)
+	}
+	_, err := c.doRequestWithHeader(ctx, "GET", "/api/products/"+id, nil, result, owner.Token, header)
+	return result, err
+}
+
+// GetRecommendations gets recommendations for the signed-in user, or the
+// most popular products when token is empty
+func (c *APIClient) GetRecommendations(ctx context.Context, token string, limit int) (*RecommendationsResponse, error) {
+	result := &RecommendationsResponse{}
+	_, err := c.getWithAuth(ctx, "/api/recommendations?limit="+strconv.Itoa(limit), result, token)
+	return result, err
+}
+
+func (c *APIClient) GetProductRecommendations(ctx context.Context, productID string, limit int) (*RecommendationsResponse, error) {
+	result := &RecommendationsResponse{}
+	_, err := c.get(ctx, "/api/products/"+productID+"/recommendations?limit="+strconv.Itoa(limit), result)
+	return result, err
+}
+
 func (c *APIClient) CreateProduct(ctx context.Context, token string, req CreateProductRequest) (*ProductResponse, error) {
 	result := &ProductResponse{}
 	_, err := c.postWithAuth(ctx, "/api/products", req, result, token)
@@ -351,9 +935,439 @@ func (c *APIClient) UpdateProduct(ctx context.Context, token string, id string,
 	return result, err
 }
 
-func (c *APIClient) DeleteProduct(ctx context.Context, token string, id string) (*APIResponse, error) {
+func (c *APIClient) DeleteProduct(ctx context.Context, token string, id string, version int32) (*DeleteProductResponse, error) {
+	result := &DeleteProductResponse{}
+	path := "/api/products/" + id + "?version=" + strconv.Itoa(int(version))
+	_, err := c.deleteWithAuth(ctx, path, result, token)
+	return result, err
+}
+
+// ListDeletedProducts lists the trash; the gateway only allows admins
+func (c *APIClient) ListDeletedProducts(ctx context.Context, token string, page, limit int) (*ProductListResponse, error) {
+	query := url.Values{}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/products/trash"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	result := &ProductListResponse{}
+	_, err := c.getWithAuth(ctx, path, result, token)
+	return result, err
+}
+
+func (c *APIClient) RestoreProduct(ctx context.Context, token string, id string) (*ProductResponse, error) {
+	result := &ProductResponse{}
+	_, err := c.postWithAuth(ctx, "/api/products/"+id+"/restore", nil, result, token)
+	return result, err
+}
+
+func (c *APIClient) GetProductHistory(ctx context.Context, token string, id string, page int) (*ProductHistoryResponse, error) {
+	path := "/api/products/" + id + "/history"
+	if page > 0 {
+		path += "?page=" + strconv.Itoa(page)
+	}
+
+	result := &ProductHistoryResponse{}
+	_, err := c.getWithAuth(ctx, path, result, token)
+	return result, err
+}
+
+func (c *APIClient) RevertProduct(ctx context.Context, token string, id string, revisionID string, version int32) (*ProductResponse, error) {
+	result := &ProductResponse{}
+	body := map[string]interface{}{"revision_id": revisionID, "version": version}
+	_, err := c.postWithAuth(ctx, "/api/products/"+id+"/revert", body, result, token)
+	return result, err
+}
+
+// ListPriceSchedules lists a product's sales; only its owner and admins can
+func (c *APIClient) ListPriceSchedules(ctx context.Context, token string, id string) (*PriceScheduleListResponse, error) {
+	result := &PriceScheduleListResponse{}
+	_, err := c.getWithAuth(ctx, "/api/products/"+id+"/price-schedules", result, token)
+	return result, err
+}
+
+func (c *APIClient) CreatePriceSchedule(ctx context.Context, token string, id string, salePrice Money, startsAt, endsAt time.Time) (*PriceScheduleResponse, error) {
+	result := &PriceScheduleResponse{}
+	body := map[string]interface{}{
+		"sale_price": salePrice,
+		"ends_at":    endsAt.Format(time.RFC3339),
+	}
+	if !startsAt.IsZero() {
+		body["starts_at"] = startsAt.Format(time.RFC3339)
+	}
+	_, err := c.postWithAuth(ctx, "/api/products/"+id+"/price-schedules", body, result, token)
+	return result, err
+}
+
+func (c *APIClient) CancelPriceSchedule(ctx context.Context, token string, id string, scheduleID string) (*PriceScheduleResponse, error) {
+	result := &PriceScheduleResponse{}
+	_, err := c.deleteWithAuth(ctx, "/api/products/"+id+"/price-schedules/"+scheduleID, result, token)
+	return result, err
+}
+
+// ListProductReviews lists a product's reviews as the token's user sees
+// them; the token can be empty for visitors
+func (c *APIClient) ListProductReviews(ctx context.Context, token string, id string, params ReviewListParams) (*ReviewListResponse, error) {
+	query := url.Values{}
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.SortBy != "" {
+		query.Set("sort_by", params.SortBy)
+		query.Set("sort_order", params.SortOrder)
+	}
+	if params.Rating > 0 {
+		query.Set("rating", strconv.Itoa(params.Rating))
+	}
+
+	path := "/api/products/" + id + "/reviews"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	result := &ReviewListResponse{}
+	_, err := c.getWithAuth(ctx, path, result, token)
+	return result, err
+}
+
+func (c *APIClient) CreateReview(ctx context.Context, token string, productID string, req ReviewRequest) (*ReviewResponse, error) {
+	result := &ReviewResponse{}
+	_, err := c.postWithAuth(ctx, "/api/products/"+productID+"/reviews", req, result, token)
+	return result, err
+}
+
+func (c *APIClient) UpdateReview(ctx context.Context, token string, reviewID string, req ReviewRequest) (*ReviewResponse, error) {
+	result := &ReviewResponse{}
+	_, err := c.putWithAuth(ctx, "/api/reviews/"+reviewID, req, result, token)
+	return result, err
+}
+
+func (c *APIClient) DeleteReview(ctx context.Context, token string, reviewID string) (*APIResponse, error) {
 	result := &APIResponse{}
-	_, err := c.deleteWithAuth(ctx, "/api/products/"+id, result, token)
+	_, err := c.deleteWithAuth(ctx, "/api/reviews/"+reviewID, result, token)
+	return result, err
+}
+
+// ModerateReview approves or rejects a review; only admins can
+func (c *APIClient) ModerateReview(ctx context.Context, token string, reviewID string, status string, note string) (*ReviewResponse, error) {
+	result := &ReviewResponse{}
+	body := map[string]string{"status": status, "note": note}
+	_, err := c.postWithAuth(ctx, "/api/reviews/"+reviewID+"/moderate", body, result, token)
+	return result, err
+}
+
+// Cart API methods. Guests' calls carry their cart session in the
+// X-Cart-Session header.
+
+func (c *APIClient) cartRequest(ctx context.Context, method, path string, body interface{}, owner CartOwner) (*CartResponse, error) {
+	result := &CartResponse{}
+	header := http.Header{}
+	if owner.Token == "" {
+		header.Set("X-Cart-Session", owner.Session)
+	}
+	_, err := c.doRequestWithHeader(ctx, method, path, body, result, owner.Token, header)
+	return result, err
+}
+
+func (c *APIClient) GetCart(ctx context.Context, owner CartOwner) (*CartResponse, error) {
+	return c.cartRequest(ctx, "GET", "/api/cart", nil, owner)
+}
+
+func (c *APIClient) AddCartItem(ctx context.Context, owner CartOwner, productID, variantID string, quantity int32) (*CartResponse, error) {
+	body := map[string]interface{}{"product_id": productID, "variant_id": variantID, "quantity": quantity}
+	return c.cartRequest(ctx, "POST", "/api/cart/items", body, owner)
+}
+
+// UpdateCartItem sets an item's quantity; zero removes it
+func (c *APIClient) UpdateCartItem(ctx context.Context, owner CartOwner, itemID string, quantity int32) (*CartResponse, error) {
+	body := map[string]int32{"quantity": quantity}
+	return c.cartRequest(ctx, "PUT", "/api/cart/items/"+itemID, body, owner)
+}
+
+func (c *APIClient) RemoveCartItem(ctx context.Context, owner CartOwner, itemID string) (*CartResponse, error) {
+	return c.cartRequest(ctx, "DELETE", "/api/cart/items/"+itemID, nil, owner)
+}
+
+func (c *APIClient) ClearCart(ctx context.Context, owner CartOwner) (*CartResponse, error) {
+	return c.cartRequest(ctx, "DELETE", "/api/cart", nil, owner)
+}
+
+// MergeCart moves the guest cart with the given session into the signed-in
+// user's cart
+func (c *APIClient) MergeCart(ctx context.Context, token string, session string) (*CartResponse, error) {
+	result := &CartResponse{}
+	body := map[string]string{"session_id": session}
+	_, err := c.postWithAuth(ctx, "/api/cart/merge", body, result, token)
+	return result, err
+}
+
+// Order API methods
+
+// checkoutItems lists the cart's items at the prices the buyer was shown
+func checkoutItems(cart *Cart) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, map[string]interface{}{
+			"product_id": item.ProductID,
+			"variant_id": item.VariantID,
+			"quantity":   item.Quantity,
+			"unit_price": item.UnitPrice,
+		})
+	}
+	return items
+}
+
+// Checkout places an order for the cart's items at the prices the buyer was
+// shown, with the promotion codes, which must all apply. A failed checkout
+// that got as far as creating the order returns the cancelled order along
+// with the error.
+func (c *APIClient) Checkout(ctx context.Context, token string, cart *Cart, paymentToken string, promotionCodes []string) (*OrderResponse, error) {
+	body := map[string]interface{}{
+		"items":           checkoutItems(cart),
+		"currency_code":   cart.CurrencyCode,
+		"payment_token":   paymentToken,
+		"promotion_codes": promotionCodes,
+	}
+
+	result := &OrderResponse{}
+	_, err := c.postWithAuth(ctx, "/api/orders", body, result, token)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		json.Unmarshal([]byte(apiErr.Body), result)
+	}
+	return result, err
+}
+
+// EvaluatePromotions works out what the codes take off the cart; codes that
+// can't be used come back in Rejected
+func (c *APIClient) EvaluatePromotions(ctx context.Context, token string, cart *Cart, codes []string) (*PromotionEvaluation, error) {
+	result := &PromotionEvaluation{}
+	body := map[string]interface{}{
+		"items":         checkoutItems(cart),
+		"currency_code": cart.CurrencyCode,
+		"codes":         codes,
+	}
+	_, err := c.postWithAuth(ctx, "/api/promotions/evaluate", body, result, token)
+	return result, err
+}
+
+func (c *APIClient) ListOrders(ctx context.Context, token string, page int) (*OrderListResponse, error) {
+	result := &OrderListResponse{}
+	_, err := c.getWithAuth(ctx, "/api/orders?page="+strconv.Itoa(page), result, token)
+	return result, err
+}
+
+func (c *APIClient) GetOrder(ctx context.Context, token string, id string) (*OrderResponse, error) {
+	result := &OrderResponse{}
+	_, err := c.getWithAuth(ctx, "/api/orders/"+id, result, token)
+	return result, err
+}
+
+// UpdateOrderStatus moves an order on; buyers can only cancel
+func (c *APIClient) UpdateOrderStatus(ctx context.Context, token string, id string, status string, reason string) (*OrderResponse, error) {
+	result := &OrderResponse{}
+	body := map[string]string{"status": status, "reason": reason}
+	_, err := c.postWithAuth(ctx, "/api/orders/"+id+"/status", body, result, token)
+	return result, err
+}
+
+// Wishlist API methods
+
+func (c *APIClient) GetWishlist(ctx context.Context, token string) (*WishlistResponse, error) {
+	result := &WishlistResponse{}
+	_, err := c.getWithAuth(ctx, "/api/wishlist", result, token)
+	return result, err
+}
+
+func (c *APIClient) AddToWishlist(ctx context.Context, token string, productID string) (*WishlistResponse, error) {
+	result := &WishlistResponse{}
+	body := map[string]string{"product_id": productID}
+	_, err := c.postWithAuth(ctx, "/api/wishlist/items", body, result, token)
+	return result, err
+}
+
+func (c *APIClient) RemoveFromWishlist(ctx context.Context, token string, productID string) (*WishlistResponse, error) {
+	result := &WishlistResponse{}
+	_, err := c.deleteWithAuth(ctx, "/api/wishlist/items/"+productID, result, token)
+	return result, err
+}
+
+// ReorderWishlist takes every saved product's ID in the new order
+func (c *APIClient) ReorderWishlist(ctx context.Context, token string, productIDs []string) (*WishlistResponse, error) {
+	result := &WishlistResponse{}
+	body := map[string][]string{"product_ids": productIDs}
+	_, err := c.putWithAuth(ctx, "/api/wishlist/order", body, result, token)
+	return result, err
+}
+
+// ShareWishlist turns the share link on or off
+func (c *APIClient) ShareWishlist(ctx context.Context, token string, shared bool) (*WishlistResponse, error) {
+	result := &WishlistResponse{}
+	body := map[string]bool{"shared": shared}
+	_, err := c.postWithAuth(ctx, "/api/wishlist/share", body, result, token)
+	return result, err
+}
+
+func (c *APIClient) GetSharedWishlist(ctx context.Context, shareToken string) (*WishlistResponse, error) {
+	result := &WishlistResponse{}
+	_, err := c.get(ctx, "/api/wishlists/shared/"+url.PathEscape(shareToken), result)
+	return result, err
+}
+
+// Notification API methods
+
+func (c *APIClient) ListNotifications(ctx context.Context, token string, unreadOnly bool, page int, limit int) (*NotificationListResponse, error) {
+	result := &NotificationListResponse{}
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(limit))
+	if unreadOnly {
+		query.Set("unread", "true")
+	}
+	_, err := c.getWithAuth(ctx, "/api/notifications?"+query.Encode(), result, token)
+	return result, err
+}
+
+// MarkNotificationsRead marks the given notifications read, or all of them
+// if ids is empty
+func (c *APIClient) MarkNotificationsRead(ctx context.Context, token string, ids []string) (*MarkNotificationsReadResponse, error) {
+	result := &MarkNotificationsReadResponse{}
+	body := map[string][]string{"ids": ids}
+	_, err := c.postWithAuth(ctx, "/api/notifications/read", body, result, token)
+	return result, err
+}
+
+// bulkTimeout bounds imports and exports, which can take far longer than
+// regular API calls
+const bulkTimeout = 10 * time.Minute
+
+// ImportProducts uploads a CSV or JSON Lines file; the gateway picks the
+// format from the file name
+func (c *APIClient) ImportProducts(ctx context.Context, token string, file *multipart.FileHeader, opts ImportOptions) (*ImportResponse, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := copyFormFile(writer, "file", file); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build multipart body: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("dry_run", strconv.FormatBool(opts.DryRun))
+	query.Set("upsert", strconv.FormatBool(opts.Upsert))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/products/import?"+query.Encode(), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	setStorefrontHost(req)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := *c.httpClient
+	client.Timeout = bulkTimeout
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	result := &ImportResponse{}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	}
+	return result, nil
+}
+
+// ExportProducts starts a catalog export in the given format ("csv" or
+// "jsonl"). The caller streams the body on and must close it.
+func (c *APIClient) ExportProducts(ctx context.Context, token string, format string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/products/export?format="+url.QueryEscape(format), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	setStorefrontHost(req)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := *c.httpClient
+	client.Timeout = bulkTimeout
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	}
+	return resp, nil
+}
+
+// StreamProducts opens the gateway's product change stream with the given
+// filter query. The stream has no timeout; it ends with ctx.
+func (c *APIClient) StreamProducts(ctx context.Context, query url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/products/stream?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	setStorefrontHost(req)
+	req.Header.Set("Accept", "text/event-stream")
+
+	client := *c.httpClient
+	client.Timeout = 0
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	}
+	return resp, nil
+}
+
+// ListLowStockProducts returns the caller's products that need restocking;
+// admins get every product
+func (c *APIClient) ListLowStockProducts(ctx context.Context, token string, limit int) (*LowStockResponse, error) {
+	path := "/api/inventory/low-stock"
+	if limit > 0 {
+		path += "?limit=" + strconv.Itoa(limit)
+	}
+
+	result := &LowStockResponse{}
+	_, err := c.getWithAuth(ctx, path, result, token)
+	return result, err
+}
+
+func (c *APIClient) BatchGetProducts(ctx context.Context, req BatchGetProductsRequest) (*BatchGetProductsResponse, error) {
+	result := &BatchGetProductsResponse{}
+	_, err := c.post(ctx, "/api/products:batchGet", req, result)
+	return result, err
+}
+
+func (c *APIClient) BatchUpdateProducts(ctx context.Context, token string, req BatchUpdateProductsRequest) (*BatchUpdateProductsResponse, error) {
+	result := &BatchUpdateProductsResponse{}
+	_, err := c.patchWithAuth(ctx, "/api/products:batch", req, result, token)
 	return result, err
 }
 
@@ -361,4 +1375,86 @@ func (c *APIClient) GetCategories(ctx context.Context) (*CategoriesResponse, err
 	result := &CategoriesResponse{}
 	_, err := c.get(ctx, "/api/products/categories", result)
 	return result, err
-}
\ No newline at end of file
+}
+
+func (c *APIClient) GetCategoryTree(ctx context.Context) (*CategoryTreeResponse, error) {
+	result := &CategoryTreeResponse{}
+	_, err := c.get(ctx, "/api/products/categories/tree", result)
+	return result, err
+}
+
+// UploadProductImages forwards uploaded files to the gateway as a multipart
+// request. Validation failures come back as a response with Success false.
+func (c *APIClient) UploadProductImages(ctx context.Context, token string, productID string, files []*multipart.FileHeader) (*ProductImagesResponse, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, file := range files {
+		if err := copyFormFile(writer, "images", file); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build multipart body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/products/"+productID+"/images", &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	setStorefrontHost(req)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	result := &ProductImagesResponse{}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	}
+	return result, nil
+}
+
+func copyFormFile(writer *multipart.Writer, field string, file *multipart.FileHeader) error {
+	src, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open upload: %w", err)
+	}
+	defer src.Close()
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, field, quoteEscaper.Replace(file.Filename)))
+	header.Set("Content-Type", file.Header.Get("Content-Type"))
+
+	dst, err := writer.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to build multipart body: %w", err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("failed to copy upload: %w", err)
+	}
+	return nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func (c *APIClient) DeleteProductImage(ctx context.Context, token string, productID, imageID string) (*APIResponse, error) {
+	result := &APIResponse{}
+	_, err := c.deleteWithAuth(ctx, "/api/products/"+productID+"/images/"+imageID, result, token)
+	return result, err
+}
+
+func (c *APIClient) ReorderProductImages(ctx context.Context, token string, productID string, imageIDs []string) (*ProductImagesResponse, error) {
+	result := &ProductImagesResponse{}
+	body := map[string][]string{"image_ids": imageIDs}
+	_, err := c.putWithAuth(ctx, "/api/products/"+productID+"/images/order", body, result, token)
+	return result, err
+}
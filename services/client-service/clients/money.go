@@ -0,0 +1,101 @@
+package clients
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Money is an exact amount as the API sends it: whole units plus nanos
+// billionths of a unit
+type Money struct {
+	CurrencyCode string `json:"currency_code"`
+	Units        int64  `json:"units,omitempty"`
+	Nanos        int32  `json:"nanos,omitempty"`
+}
+
+// currencyDigits lists the currencies that don't have two decimal places
+var currencyDigits = map[string]int{
+	"CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "VND": 0,
+	"BHD": 3, "JOD": 3, "KWD": 3, "OMR": 3, "TND": 3,
+}
+
+var currencySymbols = map[string]string{
+	"USD": "$", "EUR": "€", "GBP": "£", "JPY": "¥", "INR": "₹", "KRW": "₩",
+	"CAD": "CA$", "AUD": "A$", "NZD": "NZ$", "MXN": "MX$", "BRL": "R$",
+}
+
+// CurrencyDigits is the number of decimal places amounts in the currency have
+func CurrencyDigits(code string) int {
+	if digits, ok := currencyDigits[code]; ok {
+		return digits
+	}
+	return 2
+}
+
+// ParseMoney reads a decimal amount such as "12.50" without going through
+// floating point
+func ParseMoney(amount, currency string) (Money, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok || strings.ContainsAny(amount, "eE/") {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	return MoneyFromRat(rat, currency), nil
+}
+
+// MoneyFromRat rounds an amount to the nearest billionth
+func MoneyFromRat(amount *big.Rat, currency string) Money {
+	nanos := new(big.Rat).Mul(amount, big.NewRat(1000000000, 1))
+	total, _ := new(big.Int).SetString(nanos.FloatString(0), 10)
+
+	units, remainder := new(big.Int).QuoRem(total, big.NewInt(1000000000), new(big.Int))
+	return Money{
+		CurrencyCode: strings.ToUpper(currency),
+		Units:        units.Int64(),
+		Nanos:        int32(remainder.Int64()),
+	}
+}
+
+// Rat returns the amount as an exact fraction
+func (m Money) Rat() *big.Rat {
+	amount := new(big.Rat).SetInt64(m.Units)
+	return amount.Add(amount, big.NewRat(int64(m.Nanos), 1000000000))
+}
+
+func (m Money) IsZero() bool {
+	return m.Units == 0 && m.Nanos == 0
+}
+
+// Cmp compares the amounts, ignoring the currencies
+func (m Money) Cmp(other Money) int {
+	return m.Rat().Cmp(other.Rat())
+}
+
+// Amount formats the amount with the currency's decimal places, for form
+// fields, e.g. "12.50"
+func (m Money) Amount() string {
+	return m.Rat().FloatString(CurrencyDigits(m.CurrencyCode))
+}
+
+// String formats the amount for display, e.g. "$12.50" or "CHF 12.50"
+func (m Money) String() string {
+	if symbol, ok := currencySymbols[m.CurrencyCode]; ok {
+		if m.Units < 0 || m.Nanos < 0 {
+			return "-" + symbol + strings.TrimPrefix(m.Amount(), "-")
+		}
+		return symbol + m.Amount()
+	}
+	if m.CurrencyCode == "" {
+		return m.Amount()
+	}
+	return m.CurrencyCode + " " + m.Amount()
+}
+
+// Step is the smallest amount the currency has, for number inputs
+func (m Money) Step() string {
+	digits := CurrencyDigits(m.CurrencyCode)
+	if digits == 0 {
+		return "1"
+	}
+	return "0." + strings.Repeat("0", digits-1) + "1"
+}
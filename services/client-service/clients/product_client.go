@@ -55,14 +55,42 @@ func (c *ProductClient) UpdateProduct(ctx context.Context, token string, id stri
 }
 
 // DeleteProduct deletes a product (requires authentication)
-func (c *ProductClient) DeleteProduct(ctx context.Context, token string, id string) (*APIResponse, error) {
+func (c *ProductClient) DeleteProduct(ctx context.Context, token string, id string, version int32) (*DeleteProductResponse, error) {
 	if token == "" {
 		return nil, fmt.Errorf("authentication token is required")
 	}
 	if id == "" {
 		return nil, fmt.Errorf("product ID is required")
 	}
-	return c.apiClient.DeleteProduct(ctx, token, id)
+	return c.apiClient.DeleteProduct(ctx, token, id, version)
+}
+
+// GetProductsByIDs gets several products in one request
+func (c *ProductClient) GetProductsByIDs(ctx context.Context, ids []string) (*BatchGetProductsResponse, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one product ID is required")
+	}
+	return c.apiClient.BatchGetProducts(ctx, BatchGetProductsRequest{IDs: ids})
+}
+
+// GetProductsBySKUs gets several products by SKU in one request
+func (c *ProductClient) GetProductsBySKUs(ctx context.Context, skus []string) (*BatchGetProductsResponse, error) {
+	if len(skus) == 0 {
+		return nil, fmt.Errorf("at least one SKU is required")
+	}
+	return c.apiClient.BatchGetProducts(ctx, BatchGetProductsRequest{SKUs: skus})
+}
+
+// BatchUpdateProducts changes price, stock or status of several products in
+// one transaction (requires authentication)
+func (c *ProductClient) BatchUpdateProducts(ctx context.Context, token string, updates []ProductPatch) (*BatchUpdateProductsResponse, error) {
+	if token == "" {
+		return nil, fmt.Errorf("authentication token is required")
+	}
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("at least one update is required")
+	}
+	return c.apiClient.BatchUpdateProducts(ctx, token, BatchUpdateProductsRequest{Updates: updates})
 }
 
 // GetCategories gets all available categories
@@ -105,13 +133,14 @@ func (c *ProductClient) SearchProductsByName(ctx context.Context, query string,
 	return c.SearchProducts(ctx, params)
 }
 
-// GetProductsInPriceRange gets products within a price range
-func (c *ProductClient) GetProductsInPriceRange(ctx context.Context, minPrice, maxPrice float64, page, limit int) (*ProductListResponse, error) {
+// GetProductsInPriceRange gets products within a price range; both bounds
+// must be in the same currency
+func (c *ProductClient) GetProductsInPriceRange(ctx context.Context, minPrice, maxPrice Money, page, limit int) (*ProductListResponse, error) {
 	params := ProductSearchParams{
 		Page:     page,
 		Limit:    limit,
-		MinPrice: minPrice,
-		MaxPrice: maxPrice,
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
 	}
 	return c.SearchProducts(ctx, params)
 }
@@ -128,7 +157,7 @@ func (c *ProductClient) IsProductAvailable(ctx context.Context, productID string
 	}
 
 	product := resp.Product
-	return product.IsActive && product.StockQuantity > 0, nil
+	return product.IsActive && product.AvailableQuantity > 0, nil
 }
 
 // GetProductStock gets the stock quantity for a product
@@ -143,4 +172,47 @@ func (c *ProductClient) GetProductStock(ctx context.Context, productID string) (
 	}
 
 	return resp.Product.StockQuantity, nil
-}
\ No newline at end of file
+}
+
+// AreProductsAvailable checks availability of several products at once.
+// Products that don't exist are reported as unavailable.
+func (c *ProductClient) AreProductsAvailable(ctx context.Context, productIDs []string) (map[string]bool, error) {
+	resp, err := c.GetProductsByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("%s", resp.Response.Message)
+	}
+
+	available := make(map[string]bool, len(productIDs))
+	for _, id := range productIDs {
+		available[id] = false
+	}
+	for _, product := range resp.Products {
+		available[product.ID] = product.IsActive && product.AvailableQuantity > 0
+	}
+
+	return available, nil
+}
+
+// GetProductStocks gets the stock quantities for several products; products
+// that don't exist are left out
+func (c *ProductClient) GetProductStocks(ctx context.Context, productIDs []string) (map[string]int32, error) {
+	resp, err := c.GetProductsByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("%s", resp.Response.Message)
+	}
+
+	stocks := make(map[string]int32, len(resp.Products))
+	for _, product := range resp.Products {
+		stocks[product.ID] = product.StockQuantity
+	}
+
+	return stocks, nil
+}
@@ -8,6 +8,9 @@ type Config struct {
 	Port           string
 	APIGatewayURL  string
 	SessionSecret  string
+
+	// JSON file of the exchange rates prices are converted at for display
+	ExchangeRatesFile string
 }
 
 func Load() *Config {
@@ -15,6 +18,8 @@ func Load() *Config {
 		Port:           getEnv("PORT", "8083"),
 		APIGatewayURL:  getEnv("API_GATEWAY_URL", "http://localhost:8080"),
 		SessionSecret:  getEnv("SESSION_SECRET", "your-super-secret-session-key-change-in-production"),
+
+		ExchangeRatesFile: getEnv("EXCHANGE_RATES_FILE", "config/exchange_rates.json"),
 	}
 }
 
@@ -0,0 +1,98 @@
+package currency
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+)
+
+// Provider supplies the exchange rates prices are converted at for display.
+// Converted prices are only indicative; checkout uses the product's prices.
+type Provider interface {
+	// Rate is how much of currency to one unit of currency from buys
+	Rate(from, to string) (*big.Rat, error)
+	// Currencies lists the currencies there are rates for
+	Currencies() []string
+}
+
+// StaticProvider serves rates read once from a JSON file such as
+//
+//	{"base": "USD", "rates": {"EUR": "0.92", "JPY": "151.30"}}
+//
+// where each rate is the price of one base unit. Rates are strings so they
+// are read exactly.
+type StaticProvider struct {
+	rates map[string]*big.Rat
+}
+
+func LoadStaticProvider(path string) (*StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rates: %w", err)
+	}
+
+	var file struct {
+		Base  string            `json:"base"`
+		Rates map[string]string `json:"rates"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rates: %w", err)
+	}
+	if file.Base == "" {
+		return nil, fmt.Errorf("exchange rates need a base currency")
+	}
+
+	provider := &StaticProvider{rates: map[string]*big.Rat{strings.ToUpper(file.Base): big.NewRat(1, 1)}}
+	for code, value := range file.Rates {
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate %q for %s", value, code)
+		}
+		provider.rates[strings.ToUpper(code)] = rate
+	}
+
+	return provider, nil
+}
+
+func (p *StaticProvider) Rate(from, to string) (*big.Rat, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return nil, fmt.Errorf("no exchange rate for %s", from)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return nil, fmt.Errorf("no exchange rate for %s", to)
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
+
+func (p *StaticProvider) Currencies() []string {
+	codes := make([]string, 0, len(p.rates))
+	for code := range p.rates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Convert converts an amount into another currency, rounded to that
+// currency's decimal places
+func Convert(provider Provider, amount clients.Money, to string) (clients.Money, error) {
+	if amount.CurrencyCode == to {
+		return amount, nil
+	}
+
+	rate, err := provider.Rate(amount.CurrencyCode, to)
+	if err != nil {
+		return clients.Money{}, err
+	}
+
+	converted := new(big.Rat).Mul(amount.Rat(), rate)
+	rounded, _ := new(big.Rat).SetString(converted.FloatString(clients.CurrencyDigits(to)))
+	return clients.MoneyFromRat(rounded, to), nil
+}
@@ -20,14 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Register_FullMethodName       = "/auth.AuthService/Register"
-	AuthService_Login_FullMethodName          = "/auth.AuthService/Login"
-	AuthService_ValidateToken_FullMethodName  = "/auth.AuthService/ValidateToken"
-	AuthService_GetUser_FullMethodName        = "/auth.AuthService/GetUser"
-	AuthService_UpdateProfile_FullMethodName  = "/auth.AuthService/UpdateProfile"
-	AuthService_ChangePassword_FullMethodName = "/auth.AuthService/ChangePassword"
-	AuthService_RefreshToken_FullMethodName   = "/auth.AuthService/RefreshToken"
-	AuthService_HealthCheck_FullMethodName    = "/auth.AuthService/HealthCheck"
+	AuthService_Register_FullMethodName          = "/auth.AuthService/Register"
+	AuthService_Login_FullMethodName             = "/auth.AuthService/Login"
+	AuthService_ValidateToken_FullMethodName     = "/auth.AuthService/ValidateToken"
+	AuthService_GetUser_FullMethodName           = "/auth.AuthService/GetUser"
+	AuthService_UpdateProfile_FullMethodName     = "/auth.AuthService/UpdateProfile"
+	AuthService_ChangePassword_FullMethodName    = "/auth.AuthService/ChangePassword"
+	AuthService_RefreshToken_FullMethodName      = "/auth.AuthService/RefreshToken"
+	AuthService_HealthCheck_FullMethodName       = "/auth.AuthService/HealthCheck"
+	AuthService_ResolveStore_FullMethodName      = "/auth.AuthService/ResolveStore"
+	AuthService_CreateStore_FullMethodName       = "/auth.AuthService/CreateStore"
+	AuthService_UpdateStore_FullMethodName       = "/auth.AuthService/UpdateStore"
+	AuthService_ListStores_FullMethodName        = "/auth.AuthService/ListStores"
+	AuthService_ListStoreMembers_FullMethodName  = "/auth.AuthService/ListStoreMembers"
+	AuthService_SetStoreMember_FullMethodName    = "/auth.AuthService/SetStoreMember"
+	AuthService_RemoveStoreMember_FullMethodName = "/auth.AuthService/RemoveStoreMember"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -42,6 +49,15 @@ type AuthServiceClient interface {
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	HealthCheck(ctx context.Context, in *common.HealthCheckRequest, opts ...grpc.CallOption) (*common.HealthCheckResponse, error)
+	// Stores are the storefronts sharing this deployment. Calls made on
+	// behalf of a store carry its ID in the x-store-id metadata.
+	ResolveStore(ctx context.Context, in *ResolveStoreRequest, opts ...grpc.CallOption) (*ResolveStoreResponse, error)
+	CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*CreateStoreResponse, error)
+	UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*UpdateStoreResponse, error)
+	ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error)
+	ListStoreMembers(ctx context.Context, in *ListStoreMembersRequest, opts ...grpc.CallOption) (*ListStoreMembersResponse, error)
+	SetStoreMember(ctx context.Context, in *SetStoreMemberRequest, opts ...grpc.CallOption) (*SetStoreMemberResponse, error)
+	RemoveStoreMember(ctx context.Context, in *RemoveStoreMemberRequest, opts ...grpc.CallOption) (*RemoveStoreMemberResponse, error)
 }
 
 type authServiceClient struct {
@@ -132,6 +148,76 @@ func (c *authServiceClient) HealthCheck(ctx context.Context, in *common.HealthCh
 	return out, nil
 }
 
+func (c *authServiceClient) ResolveStore(ctx context.Context, in *ResolveStoreRequest, opts ...grpc.CallOption) (*ResolveStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveStoreResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResolveStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*CreateStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStoreResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*UpdateStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStoreResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStoresResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListStores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListStoreMembers(ctx context.Context, in *ListStoreMembersRequest, opts ...grpc.CallOption) (*ListStoreMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStoreMembersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListStoreMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SetStoreMember(ctx context.Context, in *SetStoreMemberRequest, opts ...grpc.CallOption) (*SetStoreMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStoreMemberResponse)
+	err := c.cc.Invoke(ctx, AuthService_SetStoreMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RemoveStoreMember(ctx context.Context, in *RemoveStoreMemberRequest, opts ...grpc.CallOption) (*RemoveStoreMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStoreMemberResponse)
+	err := c.cc.Invoke(ctx, AuthService_RemoveStoreMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -144,6 +230,15 @@ type AuthServiceServer interface {
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	HealthCheck(context.Context, *common.HealthCheckRequest) (*common.HealthCheckResponse, error)
+	// Stores are the storefronts sharing this deployment. Calls made on
+	// behalf of a store carry its ID in the x-store-id metadata.
+	ResolveStore(context.Context, *ResolveStoreRequest) (*ResolveStoreResponse, error)
+	CreateStore(context.Context, *CreateStoreRequest) (*CreateStoreResponse, error)
+	UpdateStore(context.Context, *UpdateStoreRequest) (*UpdateStoreResponse, error)
+	ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error)
+	ListStoreMembers(context.Context, *ListStoreMembersRequest) (*ListStoreMembersResponse, error)
+	SetStoreMember(context.Context, *SetStoreMemberRequest) (*SetStoreMemberResponse, error)
+	RemoveStoreMember(context.Context, *RemoveStoreMemberRequest) (*RemoveStoreMemberResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -178,6 +273,27 @@ func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshToke
 func (UnimplementedAuthServiceServer) HealthCheck(context.Context, *common.HealthCheckRequest) (*common.HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (UnimplementedAuthServiceServer) ResolveStore(context.Context, *ResolveStoreRequest) (*ResolveStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveStore not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateStore(context.Context, *CreateStoreRequest) (*CreateStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStore not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateStore(context.Context, *UpdateStoreRequest) (*UpdateStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStore not implemented")
+}
+func (UnimplementedAuthServiceServer) ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStores not implemented")
+}
+func (UnimplementedAuthServiceServer) ListStoreMembers(context.Context, *ListStoreMembersRequest) (*ListStoreMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreMembers not implemented")
+}
+func (UnimplementedAuthServiceServer) SetStoreMember(context.Context, *SetStoreMemberRequest) (*SetStoreMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStoreMember not implemented")
+}
+func (UnimplementedAuthServiceServer) RemoveStoreMember(context.Context, *RemoveStoreMemberRequest) (*RemoveStoreMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStoreMember not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -343,6 +459,132 @@ func _AuthService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ResolveStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResolveStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResolveStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResolveStore(ctx, req.(*ResolveStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateStore(ctx, req.(*CreateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateStore(ctx, req.(*UpdateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListStores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListStores(ctx, req.(*ListStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListStoreMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListStoreMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListStoreMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListStoreMembers(ctx, req.(*ListStoreMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SetStoreMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStoreMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SetStoreMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SetStoreMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SetStoreMember(ctx, req.(*SetStoreMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RemoveStoreMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStoreMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RemoveStoreMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RemoveStoreMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RemoveStoreMember(ctx, req.(*RemoveStoreMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +624,34 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HealthCheck",
 			Handler:    _AuthService_HealthCheck_Handler,
 		},
+		{
+			MethodName: "ResolveStore",
+			Handler:    _AuthService_ResolveStore_Handler,
+		},
+		{
+			MethodName: "CreateStore",
+			Handler:    _AuthService_CreateStore_Handler,
+		},
+		{
+			MethodName: "UpdateStore",
+			Handler:    _AuthService_UpdateStore_Handler,
+		},
+		{
+			MethodName: "ListStores",
+			Handler:    _AuthService_ListStores_Handler,
+		},
+		{
+			MethodName: "ListStoreMembers",
+			Handler:    _AuthService_ListStoreMembers_Handler,
+		},
+		{
+			MethodName: "SetStoreMember",
+			Handler:    _AuthService_SetStoreMember_Handler,
+		},
+		{
+			MethodName: "RemoveStoreMember",
+			Handler:    _AuthService_RemoveStoreMember_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/auth.proto",
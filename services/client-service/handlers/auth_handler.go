@@ -69,6 +69,9 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Keep what they put in their cart before signing in
+	mergeGuestCart(w, r, h.apiClient, h.store, resp.Token)
+
 	// Redirect to dashboard
 	http.Redirect(w, r, "/dashboard", http.StatusFound)
 }
@@ -134,6 +137,9 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Keep what they put in their cart before signing in
+	mergeGuestCart(w, r, h.apiClient, h.store, resp.Token)
+
 	// Redirect to dashboard
 	http.Redirect(w, r, "/dashboard", http.StatusFound)
 }
@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/sessions"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+type CartHandler struct {
+	apiClient *clients.APIClient
+	store     *sessions.CookieStore
+}
+
+func NewCartHandler(apiClient *clients.APIClient, store *sessions.CookieStore) *CartHandler {
+	return &CartHandler{
+		apiClient: apiClient,
+		store:     store,
+	}
+}
+
+// cartOwner works out whose cart the request is about: the signed-in user's,
+// or the guest's by their cart session. Guests without one get one when
+// create is set; otherwise ok is false.
+func (h *CartHandler) cartOwner(w http.ResponseWriter, r *http.Request, create bool) (owner clients.CartOwner, user *clients.User, ok bool) {
+	user, token, _ := utils.GetUserFromSession(r, h.store)
+	if user != nil {
+		return clients.CartOwner{Token: token}, user, true
+	}
+
+	session := utils.GetCartSession(r, h.store)
+	if session == "" && create {
+		var err error
+		if session, err = utils.EnsureCartSession(w, r, h.store); err != nil {
+			log.Printf("Failed to start cart session: %v", err)
+			return owner, nil, false
+		}
+	}
+	return clients.CartOwner{Session: session}, nil, session != ""
+}
+
+// cartError is the message to show for a failed cart call; the gateway
+// answers rejected changes, such as too few in stock, with its reason
+func cartError(err error) string {
+	var apiErr *clients.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < 500 {
+		if message := apiErr.Message(); message != "" {
+			return message
+		}
+	}
+	return "server_error"
+}
+
+func (h *CartHandler) ShowCart(w http.ResponseWriter, r *http.Request) {
+	owner, user, ok := h.cartOwner(w, r, false)
+
+	cart := &clients.Cart{}
+	data := map[string]interface{}{
+		"Title":   "Your Cart",
+		"User":    user,
+		"Success": r.URL.Query().Get("success"),
+		"Error":   r.URL.Query().Get("error"),
+	}
+
+	if ok {
+		resp, err := h.apiClient.GetCart(r.Context(), owner)
+		if err != nil {
+			log.Printf("Get cart error: %v", err)
+			data["Error"] = "cart_unavailable"
+		} else if resp.Cart != nil {
+			cart = resp.Cart
+		}
+	}
+	data["Cart"] = cart
+
+	// Show what the promotion code takes off, as checkout will work it out
+	if code := utils.GetPromotionCode(r, h.store); code != "" && user != nil && cart.CheckoutReady && len(cart.Items) > 0 {
+		data["PromotionCode"] = code
+		evaluation, err := h.apiClient.EvaluatePromotions(r.Context(), owner.Token, cart, []string{code})
+		if err != nil {
+			log.Printf("Evaluate promotions error: %v", err)
+		} else {
+			data["Promotion"] = evaluation
+		}
+	}
+
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/pages/cart.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	tmpl.Execute(w, data)
+}
+
+// AddToCart adds a product from its detail page, then shows the cart
+func (h *CartHandler) AddToCart(w http.ResponseWriter, r *http.Request) {
+	productID := r.FormValue("product_id")
+
+	quantity, err := strconv.Atoi(r.FormValue("quantity"))
+	if err != nil || quantity < 1 {
+		quantity = 1
+	}
+
+	owner, _, ok := h.cartOwner(w, r, true)
+	if !ok {
+		http.Redirect(w, r, "/products/"+url.PathEscape(productID)+"?error=server_error", http.StatusFound)
+		return
+	}
+
+	if _, err := h.apiClient.AddCartItem(r.Context(), owner, productID, r.FormValue("variant_id"), int32(quantity)); err != nil {
+		http.Redirect(w, r, "/products/"+url.PathEscape(productID)+"?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/cart?success=item_added", http.StatusFound)
+}
+
+func (h *CartHandler) UpdateCartItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	quantity, err := strconv.Atoi(r.FormValue("quantity"))
+	if err != nil || quantity < 0 {
+		http.Redirect(w, r, "/cart?error=invalid_quantity", http.StatusFound)
+		return
+	}
+
+	owner, _, ok := h.cartOwner(w, r, false)
+	if !ok {
+		http.Redirect(w, r, "/cart", http.StatusFound)
+		return
+	}
+
+	if _, err := h.apiClient.UpdateCartItem(r.Context(), owner, vars["itemId"], int32(quantity)); err != nil {
+		http.Redirect(w, r, "/cart?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/cart?success=cart_updated", http.StatusFound)
+}
+
+func (h *CartHandler) RemoveCartItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	owner, _, ok := h.cartOwner(w, r, false)
+	if !ok {
+		http.Redirect(w, r, "/cart", http.StatusFound)
+		return
+	}
+
+	if _, err := h.apiClient.RemoveCartItem(r.Context(), owner, vars["itemId"]); err != nil {
+		http.Redirect(w, r, "/cart?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/cart?success=item_removed", http.StatusFound)
+}
+
+func (h *CartHandler) ClearCart(w http.ResponseWriter, r *http.Request) {
+	owner, _, ok := h.cartOwner(w, r, false)
+	if !ok {
+		http.Redirect(w, r, "/cart", http.StatusFound)
+		return
+	}
+
+	if _, err := h.apiClient.ClearCart(r.Context(), owner); err != nil {
+		http.Redirect(w, r, "/cart?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/cart?success=cart_cleared", http.StatusFound)
+}
+
+// ApplyPromotion checks a promotion code against the cart and, if it applies,
+// keeps it for checkout
+func (h *CartHandler) ApplyPromotion(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(r.FormValue("code")))
+	if code == "" {
+		http.Redirect(w, r, "/cart?error=promotion_code_required", http.StatusFound)
+		return
+	}
+
+	cartResp, err := h.apiClient.GetCart(r.Context(), clients.CartOwner{Token: token})
+	if err != nil || cartResp.Cart == nil {
+		http.Redirect(w, r, "/cart?error=cart_unavailable", http.StatusFound)
+		return
+	}
+	if len(cartResp.Cart.Items) == 0 {
+		http.Redirect(w, r, "/cart", http.StatusFound)
+		return
+	}
+
+	evaluation, err := h.apiClient.EvaluatePromotions(r.Context(), token, cartResp.Cart, []string{code})
+	if err != nil {
+		http.Redirect(w, r, "/cart?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+	if len(evaluation.Rejected) > 0 {
+		message := "Code " + code + " can't be used: " + evaluation.Rejected[0].Reason
+		http.Redirect(w, r, "/cart?error="+url.QueryEscape(message), http.StatusFound)
+		return
+	}
+
+	if err := utils.SetPromotionCode(w, r, h.store, code); err != nil {
+		log.Printf("Failed to save promotion code: %v", err)
+		http.Redirect(w, r, "/cart?error=server_error", http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, "/cart?success=promotion_applied", http.StatusFound)
+}
+
+func (h *CartHandler) RemovePromotion(w http.ResponseWriter, r *http.Request) {
+	if err := utils.SetPromotionCode(w, r, h.store, ""); err != nil {
+		log.Printf("Failed to clear promotion code: %v", err)
+	}
+	http.Redirect(w, r, "/cart?success=promotion_removed", http.StatusFound)
+}
+
+// mergeGuestCart moves what the visitor put in their cart before signing in
+// into their account's cart. Failing to is logged rather than failing the
+// sign-in; the guest cart is kept so a later sign-in can try again.
+func mergeGuestCart(w http.ResponseWriter, r *http.Request, apiClient *clients.APIClient, store *sessions.CookieStore, token string) {
+	session := utils.GetCartSession(r, store)
+	if session == "" {
+		return
+	}
+
+	if _, err := apiClient.MergeCart(r.Context(), token, session); err != nil {
+		log.Printf("Failed to merge guest cart: %v", err)
+		return
+	}
+	if err := utils.ClearCartSession(w, r, store); err != nil {
+		log.Printf("Failed to clear cart session: %v", err)
+	}
+}
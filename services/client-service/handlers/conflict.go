@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+)
+
+// fieldChange is one row of the diff shown when an edit hits a conflict
+type fieldChange struct {
+	Field  string
+	Yours  string
+	Theirs string
+}
+
+// applyProductUpdate returns product with the fields of req applied, which is
+// what the product would have looked like had the edit gone through
+func applyProductUpdate(product clients.Product, req clients.UpdateProductRequest, categories []clients.Category) clients.Product {
+	if req.Name != "" {
+		product.Name = req.Name
+	}
+	if req.Description != nil {
+		product.Description = *req.Description
+	}
+	if req.Price != nil {
+		product.Price = *req.Price
+	}
+	if req.CategoryID != "" && req.CategoryID != product.CategoryID {
+		product.CategoryID = req.CategoryID
+		product.Category = req.CategoryID
+		for _, category := range categories {
+			if category.ID == req.CategoryID {
+				product.Category = category.Name
+			}
+		}
+	}
+	if req.ImageURL != nil {
+		product.ImageURL = *req.ImageURL
+	}
+	if req.StockQuantity != nil {
+		product.StockQuantity = *req.StockQuantity
+	}
+	if req.IsActive != nil {
+		product.IsActive = *req.IsActive
+	}
+	if req.ClearReorderThreshold {
+		product.ReorderThreshold = nil
+	} else if req.ReorderThreshold != nil {
+		product.ReorderThreshold = req.ReorderThreshold
+	}
+	if req.ClearCompareAtPrice {
+		product.CompareAtPrice = nil
+	} else if req.CompareAtPrice != nil {
+		product.CompareAtPrice = req.CompareAtPrice
+	}
+	return product
+}
+
+// productDiff lists the edit form fields where yours and theirs differ
+func productDiff(yours, theirs clients.Product) []fieldChange {
+	fields := []struct {
+		name          string
+		yours, theirs string
+	}{
+		{"Name", yours.Name, theirs.Name},
+		{"Description", yours.Description, theirs.Description},
+		{"Price", yours.Price.String(), theirs.Price.String()},
+		{"Compare-at Price", formatCompareAtPrice(yours.CompareAtPrice), formatCompareAtPrice(theirs.CompareAtPrice)},
+		{"Stock Quantity", strconv.Itoa(int(yours.StockQuantity)), strconv.Itoa(int(theirs.StockQuantity))},
+		{"Reorder Threshold", formatThreshold(yours.ReorderThreshold), formatThreshold(theirs.ReorderThreshold)},
+		{"Category", yours.Category, theirs.Category},
+		{"Image URL", yours.ImageURL, theirs.ImageURL},
+		{"Active", formatActive(yours.IsActive), formatActive(theirs.IsActive)},
+	}
+
+	var changes []fieldChange
+	for _, field := range fields {
+		if field.yours != field.theirs {
+			changes = append(changes, fieldChange{Field: field.name, Yours: field.yours, Theirs: field.theirs})
+		}
+	}
+	return changes
+}
+
+func formatThreshold(threshold *int32) string {
+	if threshold == nil {
+		return "No alerts"
+	}
+	return strconv.Itoa(int(*threshold))
+}
+
+func formatCompareAtPrice(price *clients.Money) string {
+	if price == nil {
+		return "None"
+	}
+	return price.String()
+}
+
+func formatActive(active bool) string {
+	if active {
+		return "Yes"
+	}
+	return "No"
+}
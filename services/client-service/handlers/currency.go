@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/currency"
+)
+
+// displayCurrencyCookie remembers the currency a visitor chose to see prices in
+const displayCurrencyCookie = "display_currency"
+
+// displayCurrency is the currency to show prices in: one picked with the
+// currency query parameter, which is remembered, or the remembered one.
+// Empty means each product's own currency.
+func displayCurrency(w http.ResponseWriter, r *http.Request) string {
+	if values, ok := r.URL.Query()["currency"]; ok {
+		code := strings.ToUpper(strings.TrimSpace(values[0]))
+		if !isCurrencyCode(code) {
+			code = ""
+		}
+
+		cookie := &http.Cookie{Name: displayCurrencyCookie, Value: code, Path: "/", MaxAge: 365 * 24 * 3600}
+		if code == "" {
+			cookie.MaxAge = -1
+		}
+		http.SetCookie(w, cookie)
+		return code
+	}
+
+	if cookie, err := r.Cookie(displayCurrencyCookie); err == nil && isCurrencyCode(cookie.Value) {
+		return cookie.Value
+	}
+	return ""
+}
+
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// displayCurrencies lists the currencies visitors can pick from
+func displayCurrencies(rates currency.Provider) []string {
+	if rates == nil {
+		return nil
+	}
+	return rates.Currencies()
+}
+
+// localizePrices fills in the prices products are shown at in code
+func localizePrices(rates currency.Provider, products []clients.Product, code string) {
+	for i := range products {
+		localizePrice(rates, &products[i], code)
+	}
+}
+
+// localizePrice shows the product's fixed price in code when its price list
+// has one, and otherwise converts its own price at the current rate. Without
+// a rate the product's own price is shown.
+func localizePrice(rates currency.Provider, product *clients.Product, code string) {
+	product.Display = clients.DisplayPrice{Price: product.EffectivePrice, Original: product.OriginalPrice()}
+	for i := range product.Variants {
+		product.Variants[i].DisplayPrice = product.Variants[i].EffectivePrice.String()
+	}
+	if code == "" || code == product.Price.CurrencyCode {
+		return
+	}
+
+	// Sales only change the price in the product's own currency, so a
+	// fixed price has nothing to strike through
+	listPrice, fixed := product.ListPrice(code)
+	display := clients.DisplayPrice{Price: listPrice, Original: clients.Money{CurrencyCode: code}}
+	if !fixed {
+		if rates == nil {
+			return
+		}
+		price, err := currency.Convert(rates, product.EffectivePrice, code)
+		if err != nil {
+			return
+		}
+		display = clients.DisplayPrice{Price: price, Original: clients.Money{CurrencyCode: code}, Approximate: true}
+		if original := product.OriginalPrice(); !original.IsZero() {
+			if converted, err := currency.Convert(rates, original, code); err == nil {
+				display.Original = converted
+			}
+		}
+	}
+	product.Display = display
+
+	for i := range product.Variants {
+		variant := &product.Variants[i]
+		if variant.Price == nil && fixed {
+			variant.DisplayPrice = listPrice.String()
+			continue
+		}
+		if rates == nil {
+			continue
+		}
+		if converted, err := currency.Convert(rates, variant.EffectivePrice, code); err == nil {
+			variant.DisplayPrice = "≈ " + converted.String()
+		}
+	}
+}
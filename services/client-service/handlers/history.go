@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+// revisionView is a history entry ready for the template
+type revisionView struct {
+	ID         string
+	Version    int32
+	Action     string
+	Actor      string
+	RevertedTo string
+	CreatedAt  time.Time
+	Changes    []clients.FieldChange
+}
+
+var revisionActions = map[string]string{
+	"create":  "Created",
+	"update":  "Updated",
+	"delete":  "Moved to trash",
+	"restore": "Restored from trash",
+	"revert":  "Reverted",
+}
+
+// revisionViews labels actions, shows the current user as "You" and turns
+// the JSON change values into plain text
+func revisionViews(revisions []clients.ProductRevision, userID string) []revisionView {
+	views := make([]revisionView, 0, len(revisions))
+	for _, revision := range revisions {
+		view := revisionView{
+			ID:         revision.ID,
+			Version:    revision.Version,
+			Action:     revision.Action,
+			Actor:      revision.Actor,
+			RevertedTo: revision.RevertedTo,
+			CreatedAt:  revision.CreatedAt,
+		}
+		if label, ok := revisionActions[revision.Action]; ok {
+			view.Action = label
+		}
+		if revision.Actor == userID {
+			view.Actor = "You"
+		}
+		for _, change := range revision.Changes {
+			view.Changes = append(view.Changes, clients.FieldChange{
+				Field:  revisionFieldLabel(change.Field),
+				Before: formatRevisionValue(change.Before),
+				After:  formatRevisionValue(change.After),
+			})
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+var revisionFields = map[string]string{
+	"name":              "Name",
+	"description":       "Description",
+	"price":             "Price",
+	"category_id":       "Category",
+	"image_url":         "Image URL",
+	"sku":               "SKU",
+	"is_active":         "Active",
+	"reorder_threshold": "Reorder Threshold",
+	"compare_at_price":  "Compare-at Price",
+}
+
+// revisionFieldLabel names a field the way the edit form does; attributes
+// go by their key
+func revisionFieldLabel(field string) string {
+	if key, ok := strings.CutPrefix(field, "attributes."); ok {
+		return key
+	}
+	if label, ok := revisionFields[field]; ok {
+		return label
+	}
+	return field
+}
+
+// formatRevisionValue shows strings without quotes and unset values as a dash
+func formatRevisionValue(raw string) string {
+	if raw == "" {
+		return "—"
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return raw
+	}
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return "—"
+		}
+		return v
+	case bool:
+		return formatActive(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// RevertProduct puts a product back the way it was after a revision
+func (h *ProductHandler) RevertProduct(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	historyURL := "/products/" + id + "?tab=history"
+
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 32)
+
+	resp, err := h.apiClient.RevertProduct(r.Context(), token, id, r.FormValue("revision_id"), int32(version))
+	if err != nil {
+		http.Redirect(w, r, historyURL+"&error=server_error", http.StatusFound)
+		return
+	}
+
+	if resp.Conflict {
+		http.Redirect(w, r, historyURL+"&error=revert_conflict", http.StatusFound)
+		return
+	}
+
+	if !resp.Response.Success {
+		http.Redirect(w, r, historyURL+"&error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, historyURL+"&success=product_reverted", http.StatusFound)
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+// maxImportUpload matches the gateway's import size limit
+const maxImportUpload = 50 << 20
+
+func (h *ProductHandler) ShowImportProducts(w http.ResponseWriter, r *http.Request) {
+	user, _, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	h.renderImport(w, map[string]interface{}{
+		"User":  user,
+		"Error": r.URL.Query().Get("error"),
+	})
+}
+
+func (h *ProductHandler) ImportProducts(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUpload)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		http.Redirect(w, r, "/products/import?error=invalid_file", http.StatusFound)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	files := r.MultipartForm.File["file"]
+	if len(files) == 0 {
+		http.Redirect(w, r, "/products/import?error=no_file", http.StatusFound)
+		return
+	}
+
+	opts := clients.ImportOptions{
+		DryRun: r.FormValue("dry_run") == "on",
+		Upsert: r.FormValue("upsert") == "on",
+	}
+
+	resp, err := h.apiClient.ImportProducts(r.Context(), token, files[0], opts)
+	if err != nil {
+		log.Printf("Import products error: %v", err)
+		http.Redirect(w, r, "/products/import?error=server_error", http.StatusFound)
+		return
+	}
+
+	data := map[string]interface{}{
+		"User":     user,
+		"Result":   resp,
+		"Filename": files[0].Filename,
+		"DryRun":   opts.DryRun,
+		"Upsert":   opts.Upsert,
+	}
+	if !resp.Response.Success {
+		data["Error"] = resp.Response.Message
+	}
+	h.renderImport(w, data)
+}
+
+// ExportProducts passes the gateway's export download straight through
+func (h *ProductHandler) ExportProducts(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "jsonl" {
+		format = "csv"
+	}
+
+	resp, err := h.apiClient.ExportProducts(r.Context(), token, format)
+	if err != nil {
+		log.Printf("Export products error: %v", err)
+		http.Redirect(w, r, "/products/import?error=export_failed", http.StatusFound)
+		return
+	}
+	defer resp.Body.Close()
+
+	w.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+	w.Header().Set("Content-Disposition", resp.Header.Get("Content-Disposition"))
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		log.Printf("Export products error: %v", err)
+	}
+}
+
+func (h *ProductHandler) renderImport(w http.ResponseWriter, data map[string]interface{}) {
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/products/import.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	data["Title"] = "Import Products"
+	tmpl.Execute(w, data)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"html/template"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/sessions"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+type NotificationHandler struct {
+	apiClient *clients.APIClient
+	store     *sessions.CookieStore
+}
+
+func NewNotificationHandler(apiClient *clients.APIClient, store *sessions.CookieStore) *NotificationHandler {
+	return &NotificationHandler{
+		apiClient: apiClient,
+		store:     store,
+	}
+}
+
+func (h *NotificationHandler) ShowNotifications(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	resp, err := h.apiClient.ListNotifications(r.Context(), token, unreadOnly, page, 20)
+	if err != nil {
+		http.Error(w, "Failed to fetch notifications", http.StatusInternalServerError)
+		return
+	}
+
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/pages/notifications.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":         "Notifications",
+		"User":          user,
+		"Notifications": resp.Notifications,
+		"UnreadCount":   resp.UnreadCount,
+		"UnreadOnly":    unreadOnly,
+		"Page":          resp.Pagination.Page,
+		"TotalCount":    resp.Pagination.TotalCount,
+		"HasPrev":       resp.Pagination.HasPrev,
+		"HasNext":       resp.Pagination.HasNext,
+		"PrevPage":      resp.Pagination.Page - 1,
+		"NextPage":      resp.Pagination.Page + 1,
+		"Error":         r.URL.Query().Get("error"),
+	}
+
+	tmpl.Execute(w, data)
+}
+
+// MarkNotificationsRead marks the notifications in the form's id fields
+// read, or all of them if there are none. With a redirect field, which has
+// to be a path on this site, it goes there afterwards; that's how opening
+// a notification marks it read.
+func (h *NotificationHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	_, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || token == "" {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Redirect(w, r, "/notifications?error=invalid_form", http.StatusFound)
+		return
+	}
+
+	target := "/notifications"
+	if redirect := r.FormValue("redirect"); strings.HasPrefix(redirect, "/") && !strings.HasPrefix(redirect, "//") {
+		target = redirect
+	}
+
+	if _, err := h.apiClient.MarkNotificationsRead(r.Context(), token, r.Form["id"]); err != nil {
+		// Still follow the link; it only stays unread
+		log.Printf("Failed to mark notifications read: %v", err)
+		if target == "/notifications" {
+			target += "?error=server_error"
+		}
+	}
+
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+// UnreadCount is polled by the bell in the header
+func (h *NotificationHandler) UnreadCount(w http.ResponseWriter, r *http.Request) {
+	_, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || token == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := h.apiClient.ListNotifications(r.Context(), token, true, 1, 1)
+	if err != nil {
+		http.Error(w, "Failed to fetch notifications", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(map[string]int32{"unread_count": resp.UnreadCount})
+}
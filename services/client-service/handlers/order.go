@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/sessions"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+type OrderHandler struct {
+	apiClient *clients.APIClient
+	store     *sessions.CookieStore
+}
+
+func NewOrderHandler(apiClient *clients.APIClient, store *sessions.CookieStore) *OrderHandler {
+	return &OrderHandler{
+		apiClient: apiClient,
+		store:     store,
+	}
+}
+
+// Checkout places an order for everything in the cart at the prices shown
+// there. A checkout that fails after the order was created shows the
+// cancelled order with the reason; earlier failures go back to the cart.
+func (h *OrderHandler) Checkout(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	owner := clients.CartOwner{Token: token}
+	cartResp, err := h.apiClient.GetCart(r.Context(), owner)
+	if err != nil || cartResp.Cart == nil {
+		http.Redirect(w, r, "/cart?error=cart_unavailable", http.StatusFound)
+		return
+	}
+	if len(cartResp.Cart.Items) == 0 {
+		http.Redirect(w, r, "/cart", http.StatusFound)
+		return
+	}
+	if !cartResp.Cart.CheckoutReady {
+		http.Redirect(w, r, "/cart?error=checkout_not_ready", http.StatusFound)
+		return
+	}
+
+	var promotionCodes []string
+	if code := utils.GetPromotionCode(r, h.store); code != "" {
+		promotionCodes = []string{code}
+	}
+
+	resp, err := h.apiClient.Checkout(r.Context(), token, cartResp.Cart, r.FormValue("payment_token"), promotionCodes)
+	if err != nil {
+		if resp.Order != nil {
+			http.Redirect(w, r, "/orders/"+url.PathEscape(resp.Order.ID)+"?error=checkout_failed", http.StatusFound)
+			return
+		}
+		http.Redirect(w, r, "/cart?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	// The order is placed either way; a cart or code left behind is only
+	// untidy
+	if _, err := h.apiClient.ClearCart(r.Context(), owner); err != nil {
+		log.Printf("Failed to clear cart after checkout: %v", err)
+	}
+	if err := utils.SetPromotionCode(w, r, h.store, ""); err != nil {
+		log.Printf("Failed to clear promotion code after checkout: %v", err)
+	}
+
+	http.Redirect(w, r, "/orders/"+url.PathEscape(resp.Order.ID)+"?success=order_placed", http.StatusFound)
+}
+
+func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+
+	resp, err := h.apiClient.ListOrders(r.Context(), token, page)
+	if err != nil {
+		http.Error(w, "Failed to fetch orders", http.StatusInternalServerError)
+		return
+	}
+
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/orders/list.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":      "Your Orders",
+		"User":       user,
+		"Orders":     resp.Orders,
+		"Page":       resp.Pagination.Page,
+		"TotalCount": resp.Pagination.TotalCount,
+		"HasPrev":    resp.Pagination.HasPrev,
+		"HasNext":    resp.Pagination.HasNext,
+		"PrevPage":   resp.Pagination.Page - 1,
+		"NextPage":   resp.Pagination.Page + 1,
+		"Success":    r.URL.Query().Get("success"),
+		"Error":      r.URL.Query().Get("error"),
+	}
+
+	tmpl.Execute(w, data)
+}
+
+func (h *OrderHandler) ShowOrder(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	resp, err := h.apiClient.GetOrder(r.Context(), token, vars["id"])
+	if err != nil || resp.Order == nil {
+		var apiErr *clients.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			http.Redirect(w, r, "/orders?error=order_not_found", http.StatusFound)
+			return
+		}
+		http.Error(w, "Failed to fetch order", http.StatusInternalServerError)
+		return
+	}
+
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/orders/detail.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":   "Order " + resp.Order.ID,
+		"User":    user,
+		"Order":   resp.Order,
+		"Success": r.URL.Query().Get("success"),
+		"Error":   r.URL.Query().Get("error"),
+	}
+
+	tmpl.Execute(w, data)
+}
+
+// CancelOrder cancels one of the user's orders that hasn't shipped yet; the
+// payment is refunded
+func (h *OrderHandler) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	h.updateStatus(w, r, "cancelled", r.FormValue("reason"))
+}
+
+// UpdateOrderStatus lets admins ship, deliver, cancel and refund orders
+func (h *OrderHandler) UpdateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	user, _, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+	if !user.IsAdmin() {
+		http.Redirect(w, r, "/orders/"+url.PathEscape(mux.Vars(r)["id"])+"?error="+url.QueryEscape("Only admins can change an order's status"), http.StatusFound)
+		return
+	}
+
+	h.updateStatus(w, r, r.FormValue("status"), r.FormValue("reason"))
+}
+
+func (h *OrderHandler) updateStatus(w http.ResponseWriter, r *http.Request, status, reason string) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	id := mux.Vars(r)["id"]
+	if _, err := h.apiClient.UpdateOrderStatus(r.Context(), token, id, status, reason); err != nil {
+		http.Redirect(w, r, "/orders/"+url.PathEscape(id)+"?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/orders/"+url.PathEscape(id)+"?success=status_updated", http.StatusFound)
+}
@@ -6,39 +6,31 @@ import (
 
 	"github.com/gorilla/sessions"
 	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/currency"
 	"github.com/martbul/playground_microservices/services/client-service/utils"
 )
 
 type PageHandler struct {
 	apiClient *clients.APIClient
 	store     *sessions.CookieStore
+	rates     currency.Provider
 }
 
-func NewPageHandler(apiClient *clients.APIClient, store *sessions.CookieStore) *PageHandler {
+// NewPageHandler converts prices for display at rates, which may be nil
+func NewPageHandler(apiClient *clients.APIClient, store *sessions.CookieStore, rates currency.Provider) *PageHandler {
 	return &PageHandler{
 		apiClient: apiClient,
 		store:     store,
+		rates:     rates,
 	}
 }
 
 func (h *PageHandler) Home(w http.ResponseWriter, r *http.Request) {
-	// Get featured products (first 6 products)
-	params := clients.ProductListParams{
-		Page:  1,
-		Limit: 6,
-	}
-
-	// FIXED: Remove type assertion
-	resp, err := h.apiClient.ListProducts(r.Context(), params)
-	if err != nil {
-		// Continue without products if there's an error
-		resp = &clients.ProductListResponse{
-			Products: []clients.Product{},
-		}
-	}
-
 	// Check if user is authenticated
-	user, _, _ := utils.GetUserFromSession(r, h.store)
+	user, token, _ := utils.GetUserFromSession(r, h.store)
+
+	featured, personal := h.featuredProducts(r, token)
+	localizePrices(h.rates, featured, displayCurrency(w, r))
 
 	tmpl, err := template.ParseFiles(
 		"templates/layout/base.html",
@@ -51,13 +43,37 @@ func (h *PageHandler) Home(w http.ResponseWriter, r *http.Request) {
 
 	data := map[string]interface{}{
 		"Title":            "Welcome to MicroStore",
-		"FeaturedProducts": resp.Products,
+		"FeaturedProducts": featured,
+		"Personal":         personal,
 		"User":             user,
 	}
 
 	tmpl.Execute(w, data)
 }
 
+// featuredProducts picks the home page's products: recommendations from
+// what the user viewed, or the most popular products. personal is set when
+// any of them come from the user's own views. The newest products stand in
+// when recommendations are unavailable.
+func (h *PageHandler) featuredProducts(r *http.Request, token string) (products []clients.Product, personal bool) {
+	resp, err := h.apiClient.GetRecommendations(r.Context(), token, 6)
+	if err == nil && resp.Response.Success {
+		products = []clients.Product{}
+		for _, recommendation := range resp.Recommendations {
+			products = append(products, recommendation.Product)
+			personal = personal || recommendation.Reason != "popular"
+		}
+		return products, personal
+	}
+
+	listResp, err := h.apiClient.ListProducts(r.Context(), clients.ProductListParams{Page: 1, Limit: 6})
+	if err != nil {
+		// Continue without products if there's an error
+		return []clients.Product{}, false
+	}
+	return listResp.Products, false
+}
+
 func (h *PageHandler) Dashboard(w http.ResponseWriter, r *http.Request) {
 	user, token, err := utils.GetUserFromSession(r, h.store)
 	if err != nil || user == nil {
@@ -92,6 +108,23 @@ func (h *PageHandler) Dashboard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Low stock is a nice-to-have; the dashboard still renders without it
+	var lowStock []clients.LowStockItem
+	if lowStockResp, err := h.apiClient.ListLowStockProducts(r.Context(), token, 10); err == nil && lowStockResp.Response.Success {
+		lowStock = lowStockResp.Items
+	}
+
+	// So is the wishlist
+	wishlist := &clients.Wishlist{}
+	shareURL := ""
+	if wishlistResp, err := h.apiClient.GetWishlist(r.Context(), token); err == nil && wishlistResp.Wishlist != nil {
+		wishlist = wishlistResp.Wishlist
+		h.localizeWishlist(w, r, wishlist)
+		if wishlist.ShareToken != "" {
+			shareURL = wishlistShareURL(r, wishlist.ShareToken)
+		}
+	}
+
 	tmpl, err := template.ParseFiles(
 		"templates/layout/base.html",
 		"templates/pages/dashboard.html",
@@ -105,6 +138,9 @@ func (h *PageHandler) Dashboard(w http.ResponseWriter, r *http.Request) {
 		"Title":          "Dashboard",
 		"User":           profileResp.User,
 		"RecentProducts": resp.Products,
+		"LowStock":       lowStock,
+		"Wishlist":       wishlist,
+		"ShareURL":       shareURL,
 		"Success":        r.URL.Query().Get("success"),
 		"Error":          r.URL.Query().Get("error"),
 	}
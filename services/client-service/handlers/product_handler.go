@@ -3,32 +3,52 @@ package handlers
 import (
 	"html/template"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
 	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/currency"
 	"github.com/martbul/playground_microservices/services/client-service/utils"
 )
 
 type ProductHandler struct {
 	apiClient *clients.APIClient
 	store     *sessions.CookieStore
+	rates     currency.Provider
 }
 
-func NewProductHandler(apiClient *clients.APIClient, store *sessions.CookieStore) *ProductHandler {
+// NewProductHandler converts prices for display at rates, which may be nil
+func NewProductHandler(apiClient *clients.APIClient, store *sessions.CookieStore, rates currency.Provider) *ProductHandler {
 	return &ProductHandler{
 		apiClient: apiClient,
 		store:     store,
+		rates:     rates,
 	}
 }
 
+// productSorts maps the sort choices on the product list to the API's sort
+// fields; no choice lists the newest first
+var productSorts = map[string][2]string{
+	"price_asc":  {"price", "asc"},
+	"price_desc": {"price", "desc"},
+	"rating":     {"average_rating", "desc"},
+	"reviews":    {"review_count", "desc"},
+	"name":       {"name", "asc"},
+}
+
 func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 	// Parse query parameters
 	pageStr := r.URL.Query().Get("page")
 	limitStr := r.URL.Query().Get("limit")
 	category := r.URL.Query().Get("category")
 	searchQuery := r.URL.Query().Get("q")
+	sort := r.URL.Query().Get("sort")
+	if _, ok := productSorts[sort]; !ok {
+		sort = ""
+	}
 
 	page := 1
 	limit := 12
@@ -65,6 +85,9 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 			Limit:    limit,
 			Category: category,
 		}
+		if sort != "" {
+			params.SortBy, params.SortOrder = productSorts[sort][0], productSorts[sort][1]
+		}
 		resp, err = h.apiClient.ListProducts(r.Context(), params)
 	}
 
@@ -73,6 +96,9 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	displayCode := displayCurrency(w, r)
+	localizePrices(h.rates, resp.Products, displayCode)
+
 	// Get categories for filter
 	categoriesResp, _ := h.apiClient.GetCategories(r.Context())
 	var categories []clients.Category
@@ -102,7 +128,11 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 		"Categories":       categories,
 		"SelectedCategory": category,
 		"SearchQuery":      searchQuery,
+		"Sort":             sort,
+		"Currencies":       displayCurrencies(h.rates),
+		"DisplayCurrency":  displayCode,
 		"User":             user,
+		"Success":          r.URL.Query().Get("success"),
 	}
 
 	tmpl.Execute(w, data)
@@ -117,7 +147,16 @@ func (h *ProductHandler) ShowProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := h.apiClient.GetProduct(r.Context(), id)
+	// Check if user is authenticated
+	user, token, _ := utils.GetUserFromSession(r, h.store)
+
+	// Guests' views count by their cart session, if they have one
+	owner := clients.CartOwner{Token: token}
+	if user == nil {
+		owner = clients.CartOwner{Session: utils.GetCartSession(r, h.store)}
+	}
+
+	resp, err := h.apiClient.ViewProduct(r.Context(), owner, id)
 	if err != nil {
 		http.Error(w, "Product not found", http.StatusNotFound)
 		return
@@ -128,8 +167,8 @@ func (h *ProductHandler) ShowProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check if user is authenticated
-	user, _, _ := utils.GetUserFromSession(r, h.store)
+	displayCode := displayCurrency(w, r)
+	localizePrice(h.rates, &resp.Product, displayCode)
 
 	tmpl, err := template.ParseFiles(
 		"templates/layout/base.html",
@@ -140,15 +179,66 @@ func (h *ProductHandler) ShowProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Owners and admins get the history and sales tabs
+	canManage := user != nil && (user.ID == resp.Product.CreatedBy || user.IsAdmin())
+
 	data := map[string]interface{}{
-		"Title":   resp.Product.Name,
-		"Product": resp.Product,
-		"User":    user,
+		"Title":     resp.Product.Name,
+		"Product":   resp.Product,
+		"User":      user,
+		"CanManage": canManage,
+		"Tab":       "details",
+		"Success":   r.URL.Query().Get("success"),
+		"Error":     r.URL.Query().Get("error"),
+		"Related":   h.relatedProducts(r, id, displayCode),
+	}
+
+	switch tab := r.URL.Query().Get("tab"); {
+	case canManage && tab == "history":
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		history, err := h.apiClient.GetProductHistory(r.Context(), token, id, page)
+		if err != nil || !history.Response.Success {
+			data["Error"] = "history_unavailable"
+		} else {
+			data["History"] = revisionViews(history.Revisions, user.ID)
+			data["HistoryPage"] = history.Pagination
+			data["HistoryPrevPage"] = history.Pagination.Page - 1
+			data["HistoryNextPage"] = history.Pagination.Page + 1
+		}
+		data["Tab"] = "history"
+	case canManage && tab == "sales":
+		schedules, err := h.apiClient.ListPriceSchedules(r.Context(), token, id)
+		if err != nil || !schedules.Response.Success {
+			data["Error"] = "sales_unavailable"
+		} else {
+			data["Schedules"] = schedules.Schedules
+		}
+		data["TimeZone"] = time.Now().Format("MST")
+		data["Tab"] = "sales"
+	default:
+		data["RatingStars"] = starIcons(resp.Product.AverageRating)
+		h.loadReviews(r, data, id, user, token)
 	}
 
 	tmpl.Execute(w, data)
 }
 
+// relatedProducts gets the "You may also like" products; the block is left
+// out when they can't be loaded
+func (h *ProductHandler) relatedProducts(r *http.Request, id, displayCode string) []clients.Product {
+	resp, err := h.apiClient.GetProductRecommendations(r.Context(), id, 4)
+	if err != nil || !resp.Response.Success {
+		return nil
+	}
+
+	products := make([]clients.Product, 0, len(resp.Recommendations))
+	for _, recommendation := range resp.Recommendations {
+		products = append(products, recommendation.Product)
+	}
+	localizePrices(h.rates, products, displayCode)
+	return products
+}
+
 func (h *ProductHandler) ShowCreateProduct(w http.ResponseWriter, r *http.Request) {
 	user, _, err := utils.GetUserFromSession(r, h.store)
 	if err != nil || user == nil {
@@ -198,12 +288,16 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 	name := r.FormValue("name")
 	description := r.FormValue("description")
 	priceStr := r.FormValue("price")
-	category := r.FormValue("category")
+	categoryID := r.FormValue("category_id")
 	imageURL := r.FormValue("image_url")
 	stockStr := r.FormValue("stock_quantity")
+	thresholdStr := r.FormValue("reorder_threshold")
+	compareAtStr := r.FormValue("compare_at_price")
+	// Empty leaves the product in the store's default currency
+	currencyCode := r.FormValue("currency")
 
 	// Validate and convert
-	price, err := strconv.ParseFloat(priceStr, 64)
+	price, err := clients.ParseMoney(priceStr, currencyCode)
 	if err != nil {
 		http.Redirect(w, r, "/products/create?error=invalid_price", http.StatusFound)
 		return
@@ -219,10 +313,27 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 		Name:          name,
 		Description:   description,
 		Price:         price,
-		Category:      category,
+		CategoryID:    categoryID,
 		ImageURL:      imageURL,
 		StockQuantity: int32(stock),
 	}
+	if thresholdStr != "" {
+		threshold, err := strconv.ParseInt(thresholdStr, 10, 32)
+		if err != nil {
+			http.Redirect(w, r, "/products/create?error=invalid_reorder_threshold", http.StatusFound)
+			return
+		}
+		reorderThreshold := int32(threshold)
+		req.ReorderThreshold = &reorderThreshold
+	}
+	if compareAtStr != "" {
+		compareAtPrice, err := clients.ParseMoney(compareAtStr, currencyCode)
+		if err != nil || compareAtPrice.Rat().Sign() <= 0 {
+			http.Redirect(w, r, "/products/create?error=invalid_compare_at_price", http.StatusFound)
+			return
+		}
+		req.CompareAtPrice = &compareAtPrice
+	}
 
 	resp, err := h.apiClient.CreateProduct(r.Context(), token, req)
 	if err != nil {
@@ -235,6 +346,13 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The product exists at this point, so upload problems are reported on
+	// its edit page rather than losing the rest of the form
+	if msg := h.uploadImages(r, token, resp.Product.ID); msg != "" {
+		http.Redirect(w, r, "/products/"+resp.Product.ID+"/edit?error="+url.QueryEscape(msg), http.StatusFound)
+		return
+	}
+
 	http.Redirect(w, r, "/products/"+resp.Product.ID, http.StatusFound)
 }
 
@@ -265,13 +383,25 @@ func (h *ProductHandler) ShowEditProduct(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Get categories
+	h.renderEditProduct(w, map[string]interface{}{
+		"Product":    resp.Product,
+		"Categories": h.getCategories(r),
+		"User":       user,
+		"Token":      token,
+		"Error":      r.URL.Query().Get("error"),
+		"Success":    r.URL.Query().Get("success"),
+	})
+}
+
+func (h *ProductHandler) getCategories(r *http.Request) []clients.Category {
 	categoriesResp, _ := h.apiClient.GetCategories(r.Context())
-	var categories []clients.Category
-	if categoriesResp != nil {
-		categories = categoriesResp.Categories
+	if categoriesResp == nil {
+		return nil
 	}
+	return categoriesResp.Categories
+}
 
+func (h *ProductHandler) renderEditProduct(w http.ResponseWriter, data map[string]interface{}) {
 	tmpl, err := template.ParseFiles(
 		"templates/layout/base.html",
 		"templates/products/edit.html",
@@ -281,16 +411,7 @@ func (h *ProductHandler) ShowEditProduct(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	data := map[string]interface{}{
-		"Title":      "Edit Product",
-		"Product":    resp.Product,
-		"Categories": categories,
-		"User":       user,
-		"Token":      token,
-		"Error":      r.URL.Query().Get("error"),
-		"Success":    r.URL.Query().Get("success"),
-	}
-
+	data["Title"] = "Edit Product"
 	tmpl.Execute(w, data)
 }
 
@@ -318,33 +439,37 @@ func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 	name := r.FormValue("name")
 	description := r.FormValue("description")
 	priceStr := r.FormValue("price")
-	category := r.FormValue("category")
+	categoryID := r.FormValue("category_id")
 	imageURL := r.FormValue("image_url")
 	stockStr := r.FormValue("stock_quantity")
-	isActiveStr := r.FormValue("is_active")
+	isActive := r.FormValue("is_active") == "true" || r.FormValue("is_active") == "on"
+	thresholdStr := r.FormValue("reorder_threshold")
+	compareAtStr := r.FormValue("compare_at_price")
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 32)
 
-	// Build update request
-	req := clients.UpdateProductRequest{}
+	// Build update request. The edit form always submits the description,
+	// image URL and active checkbox, so those are sent even when empty.
+	req := clients.UpdateProductRequest{
+		Description: &description,
+		ImageURL:    &imageURL,
+		IsActive:    &isActive,
+		Version:     int32(version),
+	}
 
 	if name != "" {
 		req.Name = name
 	}
-	if description != "" {
-		req.Description = description
-	}
 	if priceStr != "" {
-		price, err := strconv.ParseFloat(priceStr, 64)
+		// Prices without a currency are in the product's own
+		price, err := clients.ParseMoney(priceStr, "")
 		if err != nil {
 			http.Redirect(w, r, "/products/"+id+"/edit?error=invalid_price", http.StatusFound)
 			return
 		}
-		req.Price = price
-	}
-	if category != "" {
-		req.Category = category
+		req.Price = &price
 	}
-	if imageURL != "" {
-		req.ImageURL = imageURL
+	if categoryID != "" {
+		req.CategoryID = categoryID
 	}
 	if stockStr != "" {
 		stock, err := strconv.ParseInt(stockStr, 10, 32)
@@ -352,11 +477,31 @@ func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 			http.Redirect(w, r, "/products/"+id+"/edit?error=invalid_stock", http.StatusFound)
 			return
 		}
-		req.StockQuantity = int32(stock)
+		stockQuantity := int32(stock)
+		req.StockQuantity = &stockQuantity
 	}
-	if isActiveStr != "" {
-		isActive := isActiveStr == "true" || isActiveStr == "on"
-		req.IsActive = &isActive
+	// The edit form always submits the threshold, so empty means no alerts
+	if thresholdStr != "" {
+		threshold, err := strconv.ParseInt(thresholdStr, 10, 32)
+		if err != nil {
+			http.Redirect(w, r, "/products/"+id+"/edit?error=invalid_reorder_threshold", http.StatusFound)
+			return
+		}
+		reorderThreshold := int32(threshold)
+		req.ReorderThreshold = &reorderThreshold
+	} else {
+		req.ClearReorderThreshold = true
+	}
+	// Likewise an empty compare-at price removes it
+	if compareAtStr != "" {
+		compareAtPrice, err := clients.ParseMoney(compareAtStr, "")
+		if err != nil || compareAtPrice.Rat().Sign() <= 0 {
+			http.Redirect(w, r, "/products/"+id+"/edit?error=invalid_compare_at_price", http.StatusFound)
+			return
+		}
+		req.CompareAtPrice = &compareAtPrice
+	} else {
+		req.ClearCompareAtPrice = true
 	}
 
 	resp, err := h.apiClient.UpdateProduct(r.Context(), token, id, req)
@@ -365,11 +510,33 @@ func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if resp.Conflict {
+		// Keep what the user typed, based on the current version, so saving
+		// again deliberately overwrites the other change
+		categories := h.getCategories(r)
+		current := resp.Product
+		yours := applyProductUpdate(current, req, categories)
+		h.renderEditProduct(w, map[string]interface{}{
+			"Product":    yours,
+			"Categories": categories,
+			"Conflict":   true,
+			"Changes":    productDiff(yours, current),
+			"User":       user,
+			"Token":      token,
+		})
+		return
+	}
+
 	if !resp.Response.Success {
 		http.Redirect(w, r, "/products/"+id+"/edit?error="+resp.Response.Message, http.StatusFound)
 		return
 	}
 
+	if msg := h.uploadImages(r, token, id); msg != "" {
+		http.Redirect(w, r, "/products/"+id+"/edit?error="+url.QueryEscape(msg), http.StatusFound)
+		return
+	}
+
 	http.Redirect(w, r, "/products/"+id+"/edit?success=product_updated", http.StatusFound)
 }
 
@@ -393,16 +560,108 @@ func (h *ProductHandler) DeleteProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := h.apiClient.DeleteProduct(r.Context(), token, id)
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 32)
+
+	resp, err := h.apiClient.DeleteProduct(r.Context(), token, id, int32(version))
 	if err != nil {
 		http.Redirect(w, r, "/products/"+id+"/edit?error=server_error", http.StatusFound)
 		return
 	}
 
+	if resp.Conflict {
+		http.Redirect(w, r, "/products/"+id+"/edit?error=delete_conflict", http.StatusFound)
+		return
+	}
+
 	if !resp.Response.Success {
 		http.Redirect(w, r, "/products/"+id+"/edit?error="+resp.Response.Message, http.StatusFound)
 		return
 	}
 
 	http.Redirect(w, r, "/products?success=product_deleted", http.StatusFound)
-}
\ No newline at end of file
+}
+
+// uploadImages forwards any files from the form's "images" field and returns
+// a message describing the failure, or "" when there was nothing to do
+func (h *ProductHandler) uploadImages(r *http.Request, token, productID string) string {
+	if r.MultipartForm == nil {
+		return ""
+	}
+	files := r.MultipartForm.File["images"]
+	if len(files) == 0 {
+		return ""
+	}
+
+	resp, err := h.apiClient.UploadProductImages(r.Context(), token, productID, files)
+	if err != nil {
+		return "image upload failed"
+	}
+	if !resp.Response.Success {
+		return resp.Response.Message
+	}
+	return ""
+}
+
+func (h *ProductHandler) DeleteProductImage(w http.ResponseWriter, r *http.Request) {
+	_, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || token == "" {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	resp, err := h.apiClient.DeleteProductImage(r.Context(), token, id, vars["imageId"])
+	if err != nil {
+		http.Redirect(w, r, "/products/"+id+"/edit?error=server_error", http.StatusFound)
+		return
+	}
+
+	if !resp.Response.Success {
+		http.Redirect(w, r, "/products/"+id+"/edit?error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/products/"+id+"/edit?success=image_deleted", http.StatusFound)
+}
+
+// MakePrimaryImage moves one image to the front, which also makes it the
+// product's main image
+func (h *ProductHandler) MakePrimaryImage(w http.ResponseWriter, r *http.Request) {
+	_, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || token == "" {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	imageID := vars["imageId"]
+
+	productResp, err := h.apiClient.GetProduct(r.Context(), id)
+	if err != nil || !productResp.Response.Success {
+		http.Redirect(w, r, "/products/"+id+"/edit?error=server_error", http.StatusFound)
+		return
+	}
+
+	order := []string{imageID}
+	for _, image := range productResp.Product.Images {
+		if image.ID != imageID {
+			order = append(order, image.ID)
+		}
+	}
+
+	resp, err := h.apiClient.ReorderProductImages(r.Context(), token, id, order)
+	if err != nil {
+		http.Redirect(w, r, "/products/"+id+"/edit?error=server_error", http.StatusFound)
+		return
+	}
+
+	if !resp.Response.Success {
+		http.Redirect(w, r, "/products/"+id+"/edit?error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/products/"+id+"/edit?success=image_updated", http.StatusFound)
+}
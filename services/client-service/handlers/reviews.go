@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+// reviewSorts maps the review sort choices on the product page to the API's
+// sort fields
+var reviewSorts = map[string][2]string{
+	"newest":  {"created_at", "desc"},
+	"highest": {"rating", "desc"},
+	"lowest":  {"rating", "asc"},
+}
+
+// reviewView is a review as the product page shows it
+type reviewView struct {
+	clients.Review
+	Stars []string
+	// Mine marks the signed-in user's own review
+	Mine bool
+}
+
+// starIcons turns a rating into five Font Awesome star classes, rounding to
+// the nearest half star
+func starIcons(rating float64) []string {
+	halves := int(math.Round(rating * 2))
+	stars := make([]string, 5)
+	for i := range stars {
+		switch {
+		case halves >= 2*(i+1):
+			stars[i] = "fas fa-star"
+		case halves == 2*i+1:
+			stars[i] = "fas fa-star-half-alt"
+		default:
+			stars[i] = "far fa-star"
+		}
+	}
+	return stars
+}
+
+// loadReviews adds a page of the product's reviews to the product page data,
+// along with the signed-in user's own review if they have written one
+func (h *ProductHandler) loadReviews(r *http.Request, data map[string]interface{}, productID string, user *clients.User, token string) {
+	query := r.URL.Query()
+	sort := query.Get("review_sort")
+	if _, ok := reviewSorts[sort]; !ok {
+		sort = "newest"
+	}
+	page, _ := strconv.Atoi(query.Get("reviews_page"))
+
+	params := clients.ReviewListParams{
+		Page:      page,
+		Limit:     10,
+		SortBy:    reviewSorts[sort][0],
+		SortOrder: reviewSorts[sort][1],
+	}
+	data["ReviewSort"] = sort
+
+	resp, err := h.apiClient.ListProductReviews(r.Context(), token, productID, params)
+	if err != nil || !resp.Response.Success {
+		data["ReviewsError"] = true
+		return
+	}
+
+	views := make([]reviewView, 0, len(resp.Reviews))
+	for _, review := range resp.Reviews {
+		view := reviewView{Review: review, Stars: starIcons(float64(review.Rating))}
+		if user != nil && review.UserID == user.ID {
+			view.Mine = true
+			// The API lists the viewer's own review first
+			mine := view
+			data["MyReview"] = &mine
+		}
+		views = append(views, view)
+	}
+
+	data["Reviews"] = views
+	data["ReviewPage"] = resp.Pagination
+	data["ReviewPrevPage"] = resp.Pagination.Page - 1
+	data["ReviewNextPage"] = resp.Pagination.Page + 1
+}
+
+// SubmitReview creates the user's review of a product, or updates it when
+// the form carries the review's ID
+func (h *ProductHandler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	rating, err := strconv.Atoi(r.FormValue("rating"))
+	if err != nil || rating < 1 || rating > 5 {
+		redirectToReviews(w, r, id, "error", "invalid_rating")
+		return
+	}
+
+	req := clients.ReviewRequest{
+		Rating: int32(rating),
+		Title:  strings.TrimSpace(r.FormValue("title")),
+		Body:   strings.TrimSpace(r.FormValue("body")),
+	}
+
+	var resp *clients.ReviewResponse
+	if reviewID := r.FormValue("review_id"); reviewID != "" {
+		resp, err = h.apiClient.UpdateReview(r.Context(), token, reviewID, req)
+	} else {
+		resp, err = h.apiClient.CreateReview(r.Context(), token, id, req)
+	}
+	if err != nil {
+		redirectToReviews(w, r, id, "error", "review_failed")
+		return
+	}
+
+	if !resp.Response.Success {
+		redirectToReviews(w, r, id, "error", resp.Response.Message)
+		return
+	}
+
+	redirectToReviews(w, r, id, "success", "review_submitted")
+}
+
+// DeleteReview removes the user's own review, or any review for admins
+func (h *ProductHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	resp, err := h.apiClient.DeleteReview(r.Context(), token, vars["reviewId"])
+	if err != nil {
+		redirectToReviews(w, r, id, "error", "server_error")
+		return
+	}
+
+	if !resp.Response.Success {
+		redirectToReviews(w, r, id, "error", resp.Response.Message)
+		return
+	}
+
+	redirectToReviews(w, r, id, "success", "review_deleted")
+}
+
+// ModerateReview approves or rejects a review; the API only lets admins
+func (h *ProductHandler) ModerateReview(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	status := r.FormValue("status")
+
+	resp, err := h.apiClient.ModerateReview(r.Context(), token, vars["reviewId"], status, strings.TrimSpace(r.FormValue("note")))
+	if err != nil {
+		redirectToReviews(w, r, id, "error", "server_error")
+		return
+	}
+
+	if !resp.Response.Success {
+		redirectToReviews(w, r, id, "error", resp.Response.Message)
+		return
+	}
+
+	redirectToReviews(w, r, id, "success", "review_"+status)
+}
+
+func redirectToReviews(w http.ResponseWriter, r *http.Request, productID, key, value string) {
+	http.Redirect(w, r, "/products/"+productID+"?"+key+"="+url.QueryEscape(value)+"#reviews", http.StatusFound)
+}
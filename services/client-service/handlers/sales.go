@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+// saleTimeLayout is what datetime-local inputs submit; the times are read in
+// the server's time zone, which the form shows
+const saleTimeLayout = "2006-01-02T15:04"
+
+// SchedulePriceSale puts a product on sale for a while
+func (h *ProductHandler) SchedulePriceSale(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	salesURL := "/products/" + id + "?tab=sales"
+
+	// The sale is in the product's currency
+	salePrice, err := clients.ParseMoney(r.FormValue("sale_price"), "")
+	if err != nil || salePrice.Rat().Sign() <= 0 {
+		http.Redirect(w, r, salesURL+"&error=invalid_sale_price", http.StatusFound)
+		return
+	}
+
+	var startsAt time.Time
+	if value := r.FormValue("starts_at"); value != "" {
+		startsAt, err = time.ParseInLocation(saleTimeLayout, value, time.Local)
+		if err != nil {
+			http.Redirect(w, r, salesURL+"&error=invalid_sale_dates", http.StatusFound)
+			return
+		}
+	}
+	endsAt, err := time.ParseInLocation(saleTimeLayout, r.FormValue("ends_at"), time.Local)
+	if err != nil || (!startsAt.IsZero() && !endsAt.After(startsAt)) || !endsAt.After(time.Now()) {
+		http.Redirect(w, r, salesURL+"&error=invalid_sale_dates", http.StatusFound)
+		return
+	}
+
+	resp, err := h.apiClient.CreatePriceSchedule(r.Context(), token, id, salePrice, startsAt, endsAt)
+	if err != nil {
+		http.Redirect(w, r, salesURL+"&error=server_error", http.StatusFound)
+		return
+	}
+
+	if !resp.Response.Success {
+		http.Redirect(w, r, salesURL+"&error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, salesURL+"&success=sale_scheduled", http.StatusFound)
+}
+
+// CancelPriceSale stops a scheduled or running sale
+func (h *ProductHandler) CancelPriceSale(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	salesURL := "/products/" + id + "?tab=sales"
+
+	resp, err := h.apiClient.CancelPriceSchedule(r.Context(), token, id, vars["scheduleId"])
+	if err != nil {
+		http.Redirect(w, r, salesURL+"&error=server_error", http.StatusFound)
+		return
+	}
+
+	if !resp.Response.Success {
+		http.Redirect(w, r, salesURL+"&error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, salesURL+"&success=sale_cancelled", http.StatusFound)
+}
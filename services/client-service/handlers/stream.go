@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// StreamProducts relays the gateway's product change stream, so pages can
+// open it on their own origin. Only the filter parameters are passed on.
+func (h *ProductHandler) StreamProducts(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	query := url.Values{}
+	for _, key := range []string{"category_id", "product_id"} {
+		if values := r.URL.Query()[key]; len(values) > 0 {
+			query[key] = values
+		}
+	}
+
+	resp, err := h.apiClient.StreamProducts(r.Context(), query)
+	if err != nil {
+		log.Printf("Stream products error: %v", err)
+		http.Error(w, "Product updates are unavailable", http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Events are small and have to arrive as they happen, so every read is
+	// passed on right away
+	buf := make([]byte, 4096)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
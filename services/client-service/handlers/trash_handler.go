@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+// ShowTrash lists deleted products so admins can restore them before they
+// are purged
+func (h *ProductHandler) ShowTrash(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+	if !user.IsAdmin() {
+		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape("Only admins can view the trash"), http.StatusFound)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+
+	resp, err := h.apiClient.ListDeletedProducts(r.Context(), token, page, 20)
+	if err != nil {
+		http.Error(w, "Failed to fetch the trash", http.StatusInternalServerError)
+		return
+	}
+	if !resp.Response.Success {
+		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/products/trash.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":      "Trash",
+		"User":       user,
+		"Products":   resp.Products,
+		"Page":       resp.Pagination.Page,
+		"TotalCount": resp.Pagination.TotalCount,
+		"HasPrev":    resp.Pagination.HasPrev,
+		"HasNext":    resp.Pagination.HasNext,
+		"PrevPage":   resp.Pagination.Page - 1,
+		"NextPage":   resp.Pagination.Page + 1,
+		"Success":    r.URL.Query().Get("success"),
+		"Error":      r.URL.Query().Get("error"),
+	}
+
+	tmpl.Execute(w, data)
+}
+
+func (h *ProductHandler) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	resp, err := h.apiClient.RestoreProduct(r.Context(), token, id)
+	if err != nil {
+		http.Redirect(w, r, "/products/trash?error=server_error", http.StatusFound)
+		return
+	}
+
+	if !resp.Response.Success {
+		http.Redirect(w, r, "/products/trash?error="+url.QueryEscape(resp.Response.Message), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/products/trash?success=product_restored", http.StatusFound)
+}
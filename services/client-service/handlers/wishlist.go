@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/martbul/playground_microservices/services/client-service/clients"
+	"github.com/martbul/playground_microservices/services/client-service/utils"
+)
+
+// localizeWishlist fills in the display prices of the saved products that
+// still exist
+func (h *PageHandler) localizeWishlist(w http.ResponseWriter, r *http.Request, wishlist *clients.Wishlist) {
+	code := displayCurrency(w, r)
+	for _, item := range wishlist.Items {
+		if item.Product != nil {
+			localizePrice(h.rates, item.Product, code)
+		}
+	}
+}
+
+// wishlistShareURL is the full link to a shared wishlist on this site
+func wishlistShareURL(r *http.Request, shareToken string) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + "/wishlists/shared/" + url.PathEscape(shareToken)
+}
+
+// wishlistRedirect goes back to where the wishlist was changed from: the
+// product page for saves, otherwise the dashboard
+func wishlistRedirect(w http.ResponseWriter, r *http.Request, fallback, key, value string) {
+	target := fallback
+	if next := r.FormValue("next"); next != "" && next[0] == '/' && (len(next) == 1 || next[1] != '/') {
+		target = next
+	}
+	http.Redirect(w, r, target+"?"+key+"="+url.QueryEscape(value), http.StatusFound)
+}
+
+// AddToWishlist saves the product for later
+func (h *PageHandler) AddToWishlist(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	if _, err := h.apiClient.AddToWishlist(r.Context(), token, r.FormValue("product_id")); err != nil {
+		wishlistRedirect(w, r, "/dashboard", "error", cartError(err))
+		return
+	}
+
+	wishlistRedirect(w, r, "/dashboard", "success", "Saved to your wishlist")
+}
+
+func (h *PageHandler) RemoveFromWishlist(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	if _, err := h.apiClient.RemoveFromWishlist(r.Context(), token, mux.Vars(r)["productId"]); err != nil {
+		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/dashboard?success="+url.QueryEscape("Removed from your wishlist"), http.StatusFound)
+}
+
+// MoveWishlistItem moves a saved product one place up or down
+func (h *PageHandler) MoveWishlistItem(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	resp, err := h.apiClient.GetWishlist(r.Context(), token)
+	if err != nil || resp.Wishlist == nil {
+		http.Redirect(w, r, "/dashboard?error=server_error", http.StatusFound)
+		return
+	}
+
+	productIDs := make([]string, 0, len(resp.Wishlist.Items))
+	for _, item := range resp.Wishlist.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+
+	productID := mux.Vars(r)["productId"]
+	for i, id := range productIDs {
+		if id != productID {
+			continue
+		}
+		j := i - 1
+		if r.FormValue("direction") == "down" {
+			j = i + 1
+		}
+		if j >= 0 && j < len(productIDs) {
+			productIDs[i], productIDs[j] = productIDs[j], productIDs[i]
+		}
+		break
+	}
+
+	if _, err := h.apiClient.ReorderWishlist(r.Context(), token, productIDs); err != nil {
+		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/dashboard#wishlist", http.StatusFound)
+}
+
+// ShareWishlist turns the wishlist's share link on or off
+func (h *PageHandler) ShareWishlist(w http.ResponseWriter, r *http.Request) {
+	user, token, err := utils.GetUserFromSession(r, h.store)
+	if err != nil || user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	shared := r.FormValue("shared") == "true"
+	if _, err := h.apiClient.ShareWishlist(r.Context(), token, shared); err != nil {
+		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape(cartError(err)), http.StatusFound)
+		return
+	}
+
+	message := "Your wishlist is no longer shared"
+	if shared {
+		message = "Anyone with the link can now see your wishlist"
+	}
+	http.Redirect(w, r, "/dashboard?success="+url.QueryEscape(message), http.StatusFound)
+}
+
+// SharedWishlist shows a wishlist someone shared by link
+func (h *PageHandler) SharedWishlist(w http.ResponseWriter, r *http.Request) {
+	user, _, _ := utils.GetUserFromSession(r, h.store)
+
+	resp, err := h.apiClient.GetSharedWishlist(r.Context(), mux.Vars(r)["token"])
+	if err != nil || resp.Wishlist == nil {
+		if err != nil {
+			log.Printf("Get shared wishlist error: %v", err)
+		}
+		h.NotFound(w, r)
+		return
+	}
+	h.localizeWishlist(w, r, resp.Wishlist)
+
+	tmpl, err := template.ParseFiles(
+		"templates/layout/base.html",
+		"templates/pages/wishlist.html",
+	)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":    "Wishlist",
+		"User":     user,
+		"Wishlist": resp.Wishlist,
+	}
+
+	tmpl.Execute(w, data)
+}
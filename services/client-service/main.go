@@ -6,6 +6,7 @@ import (
 
 	"github.com/martbul/playground_microservices/services/client-service/clients"
 	"github.com/martbul/playground_microservices/services/client-service/config"
+	"github.com/martbul/playground_microservices/services/client-service/currency"
 	"github.com/martbul/playground_microservices/services/client-service/handlers"
 	"github.com/martbul/playground_microservices/services/client-service/middleware"
 	"github.com/martbul/playground_microservices/services/client-service/routes"
@@ -30,10 +31,21 @@ func main() {
 	// Initialize API Gateway client
 	apiClient := clients.NewAPIClient(cfg.APIGatewayURL)
 
+	// Prices are still shown in each product's own currency without rates
+	var rates currency.Provider
+	if staticRates, err := currency.LoadStaticProvider(cfg.ExchangeRatesFile); err != nil {
+		log.Printf("Currency conversion disabled: %v", err)
+	} else {
+		rates = staticRates
+	}
+
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(apiClient, store)
-	productHandler := handlers.NewProductHandler(apiClient, store)
-	pageHandler := handlers.NewPageHandler(apiClient, store)
+	productHandler := handlers.NewProductHandler(apiClient, store, rates)
+	pageHandler := handlers.NewPageHandler(apiClient, store, rates)
+	cartHandler := handlers.NewCartHandler(apiClient, store)
+	orderHandler := handlers.NewOrderHandler(apiClient, store)
+	notificationHandler := handlers.NewNotificationHandler(apiClient, store)
 
 	// Create router
 	router := mux.NewRouter()
@@ -45,9 +57,10 @@ func main() {
 	// Apply middleware
 	router.Use(middleware.LoggingMiddleware)
 	router.Use(middleware.SessionMiddleware(store))
+	router.Use(middleware.StorefrontMiddleware)
 
 	// Setup routes
-	routes.SetupRoutesWithStore(router, authHandler, productHandler, pageHandler, store)
+	routes.SetupRoutesWithStore(router, authHandler, productHandler, pageHandler, cartHandler, orderHandler, notificationHandler, store)
 
 	log.Printf("Client service starting on port %s", cfg.Port)
 	log.Fatal(http.ListenAndServe(":"+cfg.Port, router))
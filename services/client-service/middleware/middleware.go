@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/gorilla/sessions"
+
+	"github.com/martbul/playground_microservices/services/client-service/clients"
 )
 
 // LoggingMiddleware logs HTTP requests
@@ -38,6 +40,14 @@ func SessionMiddleware(store *sessions.CookieStore) func(http.Handler) http.Hand
 	}
 }
 
+// StorefrontMiddleware remembers the host each request was made to, so the
+// API gateway serves the store for that host
+func StorefrontMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(clients.WithStorefrontHost(r.Context(), r.Host)))
+	})
+}
+
 // AuthRequiredMiddleware redirects to login if not authenticated
 func AuthRequiredMiddleware(store *sessions.CookieStore) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
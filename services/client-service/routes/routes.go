@@ -13,6 +13,9 @@ func SetupRoutesWithStore(
 	authHandler *handlers.AuthHandler,
 	productHandler *handlers.ProductHandler,
 	pageHandler *handlers.PageHandler,
+	cartHandler *handlers.CartHandler,
+	orderHandler *handlers.OrderHandler,
+	notificationHandler *handlers.NotificationHandler,
 	store *sessions.CookieStore,
 ) {
 	// Public routes
@@ -37,14 +40,56 @@ func SetupRoutesWithStore(
 
 	// Product routes (public + protected)
 	router.HandleFunc("/products", productHandler.ListProducts).Methods("GET")
+	router.HandleFunc("/products/stream", productHandler.StreamProducts).Methods("GET")
 	router.HandleFunc("/products/{id}", productHandler.ShowProduct).Methods("GET")
 
+	// Cart routes; guests have a cart too
+	router.HandleFunc("/cart", cartHandler.ShowCart).Methods("GET")
+	router.HandleFunc("/cart/items", cartHandler.AddToCart).Methods("POST")
+	router.HandleFunc("/cart/items/{itemId}", cartHandler.UpdateCartItem).Methods("POST")
+	router.HandleFunc("/cart/items/{itemId}/remove", cartHandler.RemoveCartItem).Methods("POST")
+	router.HandleFunc("/cart/clear", cartHandler.ClearCart).Methods("POST")
+	router.HandleFunc("/cart/promotion", cartHandler.ApplyPromotion).Methods("POST")
+	router.HandleFunc("/cart/promotion/remove", cartHandler.RemovePromotion).Methods("POST")
+
+	// Order routes
+	protectedRouter.HandleFunc("/checkout", orderHandler.Checkout).Methods("POST")
+	protectedRouter.HandleFunc("/orders", orderHandler.ListOrders).Methods("GET")
+	protectedRouter.HandleFunc("/orders/{id}", orderHandler.ShowOrder).Methods("GET")
+	protectedRouter.HandleFunc("/orders/{id}/cancel", orderHandler.CancelOrder).Methods("POST")
+	protectedRouter.HandleFunc("/orders/{id}/status", orderHandler.UpdateOrderStatus).Methods("POST")
+
+	// Notification routes
+	protectedRouter.HandleFunc("/notifications", notificationHandler.ShowNotifications).Methods("GET")
+	protectedRouter.HandleFunc("/notifications/read", notificationHandler.MarkNotificationsRead).Methods("POST")
+	protectedRouter.HandleFunc("/notifications/unread-count", notificationHandler.UnreadCount).Methods("GET")
+
+	// Wishlist routes; shared wishlists are public
+	router.HandleFunc("/wishlists/shared/{token}", pageHandler.SharedWishlist).Methods("GET")
+	protectedRouter.HandleFunc("/wishlist/items", pageHandler.AddToWishlist).Methods("POST")
+	protectedRouter.HandleFunc("/wishlist/items/{productId}/remove", pageHandler.RemoveFromWishlist).Methods("POST")
+	protectedRouter.HandleFunc("/wishlist/items/{productId}/move", pageHandler.MoveWishlistItem).Methods("POST")
+	protectedRouter.HandleFunc("/wishlist/share", pageHandler.ShareWishlist).Methods("POST")
+
 	// Protected product routes
 	protectedRouter.HandleFunc("/products/create", productHandler.ShowCreateProduct).Methods("GET")
 	protectedRouter.HandleFunc("/products/create", productHandler.CreateProduct).Methods("POST")
+	protectedRouter.HandleFunc("/products/import", productHandler.ShowImportProducts).Methods("GET")
+	protectedRouter.HandleFunc("/products/import", productHandler.ImportProducts).Methods("POST")
+	protectedRouter.HandleFunc("/products/export", productHandler.ExportProducts).Methods("GET")
+	protectedRouter.HandleFunc("/products/trash", productHandler.ShowTrash).Methods("GET")
+	protectedRouter.HandleFunc("/products/{id}/restore", productHandler.RestoreProduct).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/revert", productHandler.RevertProduct).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/sales", productHandler.SchedulePriceSale).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/sales/{scheduleId}/cancel", productHandler.CancelPriceSale).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/reviews", productHandler.SubmitReview).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/reviews/{reviewId}/delete", productHandler.DeleteReview).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/reviews/{reviewId}/moderate", productHandler.ModerateReview).Methods("POST")
 	protectedRouter.HandleFunc("/products/{id}/edit", productHandler.ShowEditProduct).Methods("GET")
 	protectedRouter.HandleFunc("/products/{id}/edit", productHandler.UpdateProduct).Methods("POST")
 	protectedRouter.HandleFunc("/products/{id}/delete", productHandler.DeleteProduct).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/images/{imageId}/delete", productHandler.DeleteProductImage).Methods("POST")
+	protectedRouter.HandleFunc("/products/{id}/images/{imageId}/primary", productHandler.MakePrimaryImage).Methods("POST")
 
 	// 404 handler
 	router.NotFoundHandler = router.NewRoute().HandlerFunc(pageHandler.NotFound).GetHandler()
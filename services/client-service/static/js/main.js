@@ -0,0 +1,155 @@
+// Live product updates. Elements with data-product-id are watched over
+// /products/stream; inside them, data-live-price, data-live-original and
+// data-live-stock are kept current. Prices shown in another currency than
+// the product's aren't marked, since they can't be worked out here.
+(function () {
+    const containers = document.querySelectorAll('[data-product-id]');
+    if (containers.length === 0 || !window.EventSource) {
+        return;
+    }
+
+    const ids = Array.from(new Set(Array.from(containers, function (el) {
+        return el.dataset.productId;
+    })));
+
+    function amount(money) {
+        return Number(money.units || 0) + (money.nanos || 0) / 1e9;
+    }
+
+    function formatMoney(money) {
+        try {
+            return new Intl.NumberFormat('en-US', {
+                style: 'currency',
+                currency: money.currency_code
+            }).format(amount(money));
+        } catch (e) {
+            return money.currency_code + ' ' + amount(money).toFixed(2);
+        }
+    }
+
+    // Matches Product.OriginalPrice on the server
+    function originalPrice(product) {
+        const effective = product.effective_price || product.price;
+        if (product.compare_at_price && amount(product.compare_at_price) > amount(effective)) {
+            return product.compare_at_price;
+        }
+        if (amount(product.price) > amount(effective)) {
+            return product.price;
+        }
+        return null;
+    }
+
+    function stockHTML(compact, quantity) {
+        const color = quantity > 0 ? 'text-green-600' : 'text-red-600';
+        const icon = quantity > 0 ? 'fa-check-circle' : 'fa-times-circle';
+        if (compact) {
+            return '<span class="' + color + ' text-xs"><i class="fas ' + icon + '"></i> <span></span></span>';
+        }
+        return '<div class="flex items-center ' + color + ' mb-2">' +
+            '<i class="fas ' + icon + ' text-xl mr-2"></i>' +
+            '<span class="font-semibold"></span></div>';
+    }
+
+    function showStock(el, quantity, text) {
+        const compact = el.dataset.liveStock === 'compact';
+        el.innerHTML = stockHTML(compact, quantity);
+        el.querySelector('span span, div span').textContent = text;
+    }
+
+    function apply(container, change) {
+        const product = change.product;
+        const priceEl = container.querySelector('[data-live-price]');
+        const originalEl = container.querySelector('[data-live-original]');
+        const stockEl = container.querySelector('[data-live-stock]');
+        const cartButton = container.querySelector('#add-to-cart');
+
+        if (change.removed || !product) {
+            if (stockEl) {
+                showStock(stockEl, 0, 'No longer available');
+            }
+            if (cartButton) {
+                cartButton.disabled = true;
+                cartButton.classList.add('opacity-50', 'cursor-not-allowed');
+            }
+            return;
+        }
+
+        if (priceEl && product.price) {
+            priceEl.textContent = formatMoney(product.effective_price || product.price);
+        }
+        if (originalEl && product.price) {
+            const original = originalPrice(product);
+            originalEl.textContent = original ? formatMoney(original) : '';
+            originalEl.classList.toggle('hidden', !original);
+        }
+
+        const quantity = product.available_quantity || 0;
+        if (stockEl) {
+            const compact = stockEl.dataset.liveStock === 'compact';
+            let text = 'Out of Stock';
+            if (quantity > 0) {
+                text = compact ? quantity + ' in stock' : 'In Stock (' + quantity + ' available)';
+            }
+            showStock(stockEl, quantity, text);
+        }
+        if (cartButton) {
+            cartButton.disabled = quantity <= 0;
+            cartButton.classList.toggle('opacity-50', quantity <= 0);
+            cartButton.classList.toggle('cursor-not-allowed', quantity <= 0);
+        }
+    }
+
+    function handle(event) {
+        let change;
+        try {
+            change = JSON.parse(event.data);
+        } catch (e) {
+            return;
+        }
+        containers.forEach(function (container) {
+            if (container.dataset.productId === change.product_id) {
+                apply(container, change);
+            }
+        });
+    }
+
+    const source = new EventSource('/products/stream?product_id=' + encodeURIComponent(ids.join(',')));
+    ['product.created', 'product.updated', 'product.deleted', 'product.restored', 'stock.changed'].forEach(function (type) {
+        source.addEventListener(type, handle);
+    });
+    window.addEventListener('beforeunload', function () {
+        source.close();
+    });
+})();
+
+// Unread badge on the notification bell, refreshed every minute while the
+// page is visible
+(function () {
+    const badge = document.querySelector('[data-unread-count]');
+    if (!badge || !window.fetch) {
+        return;
+    }
+
+    function refresh() {
+        if (document.hidden) {
+            return;
+        }
+        fetch('/notifications/unread-count', { credentials: 'same-origin' })
+            .then(function (resp) {
+                return resp.ok ? resp.json() : null;
+            })
+            .then(function (body) {
+                if (!body) {
+                    return;
+                }
+                const count = body.unread_count || 0;
+                badge.textContent = count > 99 ? '99+' : String(count);
+                badge.classList.toggle('hidden', count === 0);
+            })
+            .catch(function () {});
+    }
+
+    refresh();
+    setInterval(refresh, 60000);
+    document.addEventListener('visibilitychange', refresh);
+})();
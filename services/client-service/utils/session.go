@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"net/http"
 
 	"github.com/gorilla/sessions"
@@ -57,6 +59,79 @@ func ClearSession(w http.ResponseWriter, r *http.Request, store *sessions.Cookie
 	return session.Save(r, w)
 }
 
+// GetCartSession returns the guest's cart session, or "" if they haven't
+// added anything to a cart yet
+func GetCartSession(r *http.Request, store *sessions.CookieStore) string {
+	session, err := store.Get(r, "session")
+	if err != nil {
+		return ""
+	}
+
+	cartSession, _ := session.Values["cart_session"].(string)
+	return cartSession
+}
+
+// EnsureCartSession returns the guest's cart session, starting one if needed
+func EnsureCartSession(w http.ResponseWriter, r *http.Request, store *sessions.CookieStore) (string, error) {
+	if cartSession := GetCartSession(r, store); cartSession != "" {
+		return cartSession, nil
+	}
+
+	session, err := store.Get(r, "session")
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	cartSession := hex.EncodeToString(buf)
+	session.Values["cart_session"] = cartSession
+
+	return cartSession, session.Save(r, w)
+}
+
+// ClearCartSession forgets the guest's cart session once their cart has been
+// merged into their account's
+func ClearCartSession(w http.ResponseWriter, r *http.Request, store *sessions.CookieStore) error {
+	session, err := store.Get(r, "session")
+	if err != nil {
+		return err
+	}
+
+	delete(session.Values, "cart_session")
+	return session.Save(r, w)
+}
+
+// GetPromotionCode returns the promotion code the user entered on the cart
+// page, or "" if there is none
+func GetPromotionCode(r *http.Request, store *sessions.CookieStore) string {
+	session, err := store.Get(r, "session")
+	if err != nil {
+		return ""
+	}
+
+	code, _ := session.Values["promotion_code"].(string)
+	return code
+}
+
+// SetPromotionCode remembers the promotion code for checkout; an empty code
+// forgets it
+func SetPromotionCode(w http.ResponseWriter, r *http.Request, store *sessions.CookieStore, code string) error {
+	session, err := store.Get(r, "session")
+	if err != nil {
+		return err
+	}
+
+	if code == "" {
+		delete(session.Values, "promotion_code")
+	} else {
+		session.Values["promotion_code"] = code
+	}
+	return session.Save(r, w)
+}
+
 // IsAuthenticated checks if user is authenticated
 func IsAuthenticated(r *http.Request, store *sessions.CookieStore) bool {
 	session, err := store.Get(r, "session")
@@ -0,0 +1,32 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+)
+
+// ErrNoRecipient is returned by channels that have nowhere to send a
+// message, such as email without an address; the channel is skipped
+var ErrNoRecipient = errors.New("no recipient for this channel")
+
+// Channel delivers rendered messages. Send returns a reference to what it
+// made, such as the inbox entry's ID, if there is one.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, recipient *models.Recipient, msg *models.Message) (string, error)
+}
+
+// Log writes messages to the service log, for development
+type Log struct{}
+
+func (Log) Name() string {
+	return models.ChannelLog
+}
+
+func (Log) Send(ctx context.Context, recipient *models.Recipient, msg *models.Message) (string, error) {
+	log.Printf("Notification %s (%s) to user=%q email=%q: %s\n%s", msg.Type, msg.Locale, recipient.UserID, recipient.Email, msg.Subject, msg.Text)
+	return "", nil
+}
@@ -0,0 +1,181 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+)
+
+// smtpTimeout bounds a whole send when the context has no deadline
+const smtpTimeout = 30 * time.Second
+
+// Email sends messages over SMTP, with an HTML part when the notification
+// type has an HTML template. Relative links are made absolute with
+// linkBase and added at the end.
+type Email struct {
+	host     string
+	addr     string
+	auth     smtp.Auth
+	from     *mail.Address
+	linkBase string
+}
+
+func NewEmail(host, port, user, password, from, linkBase string) (*Email, error) {
+	fromAddr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", from, err)
+	}
+
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, password, host)
+	}
+	return &Email{
+		host:     host,
+		addr:     net.JoinHostPort(host, port),
+		auth:     auth,
+		from:     fromAddr,
+		linkBase: strings.TrimRight(linkBase, "/"),
+	}, nil
+}
+
+func (e *Email) Name() string {
+	return models.ChannelEmail
+}
+
+func (e *Email) Send(ctx context.Context, recipient *models.Recipient, msg *models.Message) (string, error) {
+	if recipient.Email == "" {
+		return "", ErrNoRecipient
+	}
+	to, err := mail.ParseAddress(recipient.Email)
+	if err != nil {
+		return "", fmt.Errorf("invalid email address %q", recipient.Email)
+	}
+
+	message, err := e.compose(to, msg)
+	if err != nil {
+		return "", err
+	}
+	if err := e.deliver(ctx, to.Address, message); err != nil {
+		return "", fmt.Errorf("failed to send email: %w", err)
+	}
+	return "", nil
+}
+
+// compose builds the message: plain text alone, or plain text and HTML as
+// alternatives
+func (e *Email) compose(to *mail.Address, msg *models.Message) ([]byte, error) {
+	text, htmlBody := msg.Text, msg.HTML
+	if msg.Link != "" {
+		link := msg.Link
+		if strings.HasPrefix(link, "/") {
+			link = e.linkBase + link
+		}
+		text += "\n\n" + link
+		if htmlBody != "" {
+			htmlBody += fmt.Sprintf("\n<p><a href=\"%s\">%s</a></p>", html.EscapeString(link), html.EscapeString(link))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", e.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if htmlBody == "" {
+		writePart(&buf, "text/plain; charset=utf-8", text)
+		return buf.Bytes(), nil
+	}
+
+	boundary, err := newBoundary()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	writePart(&buf, "text/plain; charset=utf-8", text)
+	fmt.Fprintf(&buf, "\r\n--%s\r\n", boundary)
+	writePart(&buf, "text/html; charset=utf-8", htmlBody)
+	fmt.Fprintf(&buf, "\r\n--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+func writePart(buf *bytes.Buffer, contentType, body string) {
+	fmt.Fprintf(buf, "Content-Type: %s\r\n", contentType)
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	w := quotedprintable.NewWriter(buf)
+	w.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n")))
+	w.Close()
+}
+
+// deliver talks SMTP itself rather than using smtp.SendMail so the send
+// can be bounded by ctx
+func (e *Email) deliver(ctx context.Context, to string, message []byte) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", e.addr)
+	if err != nil {
+		return err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, e.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: e.host}); err != nil {
+			return err
+		}
+	}
+	if e.auth != nil {
+		if err := client.Auth(e.auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(e.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func newBoundary() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate MIME boundary: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,343 @@
+package channel
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+)
+
+// smtpSession is what the fake server was told during one connection
+type smtpSession struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTP is an SMTP server on a net.Listener that accepts every message
+// unless told otherwise. It offers AUTH PLAIN but not STARTTLS, so the
+// client never leaves plain text.
+type fakeSMTP struct {
+	t        *testing.T
+	listener net.Listener
+
+	// rejectRcpt makes RCPT TO fail with 550; silent makes the server accept
+	// connections and never answer
+	rejectRcpt bool
+	silent     bool
+
+	mu       sync.Mutex
+	sessions []smtpSession
+}
+
+// newFakeSMTP starts a server; configure, if given, sets it up before it
+// accepts anything
+func newFakeSMTP(t *testing.T, configure ...func(*fakeSMTP)) *fakeSMTP {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	f := &fakeSMTP{t: t, listener: listener}
+	for _, c := range configure {
+		c(f)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go f.serve()
+	return f
+}
+
+func (f *fakeSMTP) port() string {
+	_, port, _ := net.SplitHostPort(f.listener.Addr().String())
+	return port
+}
+
+func (f *fakeSMTP) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+	if f.silent {
+		io.Copy(io.Discard, conn)
+		return
+	}
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	var session smtpSession
+
+	reply("220 fake.test ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250-fake.test")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			decoded, err := base64.StdEncoding.DecodeString(initial)
+			if mechanism != "PLAIN" || err != nil {
+				reply("504 unsupported")
+				continue
+			}
+			session.auth = string(decoded)
+			reply("235 authenticated")
+		case "MAIL":
+			session.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			reply("250 ok")
+		case "RCPT":
+			if f.rejectRcpt {
+				reply("550 no such user")
+				continue
+			}
+			session.to = append(session.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			// The CRLF before the final "." belongs to the terminator
+			session.data = strings.TrimSuffix(data.String(), "\r\n")
+			f.mu.Lock()
+			f.sessions = append(f.sessions, session)
+			f.mu.Unlock()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// delivered returns the one message the server accepted
+func (f *fakeSMTP) delivered() smtpSession {
+	f.t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.sessions) != 1 {
+		f.t.Fatalf("server accepted %d messages, want 1", len(f.sessions))
+	}
+	return f.sessions[0]
+}
+
+func newTestEmail(t *testing.T, server *fakeSMTP, user, password string) *Email {
+	t.Helper()
+	email, err := NewEmail("127.0.0.1", server.port(), user, password, "Shop <noreply@shop.test>", "https://shop.test/")
+	if err != nil {
+		t.Fatalf("NewEmail: %v", err)
+	}
+	return email
+}
+
+// readPart decodes a quoted-printable body
+func readPart(t *testing.T, r io.Reader) string {
+	t.Helper()
+	body, err := io.ReadAll(quotedprintable.NewReader(r))
+	if err != nil {
+		t.Fatalf("failed to decode part: %v", err)
+	}
+	return string(body)
+}
+
+func TestEmailSendsPlainText(t *testing.T) {
+	server := newFakeSMTP(t)
+	email := newTestEmail(t, server, "", "")
+
+	msg := &models.Message{
+		Subject: "Ваша поръчка е изпратена",
+		Text:    "Your order is on its way.\nThanks for shopping with us.",
+		Link:    "/orders/42",
+	}
+	recipient := &models.Recipient{UserID: "user-1", Email: "Ada Lovelace <ada@example.com>"}
+	if _, err := email.Send(context.Background(), recipient, msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	session := server.delivered()
+	if session.from != "noreply@shop.test" {
+		t.Errorf("MAIL FROM %q, want noreply@shop.test", session.from)
+	}
+	if len(session.to) != 1 || session.to[0] != "ada@example.com" {
+		t.Errorf("RCPT TO %q, want ada@example.com", session.to)
+	}
+	if session.auth != "" {
+		t.Errorf("client authenticated as %q without credentials", session.auth)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(session.data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	if from := parsed.Header.Get("From"); from != `"Shop" <noreply@shop.test>` {
+		t.Errorf("From %q", from)
+	}
+	if to := parsed.Header.Get("To"); to != `"Ada Lovelace" <ada@example.com>` {
+		t.Errorf("To %q", to)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != msg.Subject {
+		t.Errorf("Subject decodes to %q (%v), want %q", subject, err, msg.Subject)
+	}
+	if _, err := parsed.Header.Date(); err != nil {
+		t.Errorf("Date: %v", err)
+	}
+	if ct := parsed.Header.Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type %q, want text/plain", ct)
+	}
+
+	// Relative links are made absolute and added at the end
+	want := "Your order is on its way.\r\nThanks for shopping with us.\r\n\r\nhttps://shop.test/orders/42"
+	if body := readPart(t, parsed.Body); body != want {
+		t.Errorf("body %q, want %q", body, want)
+	}
+}
+
+func TestEmailSendsHTMLAlternative(t *testing.T) {
+	server := newFakeSMTP(t)
+	email := newTestEmail(t, server, "", "")
+
+	msg := &models.Message{
+		Subject: "Price drop",
+		Text:    "A product on your wishlist is cheaper.",
+		HTML:    "<p>A product on your <b>wishlist</b> is cheaper.</p>",
+		Link:    "https://other.test/p?id=1&ref=mail",
+	}
+	if _, err := email.Send(context.Background(), &models.Recipient{Email: "ada@example.com"}, msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(server.delivered().data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type %q (%v), want multipart/alternative", parsed.Header.Get("Content-Type"), err)
+	}
+
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+	want := []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", "A product on your wishlist is cheaper.\r\n\r\nhttps://other.test/p?id=1&ref=mail"},
+		{"text/html; charset=utf-8", "<p>A product on your <b>wishlist</b> is cheaper.</p>\r\n" +
+			`<p><a href="https://other.test/p?id=1&amp;ref=mail">https://other.test/p?id=1&amp;ref=mail</a></p>`},
+	}
+	for i, w := range want {
+		// multipart.Reader decodes quoted-printable itself and drops the header
+		part, err := parts.NextRawPart()
+		if err != nil {
+			t.Fatalf("part %d: %v", i, err)
+		}
+		if ct := part.Header.Get("Content-Type"); ct != w.contentType {
+			t.Errorf("part %d Content-Type %q, want %q", i, ct, w.contentType)
+		}
+		if cte := part.Header.Get("Content-Transfer-Encoding"); cte != "quoted-printable" {
+			t.Errorf("part %d Content-Transfer-Encoding %q", i, cte)
+		}
+		if body := readPart(t, part); body != w.body {
+			t.Errorf("part %d body %q, want %q", i, body, w.body)
+		}
+	}
+	if _, err := parts.NextRawPart(); err != io.EOF {
+		t.Errorf("want two parts, next part: %v", err)
+	}
+}
+
+func TestEmailAuthenticates(t *testing.T) {
+	server := newFakeSMTP(t)
+	email := newTestEmail(t, server, "mailer", "s3cret")
+
+	msg := &models.Message{Subject: "Hi", Text: "Hello"}
+	if _, err := email.Send(context.Background(), &models.Recipient{Email: "ada@example.com"}, msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if auth := server.delivered().auth; auth != "\x00mailer\x00s3cret" {
+		t.Errorf("AUTH PLAIN %q, want mailer/s3cret", auth)
+	}
+}
+
+func TestEmailReportsFailures(t *testing.T) {
+	msg := &models.Message{Subject: "Hi", Text: "Hello"}
+
+	t.Run("NoAddress", func(t *testing.T) {
+		email := newTestEmail(t, newFakeSMTP(t), "", "")
+		if _, err := email.Send(context.Background(), &models.Recipient{UserID: "user-1"}, msg); !errors.Is(err, ErrNoRecipient) {
+			t.Errorf("Send without an address returned %v, want ErrNoRecipient", err)
+		}
+	})
+
+	t.Run("InvalidAddress", func(t *testing.T) {
+		server := newFakeSMTP(t)
+		email := newTestEmail(t, server, "", "")
+		if _, err := email.Send(context.Background(), &models.Recipient{Email: "not an address"}, msg); err == nil {
+			t.Error("Send to an invalid address succeeded")
+		}
+	})
+
+	t.Run("RecipientRejected", func(t *testing.T) {
+		server := newFakeSMTP(t, func(f *fakeSMTP) { f.rejectRcpt = true })
+		email := newTestEmail(t, server, "", "")
+		_, err := email.Send(context.Background(), &models.Recipient{Email: "ada@example.com"}, msg)
+		if err == nil || !strings.Contains(err.Error(), "550") {
+			t.Errorf("Send returned %v, want the server's 550", err)
+		}
+	})
+
+	t.Run("ServerHangs", func(t *testing.T) {
+		server := newFakeSMTP(t, func(f *fakeSMTP) { f.silent = true })
+		email := newTestEmail(t, server, "", "")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		if _, err := email.Send(ctx, &models.Recipient{Email: "ada@example.com"}, msg); err == nil {
+			t.Error("Send to a silent server succeeded")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Send took %v, want it bounded by the context", elapsed)
+		}
+	})
+
+	t.Run("ServerDown", func(t *testing.T) {
+		server := newFakeSMTP(t)
+		email := newTestEmail(t, server, "", "")
+		server.listener.Close()
+		if _, err := email.Send(context.Background(), &models.Recipient{Email: "ada@example.com"}, msg); err == nil {
+			t.Error("Send with no server succeeded")
+		}
+	})
+}
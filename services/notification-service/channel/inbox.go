@@ -0,0 +1,39 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+	"github.com/martbul/playground_microservices/services/notification-service/repository"
+)
+
+// Inbox stores messages in the user's in-app inbox
+type Inbox struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewInbox(notificationRepo repository.NotificationRepository) *Inbox {
+	return &Inbox{notificationRepo: notificationRepo}
+}
+
+func (i *Inbox) Name() string {
+	return models.ChannelInApp
+}
+
+func (i *Inbox) Send(ctx context.Context, recipient *models.Recipient, msg *models.Message) (string, error) {
+	if recipient.UserID == "" {
+		return "", ErrNoRecipient
+	}
+
+	notification := &models.Notification{
+		UserID: recipient.UserID,
+		Type:   msg.Type,
+		Title:  msg.Title,
+		Body:   msg.Body,
+		Link:   msg.Link,
+	}
+	if err := i.notificationRepo.Create(notification); err != nil {
+		return "", err
+	}
+	return notification.ID, nil
+}
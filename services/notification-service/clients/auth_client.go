@@ -0,0 +1,50 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/notification-service/genproto/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// AuthClient looks up users' email addresses in auth-service
+type AuthClient struct {
+	client pb.AuthServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewAuthClient(address string) (*AuthClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to auth service: %w", err)
+	}
+
+	return &AuthClient{
+		client: pb.NewAuthServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *AuthClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetEmail returns the user's email address. auth-service only answers
+// with a valid token, so the caller's is passed on.
+func (c *AuthClient) GetEmail(ctx context.Context, userID, token string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetUser(ctx, &pb.GetUserRequest{UserId: userID, Token: token})
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if !resp.Response.Success {
+		return "", fmt.Errorf("failed to get user: %s", resp.Response.Message)
+	}
+	return resp.User.Email, nil
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/notification-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/notification-service/genproto/notification"
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+	"github.com/martbul/playground_microservices/services/notification-service/service"
+)
+
+type NotificationGrpcHandler struct {
+	pb.UnimplementedNotificationServiceServer
+	notificationService service.NotificationService
+}
+
+func NewNotificationGrpcHandler(notificationService service.NotificationService) *NotificationGrpcHandler {
+	return &NotificationGrpcHandler{
+		notificationService: notificationService,
+	}
+}
+
+func (h *NotificationGrpcHandler) SendNotification(ctx context.Context, req *pb.SendNotificationRequest) (*pb.SendNotificationResponse, error) {
+	log.Printf("Send notification request: %s to user %q", req.Type, req.UserId)
+
+	// TODO: Validate token; until then trust the caller
+	result, err := h.notificationService.Send(ctx, &models.SendRequest{
+		Type:     req.Type,
+		UserID:   req.UserId,
+		Email:    req.Email,
+		Locale:   req.Locale,
+		Data:     req.Data,
+		Channels: req.Channels,
+		Token:    req.Token,
+	})
+	if err != nil {
+		log.Printf("Send notification error: %v", err)
+		return &pb.SendNotificationResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	resp := &pb.SendNotificationResponse{NotificationId: result.NotificationID}
+	sent := 0
+	for _, channelResult := range result.Results {
+		if channelResult.Status == models.ResultSent {
+			sent++
+		}
+		resp.Results = append(resp.Results, &pb.ChannelResult{
+			Channel: channelResult.Channel,
+			Status:  channelResult.Status,
+			Error:   channelResult.Error,
+		})
+	}
+	resp.Response = &commonPb.Response{
+		Success: true,
+		Message: fmt.Sprintf("Notification sent through %d of %d channels", sent, len(result.Results)),
+	}
+
+	return resp, nil
+}
+
+func (h *NotificationGrpcHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	// TODO: Validate token; until then trust the gateway's user ID
+	notifications, paginationResp, unread, err := h.notificationService.ListNotifications(req.UserId, req.UnreadOnly, pagination)
+	if err != nil {
+		log.Printf("List notifications error: %v", err)
+		return &pb.ListNotificationsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoNotifications := make([]*pb.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		protoNotifications = append(protoNotifications, notificationToProto(notification))
+	}
+
+	return &pb.ListNotificationsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Notifications retrieved successfully",
+		},
+		Notifications: protoNotifications,
+		Pagination: &commonPb.PaginationResponse{
+			Page:       paginationResp.Page,
+			Limit:      paginationResp.Limit,
+			TotalPages: paginationResp.TotalPages,
+			TotalCount: paginationResp.TotalCount,
+			HasNext:    paginationResp.HasNext,
+			HasPrev:    paginationResp.HasPrev,
+		},
+		UnreadCount: unread,
+	}, nil
+}
+
+func (h *NotificationGrpcHandler) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadResponse, error) {
+	log.Printf("Mark notifications read request: user %s (%d ids)", req.UserId, len(req.Ids))
+
+	// TODO: Validate token; until then trust the gateway's user ID
+	marked, unread, err := h.notificationService.MarkRead(req.UserId, req.Ids)
+	if err != nil {
+		log.Printf("Mark notifications read error: %v", err)
+		return &pb.MarkNotificationsReadResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.MarkNotificationsReadResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Notifications marked read",
+		},
+		Marked:      marked,
+		UnreadCount: unread,
+	}, nil
+}
+
+func (h *NotificationGrpcHandler) HealthCheck(ctx context.Context, req *commonPb.HealthCheckRequest) (*commonPb.HealthCheckResponse, error) {
+	return &commonPb.HealthCheckResponse{
+		Status:    "healthy",
+		Service:   "notification-service",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+func notificationToProto(notification *models.Notification) *pb.Notification {
+	protoNotification := &pb.Notification{
+		Id:        notification.ID,
+		UserId:    notification.UserID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Link:      notification.Link,
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+	if notification.ReadAt != nil {
+		protoNotification.ReadAt = notification.ReadAt.Format(time.RFC3339)
+	}
+	return protoNotification
+}
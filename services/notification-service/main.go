@@ -0,0 +1,134 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/notification-service/channel"
+	"github.com/martbul/playground_microservices/services/notification-service/clients"
+	"github.com/martbul/playground_microservices/services/notification-service/config"
+	pb "github.com/martbul/playground_microservices/services/notification-service/genproto/notification"
+	"github.com/martbul/playground_microservices/services/notification-service/handlers"
+	"github.com/martbul/playground_microservices/services/notification-service/render"
+	"github.com/martbul/playground_microservices/services/notification-service/repository"
+	"github.com/martbul/playground_microservices/services/notification-service/service"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Connect to database
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer db.Close()
+
+	// Test database connection
+	if err := db.Ping(); err != nil {
+		log.Fatal("Failed to ping database:", err)
+	}
+
+	// Run migrations
+	if err := runMigrations(db); err != nil {
+		log.Fatal("Failed to run migrations:", err)
+	}
+
+	// Parse every template up front
+	renderer, err := render.Load(cfg.TemplateDir, cfg.DefaultLocale)
+	if err != nil {
+		log.Fatal("Failed to load templates:", err)
+	}
+	log.Printf("Loaded templates for %s", strings.Join(renderer.Types(), ", "))
+
+	// Email addresses that aren't given are looked up in auth-service
+	authClient, err := clients.NewAuthClient(cfg.AuthService)
+	if err != nil {
+		log.Fatal("Failed to create auth client:", err)
+	}
+	defer authClient.Close()
+
+	// Initialize repository
+	notificationRepo := repository.NewNotificationRepository(db)
+
+	channels, err := newChannels(cfg, notificationRepo)
+	if err != nil {
+		log.Fatal("Failed to set up channels:", err)
+	}
+
+	// Initialize service
+	notificationService := service.NewNotificationService(notificationRepo, renderer, channels, authClient)
+
+	// Initialize handler
+	notificationHandler := handlers.NewNotificationGrpcHandler(notificationService)
+
+	// Create gRPC server
+	server := grpc.NewServer()
+	pb.RegisterNotificationServiceServer(server, notificationHandler)
+
+	// Start listening
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Port))
+	if err != nil {
+		log.Fatal("Failed to listen:", err)
+	}
+
+	log.Printf("Notification service starting on port %s with channels %s", cfg.Port, strings.Join(cfg.Channels, ", "))
+	if err := server.Serve(lis); err != nil {
+		log.Fatal("Failed to serve:", err)
+	}
+}
+
+// newChannels sets up the channels named in NOTIFICATION_CHANNELS, in order
+func newChannels(cfg *config.Config, notificationRepo repository.NotificationRepository) ([]channel.Channel, error) {
+	var channels []channel.Channel
+	for _, name := range cfg.Channels {
+		switch name {
+		case "in_app":
+			channels = append(channels, channel.NewInbox(notificationRepo))
+		case "log":
+			channels = append(channels, channel.Log{})
+		case "email":
+			if cfg.SMTPHost == "" {
+				return nil, fmt.Errorf("the email channel needs SMTP_HOST")
+			}
+			email, err := channel.NewEmail(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.EmailFrom, cfg.StorefrontURL)
+			if err != nil {
+				return nil, err
+			}
+			channels = append(channels, email)
+		default:
+			return nil, fmt.Errorf("unknown channel %q", name)
+		}
+	}
+	return channels, nil
+}
+
+func runMigrations(db *sql.DB) error {
+	query := `
+	-- The in-app inbox. Users live in auth-service, so user_id isn't a
+	-- foreign key.
+	CREATE TABLE IF NOT EXISTS notifications (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		user_id UUID NOT NULL,
+		type VARCHAR(100) NOT NULL,
+		title VARCHAR(255) NOT NULL,
+		body TEXT NOT NULL DEFAULT '',
+		link VARCHAR(500) NOT NULL DEFAULT '',
+		read_at TIMESTAMP,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_notifications_user_created_at ON notifications(user_id, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_notifications_user_unread ON notifications(user_id) WHERE read_at IS NULL;
+	`
+
+	_, err := db.Exec(query)
+	return err
+}
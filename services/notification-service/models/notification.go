@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+)
+
+// Channels notifications can go through
+const (
+	ChannelEmail = "email"
+	ChannelInApp = "in_app"
+	ChannelLog   = "log"
+)
+
+// How sending through a channel went
+const (
+	ResultSent    = "sent"
+	ResultSkipped = "skipped"
+	ResultFailed  = "failed"
+)
+
+// SendRequest asks for a notification of Type to be rendered in Locale from
+// Data and sent through Channels, or every enabled channel if none
+type SendRequest struct {
+	Type     string
+	UserID   string
+	Email    string
+	Locale   string
+	Data     map[string]string
+	Channels []string
+
+	// Token is used to look up the user's email address when none is given
+	Token string
+}
+
+// Message is a notification rendered for one recipient. Title and Body are
+// what the inbox shows; the email has Subject, Text and, if the type has an
+// HTML template, HTML.
+type Message struct {
+	Type    string
+	Locale  string
+	Title   string
+	Body    string
+	Link    string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Recipient is who a message goes to; either may be empty
+type Recipient struct {
+	UserID string
+	Email  string
+}
+
+// ChannelResult is how sending through one channel went. Error says why a
+// channel failed or was skipped.
+type ChannelResult struct {
+	Channel string
+	Status  string
+	Error   string
+}
+
+// SendResult is the outcome of a SendRequest. NotificationID is the inbox
+// entry, if one was made.
+type SendResult struct {
+	Results        []*ChannelResult
+	NotificationID string
+}
+
+// Notification is an entry in a user's in-app inbox
+type Notification struct {
+	ID        string     `json:"id" db:"id"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	Type      string     `json:"type" db:"type"`
+	Title     string     `json:"title" db:"title"`
+	Body      string     `json:"body" db:"body"`
+	Link      string     `json:"link" db:"link"`
+	ReadAt    *time.Time `json:"read_at" db:"read_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+type PaginationRequest struct {
+	Page      int32
+	Limit     int32
+	SortBy    string
+	SortOrder string
+}
+
+type PaginationResponse struct {
+	Page       int32
+	Limit      int32
+	TotalPages int32
+	TotalCount int64
+	HasNext    bool
+	HasPrev    bool
+}
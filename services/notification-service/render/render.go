@@ -0,0 +1,193 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+)
+
+var typePattern = regexp.MustCompile(`^[a-z0-9_.]+$`)
+
+// templateSet is one notification type in one locale. text defines
+// "title" and "body" for the inbox, and optionally "subject" and "text" for
+// email, which default to the title and body, and "link". html, if there
+// is one, is the email's HTML body.
+type templateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// Renderer renders notifications from the templates in a directory laid
+// out as <type>/<locale>.txt, with an optional <type>/<locale>.html next to
+// it. Every type needs a template in the default locale.
+type Renderer struct {
+	sets          map[string]map[string]*templateSet
+	defaultLocale string
+}
+
+// Load parses every template in dir up front, so a broken one stops the
+// service from starting rather than failing a send
+func Load(dir, defaultLocale string) (*Renderer, error) {
+	r := &Renderer{
+		sets:          make(map[string]map[string]*templateSet),
+		defaultLocale: normalizeLocale(defaultLocale),
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "*.txt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	for _, path := range paths {
+		notificationType := filepath.Base(filepath.Dir(path))
+		if !typePattern.MatchString(notificationType) {
+			return nil, fmt.Errorf("invalid notification type %q in %s", notificationType, path)
+		}
+		locale := normalizeLocale(strings.TrimSuffix(filepath.Base(path), ".txt"))
+
+		set, err := loadSet(path)
+		if err != nil {
+			return nil, err
+		}
+		if r.sets[notificationType] == nil {
+			r.sets[notificationType] = make(map[string]*templateSet)
+		}
+		r.sets[notificationType][locale] = set
+	}
+
+	for notificationType, locales := range r.sets {
+		if locales[r.defaultLocale] == nil {
+			return nil, fmt.Errorf("notification type %q has no %q template", notificationType, r.defaultLocale)
+		}
+	}
+	return r, nil
+}
+
+func loadSet(path string) (*templateSet, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+	text, err := texttemplate.New(filepath.Base(path)).Option("missingkey=error").Parse(string(source))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	for _, name := range []string{"title", "body"} {
+		if text.Lookup(name) == nil {
+			return nil, fmt.Errorf("template %s doesn't define %q", path, name)
+		}
+	}
+	set := &templateSet{text: text}
+
+	htmlPath := strings.TrimSuffix(path, ".txt") + ".html"
+	if source, err := os.ReadFile(htmlPath); err == nil {
+		set.html, err = htmltemplate.New(filepath.Base(htmlPath)).Option("missingkey=error").Parse(string(source))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+
+	return set, nil
+}
+
+// Types lists the notification types there are templates for
+func (r *Renderer) Types() []string {
+	types := make([]string, 0, len(r.sets))
+	for notificationType := range r.sets {
+		types = append(types, notificationType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Render renders a notification in the closest locale there is: "pt-BR"
+// tries pt-br, then pt, then the default locale. Every field the templates
+// use has to be in data.
+func (r *Renderer) Render(notificationType, locale string, data map[string]string) (*models.Message, error) {
+	locales, ok := r.sets[notificationType]
+	if !ok {
+		return nil, fmt.Errorf("unknown notification type %q", notificationType)
+	}
+
+	locale = normalizeLocale(locale)
+	set := locales[locale]
+	if set == nil {
+		locale, _, _ = strings.Cut(locale, "-")
+		set = locales[locale]
+	}
+	if set == nil {
+		locale = r.defaultLocale
+		set = locales[locale]
+	}
+
+	if data == nil {
+		data = map[string]string{}
+	}
+	msg := &models.Message{Type: notificationType, Locale: locale}
+
+	var err error
+	if msg.Title, err = executeText(set.text, "title", data); err != nil {
+		return nil, err
+	}
+	if msg.Body, err = executeText(set.text, "body", data); err != nil {
+		return nil, err
+	}
+	if msg.Link, err = executeText(set.text, "link", data); err != nil {
+		return nil, err
+	}
+	if msg.Subject, err = executeText(set.text, "subject", data); err != nil {
+		return nil, err
+	}
+	if msg.Text, err = executeText(set.text, "text", data); err != nil {
+		return nil, err
+	}
+
+	msg.Title = singleLine(msg.Title)
+	msg.Link = strings.TrimSpace(msg.Link)
+	msg.Subject = singleLine(msg.Subject)
+	if msg.Subject == "" {
+		msg.Subject = msg.Title
+	}
+	if msg.Text == "" {
+		msg.Text = msg.Body
+	}
+
+	if set.html != nil {
+		var buf bytes.Buffer
+		if err := set.html.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render %s email: %w", notificationType, err)
+		}
+		msg.HTML = buf.String()
+	}
+
+	return msg, nil
+}
+
+// executeText renders a named template, or returns "" if it isn't defined
+func executeText(tmpl *texttemplate.Template, name string, data map[string]string) (string, error) {
+	if tmpl.Lookup(name) == nil {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+)
+
+type NotificationRepository interface {
+	Create(notification *models.Notification) error
+	List(userID string, unreadOnly bool, pagination *models.PaginationRequest) ([]*models.Notification, *models.PaginationResponse, error)
+	CountUnread(userID string) (int32, error)
+	// MarkRead marks the given notifications of the user read, or all of
+	// them if ids is empty, and returns how many were unread
+	MarkRead(userID string, ids []string) (int32, error)
+}
+
+type notificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+const notificationSelect = `SELECT id, user_id, type, title, body, link, read_at, created_at FROM notifications`
+
+func scanNotification(row interface{ Scan(...interface{}) error }) (*models.Notification, error) {
+	notification := &models.Notification{}
+	var readAt sql.NullTime
+	err := row.Scan(
+		&notification.ID, &notification.UserID, &notification.Type, &notification.Title,
+		&notification.Body, &notification.Link, &readAt, &notification.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if readAt.Valid {
+		notification.ReadAt = &readAt.Time
+	}
+	return notification, nil
+}
+
+func (r *notificationRepository) Create(notification *models.Notification) error {
+	query := `
+		INSERT INTO notifications (user_id, type, title, body, link)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(query, notification.UserID, notification.Type, notification.Title, notification.Body, notification.Link).
+		Scan(&notification.ID, &notification.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) List(userID string, unreadOnly bool, pagination *models.PaginationRequest) ([]*models.Notification, *models.PaginationResponse, error) {
+	where := ` WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)`
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM notifications`+where, userID, unreadOnly).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	query := notificationSelect + where + ` ORDER BY created_at DESC, id LIMIT $3 OFFSET $4`
+	rows, err := r.db.Query(query, userID, unreadOnly, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []*models.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return notifications, paginationResponse, nil
+}
+
+func (r *notificationRepository) CountUnread(userID string) (int32, error) {
+	var count int32
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+func (r *notificationRepository) MarkRead(userID string, ids []string) (int32, error) {
+	query := `
+		UPDATE notifications SET read_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND read_at IS NULL AND (cardinality($2::uuid[]) = 0 OR id = ANY($2::uuid[]))`
+
+	if ids == nil {
+		ids = []string{}
+	}
+	result, err := r.db.Exec(query, userID, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	marked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return int32(marked), nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/notification-service/channel"
+	"github.com/martbul/playground_microservices/services/notification-service/clients"
+	"github.com/martbul/playground_microservices/services/notification-service/models"
+	"github.com/martbul/playground_microservices/services/notification-service/render"
+	"github.com/martbul/playground_microservices/services/notification-service/repository"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var knownChannels = map[string]bool{
+	models.ChannelEmail: true,
+	models.ChannelInApp: true,
+	models.ChannelLog:   true,
+}
+
+type NotificationService interface {
+	Send(ctx context.Context, req *models.SendRequest) (*models.SendResult, error)
+	// ListNotifications returns a page of the user's inbox and how many of
+	// their notifications are unread
+	ListNotifications(userID string, unreadOnly bool, pagination *models.PaginationRequest) ([]*models.Notification, *models.PaginationResponse, int32, error)
+	// MarkRead returns how many notifications it marked and how many are
+	// still unread
+	MarkRead(userID string, ids []string) (int32, int32, error)
+}
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	renderer         *render.Renderer
+	channels         []channel.Channel
+	authClient       *clients.AuthClient
+}
+
+// NewNotificationService sends through channels, in order. authClient looks
+// up email addresses that weren't given and may be nil.
+func NewNotificationService(notificationRepo repository.NotificationRepository, renderer *render.Renderer, channels []channel.Channel, authClient *clients.AuthClient) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		renderer:         renderer,
+		channels:         channels,
+		authClient:       authClient,
+	}
+}
+
+// Send renders the notification once and hands it to each channel. A
+// channel failing doesn't stop the others; the results say how each went.
+// Only a bad request or a template that can't be rendered is an error.
+func (s *notificationService) Send(ctx context.Context, req *models.SendRequest) (*models.SendResult, error) {
+	notificationType := strings.TrimSpace(req.Type)
+	if notificationType == "" {
+		return nil, fmt.Errorf("notification type is required")
+	}
+	userID := strings.ToLower(strings.TrimSpace(req.UserID))
+	if userID != "" && !uuidPattern.MatchString(userID) {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	requested := make(map[string]bool, len(req.Channels))
+	for _, name := range req.Channels {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !knownChannels[name] {
+			return nil, fmt.Errorf("unknown channel %q", name)
+		}
+		requested[name] = true
+	}
+
+	msg, err := s.renderer.Render(notificationType, req.Locale, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := &models.Recipient{UserID: userID, Email: strings.TrimSpace(req.Email)}
+	result := &models.SendResult{}
+	for _, ch := range s.channels {
+		if len(requested) > 0 && !requested[ch.Name()] {
+			continue
+		}
+		delete(requested, ch.Name())
+
+		channelResult := &models.ChannelResult{Channel: ch.Name(), Status: models.ResultSent}
+		result.Results = append(result.Results, channelResult)
+
+		if ch.Name() == models.ChannelEmail && recipient.Email == "" {
+			if err := s.lookupEmail(ctx, recipient, req.Token); err != nil {
+				channelResult.Status = models.ResultFailed
+				channelResult.Error = err.Error()
+				continue
+			}
+		}
+
+		ref, err := ch.Send(ctx, recipient, msg)
+		switch {
+		case errors.Is(err, channel.ErrNoRecipient):
+			channelResult.Status = models.ResultSkipped
+			channelResult.Error = err.Error()
+		case err != nil:
+			log.Printf("Failed to send %s notification through %s: %v", notificationType, ch.Name(), err)
+			channelResult.Status = models.ResultFailed
+			channelResult.Error = err.Error()
+		case ch.Name() == models.ChannelInApp:
+			result.NotificationID = ref
+		}
+	}
+
+	// Channels asked for that this deployment doesn't have
+	for name := range requested {
+		result.Results = append(result.Results, &models.ChannelResult{
+			Channel: name,
+			Status:  models.ResultSkipped,
+			Error:   "channel is not enabled",
+		})
+	}
+
+	return result, nil
+}
+
+// lookupEmail fills in the user's email address from auth-service, which
+// only answers with a token. Without a user or a token there is nobody to
+// email, and the channel is skipped.
+func (s *notificationService) lookupEmail(ctx context.Context, recipient *models.Recipient, token string) error {
+	if recipient.UserID == "" || token == "" || s.authClient == nil {
+		return nil
+	}
+	email, err := s.authClient.GetEmail(ctx, recipient.UserID, token)
+	if err != nil {
+		return err
+	}
+	recipient.Email = email
+	return nil
+}
+
+func (s *notificationService) ListNotifications(userID string, unreadOnly bool, pagination *models.PaginationRequest) ([]*models.Notification, *models.PaginationResponse, int32, error) {
+	if !uuidPattern.MatchString(userID) {
+		return nil, nil, 0, fmt.Errorf("user ID is required")
+	}
+	userID = strings.ToLower(userID)
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 || pagination.Limit > 100 {
+		pagination.Limit = 20
+	}
+
+	notifications, paginationResp, err := s.notificationRepo.List(userID, unreadOnly, pagination)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return notifications, paginationResp, unread, nil
+}
+
+func (s *notificationService) MarkRead(userID string, ids []string) (int32, int32, error) {
+	if !uuidPattern.MatchString(userID) {
+		return 0, 0, fmt.Errorf("user ID is required")
+	}
+	userID = strings.ToLower(userID)
+	for _, id := range ids {
+		if !uuidPattern.MatchString(id) {
+			return 0, 0, fmt.Errorf("invalid notification ID %q", id)
+		}
+	}
+
+	marked, err := s.notificationRepo.MarkRead(userID, ids)
+	if err != nil {
+		return 0, 0, err
+	}
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return marked, unread, nil
+}
@@ -0,0 +1,61 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/order-service/genproto/notification"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NotificationClient tells buyers about their orders through
+// notification-service
+type NotificationClient struct {
+	client pb.NotificationServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewNotificationClient(address string) (*NotificationClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to notification service: %w", err)
+	}
+
+	return &NotificationClient{
+		client: pb.NewNotificationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *NotificationClient) Close() error {
+	return c.conn.Close()
+}
+
+// Send sends a notification through the channels notification-service has
+// enabled. token lets it look up the user's email address.
+func (c *NotificationClient) Send(ctx context.Context, notificationType, userID string, data map[string]string, token string) error {
+	ctx, cancel := context.WithTimeout(ctx, 45*time.Second)
+	defer cancel()
+
+	resp, err := c.client.SendNotification(ctx, &pb.SendNotificationRequest{
+		Token:  token,
+		Type:   notificationType,
+		UserId: userID,
+		Data:   data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	if !resp.Response.Success {
+		return fmt.Errorf("failed to send notification: %s", resp.Response.Message)
+	}
+	for _, result := range resp.Results {
+		if result.Status == "failed" {
+			return fmt.Errorf("failed to send notification through %s: %s", result.Channel, result.Error)
+		}
+	}
+	return nil
+}
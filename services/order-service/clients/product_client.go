@@ -0,0 +1,163 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/order-service/genproto/product"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ProductClient checks prices with product-service and takes stock for
+// orders
+type ProductClient struct {
+	client pb.ProductServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewProductClient(address string) (*ProductClient, error) {
+	conn, err := grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(forwardStore),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to product service: %w", err)
+	}
+
+	return &ProductClient{
+		client: pb.NewProductServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *ProductClient) Close() error {
+	return c.conn.Close()
+}
+
+// BatchGetProducts returns the products found by ID; deleted and unknown
+// products are left out
+func (c *ProductClient) BatchGetProducts(ctx context.Context, ids []string) (map[string]*pb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.BatchGetProducts(ctx, &pb.BatchGetProductsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("failed to get products: %s", resp.Response.Message)
+	}
+
+	products := make(map[string]*pb.Product, len(resp.Products))
+	for _, product := range resp.Products {
+		products[product.Id] = product
+	}
+	return products, nil
+}
+
+// ListProductVariants returns all of a product's variants, inactive ones
+// included
+func (c *ProductClient) ListProductVariants(ctx context.Context, productID string) ([]*pb.ProductVariant, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListProductVariants(ctx, &pb.ListProductVariantsRequest{ProductId: productID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product variants: %w", err)
+	}
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("failed to get product variants: %s", resp.Response.Message)
+	}
+	return resp.Variants, nil
+}
+
+// ReserveStock holds stock for every item or none of them
+func (c *ProductClient) ReserveStock(ctx context.Context, reference string, items []*pb.ReservationItem, ttl time.Duration) (*pb.StockReservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ReserveStock(ctx, &pb.ReserveStockRequest{
+		Reference:  reference,
+		Items:      items,
+		TtlSeconds: int32(ttl / time.Second),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve stock: %w", err)
+	}
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("failed to reserve stock: %s", resp.Response.Message)
+	}
+	return resp.Reservation, nil
+}
+
+// CommitReservation takes the reserved items off on-hand stock; committing
+// twice is harmless
+func (c *ProductClient) CommitReservation(ctx context.Context, reservationID string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CommitReservation(ctx, &pb.CommitReservationRequest{ReservationId: reservationID})
+	if err != nil {
+		return fmt.Errorf("failed to commit stock reservation: %w", err)
+	}
+	if !resp.Response.Success {
+		return fmt.Errorf("failed to commit stock reservation: %s", resp.Response.Message)
+	}
+	return nil
+}
+
+// ReleaseReservation gives reserved stock back; releasing a released or
+// expired reservation is harmless
+func (c *ProductClient) ReleaseReservation(ctx context.Context, reservationID string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ReleaseReservation(ctx, &pb.ReleaseReservationRequest{ReservationId: reservationID})
+	if err != nil {
+		return fmt.Errorf("failed to release stock reservation: %w", err)
+	}
+	if !resp.Response.Success {
+		return fmt.Errorf("failed to release stock reservation: %s", resp.Response.Message)
+	}
+	return nil
+}
+
+// AdjustStock adds to (or with a negative delta, removes from) on-hand stock
+func (c *ProductClient) AdjustStock(ctx context.Context, productID, variantID string, delta int32, reason, reference string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.AdjustStock(ctx, &pb.AdjustStockRequest{
+		ProductId: productID,
+		VariantId: variantID,
+		Delta:     delta,
+		Reason:    reason,
+		Reference: reference,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to adjust stock: %w", err)
+	}
+	if !resp.Response.Success {
+		return fmt.Errorf("failed to adjust stock: %s", resp.Response.Message)
+	}
+	return nil
+}
+
+// GetCategories returns every category, for working out which categories
+// sit under which
+func (c *ProductClient) GetCategories(ctx context.Context) ([]*pb.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetCategories(ctx, &pb.GetCategoriesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	if !resp.Response.Success {
+		return nil, fmt.Errorf("failed to get categories: %s", resp.Response.Message)
+	}
+	return resp.Categories, nil
+}
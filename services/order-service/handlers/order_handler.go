@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/order-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/order-service/genproto/order"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+	"github.com/martbul/playground_microservices/services/order-service/service"
+)
+
+type OrderGrpcHandler struct {
+	pb.UnimplementedOrderServiceServer
+	orderService     service.OrderService
+	promotionService service.PromotionService
+}
+
+func NewOrderGrpcHandler(orderService service.OrderService, promotionService service.PromotionService) *OrderGrpcHandler {
+	return &OrderGrpcHandler{
+		orderService:     orderService,
+		promotionService: promotionService,
+	}
+}
+
+func (h *OrderGrpcHandler) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.CheckoutResponse, error) {
+	log.Printf("Checkout request: user %s (%d items)", req.UserId, len(req.Items))
+
+	// TODO: Validate token; until then trust the gateway's user ID
+	checkoutReq := &models.CheckoutRequest{
+		UserID:         req.UserId,
+		Items:          checkoutItemsFromProto(req.Items),
+		Currency:       req.CurrencyCode,
+		PaymentToken:   req.PaymentToken,
+		Token:          req.Token,
+		PromotionCodes: req.PromotionCodes,
+	}
+
+	order, err := h.orderService.Checkout(ctx, checkoutReq)
+	if err != nil {
+		log.Printf("Checkout error: %v", err)
+		resp := &pb.CheckoutResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}
+		if order != nil {
+			resp.Order = orderToProto(order)
+		}
+		return resp, nil
+	}
+
+	return &pb.CheckoutResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Order placed successfully",
+		},
+		Order: orderToProto(order),
+	}, nil
+}
+
+func (h *OrderGrpcHandler) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	// TODO: Validate token
+	order, err := h.orderService.GetOrder(req.OrderId, req.UserId)
+	if err != nil {
+		log.Printf("Get order error: %v", err)
+		return &pb.GetOrderResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.GetOrderResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Order retrieved successfully",
+		},
+		Order: orderToProto(order),
+	}, nil
+}
+
+func (h *OrderGrpcHandler) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	// TODO: Validate token
+	orders, paginationResp, err := h.orderService.ListOrders(req.UserId, req.Status, pagination)
+	if err != nil {
+		log.Printf("List orders error: %v", err)
+		return &pb.ListOrdersResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoOrders := make([]*pb.Order, 0, len(orders))
+	for _, order := range orders {
+		protoOrders = append(protoOrders, orderToProto(order))
+	}
+
+	return &pb.ListOrdersResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Orders retrieved successfully",
+		},
+		Orders: protoOrders,
+		Pagination: &commonPb.PaginationResponse{
+			Page:       paginationResp.Page,
+			Limit:      paginationResp.Limit,
+			TotalPages: paginationResp.TotalPages,
+			TotalCount: paginationResp.TotalCount,
+			HasNext:    paginationResp.HasNext,
+			HasPrev:    paginationResp.HasPrev,
+		},
+	}, nil
+}
+
+func (h *OrderGrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	log.Printf("Update order status request: %s -> %s", req.OrderId, req.Status)
+
+	// TODO: Validate token
+	order, err := h.orderService.UpdateStatus(ctx, &models.StatusUpdateRequest{
+		OrderID: req.OrderId,
+		Status:  req.Status,
+		Reason:  req.Reason,
+		Actor:   req.Actor,
+		UserID:  req.UserId,
+		Token:   req.Token,
+	})
+	if err != nil {
+		log.Printf("Update order status error: %v", err)
+		return &pb.UpdateOrderStatusResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateOrderStatusResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Order status updated successfully",
+		},
+		Order: orderToProto(order),
+	}, nil
+}
+
+func (h *OrderGrpcHandler) HealthCheck(ctx context.Context, req *commonPb.HealthCheckRequest) (*commonPb.HealthCheckResponse, error) {
+	return &commonPb.HealthCheckResponse{
+		Status:    "healthy",
+		Service:   "order-service",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+func orderToProto(order *models.Order) *pb.Order {
+	protoOrder := &pb.Order{
+		Id:              order.ID,
+		UserId:          order.UserID,
+		Status:          order.Status,
+		CurrencyCode:    order.Currency,
+		Items:           make([]*pb.OrderItem, 0, len(order.Items)),
+		ItemCount:       order.ItemCount(),
+		Subtotal:        moneyToProto(order.Subtotal),
+		DiscountTotal:   moneyToProto(order.DiscountTotal),
+		Total:           moneyToProto(order.Total),
+		PaymentProvider: order.PaymentProvider,
+		PaymentStatus:   order.PaymentStatus,
+		FailureReason:   order.FailureReason,
+		CreatedAt:       order.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       order.UpdatedAt.Format(time.RFC3339),
+	}
+
+	for _, item := range order.Items {
+		protoOrder.Items = append(protoOrder.Items, &pb.OrderItem{
+			Id:           item.ID,
+			ProductId:    item.ProductID,
+			VariantId:    item.VariantID,
+			Name:         item.Name,
+			Sku:          item.SKU,
+			OptionValues: item.OptionValues,
+			Quantity:     item.Quantity,
+			UnitPrice:    moneyToProto(item.UnitPrice),
+			LineTotal:    moneyToProto(item.LineTotal()),
+		})
+	}
+
+	for _, promotion := range order.Promotions {
+		protoOrder.Promotions = append(protoOrder.Promotions, appliedPromotionToProto(promotion))
+	}
+
+	for _, change := range order.History {
+		protoOrder.History = append(protoOrder.History, &pb.OrderStatusChange{
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			Reason:     change.Reason,
+			Actor:      change.Actor,
+			CreatedAt:  change.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return protoOrder
+}
+
+func checkoutItemsFromProto(items []*pb.CheckoutItem) []*models.CheckoutItem {
+	checkoutItems := make([]*models.CheckoutItem, 0, len(items))
+	for _, item := range items {
+		checkoutItem := &models.CheckoutItem{
+			ProductID: item.ProductId,
+			VariantID: item.VariantId,
+			Quantity:  item.Quantity,
+		}
+		if item.UnitPrice != nil {
+			price := moneyFromProto(item.UnitPrice)
+			checkoutItem.UnitPrice = &price
+		}
+		checkoutItems = append(checkoutItems, checkoutItem)
+	}
+	return checkoutItems
+}
+
+func moneyFromProto(money *commonPb.Money) models.Money {
+	return models.Money{Currency: money.CurrencyCode, Units: money.Units, Nanos: money.Nanos}
+}
+
+func moneyToProto(money models.Money) *commonPb.Money {
+	return &commonPb.Money{
+		CurrencyCode: money.Currency,
+		Units:        money.Units,
+		Nanos:        money.Nanos,
+	}
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/order-service/clients"
+	commonPb "github.com/martbul/playground_microservices/services/order-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/order-service/genproto/order"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+)
+
+func (h *OrderGrpcHandler) EvaluatePromotions(ctx context.Context, req *pb.EvaluatePromotionsRequest) (*pb.EvaluatePromotionsResponse, error) {
+	// TODO: Validate token; until then trust the gateway's user ID
+	evaluation, err := h.orderService.EvaluatePromotions(ctx, &models.EvaluatePromotionsRequest{
+		UserID:   req.UserId,
+		Items:    checkoutItemsFromProto(req.Items),
+		Currency: req.CurrencyCode,
+		Codes:    req.Codes,
+	})
+	if err != nil {
+		log.Printf("Evaluate promotions error: %v", err)
+		return &pb.EvaluatePromotionsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	resp := &pb.EvaluatePromotionsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Promotions evaluated successfully",
+		},
+		Subtotal:      moneyToProto(evaluation.Subtotal),
+		DiscountTotal: moneyToProto(evaluation.DiscountTotal),
+		Total:         moneyToProto(evaluation.Total),
+	}
+	for _, applied := range evaluation.Applied {
+		resp.Applied = append(resp.Applied, appliedPromotionToProto(applied))
+	}
+	for _, rejected := range evaluation.Rejected {
+		resp.Rejected = append(resp.Rejected, &pb.RejectedPromotion{Code: rejected.Code, Reason: rejected.Reason})
+	}
+	return resp, nil
+}
+
+func (h *OrderGrpcHandler) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.CreatePromotionResponse, error) {
+	log.Printf("Create promotion request: %s", req.GetPromotion().GetCode())
+
+	// TODO: Validate token and admin role
+	promotion, err := h.promotionService.CreatePromotion(clients.IncomingStore(ctx), promotionRequestFromProto(req.Promotion), req.CreatedBy)
+	if err != nil {
+		log.Printf("Create promotion error: %v", err)
+		return &pb.CreatePromotionResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreatePromotionResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Promotion created successfully",
+		},
+		Promotion: promotionToProto(promotion),
+	}, nil
+}
+
+func (h *OrderGrpcHandler) UpdatePromotion(ctx context.Context, req *pb.UpdatePromotionRequest) (*pb.UpdatePromotionResponse, error) {
+	log.Printf("Update promotion request: %s", req.GetPromotion().GetId())
+
+	// TODO: Validate token and admin role
+	promotion, err := h.promotionService.UpdatePromotion(clients.IncomingStore(ctx), req.GetPromotion().GetId(), promotionRequestFromProto(req.Promotion))
+	if err != nil {
+		log.Printf("Update promotion error: %v", err)
+		return &pb.UpdatePromotionResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdatePromotionResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Promotion updated successfully",
+		},
+		Promotion: promotionToProto(promotion),
+	}, nil
+}
+
+func (h *OrderGrpcHandler) GetPromotion(ctx context.Context, req *pb.GetPromotionRequest) (*pb.GetPromotionResponse, error) {
+	// TODO: Validate token and admin role
+	promotion, err := h.promotionService.GetPromotion(clients.IncomingStore(ctx), req.Id)
+	if err != nil {
+		log.Printf("Get promotion error: %v", err)
+		return &pb.GetPromotionResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.GetPromotionResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Promotion retrieved successfully",
+		},
+		Promotion: promotionToProto(promotion),
+	}, nil
+}
+
+func (h *OrderGrpcHandler) ListPromotions(ctx context.Context, req *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	// TODO: Validate token and admin role
+	promotions, paginationResp, err := h.promotionService.ListPromotions(clients.IncomingStore(ctx), req.Query, req.ActiveOnly, pagination)
+	if err != nil {
+		log.Printf("List promotions error: %v", err)
+		return &pb.ListPromotionsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoPromotions := make([]*pb.Promotion, 0, len(promotions))
+	for _, promotion := range promotions {
+		protoPromotions = append(protoPromotions, promotionToProto(promotion))
+	}
+
+	return &pb.ListPromotionsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Promotions retrieved successfully",
+		},
+		Promotions: protoPromotions,
+		Pagination: &commonPb.PaginationResponse{
+			Page:       paginationResp.Page,
+			Limit:      paginationResp.Limit,
+			TotalPages: paginationResp.TotalPages,
+			TotalCount: paginationResp.TotalCount,
+			HasNext:    paginationResp.HasNext,
+			HasPrev:    paginationResp.HasPrev,
+		},
+	}, nil
+}
+
+func (h *OrderGrpcHandler) DeletePromotion(ctx context.Context, req *pb.DeletePromotionRequest) (*pb.DeletePromotionResponse, error) {
+	log.Printf("Delete promotion request: %s", req.Id)
+
+	// TODO: Validate token and admin role
+	if err := h.promotionService.DeletePromotion(clients.IncomingStore(ctx), req.Id); err != nil {
+		log.Printf("Delete promotion error: %v", err)
+		return &pb.DeletePromotionResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.DeletePromotionResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Promotion deleted successfully",
+		},
+	}, nil
+}
+
+func promotionRequestFromProto(promotion *pb.Promotion) *models.PromotionRequest {
+	if promotion == nil {
+		return &models.PromotionRequest{}
+	}
+
+	req := &models.PromotionRequest{
+		Code:           promotion.Code,
+		Name:           promotion.Name,
+		Description:    promotion.Description,
+		DiscountType:   promotion.DiscountType,
+		PercentOff:     promotion.PercentOff,
+		ProductIDs:     promotion.ProductIds,
+		CategoryIDs:    promotion.CategoryIds,
+		MaxUses:        promotion.MaxUses,
+		MaxUsesPerUser: promotion.MaxUsesPerUser,
+		StartsAt:       promotion.StartsAt,
+		EndsAt:         promotion.EndsAt,
+		IsActive:       promotion.IsActive,
+	}
+	if promotion.AmountOff != nil {
+		amount := moneyFromProto(promotion.AmountOff)
+		req.AmountOff = &amount
+	}
+	if promotion.MinSpend != nil {
+		amount := moneyFromProto(promotion.MinSpend)
+		req.MinSpend = &amount
+	}
+	return req
+}
+
+func promotionToProto(promotion *models.Promotion) *pb.Promotion {
+	protoPromotion := &pb.Promotion{
+		Id:             promotion.ID,
+		Code:           promotion.Code,
+		Name:           promotion.Name,
+		Description:    promotion.Description,
+		DiscountType:   promotion.DiscountType,
+		PercentOff:     promotion.PercentOff,
+		ProductIds:     promotion.ProductIDs,
+		CategoryIds:    promotion.CategoryIDs,
+		MaxUses:        promotion.MaxUses,
+		MaxUsesPerUser: promotion.MaxUsesPerUser,
+		Uses:           promotion.Uses,
+		IsActive:       promotion.IsActive,
+		CreatedBy:      promotion.CreatedBy,
+		CreatedAt:      promotion.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      promotion.UpdatedAt.Format(time.RFC3339),
+	}
+	if promotion.AmountOff != nil {
+		protoPromotion.AmountOff = moneyToProto(*promotion.AmountOff)
+	}
+	if promotion.MinSpend != nil {
+		protoPromotion.MinSpend = moneyToProto(*promotion.MinSpend)
+	}
+	if promotion.StartsAt != nil {
+		protoPromotion.StartsAt = promotion.StartsAt.Format(time.RFC3339)
+	}
+	if promotion.EndsAt != nil {
+		protoPromotion.EndsAt = promotion.EndsAt.Format(time.RFC3339)
+	}
+	return protoPromotion
+}
+
+func appliedPromotionToProto(promotion *models.AppliedPromotion) *pb.AppliedPromotion {
+	protoPromotion := &pb.AppliedPromotion{
+		PromotionId: promotion.PromotionID,
+		Code:        promotion.Code,
+		Name:        promotion.Name,
+		Discount:    moneyToProto(promotion.Discount),
+		Explanation: promotion.Explanation,
+	}
+	for _, line := range promotion.Lines {
+		protoPromotion.Lines = append(protoPromotion.Lines, &pb.LineDiscount{
+			ProductId: line.ProductID,
+			VariantId: line.VariantID,
+			Discount:  moneyToProto(line.Discount),
+		})
+	}
+	return protoPromotion
+}
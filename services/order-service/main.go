@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/order-service/clients"
+	"github.com/martbul/playground_microservices/services/order-service/config"
+	pb "github.com/martbul/playground_microservices/services/order-service/genproto/order"
+	"github.com/martbul/playground_microservices/services/order-service/handlers"
+	"github.com/martbul/playground_microservices/services/order-service/payment"
+	"github.com/martbul/playground_microservices/services/order-service/repository"
+	"github.com/martbul/playground_microservices/services/order-service/service"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Connect to database
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer db.Close()
+
+	// Test database connection
+	if err := db.Ping(); err != nil {
+		log.Fatal("Failed to ping database:", err)
+	}
+
+	// Run migrations
+	if err := runMigrations(db); err != nil {
+		log.Fatal("Failed to run migrations:", err)
+	}
+
+	// Prices are checked and stock is taken through product-service
+	productClient, err := clients.NewProductClient(cfg.ProductService)
+	if err != nil {
+		log.Fatal("Failed to create product client:", err)
+	}
+	defer productClient.Close()
+
+	// Notifying buyers is optional
+	var notificationClient *clients.NotificationClient
+	if cfg.NotificationService != "" {
+		notificationClient, err = clients.NewNotificationClient(cfg.NotificationService)
+		if err != nil {
+			log.Fatal("Failed to create notification client:", err)
+		}
+		defer notificationClient.Close()
+	}
+
+	payments, err := payment.New(cfg)
+	if err != nil {
+		log.Fatal("Failed to configure payment provider:", err)
+	}
+
+	// Initialize repository
+	orderRepo := repository.NewOrderRepository(db)
+	promotionRepo := repository.NewPromotionRepository(db)
+
+	// Initialize services
+	orderService := service.NewOrderService(orderRepo, promotionRepo, productClient, notificationClient, payments, cfg.MaxItemQuantity, cfg.CheckoutTimeout)
+	promotionService := service.NewPromotionService(promotionRepo)
+
+	go recoverPendingOrders(orderService, cfg.RecoverySweepInterval)
+
+	// Initialize handler
+	orderHandler := handlers.NewOrderGrpcHandler(orderService, promotionService)
+
+	// Create gRPC server
+	server := grpc.NewServer()
+	pb.RegisterOrderServiceServer(server, orderHandler)
+
+	// Start listening
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Port))
+	if err != nil {
+		log.Fatal("Failed to listen:", err)
+	}
+
+	log.Printf("Order service starting on port %s", cfg.Port)
+	if err := server.Serve(lis); err != nil {
+		log.Fatal("Failed to serve:", err)
+	}
+}
+
+func recoverPendingOrders(orderService service.OrderService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		recovered, err := orderService.RecoverPendingOrders(context.Background())
+		if err != nil {
+			log.Printf("Failed to recover pending orders: %v", err)
+			continue
+		}
+		if recovered > 0 {
+			log.Printf("Recovered %d interrupted checkouts", recovered)
+		}
+	}
+}
+
+func runMigrations(db *sql.DB) error {
+	query := `
+	-- Orders get their ID from the service, before they are stored, so the
+	-- stock reservation can refer to them. payment_status and stock_status
+	-- record how far checkout got, for recovering interrupted ones.
+	CREATE TABLE IF NOT EXISTS orders (
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL,
+		status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'paid', 'shipped', 'delivered', 'cancelled', 'refunded')),
+		currency CHAR(3) NOT NULL,
+		total DECIMAL(12,3) NOT NULL,
+		payment_provider VARCHAR(50) NOT NULL,
+		payment_id VARCHAR(255) NOT NULL DEFAULT '',
+		payment_status VARCHAR(20) NOT NULL DEFAULT 'none',
+		reservation_id UUID,
+		stock_status VARCHAR(20) NOT NULL DEFAULT 'none',
+		failure_reason TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Products live in product-service, so product and variant IDs aren't
+	-- foreign keys; name, SKU and price are copied as they were at checkout
+	CREATE TABLE IF NOT EXISTS order_items (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		product_id UUID NOT NULL,
+		variant_id UUID,
+		name VARCHAR(255) NOT NULL,
+		sku VARCHAR(100) NOT NULL DEFAULT '',
+		option_values JSONB NOT NULL DEFAULT '{}',
+		quantity INTEGER NOT NULL CHECK (quantity > 0),
+		unit_price DECIMAL(12,3) NOT NULL,
+		restocked BOOLEAN NOT NULL DEFAULT FALSE
+	);
+
+	CREATE TABLE IF NOT EXISTS order_status_history (
+		id BIGSERIAL PRIMARY KEY,
+		order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+		from_status VARCHAR(20),
+		to_status VARCHAR(20) NOT NULL,
+		reason TEXT NOT NULL DEFAULT '',
+		actor VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Orders are placed in a store, and their stock is reserved there. Orders
+	-- from before there were stores were placed in the default one.
+	ALTER TABLE orders ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+	-- Orders from before promotions have no subtotal; it's their total
+	ALTER TABLE orders ADD COLUMN IF NOT EXISTS subtotal DECIMAL(12,3);
+	ALTER TABLE orders ADD COLUMN IF NOT EXISTS discount_total DECIMAL(12,3) NOT NULL DEFAULT 0;
+
+	-- Discount codes. Codes are stored in upper case; amount_off and
+	-- min_spend are in currency, which is empty when neither is set.
+	CREATE TABLE IF NOT EXISTS promotions (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+		code VARCHAR(32) NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		discount_type VARCHAR(20) NOT NULL CHECK (discount_type IN ('percentage', 'fixed')),
+		percent_off INTEGER NOT NULL DEFAULT 0,
+		amount_off DECIMAL(12,3),
+		min_spend DECIMAL(12,3),
+		currency VARCHAR(3) NOT NULL DEFAULT '',
+		product_ids UUID[] NOT NULL DEFAULT '{}',
+		category_ids UUID[] NOT NULL DEFAULT '{}',
+		max_uses INTEGER NOT NULL DEFAULT 0,
+		max_uses_per_user INTEGER NOT NULL DEFAULT 0,
+		starts_at TIMESTAMP,
+		ends_at TIMESTAMP,
+		is_active BOOLEAN NOT NULL DEFAULT TRUE,
+		created_by VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Each store has its own promotions, and codes only have to be unique
+	-- within a store. Promotions from before there were stores belong to the
+	-- default one.
+	ALTER TABLE promotions ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+	ALTER TABLE promotions DROP CONSTRAINT IF EXISTS promotions_code_key;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_promotions_store_code ON promotions(store_id, code);
+
+	-- The promotions an order used, in the order they were applied. Code,
+	-- name and discount are copied so the order reads the same after the
+	-- promotion is changed or deleted; lines holds what came off each item.
+	CREATE TABLE IF NOT EXISTS order_promotions (
+		order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		promotion_id UUID REFERENCES promotions(id) ON DELETE SET NULL,
+		code VARCHAR(32) NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		discount DECIMAL(12,3) NOT NULL,
+		explanation TEXT NOT NULL DEFAULT '',
+		lines JSONB NOT NULL DEFAULT '[]',
+		PRIMARY KEY (order_id, position)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_orders_user_created_at ON orders(user_id, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_orders_pending_updated_at ON orders(updated_at) WHERE status = 'pending';
+	CREATE INDEX IF NOT EXISTS idx_order_items_order_id ON order_items(order_id);
+	CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history(order_id);
+	CREATE INDEX IF NOT EXISTS idx_order_promotions_promotion_id ON order_promotions(promotion_id);
+	`
+
+	_, err := db.Exec(query)
+	return err
+}
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const nanosPerUnit = 1000000000
+
+// Money is an exact amount: Units whole units plus Nanos billionths of a
+// unit in Currency. Units and Nanos never have opposite signs.
+type Money struct {
+	Currency string
+	Units    int64
+	Nanos    int32
+}
+
+// ParseMoney reads a decimal amount such as "12.50" without going through
+// floating point
+func ParseMoney(amount, currency string) (Money, error) {
+	text := strings.TrimSpace(amount)
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" && fraction == "" || !digitsOnly(whole) || !digitsOnly(fraction) || len(fraction) > 9 {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		units, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return Money{}, fmt.Errorf("amount %q is out of range", amount)
+		}
+	}
+	var nanos int64
+	if fraction != "" {
+		nanos, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+	}
+
+	if negative {
+		units, nanos = -units, -nanos
+	}
+	return Money{Currency: currency, Units: units, Nanos: int32(nanos)}, nil
+}
+
+func digitsOnly(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns the sum of two amounts in the same currency
+func (m Money) Add(other Money) Money {
+	return fromNanos(m.Currency, m.totalNanos()+other.totalNanos())
+}
+
+// Sub returns the difference of two amounts in the same currency
+func (m Money) Sub(other Money) Money {
+	return fromNanos(m.Currency, m.totalNanos()-other.totalNanos())
+}
+
+// Percent returns percent percent of the amount, rounded half up to a
+// hundredth of a unit
+func (m Money) Percent(percent int32) Money {
+	const nanosPerCent = nanosPerUnit / 100
+	cents := (m.totalNanos()*int64(percent) + 50*nanosPerCent) / (100 * nanosPerCent)
+	return fromNanos(m.Currency, cents*nanosPerCent)
+}
+
+// IsPositive reports whether the amount is more than zero
+func (m Money) IsPositive() bool {
+	return m.totalNanos() > 0
+}
+
+// Times returns the amount multiplied by a quantity
+func (m Money) Times(quantity int32) Money {
+	return fromNanos(m.Currency, m.totalNanos()*int64(quantity))
+}
+
+// Cmp compares the amounts of two values in the same currency
+func (m Money) Cmp(other Money) int {
+	a, b := m.totalNanos(), other.totalNanos()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// totalNanos fits any amount up to about nine billion units, far beyond what
+// an order comes to
+func (m Money) totalNanos() int64 {
+	return m.Units*nanosPerUnit + int64(m.Nanos)
+}
+
+func fromNanos(currency string, nanos int64) Money {
+	return Money{Currency: currency, Units: nanos / nanosPerUnit, Nanos: int32(nanos % nanosPerUnit)}
+}
+
+// Amount formats the amount as a plain decimal, e.g. "12.5"
+func (m Money) Amount() string {
+	units, nanos := m.Units, int64(m.Nanos)
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	text := sign + strconv.FormatInt(units, 10)
+	if fraction := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"); fraction != "" {
+		text += "." + fraction
+	}
+	return text
+}
+
+// Value stores the amount in a DECIMAL column; the currency has its own column
+func (m Money) Value() (driver.Value, error) {
+	return m.Amount(), nil
+}
+
+// Scan reads a DECIMAL column into the amount, leaving Currency as it is
+func (m *Money) Scan(src interface{}) error {
+	var text string
+	switch v := src.(type) {
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+
+	parsed, err := ParseMoney(text, m.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
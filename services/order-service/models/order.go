@@ -0,0 +1,172 @@
+package models
+
+import (
+	"time"
+)
+
+// Order statuses. Orders start pending while checkout runs and end up paid,
+// or cancelled if checkout fails.
+const (
+	OrderPending   = "pending"
+	OrderPaid      = "paid"
+	OrderShipped   = "shipped"
+	OrderDelivered = "delivered"
+	OrderCancelled = "cancelled"
+	OrderRefunded  = "refunded"
+)
+
+// orderTransitions lists the statuses each status can move on to. Pending
+// orders are only ever moved on by checkout itself.
+var orderTransitions = map[string][]string{
+	OrderPending:   {OrderPaid, OrderCancelled},
+	OrderPaid:      {OrderShipped, OrderCancelled},
+	OrderShipped:   {OrderDelivered, OrderRefunded},
+	OrderDelivered: {OrderRefunded},
+}
+
+// CanTransition reports whether an order may go from one status to the other
+func CanTransition(from, to string) bool {
+	for _, status := range orderTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOrderStatus reports whether status is one of the order statuses
+func IsOrderStatus(status string) bool {
+	switch status {
+	case OrderPending, OrderPaid, OrderShipped, OrderDelivered, OrderCancelled, OrderRefunded:
+		return true
+	}
+	return false
+}
+
+// Where an order's payment stands with the payment provider
+const (
+	PaymentNone       = "none"
+	PaymentAuthorized = "authorized"
+	PaymentCaptured   = "captured"
+	PaymentVoided     = "voided"
+	PaymentRefunded   = "refunded"
+)
+
+// Where an order's stock stands in product-service: held by a reservation,
+// taken off the shelf, or given back
+const (
+	StockNone      = "none"
+	StockReserved  = "reserved"
+	StockCommitted = "committed"
+	StockReleased  = "released"
+	StockReturned  = "returned"
+)
+
+// Order is a purchase by a user. Payment and stock progress are stored with
+// it so an interrupted checkout can be finished or undone later.
+type Order struct {
+	ID              string          `json:"id" db:"id"`
+	UserID          string          `json:"user_id" db:"user_id"`
+	StoreID         string          `json:"store_id" db:"store_id"`
+	Status          string          `json:"status" db:"status"`
+	Currency        string          `json:"currency" db:"currency"`
+	Items           []*OrderItem    `json:"items"`
+	Total           Money           `json:"total" db:"total"`
+	PaymentProvider string          `json:"payment_provider" db:"payment_provider"`
+	PaymentID       string          `json:"payment_id" db:"payment_id"`
+	PaymentStatus   string          `json:"payment_status" db:"payment_status"`
+	ReservationID   string          `json:"reservation_id" db:"reservation_id"`
+	StockStatus     string          `json:"stock_status" db:"stock_status"`
+	FailureReason   string          `json:"failure_reason" db:"failure_reason"`
+	History         []*StatusChange `json:"history"`
+	CreatedAt       time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at" db:"updated_at"`
+
+	// Subtotal is what the items come to; Total is that less DiscountTotal,
+	// what Promotions took off
+	Subtotal      Money               `json:"subtotal" db:"subtotal"`
+	DiscountTotal Money               `json:"discount_total" db:"discount_total"`
+	Promotions    []*AppliedPromotion `json:"promotions"`
+}
+
+// ItemCount is the total quantity of all items
+func (o *Order) ItemCount() int32 {
+	var count int32
+	for _, item := range o.Items {
+		count += item.Quantity
+	}
+	return count
+}
+
+// OrderItem is a product as it was bought. Restocked is set once a
+// cancelled order's quantity has been put back.
+type OrderItem struct {
+	ID           string            `json:"id" db:"id"`
+	OrderID      string            `json:"order_id" db:"order_id"`
+	ProductID    string            `json:"product_id" db:"product_id"`
+	VariantID    string            `json:"variant_id" db:"variant_id"`
+	Name         string            `json:"name" db:"name"`
+	SKU          string            `json:"sku" db:"sku"`
+	OptionValues map[string]string `json:"option_values" db:"option_values"`
+	Quantity     int32             `json:"quantity" db:"quantity"`
+	UnitPrice    Money             `json:"unit_price" db:"unit_price"`
+	Restocked    bool              `json:"restocked" db:"restocked"`
+}
+
+func (i *OrderItem) LineTotal() Money {
+	return i.UnitPrice.Times(i.Quantity)
+}
+
+// StatusChange is one step in an order's status history
+type StatusChange struct {
+	FromStatus string    `json:"from_status" db:"from_status"`
+	ToStatus   string    `json:"to_status" db:"to_status"`
+	Reason     string    `json:"reason" db:"reason"`
+	Actor      string    `json:"actor" db:"actor"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// CheckoutItem is a product the buyer wants. UnitPrice, if set, is the
+// price they were shown.
+type CheckoutItem struct {
+	ProductID string
+	VariantID string
+	Quantity  int32
+	UnitPrice *Money
+}
+
+type CheckoutRequest struct {
+	UserID       string
+	Items        []*CheckoutItem
+	Currency     string
+	PaymentToken string
+	Token        string
+	// PromotionCodes must all apply, or checkout fails
+	PromotionCodes []string
+}
+
+// StatusUpdateRequest moves an order on. UserID, if set, must own the order.
+type StatusUpdateRequest struct {
+	OrderID string
+	Status  string
+	Reason  string
+	Actor   string
+	UserID  string
+	Token   string
+}
+
+type PaginationRequest struct {
+	Page      int32
+	Limit     int32
+	SortBy    string
+	SortOrder string
+}
+
+type PaginationResponse struct {
+	Page       int32
+	Limit      int32
+	TotalPages int32
+	TotalCount int64
+	HasNext    bool
+	HasPrev    bool
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"time"
+)
+
+// How a promotion discounts the eligible items
+const (
+	DiscountPercentage = "percentage"
+	DiscountFixed      = "fixed"
+)
+
+// Promotion is a discount code. Percentage promotions take PercentOff
+// percent off the eligible items, fixed ones take AmountOff off them. With
+// ProductIDs or CategoryIDs set only matching items are eligible. AmountOff
+// and MinSpend share the promotion's currency. Zero limits mean no limit.
+// A promotion's code only works in its own store.
+type Promotion struct {
+	ID             string     `json:"id" db:"id"`
+	StoreID        string     `json:"store_id" db:"store_id"`
+	Code           string     `json:"code" db:"code"`
+	Name           string     `json:"name" db:"name"`
+	Description    string     `json:"description" db:"description"`
+	DiscountType   string     `json:"discount_type" db:"discount_type"`
+	PercentOff     int32      `json:"percent_off" db:"percent_off"`
+	AmountOff      *Money     `json:"amount_off" db:"amount_off"`
+	MinSpend       *Money     `json:"min_spend" db:"min_spend"`
+	ProductIDs     []string   `json:"product_ids" db:"product_ids"`
+	CategoryIDs    []string   `json:"category_ids" db:"category_ids"`
+	MaxUses        int32      `json:"max_uses" db:"max_uses"`
+	MaxUsesPerUser int32      `json:"max_uses_per_user" db:"max_uses_per_user"`
+	Uses           int32      `json:"uses" db:"uses"`
+	StartsAt       *time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt         *time.Time `json:"ends_at" db:"ends_at"`
+	IsActive       bool       `json:"is_active" db:"is_active"`
+	CreatedBy      string     `json:"created_by" db:"created_by"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Currency is the currency of the promotion's amounts, or "" if it has none
+func (p *Promotion) Currency() string {
+	if p.AmountOff != nil {
+		return p.AmountOff.Currency
+	}
+	if p.MinSpend != nil {
+		return p.MinSpend.Currency
+	}
+	return ""
+}
+
+// PromotionRequest creates a promotion or replaces one's settings
+type PromotionRequest struct {
+	Code           string
+	Name           string
+	Description    string
+	DiscountType   string
+	PercentOff     int32
+	AmountOff      *Money
+	MinSpend       *Money
+	ProductIDs     []string
+	CategoryIDs    []string
+	MaxUses        int32
+	MaxUsesPerUser int32
+	StartsAt       string
+	EndsAt         string
+	IsActive       bool
+}
+
+// LineDiscount is what a promotion took off one item
+type LineDiscount struct {
+	ProductID string `json:"product_id" db:"product_id"`
+	VariantID string `json:"variant_id" db:"variant_id"`
+	Discount  Money  `json:"discount" db:"discount"`
+}
+
+// AppliedPromotion is a promotion applied to an order. Orders keep the code
+// and name so they still read right once the promotion is changed.
+type AppliedPromotion struct {
+	PromotionID string          `json:"promotion_id" db:"promotion_id"`
+	Code        string          `json:"code" db:"code"`
+	Name        string          `json:"name" db:"name"`
+	Discount    Money           `json:"discount" db:"discount"`
+	Explanation string          `json:"explanation" db:"explanation"`
+	Lines       []*LineDiscount `json:"lines"`
+}
+
+// RejectedPromotion is a code that doesn't apply, and why
+type RejectedPromotion struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// PromotionEvaluation is what codes take off a set of items
+type PromotionEvaluation struct {
+	Subtotal      Money                `json:"subtotal"`
+	DiscountTotal Money                `json:"discount_total"`
+	Total         Money                `json:"total"`
+	Applied       []*AppliedPromotion  `json:"applied"`
+	Rejected      []*RejectedPromotion `json:"rejected"`
+}
+
+// EvaluatePromotionsRequest prices the items as checkout would and applies
+// the codes to them. Per-user limits are only checked with UserID set.
+type EvaluatePromotionsRequest struct {
+	UserID   string
+	Items    []*CheckoutItem
+	Currency string
+	Codes    []string
+}
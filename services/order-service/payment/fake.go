@@ -0,0 +1,104 @@
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// Tokens the fake provider treats specially; any other token pays
+const (
+	FakeDeclineToken     = "tok_decline"
+	FakeFailCaptureToken = "tok_fail_capture"
+)
+
+// Fake is a payment provider for development and tests. Payments are kept
+// in memory, so they are forgotten on restart; voiding a forgotten payment
+// succeeds since nothing is held.
+type Fake struct {
+	mu       sync.Mutex
+	payments map[string]*fakePayment
+}
+
+type fakePayment struct {
+	status string
+	token  string
+}
+
+func NewFake() *Fake {
+	return &Fake{payments: make(map[string]*fakePayment)}
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) Authorize(ctx context.Context, req *AuthorizeRequest) (string, error) {
+	if req.Token == FakeDeclineToken {
+		return "", ErrDeclined
+	}
+
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to create payment: %w", err)
+	}
+	id := "fake_" + hex.EncodeToString(buf)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.payments[id] = &fakePayment{status: "authorized", token: req.Token}
+	return id, nil
+}
+
+func (f *Fake) Capture(ctx context.Context, paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[paymentID]
+	switch {
+	case !ok:
+		return fmt.Errorf("payment %s not found", paymentID)
+	case payment.token == FakeFailCaptureToken:
+		return fmt.Errorf("payment %s could not be captured", paymentID)
+	case payment.status == "captured":
+		return nil
+	case payment.status != "authorized":
+		return fmt.Errorf("payment %s is %s", paymentID, payment.status)
+	}
+	payment.status = "captured"
+	return nil
+}
+
+func (f *Fake) Void(ctx context.Context, paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[paymentID]
+	switch {
+	case !ok, payment.status == "voided":
+		return nil
+	case payment.status != "authorized":
+		return fmt.Errorf("payment %s is %s", paymentID, payment.status)
+	}
+	payment.status = "voided"
+	return nil
+}
+
+func (f *Fake) Refund(ctx context.Context, paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[paymentID]
+	switch {
+	case !ok:
+		return fmt.Errorf("payment %s not found", paymentID)
+	case payment.status == "refunded":
+		return nil
+	case payment.status != "captured":
+		return fmt.Errorf("payment %s is %s", paymentID, payment.status)
+	}
+	payment.status = "refunded"
+	return nil
+}
@@ -0,0 +1,44 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/order-service/config"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+)
+
+// ErrDeclined is returned when the provider refuses to authorise a payment
+var ErrDeclined = errors.New("payment declined")
+
+// Provider takes payments for orders. A payment is authorised first, which
+// holds the amount, and then captured to take it or voided to let it go.
+// Captured payments can be refunded. Void and Refund succeed on payments
+// already voided or refunded, so undoing can be retried.
+type Provider interface {
+	Name() string
+	Authorize(ctx context.Context, req *AuthorizeRequest) (string, error)
+	Capture(ctx context.Context, paymentID string) error
+	Void(ctx context.Context, paymentID string) error
+	Refund(ctx context.Context, paymentID string) error
+}
+
+// AuthorizeRequest is a payment for an order; Token is the payment method as
+// issued by the provider
+type AuthorizeRequest struct {
+	OrderID string
+	Amount  models.Money
+	Token   string
+}
+
+// New builds the provider named in cfg.PaymentProvider
+func New(cfg *config.Config) (Provider, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.PaymentProvider)) {
+	case "fake":
+		return NewFake(), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.PaymentProvider)
+	}
+}
@@ -0,0 +1,491 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+)
+
+var (
+	// ErrOrderNotFound is returned for an order that doesn't exist, or isn't
+	// the given user's
+	ErrOrderNotFound = errors.New("order not found")
+	// ErrStatusConflict is returned when an order's status changed since it
+	// was read
+	ErrStatusConflict = errors.New("order status has changed")
+)
+
+type OrderRepository interface {
+	Create(order *models.Order) error
+	GetByID(id string) (*models.Order, error)
+	List(userID, status string, pagination *models.PaginationRequest) ([]*models.Order, *models.PaginationResponse, error)
+	SaveProgress(order *models.Order) error
+	SetRestocked(itemID string, restocked bool) (bool, error)
+	Transition(order *models.Order, to, reason, actor string) error
+	ListPendingBefore(before time.Time) ([]*models.Order, error)
+}
+
+type orderRepository struct {
+	db *sql.DB
+}
+
+func NewOrderRepository(db *sql.DB) OrderRepository {
+	return &orderRepository{db: db}
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+const orderSelect = `
+	SELECT id, user_id, store_id, status, currency, COALESCE(subtotal, total), discount_total, total, payment_provider, payment_id,
+		payment_status, COALESCE(reservation_id::text, ''), stock_status, failure_reason, created_at, updated_at
+	FROM orders
+`
+
+func scanOrder(row rowScanner) (*models.Order, error) {
+	order := &models.Order{}
+	err := row.Scan(
+		&order.ID,
+		&order.UserID,
+		&order.StoreID,
+		&order.Status,
+		&order.Currency,
+		&order.Subtotal,
+		&order.DiscountTotal,
+		&order.Total,
+		&order.PaymentProvider,
+		&order.PaymentID,
+		&order.PaymentStatus,
+		&order.ReservationID,
+		&order.StockStatus,
+		&order.FailureReason,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	order.Subtotal.Currency = order.Currency
+	order.DiscountTotal.Currency = order.Currency
+	order.Total.Currency = order.Currency
+	return order, nil
+}
+
+// Create stores a new pending order with its items, promotions and first
+// history entry. It fails with ErrPromotionUsedUp if a promotion has reached
+// one of its limits since the order was priced.
+func (r *orderRepository) Create(order *models.Order) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var reservationID interface{}
+	if order.ReservationID != "" {
+		reservationID = order.ReservationID
+	}
+
+	err = tx.QueryRow(`
+		INSERT INTO orders (id, user_id, store_id, status, currency, subtotal, discount_total, total, payment_provider, payment_id, payment_status, reservation_id, stock_status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING created_at, updated_at`,
+		order.ID, order.UserID, order.StoreID, order.Status, order.Currency, order.Subtotal, order.DiscountTotal, order.Total,
+		order.PaymentProvider, order.PaymentID, order.PaymentStatus, reservationID, order.StockStatus,
+	).Scan(&order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	for position, item := range order.Items {
+		optionValues, err := json.Marshal(item.OptionValues)
+		if err != nil {
+			return fmt.Errorf("failed to encode option values: %w", err)
+		}
+
+		var variantID interface{}
+		if item.VariantID != "" {
+			variantID = item.VariantID
+		}
+
+		err = tx.QueryRow(`
+			INSERT INTO order_items (order_id, position, product_id, variant_id, name, sku, option_values, quantity, unit_price)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING id`,
+			order.ID, position, item.ProductID, variantID, item.Name, item.SKU, optionValues, item.Quantity, item.UnitPrice,
+		).Scan(&item.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create order item: %w", err)
+		}
+		item.OrderID = order.ID
+	}
+
+	for position, promotion := range order.Promotions {
+		if err := claimPromotion(tx, order.StoreID, promotion.PromotionID, promotion.Code, order.UserID); err != nil {
+			return err
+		}
+		if err := insertAppliedPromotion(tx, order.ID, position, promotion); err != nil {
+			return err
+		}
+	}
+
+	change := &models.StatusChange{ToStatus: order.Status}
+	if err := insertStatusChange(tx, order.ID, change); err != nil {
+		return err
+	}
+	order.History = []*models.StatusChange{change}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the order with its items and status history
+func (r *orderRepository) GetByID(id string) (*models.Order, error) {
+	order, err := scanOrder(r.db.QueryRow(orderSelect+` WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if err := r.loadItems([]*models.Order{order}); err != nil {
+		return nil, err
+	}
+	if err := r.loadPromotions([]*models.Order{order}); err != nil {
+		return nil, err
+	}
+	if order.History, err = r.listHistory(order.ID); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// List returns orders newest first with their items, leaving out their
+// history. An empty userID or status matches every order.
+func (r *orderRepository) List(userID, status string, pagination *models.PaginationRequest) ([]*models.Order, *models.PaginationResponse, error) {
+	where := ` WHERE ($1 = '' OR user_id::text = $1) AND ($2 = '' OR status = $2)`
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM orders`+where, userID, status).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	query := orderSelect + where + ` ORDER BY created_at DESC, id LIMIT $3 OFFSET $4`
+	rows, err := r.db.Query(query, userID, status, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*models.Order
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	if err := r.loadItems(orders); err != nil {
+		return nil, nil, err
+	}
+	if err := r.loadPromotions(orders); err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return orders, paginationResponse, nil
+}
+
+// loadItems fills in the items of the orders with one query
+func (r *orderRepository) loadItems(orders []*models.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*models.Order, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byID[order.ID] = order
+		ids = append(ids, order.ID)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT id, order_id, product_id, COALESCE(variant_id::text, ''), name, sku, option_values, quantity, unit_price, restocked
+		FROM order_items
+		WHERE order_id = ANY($1::uuid[])
+		ORDER BY order_id, position`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to list order items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &models.OrderItem{}
+		var optionValues []byte
+		err := rows.Scan(
+			&item.ID,
+			&item.OrderID,
+			&item.ProductID,
+			&item.VariantID,
+			&item.Name,
+			&item.SKU,
+			&optionValues,
+			&item.Quantity,
+			&item.UnitPrice,
+			&item.Restocked,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan order item: %w", err)
+		}
+		if err := json.Unmarshal(optionValues, &item.OptionValues); err != nil {
+			return fmt.Errorf("failed to decode option values: %w", err)
+		}
+
+		order := byID[item.OrderID]
+		item.UnitPrice.Currency = order.Currency
+		order.Items = append(order.Items, item)
+	}
+	return rows.Err()
+}
+
+// lineDiscount is how a LineDiscount is stored in order_promotions.lines
+type lineDiscount struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	Discount  string `json:"discount"`
+}
+
+func insertAppliedPromotion(tx *sql.Tx, orderID string, position int, promotion *models.AppliedPromotion) error {
+	lines := make([]lineDiscount, 0, len(promotion.Lines))
+	for _, line := range promotion.Lines {
+		lines = append(lines, lineDiscount{ProductID: line.ProductID, VariantID: line.VariantID, Discount: line.Discount.Amount()})
+	}
+	encoded, err := json.Marshal(lines)
+	if err != nil {
+		return fmt.Errorf("failed to encode promotion lines: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO order_promotions (order_id, position, promotion_id, code, name, discount, explanation, lines)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		orderID, position, promotion.PromotionID, promotion.Code, promotion.Name, promotion.Discount, promotion.Explanation, encoded,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record order promotion: %w", err)
+	}
+	return nil
+}
+
+// loadPromotions fills in the promotions the orders used with one query
+func (r *orderRepository) loadPromotions(orders []*models.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*models.Order, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byID[order.ID] = order
+		ids = append(ids, order.ID)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT order_id, COALESCE(promotion_id::text, ''), code, name, discount, explanation, lines
+		FROM order_promotions
+		WHERE order_id = ANY($1::uuid[])
+		ORDER BY order_id, position`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to list order promotions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var orderID string
+		var encoded []byte
+		promotion := &models.AppliedPromotion{}
+		err := rows.Scan(&orderID, &promotion.PromotionID, &promotion.Code, &promotion.Name, &promotion.Discount, &promotion.Explanation, &encoded)
+		if err != nil {
+			return fmt.Errorf("failed to scan order promotion: %w", err)
+		}
+
+		order := byID[orderID]
+		promotion.Discount.Currency = order.Currency
+
+		var lines []lineDiscount
+		if err := json.Unmarshal(encoded, &lines); err != nil {
+			return fmt.Errorf("failed to decode promotion lines: %w", err)
+		}
+		for _, line := range lines {
+			discount, err := models.ParseMoney(line.Discount, order.Currency)
+			if err != nil {
+				return fmt.Errorf("failed to decode promotion lines: %w", err)
+			}
+			promotion.Lines = append(promotion.Lines, &models.LineDiscount{ProductID: line.ProductID, VariantID: line.VariantID, Discount: discount})
+		}
+
+		order.Promotions = append(order.Promotions, promotion)
+	}
+	return rows.Err()
+}
+
+func (r *orderRepository) listHistory(orderID string) ([]*models.StatusChange, error) {
+	rows, err := r.db.Query(`
+		SELECT COALESCE(from_status, ''), to_status, reason, actor, created_at
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY created_at, id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []*models.StatusChange
+	for rows.Next() {
+		change := &models.StatusChange{}
+		if err := rows.Scan(&change.FromStatus, &change.ToStatus, &change.Reason, &change.Actor, &change.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan order history: %w", err)
+		}
+		history = append(history, change)
+	}
+	return history, rows.Err()
+}
+
+// SaveProgress stores where the order's payment and stock stand
+func (r *orderRepository) SaveProgress(order *models.Order) error {
+	err := r.db.QueryRow(`
+		UPDATE orders
+		SET payment_id = $2, payment_status = $3, stock_status = $4, failure_reason = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING updated_at`,
+		order.ID, order.PaymentID, order.PaymentStatus, order.StockStatus, order.FailureReason,
+	).Scan(&order.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("failed to save order progress: %w", err)
+	}
+	return nil
+}
+
+// SetRestocked records whether an item's quantity has been put back in
+// stock. It reports whether the flag changed, so two cancellations at once
+// can't both put the stock back.
+func (r *orderRepository) SetRestocked(itemID string, restocked bool) (bool, error) {
+	result, err := r.db.Exec(`UPDATE order_items SET restocked = $2 WHERE id = $1 AND restocked <> $2`, itemID, restocked)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order item: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update order item: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// Transition moves the order from its current status to another, saving its
+// progress and recording the change in its history. It fails with
+// ErrStatusConflict if the status was changed in the meantime.
+func (r *orderRepository) Transition(order *models.Order, to, reason, actor string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var updatedAt time.Time
+	err = tx.QueryRow(`
+		UPDATE orders
+		SET status = $3, payment_id = $4, payment_status = $5, stock_status = $6, failure_reason = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = $2
+		RETURNING updated_at`,
+		order.ID, order.Status, to, order.PaymentID, order.PaymentStatus, order.StockStatus, order.FailureReason,
+	).Scan(&updatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrStatusConflict
+		}
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	change := &models.StatusChange{FromStatus: order.Status, ToStatus: to, Reason: reason, Actor: actor}
+	if err := insertStatusChange(tx, order.ID, change); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order status: %w", err)
+	}
+
+	order.Status = to
+	order.UpdatedAt = updatedAt
+	order.History = append(order.History, change)
+	return nil
+}
+
+func insertStatusChange(tx *sql.Tx, orderID string, change *models.StatusChange) error {
+	var fromStatus interface{}
+	if change.FromStatus != "" {
+		fromStatus = change.FromStatus
+	}
+
+	err := tx.QueryRow(`
+		INSERT INTO order_status_history (order_id, from_status, to_status, reason, actor)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at`,
+		orderID, fromStatus, change.ToStatus, change.Reason, change.Actor,
+	).Scan(&change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record order status: %w", err)
+	}
+	return nil
+}
+
+// ListPendingBefore returns the orders still pending that were last touched
+// before the given time, with their items
+func (r *orderRepository) ListPendingBefore(before time.Time) ([]*models.Order, error) {
+	rows, err := r.db.Query(orderSelect+` WHERE status = $1 AND updated_at < $2 ORDER BY updated_at`, models.OrderPending, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*models.Order
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list pending orders: %w", err)
+	}
+
+	if err := r.loadItems(orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+)
+
+var (
+	ErrPromotionNotFound = errors.New("promotion not found")
+	// ErrPromotionCodeTaken is returned when another promotion has the code
+	ErrPromotionCodeTaken = errors.New("promotion code is already taken")
+	// ErrPromotionUsedUp is returned by OrderRepository.Create when an order
+	// would take a promotion past one of its usage limits
+	ErrPromotionUsedUp = errors.New("promotion has been used up")
+)
+
+// PromotionRepository stores promotions. Every lookup is within a store;
+// promotions of other stores are not found.
+type PromotionRepository interface {
+	Create(promotion *models.Promotion) error
+	Update(promotion *models.Promotion) error
+	GetByID(storeID, id string) (*models.Promotion, error)
+	GetByCode(storeID, code string) (*models.Promotion, error)
+	List(storeID, query string, activeOnly bool, pagination *models.PaginationRequest) ([]*models.Promotion, *models.PaginationResponse, error)
+	Delete(storeID, id string) error
+	CountUserUses(promotionID, userID string) (int32, error)
+}
+
+type promotionRepository struct {
+	db *sql.DB
+}
+
+func NewPromotionRepository(db *sql.DB) PromotionRepository {
+	return &promotionRepository{db: db}
+}
+
+// usesCount counts the orders that used a promotion, leaving out cancelled
+// ones so a failed checkout gives the use back
+const usesCount = `
+	SELECT COUNT(*)
+	FROM order_promotions op
+	JOIN orders o ON o.id = op.order_id
+	WHERE op.promotion_id = $1 AND o.status <> 'cancelled'
+`
+
+const promotionSelect = `
+	SELECT p.id, p.store_id, p.code, p.name, p.description, p.discount_type, p.percent_off, p.amount_off, p.min_spend, p.currency,
+		p.product_ids, p.category_ids, p.max_uses, p.max_uses_per_user,
+		(SELECT COUNT(*) FROM order_promotions op JOIN orders o ON o.id = op.order_id WHERE op.promotion_id = p.id AND o.status <> 'cancelled'),
+		p.starts_at, p.ends_at, p.is_active, p.created_by, p.created_at, p.updated_at
+	FROM promotions p
+`
+
+func scanPromotion(row rowScanner) (*models.Promotion, error) {
+	promotion := &models.Promotion{}
+	var amountOff, minSpend sql.NullString
+	var currency string
+	var startsAt, endsAt sql.NullTime
+	err := row.Scan(
+		&promotion.ID,
+		&promotion.StoreID,
+		&promotion.Code,
+		&promotion.Name,
+		&promotion.Description,
+		&promotion.DiscountType,
+		&promotion.PercentOff,
+		&amountOff,
+		&minSpend,
+		&currency,
+		pq.Array(&promotion.ProductIDs),
+		pq.Array(&promotion.CategoryIDs),
+		&promotion.MaxUses,
+		&promotion.MaxUsesPerUser,
+		&promotion.Uses,
+		&startsAt,
+		&endsAt,
+		&promotion.IsActive,
+		&promotion.CreatedBy,
+		&promotion.CreatedAt,
+		&promotion.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if promotion.AmountOff, err = nullMoney(amountOff, currency); err != nil {
+		return nil, err
+	}
+	if promotion.MinSpend, err = nullMoney(minSpend, currency); err != nil {
+		return nil, err
+	}
+	if startsAt.Valid {
+		promotion.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		promotion.EndsAt = &endsAt.Time
+	}
+	return promotion, nil
+}
+
+func nullMoney(amount sql.NullString, currency string) (*models.Money, error) {
+	if !amount.Valid {
+		return nil, nil
+	}
+	money, err := models.ParseMoney(amount.String, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &money, nil
+}
+
+// promotionArgs are the stored settings of a promotion, in column order
+func promotionArgs(promotion *models.Promotion) []interface{} {
+	var amountOff, minSpend, startsAt, endsAt interface{}
+	if promotion.AmountOff != nil {
+		amountOff = *promotion.AmountOff
+	}
+	if promotion.MinSpend != nil {
+		minSpend = *promotion.MinSpend
+	}
+	if promotion.StartsAt != nil {
+		startsAt = *promotion.StartsAt
+	}
+	if promotion.EndsAt != nil {
+		endsAt = *promotion.EndsAt
+	}
+
+	return []interface{}{
+		promotion.Code, promotion.Name, promotion.Description, promotion.DiscountType, promotion.PercentOff,
+		amountOff, minSpend, promotion.Currency(), pq.Array(promotion.ProductIDs), pq.Array(promotion.CategoryIDs),
+		promotion.MaxUses, promotion.MaxUsesPerUser, startsAt, endsAt, promotion.IsActive,
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func (r *promotionRepository) Create(promotion *models.Promotion) error {
+	query := `
+		INSERT INTO promotions (code, name, description, discount_type, percent_off, amount_off, min_spend, currency,
+			product_ids, category_ids, max_uses, max_uses_per_user, starts_at, ends_at, is_active, created_by, store_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING id, created_at, updated_at
+	`
+
+	args := append(promotionArgs(promotion), promotion.CreatedBy, promotion.StoreID)
+	err := r.db.QueryRow(query, args...).Scan(&promotion.ID, &promotion.CreatedAt, &promotion.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrPromotionCodeTaken
+		}
+		return fmt.Errorf("failed to create promotion: %w", err)
+	}
+	promotion.Uses = 0
+	return nil
+}
+
+// Update replaces the promotion's settings, leaving its ID, store and
+// creator
+func (r *promotionRepository) Update(promotion *models.Promotion) error {
+	query := `
+		UPDATE promotions
+		SET code = $1, name = $2, description = $3, discount_type = $4, percent_off = $5, amount_off = $6, min_spend = $7,
+			currency = $8, product_ids = $9, category_ids = $10, max_uses = $11, max_uses_per_user = $12,
+			starts_at = $13, ends_at = $14, is_active = $15, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $16 AND store_id = $17
+		RETURNING updated_at
+	`
+
+	args := append(promotionArgs(promotion), promotion.ID, promotion.StoreID)
+	err := r.db.QueryRow(query, args...).Scan(&promotion.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrPromotionNotFound
+		}
+		if isUniqueViolation(err) {
+			return ErrPromotionCodeTaken
+		}
+		return fmt.Errorf("failed to update promotion: %w", err)
+	}
+	return nil
+}
+
+func (r *promotionRepository) GetByID(storeID, id string) (*models.Promotion, error) {
+	return r.get(`p.id = $1`, storeID, id)
+}
+
+// GetByCode looks a promotion of the store up by its upper case code
+func (r *promotionRepository) GetByCode(storeID, code string) (*models.Promotion, error) {
+	return r.get(`p.code = $1`, storeID, code)
+}
+
+func (r *promotionRepository) get(condition, storeID string, arg interface{}) (*models.Promotion, error) {
+	promotion, err := scanPromotion(r.db.QueryRow(promotionSelect+` WHERE `+condition+` AND p.store_id = $2`, arg, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrPromotionNotFound
+		}
+		return nil, fmt.Errorf("failed to get promotion: %w", err)
+	}
+	return promotion, nil
+}
+
+// List returns the store's promotions newest first. query matches codes and
+// names; activeOnly leaves out promotions that are switched off or have
+// ended.
+func (r *promotionRepository) List(storeID, query string, activeOnly bool, pagination *models.PaginationRequest) ([]*models.Promotion, *models.PaginationResponse, error) {
+	where := ` WHERE p.store_id = $4
+		AND ($1 = '' OR p.code ILIKE '%' || $1 || '%' OR p.name ILIKE '%' || $1 || '%')
+		AND (NOT $2 OR (p.is_active AND (p.ends_at IS NULL OR p.ends_at > $3)))`
+	now := time.Now().UTC()
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM promotions p`+where, query, activeOnly, now, storeID).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count promotions: %w", err)
+	}
+
+	rows, err := r.db.Query(promotionSelect+where+` ORDER BY p.created_at DESC, p.id LIMIT $5 OFFSET $6`,
+		query, activeOnly, now, storeID, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list promotions: %w", err)
+	}
+	defer rows.Close()
+
+	var promotions []*models.Promotion
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan promotion: %w", err)
+		}
+		promotions = append(promotions, promotion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list promotions: %w", err)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return promotions, paginationResponse, nil
+}
+
+// Delete removes the promotion. Orders that used it keep their copy of its
+// code, name and discount.
+func (r *promotionRepository) Delete(storeID, id string) error {
+	result, err := r.db.Exec(`DELETE FROM promotions WHERE id = $1 AND store_id = $2`, id, storeID)
+	if err != nil {
+		return fmt.Errorf("failed to delete promotion: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrPromotionNotFound
+	}
+	return nil
+}
+
+// CountUserUses counts the user's orders that used the promotion and weren't
+// cancelled
+func (r *promotionRepository) CountUserUses(promotionID, userID string) (int32, error) {
+	var uses int32
+	if err := r.db.QueryRow(usesCount+` AND o.user_id = $2`, promotionID, userID).Scan(&uses); err != nil {
+		return 0, fmt.Errorf("failed to count promotion uses: %w", err)
+	}
+	return uses, nil
+}
+
+// claimPromotion locks the promotion of the order's store for the rest of
+// the transaction and checks the order doesn't take it past its limits.
+// Orders using the same promotion are created one at a time, so two can't
+// both take its last use.
+func claimPromotion(tx *sql.Tx, storeID, promotionID, code, userID string) error {
+	var maxUses, maxUsesPerUser int32
+	err := tx.QueryRow(`SELECT max_uses, max_uses_per_user FROM promotions WHERE id = $1 AND store_id = $2 FOR UPDATE`, promotionID, storeID).
+		Scan(&maxUses, &maxUsesPerUser)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("code %s is no longer available", code)
+		}
+		return fmt.Errorf("failed to lock promotion: %w", err)
+	}
+
+	if maxUses > 0 {
+		var uses int32
+		if err := tx.QueryRow(usesCount, promotionID).Scan(&uses); err != nil {
+			return fmt.Errorf("failed to count promotion uses: %w", err)
+		}
+		if uses >= maxUses {
+			return fmt.Errorf("code %s: %w", code, ErrPromotionUsedUp)
+		}
+	}
+	if maxUsesPerUser > 0 {
+		var uses int32
+		if err := tx.QueryRow(usesCount+` AND o.user_id = $2`, promotionID, userID).Scan(&uses); err != nil {
+			return fmt.Errorf("failed to count promotion uses: %w", err)
+		}
+		if uses >= maxUsesPerUser {
+			return fmt.Errorf("code %s: %w", code, ErrPromotionUsedUp)
+		}
+	}
+	return nil
+}
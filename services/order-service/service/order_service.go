@@ -0,0 +1,655 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/order-service/clients"
+	commonPb "github.com/martbul/playground_microservices/services/order-service/genproto/common"
+	productPb "github.com/martbul/playground_microservices/services/order-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+	"github.com/martbul/playground_microservices/services/order-service/payment"
+	"github.com/martbul/playground_microservices/services/order-service/repository"
+)
+
+// maxOrderLines keeps an order within what product-service looks up in one batch
+const maxOrderLines = 100
+
+// restockReason is the stock ledger reason for stock put back by a cancelled order
+const restockReason = "order_cancelled"
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+type OrderService interface {
+	Checkout(ctx context.Context, req *models.CheckoutRequest) (*models.Order, error)
+	GetOrder(id, userID string) (*models.Order, error)
+	ListOrders(userID, status string, pagination *models.PaginationRequest) ([]*models.Order, *models.PaginationResponse, error)
+	UpdateStatus(ctx context.Context, req *models.StatusUpdateRequest) (*models.Order, error)
+	RecoverPendingOrders(ctx context.Context) (int, error)
+	EvaluatePromotions(ctx context.Context, req *models.EvaluatePromotionsRequest) (*models.PromotionEvaluation, error)
+}
+
+// ProductCatalog is what the order service needs from product-service;
+// clients.ProductClient calls the real one
+type ProductCatalog interface {
+	BatchGetProducts(ctx context.Context, ids []string) (map[string]*productPb.Product, error)
+	ListProductVariants(ctx context.Context, productID string) ([]*productPb.ProductVariant, error)
+	ReserveStock(ctx context.Context, reference string, items []*productPb.ReservationItem, ttl time.Duration) (*productPb.StockReservation, error)
+	CommitReservation(ctx context.Context, reservationID string) error
+	ReleaseReservation(ctx context.Context, reservationID string) error
+	AdjustStock(ctx context.Context, productID, variantID string, delta int32, reason, reference string) error
+	GetCategories(ctx context.Context) ([]*productPb.Category, error)
+}
+
+type orderService struct {
+	orderRepo       repository.OrderRepository
+	promotionRepo   repository.PromotionRepository
+	productClient   ProductCatalog
+	notifications   *clients.NotificationClient
+	payments        payment.Provider
+	maxQuantity     int32
+	checkoutTimeout time.Duration
+}
+
+// NewOrderService returns the order service. notifications may be nil, in
+// which case buyers aren't told about status changes.
+func NewOrderService(orderRepo repository.OrderRepository, promotionRepo repository.PromotionRepository, productClient ProductCatalog, notifications *clients.NotificationClient, payments payment.Provider, maxQuantity int32, checkoutTimeout time.Duration) OrderService {
+	return &orderService{
+		orderRepo:       orderRepo,
+		promotionRepo:   promotionRepo,
+		productClient:   productClient,
+		notifications:   notifications,
+		payments:        payments,
+		maxQuantity:     maxQuantity,
+		checkoutTimeout: checkoutTimeout,
+	}
+}
+
+// Checkout buys the items as a saga: the stock is reserved, the order is
+// created, the payment is authorised and captured, the stock is taken off
+// the shelf, and the order is marked paid. If a step fails, the ones before
+// it are undone. Once the order exists it is returned either way; a failed
+// checkout leaves it cancelled with the reason.
+func (s *orderService) Checkout(ctx context.Context, req *models.CheckoutRequest) (*models.Order, error) {
+	if !uuidPattern.MatchString(req.UserID) {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	if strings.TrimSpace(req.PaymentToken) == "" {
+		return nil, fmt.Errorf("payment token is required")
+	}
+	storeID := clients.IncomingStore(ctx)
+	if storeID == "" {
+		return nil, fmt.Errorf("store is required")
+	}
+
+	items, err := s.checkoutItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := promotionCodes(req.PromotionCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	order, entries, err := s.priceOrder(ctx, items, strings.ToUpper(strings.TrimSpace(req.Currency)))
+	if err != nil {
+		return nil, err
+	}
+	order.StoreID = storeID
+	rejected, err := s.applyPromotions(ctx, order, entries, codes, strings.ToLower(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if len(rejected) > 0 {
+		return nil, fmt.Errorf("code %s can't be used: %s", rejected[0].Code, rejected[0].Reason)
+	}
+	if order.ID, err = newOrderID(); err != nil {
+		return nil, err
+	}
+	order.UserID = strings.ToLower(req.UserID)
+	order.Status = models.OrderPending
+	order.PaymentProvider = s.payments.Name()
+	order.PaymentStatus = models.PaymentNone
+	order.StockStatus = models.StockNone
+
+	steps := []sagaStep{
+		{
+			name:       "reserve stock",
+			run:        func(ctx context.Context) error { return s.reserveStock(ctx, order) },
+			compensate: func(ctx context.Context, cause error) error { return s.releaseStock(ctx, order) },
+		},
+		{
+			name:       "create order",
+			run:        func(ctx context.Context) error { return s.orderRepo.Create(order) },
+			compensate: func(ctx context.Context, cause error) error { return s.cancelPending(order, cause) },
+		},
+		{
+			name:       "authorize payment",
+			run:        func(ctx context.Context) error { return s.authorizePayment(ctx, order, req.PaymentToken) },
+			compensate: func(ctx context.Context, cause error) error { return s.voidPayment(ctx, order) },
+		},
+		{
+			name:       "capture payment",
+			run:        func(ctx context.Context) error { return s.capturePayment(ctx, order) },
+			compensate: func(ctx context.Context, cause error) error { return s.refundPayment(ctx, order) },
+		},
+		{
+			name:       "commit stock",
+			run:        func(ctx context.Context) error { return s.commitStock(ctx, order) },
+			compensate: func(ctx context.Context, cause error) error { return s.returnStock(ctx, order) },
+		},
+		{
+			name: "confirm order",
+			run: func(ctx context.Context) error {
+				return s.orderRepo.Transition(order, models.OrderPaid, "Payment received", "")
+			},
+		},
+	}
+
+	if err := runSaga(ctx, steps); err != nil {
+		if !stored(order) {
+			return nil, err
+		}
+		return order, err
+	}
+	s.notifyStatus(order, "", req.Token)
+	return order, nil
+}
+
+// checkoutItems validates the requested items, adding up the quantities of
+// any product listed twice
+func (s *orderService) checkoutItems(requested []*models.CheckoutItem) ([]*models.CheckoutItem, error) {
+	if len(requested) == 0 {
+		return nil, fmt.Errorf("at least one item is required")
+	}
+
+	var items []*models.CheckoutItem
+	byKey := make(map[string]*models.CheckoutItem)
+	for _, item := range requested {
+		if !uuidPattern.MatchString(item.ProductID) {
+			return nil, fmt.Errorf("product not found")
+		}
+		if item.VariantID != "" && !uuidPattern.MatchString(item.VariantID) {
+			return nil, fmt.Errorf("variant not found")
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("quantity must be at least 1")
+		}
+
+		key := strings.ToLower(item.ProductID + "/" + item.VariantID)
+		if existing, ok := byKey[key]; ok {
+			existing.Quantity += item.Quantity
+			continue
+		}
+
+		normalized := *item
+		normalized.ProductID = strings.ToLower(item.ProductID)
+		normalized.VariantID = strings.ToLower(item.VariantID)
+		byKey[key] = &normalized
+		items = append(items, &normalized)
+	}
+
+	if len(items) > maxOrderLines {
+		return nil, fmt.Errorf("an order can hold at most %d different items", maxOrderLines)
+	}
+	for _, item := range items {
+		if item.Quantity > s.maxQuantity {
+			return nil, fmt.Errorf("at most %d of an item can be ordered", s.maxQuantity)
+		}
+	}
+	return items, nil
+}
+
+// priceOrder checks the items against product-service and prices them in
+// the currency, the first product's own currency if none is given. Stock is
+// left to the reservation, which checks it atomically. The products looked
+// up are returned with the order.
+func (s *orderService) priceOrder(ctx context.Context, items []*models.CheckoutItem, currency string) (*models.Order, map[string]*catalogEntry, error) {
+	entries, err := s.lookup(ctx, items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if currency == "" {
+		if entry := entries[items[0].ProductID]; entry != nil {
+			currency = entry.product.EffectivePrice.GetCurrencyCode()
+		}
+	}
+
+	order := &models.Order{Currency: currency, Total: models.Money{Currency: currency}}
+	for _, item := range items {
+		entry := entries[item.ProductID]
+		if entry == nil || !entry.product.IsActive {
+			return nil, nil, fmt.Errorf("a product in the order is no longer available")
+		}
+		product := entry.product
+
+		orderItem := &models.OrderItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Name:      product.Name,
+			SKU:       product.Sku,
+			Quantity:  item.Quantity,
+		}
+
+		var variant *productPb.ProductVariant
+		if item.VariantID != "" {
+			variant = entry.variants[item.VariantID]
+			if variant == nil || !variant.IsActive {
+				return nil, nil, fmt.Errorf("the chosen option of %s is no longer available", product.Name)
+			}
+			orderItem.SKU = variant.Sku
+			orderItem.OptionValues = variant.OptionValues
+		} else if len(entry.variants) > 0 {
+			return nil, nil, fmt.Errorf("choose an option of %s", product.Name)
+		}
+
+		price, ok := priceIn(product, variant, currency)
+		if !ok {
+			return nil, nil, fmt.Errorf("%s is not sold in %s", product.Name, currency)
+		}
+		if item.UnitPrice != nil && (item.UnitPrice.Currency != currency || item.UnitPrice.Cmp(price) != 0) {
+			return nil, nil, fmt.Errorf("the price of %s has changed to %s %s", product.Name, price.Amount(), currency)
+		}
+		orderItem.UnitPrice = price
+
+		order.Items = append(order.Items, orderItem)
+		order.Total = order.Total.Add(orderItem.LineTotal())
+	}
+	order.Subtotal = order.Total
+	order.DiscountTotal = models.Money{Currency: currency}
+	return order, entries, nil
+}
+
+// reserveStock holds the stock for every item. The hold outlives the
+// checkout timeout so an interrupted checkout can still be finished.
+func (s *orderService) reserveStock(ctx context.Context, order *models.Order) error {
+	items := make([]*productPb.ReservationItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &productPb.ReservationItem{
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	reservation, err := s.productClient.ReserveStock(ctx, "order "+order.ID, items, 2*s.checkoutTimeout)
+	if err != nil {
+		return err
+	}
+	order.ReservationID = reservation.Id
+	order.StockStatus = models.StockReserved
+	return nil
+}
+
+func (s *orderService) releaseStock(ctx context.Context, order *models.Order) error {
+	if order.StockStatus != models.StockReserved {
+		return nil
+	}
+	if err := s.productClient.ReleaseReservation(ctx, order.ReservationID); err != nil {
+		return err
+	}
+	order.StockStatus = models.StockReleased
+	return s.saveProgress(order)
+}
+
+// commitStock takes the reserved stock off the shelf
+func (s *orderService) commitStock(ctx context.Context, order *models.Order) error {
+	if order.StockStatus == models.StockCommitted {
+		return nil
+	}
+	if err := s.productClient.CommitReservation(ctx, order.ReservationID); err != nil {
+		return err
+	}
+	order.StockStatus = models.StockCommitted
+	return s.saveProgress(order)
+}
+
+// returnStock puts committed stock back on the shelf. Each item is claimed
+// before its stock is adjusted, so it is never put back twice.
+func (s *orderService) returnStock(ctx context.Context, order *models.Order) error {
+	if order.StockStatus != models.StockCommitted {
+		return nil
+	}
+
+	for _, item := range order.Items {
+		claimed, err := s.orderRepo.SetRestocked(item.ID, true)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.productClient.AdjustStock(ctx, item.ProductID, item.VariantID, item.Quantity, restockReason, "order "+order.ID); err != nil {
+			if _, unclaimErr := s.orderRepo.SetRestocked(item.ID, false); unclaimErr != nil {
+				log.Printf("Failed to unmark item %s of order %s as restocked: %v", item.ID, order.ID, unclaimErr)
+			}
+			return err
+		}
+		item.Restocked = true
+	}
+
+	order.StockStatus = models.StockReturned
+	return s.saveProgress(order)
+}
+
+func (s *orderService) authorizePayment(ctx context.Context, order *models.Order, token string) error {
+	paymentID, err := s.payments.Authorize(ctx, &payment.AuthorizeRequest{
+		OrderID: order.ID,
+		Amount:  order.Total,
+		Token:   token,
+	})
+	if err != nil {
+		return err
+	}
+	order.PaymentID = paymentID
+	order.PaymentStatus = models.PaymentAuthorized
+	return s.saveProgress(order)
+}
+
+func (s *orderService) voidPayment(ctx context.Context, order *models.Order) error {
+	if order.PaymentStatus != models.PaymentAuthorized {
+		return nil
+	}
+	if err := s.payments.Void(ctx, order.PaymentID); err != nil {
+		return fmt.Errorf("failed to void payment: %w", err)
+	}
+	order.PaymentStatus = models.PaymentVoided
+	return s.saveProgress(order)
+}
+
+func (s *orderService) capturePayment(ctx context.Context, order *models.Order) error {
+	if err := s.payments.Capture(ctx, order.PaymentID); err != nil {
+		return fmt.Errorf("failed to capture payment: %w", err)
+	}
+	order.PaymentStatus = models.PaymentCaptured
+	return s.saveProgress(order)
+}
+
+func (s *orderService) refundPayment(ctx context.Context, order *models.Order) error {
+	if order.PaymentStatus != models.PaymentCaptured {
+		return nil
+	}
+	if err := s.payments.Refund(ctx, order.PaymentID); err != nil {
+		return fmt.Errorf("failed to refund payment: %w", err)
+	}
+	order.PaymentStatus = models.PaymentRefunded
+	return s.saveProgress(order)
+}
+
+// cancelPending cancels an order whose checkout failed. An order still
+// holding the buyer's money or stock stays pending for the recovery sweep;
+// a reservation still held is fine since it runs out by itself.
+func (s *orderService) cancelPending(order *models.Order, cause error) error {
+	if !stored(order) || order.Status != models.OrderPending {
+		return nil
+	}
+	if order.PaymentStatus == models.PaymentAuthorized || order.PaymentStatus == models.PaymentCaptured || order.StockStatus == models.StockCommitted {
+		return fmt.Errorf("order %s left pending: its payment or stock could not be given back", order.ID)
+	}
+
+	order.FailureReason = cause.Error()
+	return s.orderRepo.Transition(order, models.OrderCancelled, "Checkout failed", "")
+}
+
+// saveProgress stores the order's payment and stock progress, once the order
+// has been stored at all
+func (s *orderService) saveProgress(order *models.Order) error {
+	if !stored(order) {
+		return nil
+	}
+	return s.orderRepo.SaveProgress(order)
+}
+
+func stored(order *models.Order) bool {
+	return !order.CreatedAt.IsZero()
+}
+
+// GetOrder returns an order; with userID set, only if it's that user's
+func (s *orderService) GetOrder(id, userID string) (*models.Order, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("order not found")
+	}
+
+	order, err := s.orderRepo.GetByID(strings.ToLower(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, fmt.Errorf("order not found")
+		}
+		return nil, err
+	}
+	if userID != "" && order.UserID != strings.ToLower(userID) {
+		return nil, fmt.Errorf("order not found")
+	}
+	return order, nil
+}
+
+func (s *orderService) ListOrders(userID, status string, pagination *models.PaginationRequest) ([]*models.Order, *models.PaginationResponse, error) {
+	if userID != "" && !uuidPattern.MatchString(userID) {
+		return nil, nil, fmt.Errorf("invalid user ID")
+	}
+	status = strings.ToLower(strings.TrimSpace(status))
+	if status != "" && !models.IsOrderStatus(status) {
+		return nil, nil, fmt.Errorf("invalid order status %q", status)
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 || pagination.Limit > 100 {
+		pagination.Limit = 20
+	}
+
+	return s.orderRepo.List(strings.ToLower(userID), status, pagination)
+}
+
+// UpdateStatus moves an order on. Cancelling a paid order refunds the
+// payment and puts the stock back; refunding returns the payment only.
+// Buyers (req.UserID set) can only cancel. Asking for the status an order
+// already has changes nothing, so a failed cancellation can be retried.
+func (s *orderService) UpdateStatus(ctx context.Context, req *models.StatusUpdateRequest) (*models.Order, error) {
+	status := strings.ToLower(strings.TrimSpace(req.Status))
+	if !models.IsOrderStatus(status) {
+		return nil, fmt.Errorf("invalid order status %q", req.Status)
+	}
+	if req.UserID != "" && status != models.OrderCancelled {
+		return nil, fmt.Errorf("orders can only be cancelled by their buyer")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if len(reason) > 500 {
+		return nil, fmt.Errorf("reason must be at most 500 characters")
+	}
+
+	order, err := s.GetOrder(req.OrderID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == status {
+		return order, nil
+	}
+	if order.Status == models.OrderPending {
+		return nil, fmt.Errorf("order is still being checked out")
+	}
+	if !models.CanTransition(order.Status, status) {
+		return nil, fmt.Errorf("a %s order cannot be changed to %s", order.Status, status)
+	}
+
+	switch status {
+	case models.OrderCancelled:
+		if err := s.refundPayment(ctx, order); err != nil {
+			return nil, err
+		}
+		if err := s.returnStock(ctx, order); err != nil {
+			return nil, err
+		}
+	case models.OrderRefunded:
+		if err := s.refundPayment(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.orderRepo.Transition(order, status, reason, req.Actor); err != nil {
+		if errors.Is(err, repository.ErrStatusConflict) {
+			return nil, fmt.Errorf("order was changed by someone else, please reload it and try again")
+		}
+		return nil, err
+	}
+	s.notifyStatus(order, reason, req.Token)
+	return order, nil
+}
+
+// RecoverPendingOrders finishes or undoes checkouts that were interrupted,
+// such as by a crash, and returns how many it dealt with. A checkout whose
+// payment was captured is finished if its stock can still be taken;
+// everything else is undone and the order cancelled.
+func (s *orderService) RecoverPendingOrders(ctx context.Context) (int, error) {
+	orders, err := s.orderRepo.ListPendingBefore(time.Now().Add(-s.checkoutTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	recovered := 0
+	for _, order := range orders {
+		if err := s.recover(ctx, order); err != nil {
+			log.Printf("Failed to recover order %s: %v", order.ID, err)
+			continue
+		}
+		s.notifyStatus(order, order.FailureReason, "")
+		recovered++
+	}
+	return recovered, nil
+}
+
+func (s *orderService) recover(ctx context.Context, order *models.Order) error {
+	// No one is calling for the order's store, so name it to product-service
+	ctx = clients.WithStore(ctx, order.StoreID)
+	cause := errors.New("checkout was interrupted")
+	if order.PaymentStatus == models.PaymentCaptured {
+		err := s.commitStock(ctx, order)
+		if err == nil {
+			return s.orderRepo.Transition(order, models.OrderPaid, "Payment received", "")
+		}
+		cause = err
+	}
+
+	// The same compensations as a failed checkout, in the same order
+	return errors.Join(
+		s.returnStock(ctx, order),
+		s.refundPayment(ctx, order),
+		s.voidPayment(ctx, order),
+		s.cancelPending(order, cause),
+		s.releaseStock(ctx, order),
+	)
+}
+
+// notifyStatus tells the buyer about the order's new status. It runs in the
+// background so a slow or missing notification-service never holds up an
+// order. Without a token the buyer's email can't be looked up, so only the
+// other channels are used.
+func (s *orderService) notifyStatus(order *models.Order, reason, token string) {
+	if s.notifications == nil {
+		return
+	}
+
+	data := map[string]string{"order_id": order.ID, "status": order.Status}
+	if reason != "" {
+		data["reason"] = reason
+	}
+	userID := order.UserID
+	go func() {
+		if err := s.notifications.Send(context.Background(), "order_status", userID, data, token); err != nil {
+			log.Printf("Failed to notify buyer of order %s: %v", data["order_id"], err)
+		}
+	}()
+}
+
+// catalogEntry is a product as product-service has it now, with its variants
+type catalogEntry struct {
+	product  *productPb.Product
+	variants map[string]*productPb.ProductVariant
+}
+
+// lookup fetches the products of the items from product-service, in one
+// batch plus a variant lookup per product
+func (s *orderService) lookup(ctx context.Context, items []*models.CheckoutItem) (map[string]*catalogEntry, error) {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if !seen[item.ProductID] {
+			seen[item.ProductID] = true
+			ids = append(ids, item.ProductID)
+		}
+	}
+
+	products, err := s.productClient.BatchGetProducts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*catalogEntry, len(products))
+	for _, id := range ids {
+		product, ok := products[id]
+		if !ok {
+			continue
+		}
+
+		variants, err := s.productClient.ListProductVariants(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		entry := &catalogEntry{product: product, variants: make(map[string]*productPb.ProductVariant, len(variants))}
+		for _, variant := range variants {
+			entry.variants[variant.Id] = variant
+		}
+		entries[id] = entry
+	}
+	return entries, nil
+}
+
+// priceIn is what the product or variant sells for now in the currency. A
+// variant with its own price sells for that, which is only set in the
+// product's currency; the price list holds the product's price, so it
+// doesn't apply to such a variant. Everything else sells for the product's
+// effective price in its own currency, otherwise its price list entry.
+func priceIn(product *productPb.Product, variant *productPb.ProductVariant, currency string) (models.Money, bool) {
+	if variant != nil && variant.Price != nil {
+		if variant.Price.CurrencyCode != currency {
+			return models.Money{}, false
+		}
+		return moneyFromProto(variant.Price), true
+	}
+
+	if product.EffectivePrice.GetCurrencyCode() == currency {
+		return moneyFromProto(product.EffectivePrice), true
+	}
+
+	for _, price := range product.Prices {
+		if price.CurrencyCode == currency {
+			return moneyFromProto(price), true
+		}
+	}
+	return models.Money{}, false
+}
+
+func moneyFromProto(money *commonPb.Money) models.Money {
+	return models.Money{Currency: money.CurrencyCode, Units: money.Units, Nanos: money.Nanos}
+}
+
+// newOrderID returns a random (version 4) UUID. Orders get their ID before
+// they are stored so the stock reservation can refer to them.
+func newOrderID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate order ID: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/order-service/models"
+	"github.com/martbul/playground_microservices/services/order-service/repository"
+)
+
+// maxPromotionTargets bounds the products and categories a promotion lists
+const maxPromotionTargets = 100
+
+var promotionCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// PromotionService manages a store's promotions; every method works within
+// the store given
+type PromotionService interface {
+	CreatePromotion(storeID string, req *models.PromotionRequest, createdBy string) (*models.Promotion, error)
+	UpdatePromotion(storeID, id string, req *models.PromotionRequest) (*models.Promotion, error)
+	GetPromotion(storeID, id string) (*models.Promotion, error)
+	ListPromotions(storeID, query string, activeOnly bool, pagination *models.PaginationRequest) ([]*models.Promotion, *models.PaginationResponse, error)
+	DeletePromotion(storeID, id string) error
+}
+
+type promotionService struct {
+	promotionRepo repository.PromotionRepository
+}
+
+func NewPromotionService(promotionRepo repository.PromotionRepository) PromotionService {
+	return &promotionService{promotionRepo: promotionRepo}
+}
+
+func (s *promotionService) CreatePromotion(storeID string, req *models.PromotionRequest, createdBy string) (*models.Promotion, error) {
+	if storeID == "" {
+		return nil, fmt.Errorf("store is required")
+	}
+	promotion := &models.Promotion{StoreID: storeID, CreatedBy: createdBy}
+	if err := applyPromotionRequest(promotion, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.promotionRepo.Create(promotion); err != nil {
+		if errors.Is(err, repository.ErrPromotionCodeTaken) {
+			return nil, fmt.Errorf("code %s is already taken", promotion.Code)
+		}
+		return nil, err
+	}
+	return promotion, nil
+}
+
+// UpdatePromotion replaces all of the promotion's settings. Orders that
+// already used it keep the discount they got.
+func (s *promotionService) UpdatePromotion(storeID, id string, req *models.PromotionRequest) (*models.Promotion, error) {
+	promotion, err := s.GetPromotion(storeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPromotionRequest(promotion, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.promotionRepo.Update(promotion); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPromotionNotFound):
+			return nil, fmt.Errorf("promotion not found")
+		case errors.Is(err, repository.ErrPromotionCodeTaken):
+			return nil, fmt.Errorf("code %s is already taken", promotion.Code)
+		}
+		return nil, err
+	}
+	return promotion, nil
+}
+
+func (s *promotionService) GetPromotion(storeID, id string) (*models.Promotion, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("promotion not found")
+	}
+
+	promotion, err := s.promotionRepo.GetByID(storeID, strings.ToLower(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrPromotionNotFound) {
+			return nil, fmt.Errorf("promotion not found")
+		}
+		return nil, err
+	}
+	return promotion, nil
+}
+
+func (s *promotionService) ListPromotions(storeID, query string, activeOnly bool, pagination *models.PaginationRequest) ([]*models.Promotion, *models.PaginationResponse, error) {
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 || pagination.Limit > 100 {
+		pagination.Limit = 20
+	}
+
+	return s.promotionRepo.List(storeID, strings.TrimSpace(query), activeOnly, pagination)
+}
+
+// DeletePromotion removes the promotion; orders that used it keep their
+// copy of it
+func (s *promotionService) DeletePromotion(storeID, id string) error {
+	if !uuidPattern.MatchString(id) {
+		return fmt.Errorf("promotion not found")
+	}
+
+	if err := s.promotionRepo.Delete(storeID, strings.ToLower(id)); err != nil {
+		if errors.Is(err, repository.ErrPromotionNotFound) {
+			return fmt.Errorf("promotion not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// applyPromotionRequest validates the request and copies it onto the
+// promotion
+func applyPromotionRequest(promotion *models.Promotion, req *models.PromotionRequest) error {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if !promotionCodePattern.MatchString(code) {
+		return fmt.Errorf("code must be 3 to 32 letters, digits, dashes or underscores")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(name) > 255 {
+		return fmt.Errorf("name must be at most 255 characters")
+	}
+
+	discountType := strings.ToLower(strings.TrimSpace(req.DiscountType))
+	var percentOff int32
+	var amountOff *models.Money
+	switch discountType {
+	case models.DiscountPercentage:
+		if req.PercentOff < 1 || req.PercentOff > 100 {
+			return fmt.Errorf("percent off must be between 1 and 100")
+		}
+		percentOff = req.PercentOff
+	case models.DiscountFixed:
+		if req.AmountOff == nil || !req.AmountOff.IsPositive() {
+			return fmt.Errorf("amount off must be more than zero")
+		}
+		amount, err := promotionMoney(*req.AmountOff)
+		if err != nil {
+			return err
+		}
+		amountOff = &amount
+	default:
+		return fmt.Errorf("discount type must be %s or %s", models.DiscountPercentage, models.DiscountFixed)
+	}
+
+	var minSpend *models.Money
+	if req.MinSpend != nil && req.MinSpend.IsPositive() {
+		amount, err := promotionMoney(*req.MinSpend)
+		if err != nil {
+			return err
+		}
+		if amountOff != nil && amount.Currency != amountOff.Currency {
+			return fmt.Errorf("minimum spend must be in %s, like the amount off", amountOff.Currency)
+		}
+		minSpend = &amount
+	}
+
+	productIDs, err := promotionTargets(req.ProductIDs, "product")
+	if err != nil {
+		return err
+	}
+	categoryIDs, err := promotionTargets(req.CategoryIDs, "category")
+	if err != nil {
+		return err
+	}
+
+	if req.MaxUses < 0 || req.MaxUsesPerUser < 0 {
+		return fmt.Errorf("usage limits can't be negative")
+	}
+
+	startsAt, err := promotionTime(req.StartsAt, "start")
+	if err != nil {
+		return err
+	}
+	endsAt, err := promotionTime(req.EndsAt, "end")
+	if err != nil {
+		return err
+	}
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return fmt.Errorf("end time must be after the start time")
+	}
+
+	promotion.Code = code
+	promotion.Name = name
+	promotion.Description = strings.TrimSpace(req.Description)
+	promotion.DiscountType = discountType
+	promotion.PercentOff = percentOff
+	promotion.AmountOff = amountOff
+	promotion.MinSpend = minSpend
+	promotion.ProductIDs = productIDs
+	promotion.CategoryIDs = categoryIDs
+	promotion.MaxUses = req.MaxUses
+	promotion.MaxUsesPerUser = req.MaxUsesPerUser
+	promotion.StartsAt = startsAt
+	promotion.EndsAt = endsAt
+	promotion.IsActive = req.IsActive
+	return nil
+}
+
+// promotionMoney checks an amount has a currency and fits its column
+func promotionMoney(amount models.Money) (models.Money, error) {
+	amount.Currency = strings.ToUpper(strings.TrimSpace(amount.Currency))
+	if len(amount.Currency) != 3 {
+		return models.Money{}, fmt.Errorf("amounts need a three-letter currency code")
+	}
+	if amount.Units >= 1000000000 {
+		return models.Money{}, fmt.Errorf("amount %s is too large", amount.Amount())
+	}
+	return amount, nil
+}
+
+// promotionTargets validates a list of product or category IDs, dropping
+// duplicates
+func promotionTargets(ids []string, kind string) ([]string, error) {
+	if len(ids) > maxPromotionTargets {
+		return nil, fmt.Errorf("a promotion can list at most %d %s IDs", maxPromotionTargets, kind)
+	}
+
+	targets := []string{}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if !uuidPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid %s ID %q", kind, id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			targets = append(targets, id)
+		}
+	}
+	return targets, nil
+}
+
+// promotionTime parses an optional RFC 3339 time. Times are kept in UTC, as
+// the columns have no time zone.
+func promotionTime(value, which string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s time, use RFC 3339", which)
+	}
+	parsed = parsed.UTC()
+	return &parsed, nil
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/order-service/clients"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+	"github.com/martbul/playground_microservices/services/order-service/repository"
+)
+
+// maxPromotionCodes is the most codes one order can use
+const maxPromotionCodes = 5
+
+// maxCategoryDepth bounds the walk up the category tree, in case
+// product-service ever hands back a loop
+const maxCategoryDepth = 32
+
+// EvaluatePromotions prices the items as checkout would and works out what
+// the codes take off them. Codes that don't apply are listed with the reason
+// rather than failing the evaluation.
+func (s *orderService) EvaluatePromotions(ctx context.Context, req *models.EvaluatePromotionsRequest) (*models.PromotionEvaluation, error) {
+	if req.UserID != "" && !uuidPattern.MatchString(req.UserID) {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	storeID := clients.IncomingStore(ctx)
+	if storeID == "" {
+		return nil, fmt.Errorf("store is required")
+	}
+
+	items, err := s.checkoutItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := promotionCodes(req.Codes)
+	if err != nil {
+		return nil, err
+	}
+
+	order, entries, err := s.priceOrder(ctx, items, strings.ToUpper(strings.TrimSpace(req.Currency)))
+	if err != nil {
+		return nil, err
+	}
+	order.StoreID = storeID
+	rejected, err := s.applyPromotions(ctx, order, entries, codes, strings.ToLower(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PromotionEvaluation{
+		Subtotal:      order.Subtotal,
+		DiscountTotal: order.DiscountTotal,
+		Total:         order.Total,
+		Applied:       order.Promotions,
+		Rejected:      rejected,
+	}, nil
+}
+
+// promotionCodes normalizes the codes to upper case, dropping blank ones
+func promotionCodes(requested []string) ([]string, error) {
+	var codes []string
+	for _, code := range requested {
+		if code = strings.ToUpper(strings.TrimSpace(code)); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) > maxPromotionCodes {
+		return nil, fmt.Errorf("at most %d promotion codes can be used at once", maxPromotionCodes)
+	}
+	return codes, nil
+}
+
+// applyPromotions applies the codes of the order's store to the priced order
+// in the order given, each to what the ones before it left of every item,
+// and sets the order's Promotions, DiscountTotal and Total. Codes that can't
+// be applied are returned with the reason; an error means they couldn't be
+// checked at all.
+func (s *orderService) applyPromotions(ctx context.Context, order *models.Order, entries map[string]*catalogEntry, codes []string, userID string) ([]*models.RejectedPromotion, error) {
+	remaining := make([]models.Money, len(order.Items))
+	for i, item := range order.Items {
+		remaining[i] = item.LineTotal()
+	}
+
+	var rejected []*models.RejectedPromotion
+	var categories *categoryTree
+	seen := make(map[string]bool)
+	now := time.Now()
+
+	for _, code := range codes {
+		if seen[code] {
+			rejected = append(rejected, &models.RejectedPromotion{Code: code, Reason: "code was already applied"})
+			continue
+		}
+		seen[code] = true
+
+		promotion, err := s.promotionRepo.GetByCode(order.StoreID, code)
+		if err != nil {
+			if errors.Is(err, repository.ErrPromotionNotFound) {
+				rejected = append(rejected, &models.RejectedPromotion{Code: code, Reason: "code not found"})
+				continue
+			}
+			return nil, err
+		}
+
+		reason, err := s.checkPromotion(promotion, order, userID, now)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			rejected = append(rejected, &models.RejectedPromotion{Code: code, Reason: reason})
+			continue
+		}
+
+		if len(promotion.CategoryIDs) > 0 && categories == nil {
+			if categories, err = s.loadCategories(ctx); err != nil {
+				return nil, err
+			}
+		}
+		eligible := eligibleItems(promotion, order, entries, categories)
+		if len(eligible) == 0 {
+			rejected = append(rejected, &models.RejectedPromotion{Code: code, Reason: "no items in the order are eligible"})
+			continue
+		}
+
+		if promotion.MinSpend != nil {
+			spent := models.Money{Currency: order.Currency}
+			for _, i := range eligible {
+				spent = spent.Add(order.Items[i].LineTotal())
+			}
+			if spent.Cmp(*promotion.MinSpend) < 0 {
+				rejected = append(rejected, &models.RejectedPromotion{
+					Code:   code,
+					Reason: fmt.Sprintf("spend at least %s %s on eligible items to use this code", promotion.MinSpend.Amount(), order.Currency),
+				})
+				continue
+			}
+		}
+
+		applied := discountItems(promotion, order, eligible, remaining)
+		if !applied.Discount.IsPositive() {
+			rejected = append(rejected, &models.RejectedPromotion{Code: code, Reason: "nothing is left to discount"})
+			continue
+		}
+		order.Promotions = append(order.Promotions, applied)
+		order.DiscountTotal = order.DiscountTotal.Add(applied.Discount)
+	}
+
+	order.Total = order.Subtotal.Sub(order.DiscountTotal)
+	return rejected, nil
+}
+
+// checkPromotion returns why the promotion can't be used on the order, or ""
+// if it can. Per-user limits are only checked with a user.
+func (s *orderService) checkPromotion(promotion *models.Promotion, order *models.Order, userID string, now time.Time) (string, error) {
+	switch {
+	case !promotion.IsActive:
+		return "code is not active", nil
+	case promotion.StartsAt != nil && now.Before(*promotion.StartsAt):
+		return "code is not valid yet", nil
+	case promotion.EndsAt != nil && !now.Before(*promotion.EndsAt):
+		return "code has expired", nil
+	case promotion.Currency() != "" && promotion.Currency() != order.Currency:
+		return fmt.Sprintf("code only applies to orders in %s", promotion.Currency()), nil
+	case promotion.MaxUses > 0 && promotion.Uses >= promotion.MaxUses:
+		return "code has been used up", nil
+	}
+
+	if promotion.MaxUsesPerUser > 0 && userID != "" {
+		uses, err := s.promotionRepo.CountUserUses(promotion.ID, userID)
+		if err != nil {
+			return "", err
+		}
+		if uses >= promotion.MaxUsesPerUser {
+			return "you have already used this code", nil
+		}
+	}
+	return "", nil
+}
+
+// eligibleItems returns the indexes of the order's items the promotion
+// applies to: all of them, unless it's limited to products or categories
+func eligibleItems(promotion *models.Promotion, order *models.Order, entries map[string]*catalogEntry, categories *categoryTree) []int {
+	products := make(map[string]bool, len(promotion.ProductIDs))
+	for _, id := range promotion.ProductIDs {
+		products[id] = true
+	}
+	inCategories := make(map[string]bool, len(promotion.CategoryIDs))
+	for _, id := range promotion.CategoryIDs {
+		inCategories[id] = true
+	}
+	restricted := len(products) > 0 || len(inCategories) > 0
+
+	var eligible []int
+	for i, item := range order.Items {
+		switch {
+		case !restricted, products[item.ProductID]:
+		case len(inCategories) > 0 && entries[item.ProductID] != nil && categories.within(entries[item.ProductID].product.CategoryId, inCategories):
+		default:
+			continue
+		}
+		eligible = append(eligible, i)
+	}
+	return eligible
+}
+
+// discountItems works out what the promotion takes off the eligible items,
+// taking it off what's left of them. A percentage comes off each item; a
+// fixed amount comes off the items in turn until it's used up.
+func discountItems(promotion *models.Promotion, order *models.Order, eligible []int, remaining []models.Money) *models.AppliedPromotion {
+	applied := &models.AppliedPromotion{
+		PromotionID: promotion.ID,
+		Code:        promotion.Code,
+		Name:        promotion.Name,
+		Discount:    models.Money{Currency: order.Currency},
+	}
+
+	var left models.Money
+	if promotion.DiscountType == models.DiscountFixed {
+		left = *promotion.AmountOff
+	}
+
+	var quantity int32
+	for _, i := range eligible {
+		item := order.Items[i]
+		quantity += item.Quantity
+
+		var discount models.Money
+		if promotion.DiscountType == models.DiscountFixed {
+			discount = left
+		} else {
+			discount = remaining[i].Percent(promotion.PercentOff)
+		}
+		if discount.Cmp(remaining[i]) > 0 {
+			discount = remaining[i]
+		}
+		if !discount.IsPositive() {
+			continue
+		}
+
+		if promotion.DiscountType == models.DiscountFixed {
+			left = left.Sub(discount)
+		}
+		remaining[i] = remaining[i].Sub(discount)
+		applied.Discount = applied.Discount.Add(discount)
+		applied.Lines = append(applied.Lines, &models.LineDiscount{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Discount:  discount,
+		})
+	}
+
+	off := fmt.Sprintf("%d%% off", promotion.PercentOff)
+	if promotion.DiscountType == models.DiscountFixed {
+		off = fmt.Sprintf("%s %s off", promotion.AmountOff.Amount(), order.Currency)
+	}
+	switch {
+	case len(promotion.ProductIDs) == 0 && len(promotion.CategoryIDs) == 0:
+		applied.Explanation = off + " your order"
+	case quantity == 1:
+		applied.Explanation = off + " 1 eligible item"
+	default:
+		applied.Explanation = fmt.Sprintf("%s %d eligible items", off, quantity)
+	}
+	return applied
+}
+
+// categoryTree knows each category's parent
+type categoryTree struct {
+	parents map[string]string
+}
+
+func (s *orderService) loadCategories(ctx context.Context) (*categoryTree, error) {
+	categories, err := s.productClient.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree := &categoryTree{parents: make(map[string]string, len(categories))}
+	for _, category := range categories {
+		tree.parents[category.Id] = category.ParentId
+	}
+	return tree, nil
+}
+
+// within reports whether the category is one of the set or sits under one
+func (t *categoryTree) within(categoryID string, set map[string]bool) bool {
+	for depth := 0; categoryID != "" && depth < maxCategoryDepth; depth++ {
+		if set[categoryID] {
+			return true
+		}
+		categoryID = t.parents[categoryID]
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// compensateTimeout bounds a single compensating action. Compensations get
+// a context that isn't cancelled with the request that failed, but keeps its
+// values and metadata, such as the store the order was placed in.
+const compensateTimeout = 30 * time.Second
+
+// sagaStep is one step of a saga with the action that undoes it. A step may
+// fail partway, so compensations check what was actually done and skip the
+// rest; that also makes them safe to repeat.
+type sagaStep struct {
+	name       string
+	run        func(ctx context.Context) error
+	compensate func(ctx context.Context, cause error) error
+}
+
+// runSaga runs the steps in order. When one fails, the compensations of it
+// and every step before it run in reverse order, and the step's error is
+// returned. Compensations that fail are logged; whatever they left behind is
+// picked up by the recovery sweep.
+func runSaga(ctx context.Context, steps []sagaStep) error {
+	for i, step := range steps {
+		err := step.run(ctx)
+		if err == nil {
+			continue
+		}
+
+		log.Printf("Saga step %s failed: %v", step.name, err)
+		for j := i; j >= 0; j-- {
+			if steps[j].compensate == nil {
+				continue
+			}
+			compensateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensateTimeout)
+			if compErr := steps[j].compensate(compensateCtx, err); compErr != nil {
+				log.Printf("Failed to undo saga step %s: %v", steps[j].name, compErr)
+			}
+			cancel()
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/order-service/genproto/common"
+	productPb "github.com/martbul/playground_microservices/services/order-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/order-service/models"
+	"github.com/martbul/playground_microservices/services/order-service/payment"
+	"github.com/martbul/playground_microservices/services/order-service/repository"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	sagaStore   = "00000000-0000-4000-8000-00000000000a"
+	sagaUser    = "00000000-0000-4000-8000-0000000000b1"
+	sagaProduct = "00000000-0000-4000-8000-0000000000c1"
+)
+
+func TestRunSagaUndoesEarlierStepsInReverse(t *testing.T) {
+	names := []string{"reserve", "create", "authorize", "capture", "commit"}
+	for failing := range names {
+		t.Run(names[failing], func(t *testing.T) {
+			var calls []string
+			failure := errors.New(names[failing] + " failed")
+			// The request is gone by the time the saga is undone
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			steps := make([]sagaStep, len(names))
+			for i, name := range names {
+				i, name := i, name
+				steps[i] = sagaStep{
+					name: name,
+					run: func(ctx context.Context) error {
+						calls = append(calls, "run "+name)
+						if i == failing {
+							cancel()
+							return failure
+						}
+						return nil
+					},
+					compensate: func(ctx context.Context, cause error) error {
+						calls = append(calls, "undo "+name)
+						if ctx.Err() != nil {
+							t.Errorf("undo %s: context is done: %v", name, ctx.Err())
+						}
+						if cause != failure {
+							t.Errorf("undo %s: cause %v, want %v", name, cause, failure)
+						}
+						// A compensation failing doesn't stop the ones before it
+						return errors.New("undo failed")
+					},
+				}
+			}
+
+			if err := runSaga(ctx, steps); err != failure {
+				t.Fatalf("got %v, want %v", err, failure)
+			}
+
+			var want []string
+			for i := 0; i <= failing; i++ {
+				want = append(want, "run "+names[i])
+			}
+			for i := failing; i >= 0; i-- {
+				want = append(want, "undo "+names[i])
+			}
+			if !reflect.DeepEqual(calls, want) {
+				t.Errorf("calls %v, want %v", calls, want)
+			}
+		})
+	}
+}
+
+func TestRunSagaSkipsStepsWithoutCompensation(t *testing.T) {
+	var undone []string
+	steps := []sagaStep{
+		{name: "first", run: func(ctx context.Context) error { return nil }, compensate: func(ctx context.Context, cause error) error {
+			undone = append(undone, "first")
+			return nil
+		}},
+		{name: "second", run: func(ctx context.Context) error { return nil }},
+		{name: "third", run: func(ctx context.Context) error { return errors.New("third failed") }},
+	}
+
+	if err := runSaga(context.Background(), steps); err == nil {
+		t.Fatal("got no error, want the third step's")
+	}
+	if !reflect.DeepEqual(undone, []string{"first"}) {
+		t.Errorf("undone %v, want [first]", undone)
+	}
+}
+
+// sagaCalls is the log of calls the checkout made to product-service, the
+// order repository and the payment provider, in order
+type sagaCalls struct {
+	calls []string
+	fail  string
+}
+
+// call logs the call and fails it if it is the one meant to fail
+func (c *sagaCalls) call(name string) error {
+	c.calls = append(c.calls, name)
+	if name == c.fail {
+		return fmt.Errorf("%s failed", name)
+	}
+	return nil
+}
+
+// sagaCatalog is product-service selling one product
+type sagaCatalog struct {
+	ProductCatalog
+	*sagaCalls
+}
+
+func (c *sagaCatalog) BatchGetProducts(ctx context.Context, ids []string) (map[string]*productPb.Product, error) {
+	return map[string]*productPb.Product{sagaProduct: {
+		Id:             sagaProduct,
+		Name:           "Lamp",
+		Sku:            "LAMP-1",
+		IsActive:       true,
+		EffectivePrice: &commonPb.Money{CurrencyCode: "USD", Units: 25},
+	}}, nil
+}
+
+func (c *sagaCatalog) ListProductVariants(ctx context.Context, productID string) ([]*productPb.ProductVariant, error) {
+	return nil, nil
+}
+
+func (c *sagaCatalog) ReserveStock(ctx context.Context, reference string, items []*productPb.ReservationItem, ttl time.Duration) (*productPb.StockReservation, error) {
+	if err := c.call("reserve stock"); err != nil {
+		return nil, err
+	}
+	return &productPb.StockReservation{Id: "reservation-1"}, nil
+}
+
+func (c *sagaCatalog) CommitReservation(ctx context.Context, reservationID string) error {
+	return c.call("commit stock")
+}
+
+func (c *sagaCatalog) ReleaseReservation(ctx context.Context, reservationID string) error {
+	return c.call("release stock")
+}
+
+func (c *sagaCatalog) AdjustStock(ctx context.Context, productID, variantID string, delta int32, reason, reference string) error {
+	return c.call("return stock")
+}
+
+// sagaOrders is an order repository holding the one order being checked out
+type sagaOrders struct {
+	repository.OrderRepository
+	*sagaCalls
+}
+
+func (r *sagaOrders) Create(order *models.Order) error {
+	if err := r.call("create order"); err != nil {
+		return err
+	}
+	order.CreatedAt = time.Now()
+	for i, item := range order.Items {
+		item.ID = fmt.Sprintf("item-%d", i+1)
+	}
+	return nil
+}
+
+func (r *sagaOrders) SaveProgress(order *models.Order) error {
+	return nil
+}
+
+func (r *sagaOrders) SetRestocked(itemID string, restocked bool) (bool, error) {
+	return true, nil
+}
+
+func (r *sagaOrders) Transition(order *models.Order, to, reason, actor string) error {
+	if err := r.call("mark " + to); err != nil {
+		return err
+	}
+	order.Status = to
+	return nil
+}
+
+// sagaPayments is the fake provider with its calls logged
+type sagaPayments struct {
+	*payment.Fake
+	*sagaCalls
+}
+
+func (p *sagaPayments) Authorize(ctx context.Context, req *payment.AuthorizeRequest) (string, error) {
+	p.calls = append(p.calls, "authorize payment")
+	return p.Fake.Authorize(ctx, req)
+}
+
+func (p *sagaPayments) Capture(ctx context.Context, paymentID string) error {
+	p.calls = append(p.calls, "capture payment")
+	return p.Fake.Capture(ctx, paymentID)
+}
+
+func (p *sagaPayments) Void(ctx context.Context, paymentID string) error {
+	p.calls = append(p.calls, "void payment")
+	return p.Fake.Void(ctx, paymentID)
+}
+
+func (p *sagaPayments) Refund(ctx context.Context, paymentID string) error {
+	p.calls = append(p.calls, "refund payment")
+	return p.Fake.Refund(ctx, paymentID)
+}
+
+func TestCheckoutUndoesWhatWasDone(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		fail  string
+		token string
+		calls []string
+		// The order as it is left, or "" if it was never stored
+		status        string
+		paymentStatus string
+		stockStatus   string
+	}{
+		{
+			name:  "ReserveFails",
+			fail:  "reserve stock",
+			calls: []string{"reserve stock"},
+		},
+		{
+			name:  "CreateFails",
+			fail:  "create order",
+			calls: []string{"reserve stock", "create order", "release stock"},
+		},
+		{
+			name:  "AuthorizeFails",
+			token: payment.FakeDeclineToken,
+			calls: []string{"reserve stock", "create order", "authorize payment",
+				"mark cancelled", "release stock"},
+			status:        models.OrderCancelled,
+			paymentStatus: models.PaymentNone,
+			stockStatus:   models.StockReleased,
+		},
+		{
+			name:  "CaptureFails",
+			token: payment.FakeFailCaptureToken,
+			calls: []string{"reserve stock", "create order", "authorize payment", "capture payment",
+				"void payment", "mark cancelled", "release stock"},
+			status:        models.OrderCancelled,
+			paymentStatus: models.PaymentVoided,
+			stockStatus:   models.StockReleased,
+		},
+		{
+			name: "CommitFails",
+			fail: "commit stock",
+			calls: []string{"reserve stock", "create order", "authorize payment", "capture payment", "commit stock",
+				"refund payment", "mark cancelled", "release stock"},
+			status:        models.OrderCancelled,
+			paymentStatus: models.PaymentRefunded,
+			stockStatus:   models.StockReleased,
+		},
+		{
+			name: "ConfirmFails",
+			fail: "mark paid",
+			calls: []string{"reserve stock", "create order", "authorize payment", "capture payment", "commit stock", "mark paid",
+				"return stock", "refund payment", "mark cancelled"},
+			status:        models.OrderCancelled,
+			paymentStatus: models.PaymentRefunded,
+			stockStatus:   models.StockReturned,
+		},
+		{
+			name: "Succeeds",
+			calls: []string{"reserve stock", "create order", "authorize payment", "capture payment", "commit stock",
+				"mark paid"},
+			status:        models.OrderPaid,
+			paymentStatus: models.PaymentCaptured,
+			stockStatus:   models.StockCommitted,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			calls := &sagaCalls{fail: test.fail}
+			s := NewOrderService(&sagaOrders{sagaCalls: calls}, nil, &sagaCatalog{sagaCalls: calls},
+				nil, &sagaPayments{Fake: payment.NewFake(), sagaCalls: calls}, 10, time.Minute)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-store-id", sagaStore))
+
+			token := test.token
+			if token == "" {
+				token = "tok_visa"
+			}
+			order, err := s.Checkout(ctx, &models.CheckoutRequest{
+				UserID:       sagaUser,
+				Items:        []*models.CheckoutItem{{ProductID: sagaProduct, Quantity: 2}},
+				PaymentToken: token,
+			})
+
+			if test.status == models.OrderPaid {
+				if err != nil {
+					t.Fatalf("Checkout: %v", err)
+				}
+			} else if err == nil {
+				t.Fatal("Checkout succeeded, want it to fail")
+			}
+			if !reflect.DeepEqual(calls.calls, test.calls) {
+				t.Errorf("calls:\n got %v\nwant %v", calls.calls, test.calls)
+			}
+
+			if test.status == "" {
+				if order != nil {
+					t.Errorf("got order %+v, want none", order)
+				}
+				return
+			}
+			if order == nil {
+				t.Fatal("got no order")
+			}
+			if order.Status != test.status || order.PaymentStatus != test.paymentStatus || order.StockStatus != test.stockStatus {
+				t.Errorf("order is %s with payment %s and stock %s, want %s with payment %s and stock %s",
+					order.Status, order.PaymentStatus, order.StockStatus, test.status, test.paymentStatus, test.stockStatus)
+			}
+			if order.StoreID != sagaStore {
+				t.Errorf("order store %q, want %q", order.StoreID, sagaStore)
+			}
+		})
+	}
+}
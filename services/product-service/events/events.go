@@ -0,0 +1,172 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// Source names this service in the envelopes it sends
+const Source = "product-service"
+
+// Event types. Each payload's schema version is in the Event that carries
+// it; a breaking change to a payload bumps its version.
+const (
+	TypeProductCreated  = "product.created"
+	TypeProductUpdated  = "product.updated"
+	TypeProductDeleted  = "product.deleted"
+	TypeProductRestored = "product.restored"
+	TypeStockChanged    = "stock.changed"
+)
+
+// Event is a domain event before it is put in an envelope
+type Event struct {
+	Type        string
+	Version     int32
+	AggregateID string
+	Payload     proto.Message
+}
+
+// ProductChanged describes the change a revision records; reverts are
+// sent as updates
+func ProductChanged(revision *models.ProductRevision) *Event {
+	event := &Event{Version: 1, AggregateID: revision.ProductID}
+
+	switch revision.Action {
+	case models.RevisionCreate:
+		event.Type = TypeProductCreated
+		event.Payload = &pb.ProductCreated{
+			Product: productState(revision),
+			Actor:   revision.Actor,
+		}
+	case models.RevisionDelete:
+		event.Type = TypeProductDeleted
+		payload := &pb.ProductDeleted{
+			ProductId: revision.ProductID,
+			Version:   revision.Version,
+			Actor:     revision.Actor,
+		}
+		if revision.Snapshot != nil && revision.Snapshot.CategoryID != nil {
+			payload.CategoryId = *revision.Snapshot.CategoryID
+		}
+		event.Payload = payload
+	case models.RevisionRestore:
+		event.Type = TypeProductRestored
+		event.Payload = &pb.ProductRestored{
+			Product: productState(revision),
+			Actor:   revision.Actor,
+		}
+	default:
+		fields := make([]string, 0, len(revision.Changes))
+		for _, change := range revision.Changes {
+			fields = append(fields, change.Field)
+		}
+		event.Type = TypeProductUpdated
+		event.Payload = &pb.ProductUpdated{
+			Product:            productState(revision),
+			ChangedFields:      fields,
+			Actor:              revision.Actor,
+			PreviousCategoryId: previousCategoryID(revision),
+		}
+	}
+
+	return event
+}
+
+// StockChanged describes a stock ledger entry
+func StockChanged(movement *models.StockMovement) *Event {
+	payload := &pb.StockChanged{
+		ProductId:     movement.ProductID,
+		Delta:         movement.Delta,
+		QuantityAfter: movement.QuantityAfter,
+		Reason:        movement.Reason,
+		Reference:     movement.Reference,
+		Actor:         movement.CreatedBy,
+	}
+	if movement.VariantID != nil {
+		payload.VariantId = *movement.VariantID
+	}
+	if movement.ReservationID != nil {
+		payload.ReservationId = *movement.ReservationID
+	}
+
+	return &Event{
+		Type:        TypeStockChanged,
+		Version:     1,
+		AggregateID: movement.ProductID,
+		Payload:     payload,
+	}
+}
+
+// NewPayload returns an empty message for decoding the payload of an event
+// type, or nil if the type isn't one of this service's
+func NewPayload(eventType string) proto.Message {
+	switch eventType {
+	case TypeProductCreated:
+		return &pb.ProductCreated{}
+	case TypeProductUpdated:
+		return &pb.ProductUpdated{}
+	case TypeProductDeleted:
+		return &pb.ProductDeleted{}
+	case TypeProductRestored:
+		return &pb.ProductRestored{}
+	case TypeStockChanged:
+		return &pb.StockChanged{}
+	default:
+		return nil
+	}
+}
+
+// NewEnvelope wraps an already encoded payload
+func NewEnvelope(id, eventType string, version int32, aggregateID string, occurredAt time.Time, payload []byte) *pb.Envelope {
+	return &pb.Envelope{
+		Id:          id,
+		Type:        eventType,
+		Version:     version,
+		Source:      Source,
+		AggregateId: aggregateID,
+		OccurredAt:  occurredAt.UTC().Format(time.RFC3339Nano),
+		Payload:     payload,
+	}
+}
+
+func productState(revision *models.ProductRevision) *pb.ProductState {
+	state := &pb.ProductState{
+		Id:      revision.ProductID,
+		Version: revision.Version,
+	}
+	if snapshot := revision.Snapshot; snapshot != nil {
+		state.Name = snapshot.Name
+		state.Sku = snapshot.SKU
+		state.IsActive = snapshot.IsActive
+		state.Price = &commonPb.Money{
+			CurrencyCode: snapshot.Price.Currency,
+			Units:        snapshot.Price.Units,
+			Nanos:        snapshot.Price.Nanos,
+		}
+		if snapshot.CategoryID != nil {
+			state.CategoryId = *snapshot.CategoryID
+		}
+	}
+	return state
+}
+
+// previousCategoryID is the category the revision moved the product out of,
+// or "" if it didn't move it or it had none
+func previousCategoryID(revision *models.ProductRevision) string {
+	for _, change := range revision.Changes {
+		if change.Field != "category_id" {
+			continue
+		}
+		var before *string
+		if err := json.Unmarshal(change.Before, &before); err != nil || before == nil {
+			return ""
+		}
+		return *before
+	}
+	return ""
+}
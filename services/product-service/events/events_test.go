@@ -0,0 +1,40 @@
+package events
+
+import (
+	"testing"
+
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func TestProductUpdatedNamesPreviousCategory(t *testing.T) {
+	from, to := "category-a", "category-b"
+	for name, test := range map[string]struct {
+		before, after *string
+		want          string
+	}{
+		"Moved":         {&from, &to, from},
+		"MovedIntoOne":  {nil, &to, ""},
+		"TakenOutOfOne": {&from, nil, from},
+		"NotMoved":      {&from, &from, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			before := &models.ProductSnapshot{Name: "Lamp", CategoryID: test.before}
+			after := &models.ProductSnapshot{Name: "Desk lamp", CategoryID: test.after}
+			event := ProductChanged(&models.ProductRevision{
+				ProductID: "product-1",
+				Action:    models.RevisionUpdate,
+				Changes:   after.Diff(before),
+				Snapshot:  after,
+			})
+
+			updated, ok := event.Payload.(*pb.ProductUpdated)
+			if !ok {
+				t.Fatalf("got a %T payload, want ProductUpdated", event.Payload)
+			}
+			if updated.PreviousCategoryId != test.want {
+				t.Errorf("previous category %q, want %q", updated.PreviousCategoryId, test.want)
+			}
+		})
+	}
+}
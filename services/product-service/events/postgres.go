@@ -0,0 +1,105 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/events"
+	"google.golang.org/protobuf/proto"
+)
+
+// postgresChannel is the NOTIFY channel events go out on
+const postgresChannel = "events"
+
+// maxNotifyPayload is Postgres's limit on a NOTIFY payload
+const maxNotifyPayload = 8000
+
+// PostgresBus sends events with NOTIFY, so every service listening on the
+// same database gets them without running a broker. Notifications sent
+// while a listener is reconnecting are lost.
+type PostgresBus struct {
+	db          *sql.DB
+	databaseURL string
+}
+
+func NewPostgresBus(db *sql.DB, databaseURL string) *PostgresBus {
+	return &PostgresBus{db: db, databaseURL: databaseURL}
+}
+
+func (b *PostgresBus) Publish(ctx context.Context, envelope *pb.Envelope) error {
+	data, err := proto.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	// NOTIFY payloads are text
+	payload := base64.StdEncoding.EncodeToString(data)
+	if len(payload) > maxNotifyPayload {
+		return fmt.Errorf("event %s is too large to send with NOTIFY", envelope.Id)
+	}
+
+	if _, err := b.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, postgresChannel, payload); err != nil {
+		return fmt.Errorf("failed to notify event: %w", err)
+	}
+	return nil
+}
+
+func (b *PostgresBus) Subscribe(ctx context.Context, handler Handler, types ...string) error {
+	listener := pq.NewListener(b.databaseURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Event listener: %v", err)
+		}
+	})
+	if err := listener.Listen(postgresChannel); err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to listen for events: %w", err)
+	}
+
+	go func() {
+		defer listener.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.Notify:
+				// nil after a reconnect; anything sent meanwhile is gone
+				if n == nil {
+					continue
+				}
+				b.deliver(ctx, n.Extra, handler, types)
+			}
+		}
+	}()
+	return nil
+}
+
+func (b *PostgresBus) deliver(ctx context.Context, payload string, handler Handler, types []string) {
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		log.Printf("Ignoring malformed event notification: %v", err)
+		return
+	}
+	envelope := &pb.Envelope{}
+	if err := proto.Unmarshal(data, envelope); err != nil {
+		log.Printf("Ignoring malformed event notification: %v", err)
+		return
+	}
+
+	if !wants(types, envelope.Type) {
+		return
+	}
+	if err := handler(ctx, envelope); err != nil {
+		log.Printf("Event handler failed for %s %s: %v", envelope.Type, envelope.Id, err)
+	}
+}
+
+// Close does nothing; the database belongs to the caller and listeners
+// stop with their subscription's context
+func (b *PostgresBus) Close() error {
+	return nil
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) SetCategoryAttributes(ctx context.Context, req *pb.SetCategoryAttributesRequest) (*pb.SetCategoryAttributesResponse, error) {
+	log.Printf("Set category attributes request: %s", req.CategoryId)
+
+	// TODO: Validate token
+	var definitions []*models.AttributeDefinition
+	for _, attribute := range req.Attributes {
+		definitions = append(definitions, &models.AttributeDefinition{
+			Key:        attribute.Key,
+			Label:      attribute.Label,
+			Type:       attribute.Type,
+			EnumValues: attribute.EnumValues,
+			Required:   attribute.Required,
+		})
+	}
+
+	definitions, err := h.attributeService.SetCategoryAttributes(storeFromContext(ctx), req.CategoryId, definitions)
+	if err != nil {
+		log.Printf("Set category attributes error: %v", err)
+		return &pb.SetCategoryAttributesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.SetCategoryAttributesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category attributes updated successfully",
+		},
+		Attributes: h.attributesToProto(definitions),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) GetCategoryAttributes(ctx context.Context, req *pb.GetCategoryAttributesRequest) (*pb.GetCategoryAttributesResponse, error) {
+	log.Printf("Get category attributes request: %s", req.CategoryId)
+
+	definitions, err := h.attributeService.GetCategoryAttributes(storeFromContext(ctx), req.CategoryId, req.IncludeInherited)
+	if err != nil {
+		log.Printf("Get category attributes error: %v", err)
+		return &pb.GetCategoryAttributesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.GetCategoryAttributesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category attributes retrieved successfully",
+		},
+		Attributes: h.attributesToProto(definitions),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) attributesToProto(definitions []*models.AttributeDefinition) []*pb.AttributeDefinition {
+	var protoDefinitions []*pb.AttributeDefinition
+	for _, definition := range definitions {
+		protoDefinitions = append(protoDefinitions, &pb.AttributeDefinition{
+			Id:         definition.ID,
+			CategoryId: definition.CategoryID,
+			Key:        definition.Key,
+			Label:      definition.Label,
+			Type:       definition.Type,
+			EnumValues: definition.EnumValues,
+			Required:   definition.Required,
+			Position:   definition.Position,
+		})
+	}
+	return protoDefinitions
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/service"
+)
+
+func (h *ProductGrpcHandler) BatchGetProducts(ctx context.Context, req *pb.BatchGetProductsRequest) (*pb.BatchGetProductsResponse, error) {
+	log.Printf("Batch get products request: %d ids, %d skus", len(req.Ids), len(req.Skus))
+
+	products, notFound, err := h.productService.BatchGetProducts(storeFromContext(ctx), req.Ids, req.Skus)
+	if err != nil {
+		log.Printf("Batch get products error: %v", err)
+		return &pb.BatchGetProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	var protoProducts []*pb.Product
+	for _, product := range products {
+		protoProducts = append(protoProducts, h.productToProto(product))
+	}
+
+	return &pb.BatchGetProductsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Products retrieved successfully",
+		},
+		Products: protoProducts,
+		NotFound: notFound,
+	}, nil
+}
+
+func (h *ProductGrpcHandler) BatchUpdateProducts(ctx context.Context, req *pb.BatchUpdateProductsRequest) (*pb.BatchUpdateProductsResponse, error) {
+	log.Printf("Batch update products request: %d updates", len(req.Updates))
+
+	patches := make([]*models.ProductPatch, 0, len(req.Updates))
+	for _, update := range req.Updates {
+		patches = append(patches, &models.ProductPatch{
+			ID:            update.Id,
+			Price:         moneyFromProto(update.Price),
+			StockQuantity: update.StockQuantity,
+			IsActive:      update.IsActive,
+			Version:       update.Version,
+		})
+	}
+
+	// TODO: Validate token
+	if err := h.productService.BatchUpdateProducts(storeFromContext(ctx), patches, req.UpdatedBy); err != nil {
+		log.Printf("Batch update products error: %v", err)
+		return &pb.BatchUpdateProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	resp := &pb.BatchUpdateProductsResponse{}
+	for _, patch := range patches {
+		result := &pb.ProductUpdateResult{Id: patch.ID, Success: patch.Err == nil}
+		if patch.Err != nil {
+			result.Message = patch.Err.Error()
+			if errors.Is(patch.Err, service.ErrVersionConflict) {
+				result.Conflict = true
+				if patch.Product != nil {
+					result.Product = h.productToProto(patch.Product)
+				}
+			}
+			resp.Failed++
+		} else {
+			result.Message = "Product updated successfully"
+			if patch.Product != nil {
+				result.Product = h.productToProto(patch.Product)
+			}
+			resp.Updated++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	message := "Products updated successfully"
+	if resp.Failed > 0 {
+		message = fmt.Sprintf("%d of %d updates failed", resp.Failed, len(patches))
+	}
+	resp.Response = &commonPb.Response{
+		Success: true,
+		Message: message,
+	}
+
+	return resp, nil
+}
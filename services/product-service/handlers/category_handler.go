@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	log.Printf("Create category request: %s", req.Name)
+
+	// TODO: Validate token
+	createReq := &models.CreateCategoryRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		ParentID:    req.ParentId,
+		Slug:        req.Slug,
+	}
+
+	category, err := h.categoryService.CreateCategory(storeFromContext(ctx), createReq)
+	if err != nil {
+		log.Printf("Create category error: %v", err)
+		return &pb.CreateCategoryResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreateCategoryResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category created successfully",
+		},
+		Category: h.categoryToProto(category),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	log.Printf("Update category request: %s", req.Id)
+
+	// TODO: Validate token
+	updateReq := &models.UpdateCategoryRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Slug:        req.Slug,
+	}
+
+	if req.IsActive {
+		isActive := req.IsActive
+		updateReq.IsActive = &isActive
+	}
+
+	category, err := h.categoryService.UpdateCategory(storeFromContext(ctx), req.Id, updateReq)
+	if err != nil {
+		log.Printf("Update category error: %v", err)
+		return &pb.UpdateCategoryResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateCategoryResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category updated successfully",
+		},
+		Category: h.categoryToProto(category),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	log.Printf("Delete category request: %s", req.Id)
+
+	// TODO: Validate token
+	if err := h.categoryService.DeleteCategory(storeFromContext(ctx), req.Id); err != nil {
+		log.Printf("Delete category error: %v", err)
+		return &pb.DeleteCategoryResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.DeleteCategoryResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category deleted successfully",
+		},
+	}, nil
+}
+
+func (h *ProductGrpcHandler) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.MoveCategoryResponse, error) {
+	log.Printf("Move category request: %s -> %q", req.Id, req.ParentId)
+
+	// TODO: Validate token
+	category, err := h.categoryService.MoveCategory(storeFromContext(ctx), req.Id, req.ParentId)
+	if err != nil {
+		log.Printf("Move category error: %v", err)
+		return &pb.MoveCategoryResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.MoveCategoryResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category moved successfully",
+		},
+		Category: h.categoryToProto(category),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error) {
+	log.Printf("Get category tree request: %q", req.RootId)
+
+	roots, err := h.categoryService.GetCategoryTree(storeFromContext(ctx), req.RootId, req.IncludeInactive)
+	if err != nil {
+		log.Printf("Get category tree error: %v", err)
+		return &pb.GetCategoryTreeResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	var protoRoots []*pb.CategoryNode
+	for _, root := range roots {
+		protoRoots = append(protoRoots, h.categoryNodeToProto(root))
+	}
+
+	return &pb.GetCategoryTreeResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Category tree retrieved successfully",
+		},
+		Roots: protoRoots,
+	}, nil
+}
+
+func (h *ProductGrpcHandler) categoryNodeToProto(node *models.CategoryNode) *pb.CategoryNode {
+	protoNode := &pb.CategoryNode{
+		Category: h.categoryToProto(node.Category),
+	}
+	for _, child := range node.Children {
+		protoNode.Children = append(protoNode.Children, h.categoryNodeToProto(child))
+	}
+	return protoNode
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) AddProductImage(ctx context.Context, req *pb.AddProductImageRequest) (*pb.AddProductImageResponse, error) {
+	log.Printf("Add product image request: %s", req.ProductId)
+
+	// TODO: Validate token
+	var image *models.ProductImage
+	if req.Image != nil {
+		image = &models.ProductImage{
+			URL:         req.Image.Url,
+			ContentType: req.Image.ContentType,
+			SizeBytes:   req.Image.SizeBytes,
+			Width:       req.Image.Width,
+			Height:      req.Image.Height,
+			Thumbnails:  req.Image.Thumbnails,
+			StorageKeys: req.Image.StorageKeys,
+		}
+	}
+
+	image, err := h.imageService.AddImage(storeFromContext(ctx), req.ProductId, image)
+	if err != nil {
+		log.Printf("Add product image error: %v", err)
+		return &pb.AddProductImageResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.AddProductImageResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Image added successfully",
+		},
+		Image: h.imageToProto(image),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListProductImages(ctx context.Context, req *pb.ListProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	log.Printf("List product images request: %s", req.ProductId)
+
+	images, err := h.imageService.ListImages(storeFromContext(ctx), req.ProductId)
+	if err != nil {
+		log.Printf("List product images error: %v", err)
+		return &pb.ListProductImagesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ListProductImagesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Images retrieved successfully",
+		},
+		Images: h.imagesToProto(images),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteProductImage(ctx context.Context, req *pb.DeleteProductImageRequest) (*pb.DeleteProductImageResponse, error) {
+	log.Printf("Delete product image request: %s", req.Id)
+
+	// TODO: Validate token
+	image, err := h.imageService.DeleteImage(storeFromContext(ctx), req.Id)
+	if err != nil {
+		log.Printf("Delete product image error: %v", err)
+		return &pb.DeleteProductImageResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.DeleteProductImageResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Image deleted successfully",
+		},
+		Image: h.imageToProto(image),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReorderProductImages(ctx context.Context, req *pb.ReorderProductImagesRequest) (*pb.ReorderProductImagesResponse, error) {
+	log.Printf("Reorder product images request: %s", req.ProductId)
+
+	// TODO: Validate token
+	images, err := h.imageService.ReorderImages(storeFromContext(ctx), req.ProductId, req.ImageIds)
+	if err != nil {
+		log.Printf("Reorder product images error: %v", err)
+		return &pb.ReorderProductImagesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ReorderProductImagesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Images reordered successfully",
+		},
+		Images: h.imagesToProto(images),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) imagesToProto(images []*models.ProductImage) []*pb.ProductImage {
+	var protoImages []*pb.ProductImage
+	for _, image := range images {
+		protoImages = append(protoImages, h.imageToProto(image))
+	}
+	return protoImages
+}
+
+func (h *ProductGrpcHandler) imageToProto(image *models.ProductImage) *pb.ProductImage {
+	return &pb.ProductImage{
+		Id:          image.ID,
+		ProductId:   image.ProductID,
+		Url:         image.URL,
+		ContentType: image.ContentType,
+		SizeBytes:   image.SizeBytes,
+		Width:       image.Width,
+		Height:      image.Height,
+		Position:    image.Position,
+		Thumbnails:  image.Thumbnails,
+		StorageKeys: image.StorageKeys,
+		CreatedAt:   image.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"google.golang.org/grpc"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) ImportProducts(stream grpc.ClientStreamingServer[pb.ImportProductsRequest, pb.ImportProductsResponse]) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(importFailure(fmt.Errorf("import options are required")))
+	}
+	if err != nil {
+		return err
+	}
+
+	options := first.GetOptions()
+	if options == nil {
+		return stream.SendAndClose(importFailure(fmt.Errorf("the first message must carry the import options")))
+	}
+
+	log.Printf("Import products request: dry_run=%t upsert=%t", options.DryRun, options.Upsert)
+
+	// TODO: Validate token
+	importer := h.productService.NewImporter(models.ImportOptions{
+		StoreID:   storeFromContext(stream.Context()),
+		CreatedBy: options.CreatedBy,
+		DryRun:    options.DryRun,
+		Upsert:    options.Upsert,
+		BatchSize: int(options.BatchSize),
+	})
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		row := msg.GetRow()
+		if row == nil {
+			return stream.SendAndClose(importFailure(fmt.Errorf("import options can only be sent once")))
+		}
+
+		importRow := &models.ImportRow{Row: row.Row}
+		if product := row.Product; product != nil {
+			importRow.Product = &models.CreateProductRequest{
+				Name:          product.Name,
+				Description:   product.Description,
+				StockQuantity: product.StockQuantity,
+				CategoryID:    product.CategoryId,
+				Category:      product.Category,
+				ImageURL:      product.ImageUrl,
+				SKU:           product.Sku,
+				Attributes:    product.Attributes,
+
+				ReorderThreshold: product.ReorderThreshold,
+				CompareAtPrice:   moneyFromProto(product.CompareAtPrice),
+			}
+			if price := moneyFromProto(product.Price); price != nil {
+				importRow.Product.Price = *price
+			}
+		}
+
+		if err := importer.Add(importRow); err != nil {
+			log.Printf("Import products error: %v", err)
+			return stream.SendAndClose(importFailure(err))
+		}
+	}
+
+	result, err := importer.Finish()
+	if err != nil {
+		log.Printf("Import products error: %v", err)
+		return stream.SendAndClose(importFailure(err))
+	}
+
+	log.Printf("Imported products: %d rows, %d created, %d updated, %d failed", result.TotalRows, result.Created, result.Updated, result.Failed)
+
+	message := "Products imported successfully"
+	if result.DryRun {
+		message = "Import validated successfully"
+	}
+	if result.Failed > 0 {
+		message = fmt.Sprintf("%d of %d rows failed", result.Failed, result.TotalRows)
+	}
+
+	resp := &pb.ImportProductsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: message,
+		},
+		TotalRows: result.TotalRows,
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Failed:    result.Failed,
+		DryRun:    result.DryRun,
+	}
+	for _, rowErr := range result.Errors {
+		resp.Errors = append(resp.Errors, &pb.ImportRowError{
+			Row:     rowErr.Row,
+			Sku:     rowErr.SKU,
+			Message: rowErr.Message,
+		})
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func (h *ProductGrpcHandler) ExportProducts(req *pb.ExportProductsRequest, stream grpc.ServerStreamingServer[pb.ExportProductsResponse]) error {
+	log.Printf("Export products request: category=%q", req.Category)
+
+	filter := &models.ProductFilter{
+		StoreID:    storeFromContext(stream.Context()),
+		CategoryID: req.CategoryId,
+		Category:   req.Category,
+		CreatedBy:  req.CreatedBy,
+	}
+	if !req.IncludeInactive {
+		active := true
+		filter.IsActive = &active
+	}
+
+	// TODO: Validate token
+	err := h.productService.ExportProducts(filter, func(product *models.Product) error {
+		return stream.Send(&pb.ExportProductsResponse{Product: h.productToProto(product)})
+	})
+	if err != nil {
+		log.Printf("Export products error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func importFailure(err error) *pb.ImportProductsResponse {
+	return &pb.ImportProductsResponse{
+		Response: &commonPb.Response{
+			Success: false,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.AdjustStockResponse, error) {
+	log.Printf("Adjust stock request: %s %q %+d (%s)", req.ProductId, req.VariantId, req.Delta, req.Reason)
+
+	// TODO: Validate token; until then trust the gateway's owner check
+	adjustReq := &models.AdjustStockRequest{
+		StoreID:   storeFromContext(ctx),
+		ProductID: req.ProductId,
+		VariantID: req.VariantId,
+		Delta:     req.Delta,
+		Reason:    req.Reason,
+		Reference: req.Reference,
+	}
+
+	movement, level, err := h.inventoryService.AdjustStock(adjustReq)
+	if err != nil {
+		log.Printf("Adjust stock error: %v", err)
+		return &pb.AdjustStockResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.AdjustStockResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Stock adjusted successfully",
+		},
+		StockQuantity:     level.StockQuantity,
+		ReservedQuantity:  level.ReservedQuantity,
+		AvailableQuantity: level.Available(),
+		Movement:          movementToProto(movement),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.ReserveStockResponse, error) {
+	log.Printf("Reserve stock request: %q (%d items)", req.Reference, len(req.Items))
+
+	// TODO: Validate token; until then trust the gateway's admin check
+	reserveReq := &models.ReserveStockRequest{
+		StoreID:   storeFromContext(ctx),
+		Reference: req.Reference,
+		TTL:       time.Duration(req.TtlSeconds) * time.Second,
+	}
+	for _, item := range req.Items {
+		reservationItem := &models.ReservationItem{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+		}
+		if item.VariantId != "" {
+			variantID := item.VariantId
+			reservationItem.VariantID = &variantID
+		}
+		reserveReq.Items = append(reserveReq.Items, reservationItem)
+	}
+
+	reservation, err := h.inventoryService.ReserveStock(reserveReq)
+	if err != nil {
+		log.Printf("Reserve stock error: %v", err)
+		return &pb.ReserveStockResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ReserveStockResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Stock reserved successfully",
+		},
+		Reservation: reservationToProto(reservation),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) CommitReservation(ctx context.Context, req *pb.CommitReservationRequest) (*pb.CommitReservationResponse, error) {
+	log.Printf("Commit reservation request: %s", req.ReservationId)
+
+	// TODO: Validate token; until then trust the gateway's admin check
+	reservation, err := h.inventoryService.CommitReservation(storeFromContext(ctx), req.ReservationId)
+	if err != nil {
+		log.Printf("Commit reservation error: %v", err)
+		return &pb.CommitReservationResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CommitReservationResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Reservation committed successfully",
+		},
+		Reservation: reservationToProto(reservation),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReleaseReservation(ctx context.Context, req *pb.ReleaseReservationRequest) (*pb.ReleaseReservationResponse, error) {
+	log.Printf("Release reservation request: %s", req.ReservationId)
+
+	// TODO: Validate token; until then trust the gateway's admin check
+	reservation, err := h.inventoryService.ReleaseReservation(storeFromContext(ctx), req.ReservationId)
+	if err != nil {
+		log.Printf("Release reservation error: %v", err)
+		return &pb.ReleaseReservationResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ReleaseReservationResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Reservation released successfully",
+		},
+		Reservation: reservationToProto(reservation),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	log.Printf("List stock movements request: %s %q", req.ProductId, req.VariantId)
+
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	// TODO: Validate token; until then trust the gateway's owner check
+	movements, paginationResp, err := h.inventoryService.ListStockMovements(storeFromContext(ctx), req.ProductId, req.VariantId, pagination)
+	if err != nil {
+		log.Printf("List stock movements error: %v", err)
+		return &pb.ListStockMovementsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	var protoMovements []*pb.StockMovement
+	for _, movement := range movements {
+		protoMovements = append(protoMovements, movementToProto(movement))
+	}
+
+	return &pb.ListStockMovementsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Stock movements retrieved successfully",
+		},
+		Movements:  protoMovements,
+		Pagination: h.paginationToProto(paginationResp),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListLowStockProductsResponse, error) {
+	log.Printf("List low stock products request: %q", req.CreatedBy)
+
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	// TODO: Validate token
+	items, paginationResp, err := h.inventoryService.ListLowStockProducts(storeFromContext(ctx), req.CreatedBy, pagination)
+	if err != nil {
+		log.Printf("List low stock products error: %v", err)
+		return &pb.ListLowStockProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	var protoItems []*pb.LowStockItem
+	for _, item := range items {
+		protoItem := &pb.LowStockItem{
+			ProductId:         item.ProductID,
+			ProductName:       item.ProductName,
+			Sku:               item.SKU,
+			StockQuantity:     item.StockQuantity,
+			ReservedQuantity:  item.ReservedQuantity,
+			AvailableQuantity: item.AvailableQuantity,
+			ReorderThreshold:  item.ReorderThreshold,
+			CreatedBy:         item.CreatedBy,
+		}
+		if item.VariantID != nil {
+			protoItem.VariantId = *item.VariantID
+		}
+		protoItems = append(protoItems, protoItem)
+	}
+
+	return &pb.ListLowStockProductsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Low stock products retrieved successfully",
+		},
+		Items:      protoItems,
+		Pagination: h.paginationToProto(paginationResp),
+	}, nil
+}
+
+func movementToProto(movement *models.StockMovement) *pb.StockMovement {
+	protoMovement := &pb.StockMovement{
+		Id:            movement.ID,
+		ProductId:     movement.ProductID,
+		Delta:         movement.Delta,
+		QuantityAfter: movement.QuantityAfter,
+		Reason:        movement.Reason,
+		Reference:     movement.Reference,
+		CreatedBy:     movement.CreatedBy,
+		CreatedAt:     movement.CreatedAt.Format(time.RFC3339),
+	}
+	if movement.VariantID != nil {
+		protoMovement.VariantId = *movement.VariantID
+	}
+	if movement.ReservationID != nil {
+		protoMovement.ReservationId = *movement.ReservationID
+	}
+	return protoMovement
+}
+
+func reservationToProto(reservation *models.StockReservation) *pb.StockReservation {
+	protoReservation := &pb.StockReservation{
+		Id:        reservation.ID,
+		Reference: reservation.Reference,
+		Status:    reservation.Status,
+		ExpiresAt: reservation.ExpiresAt.Format(time.RFC3339),
+		CreatedAt: reservation.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: reservation.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, item := range reservation.Items {
+		protoItem := &pb.ReservationItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+		if item.VariantID != nil {
+			protoItem.VariantId = *item.VariantID
+		}
+		protoReservation.Items = append(protoReservation.Items, protoItem)
+	}
+	return protoReservation
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) CreatePriceSchedule(ctx context.Context, req *pb.CreatePriceScheduleRequest) (*pb.CreatePriceScheduleResponse, error) {
+	log.Printf("Create price schedule request: %s", req.ProductId)
+
+	// TODO: Validate token
+	schedule, err := h.createPriceSchedule(storeFromContext(ctx), req)
+	if err != nil {
+		log.Printf("Create price schedule error: %v", err)
+		return &pb.CreatePriceScheduleResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreatePriceScheduleResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Sale scheduled successfully",
+		},
+		Schedule: priceScheduleToProto(schedule),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) createPriceSchedule(storeID string, req *pb.CreatePriceScheduleRequest) (*models.PriceSchedule, error) {
+	scheduleReq := &models.CreatePriceScheduleRequest{}
+	if salePrice := moneyFromProto(req.SalePrice); salePrice != nil {
+		scheduleReq.SalePrice = *salePrice
+	}
+
+	if req.StartsAt != "" {
+		startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return nil, fmt.Errorf("starts_at must be an RFC 3339 time")
+		}
+		scheduleReq.StartsAt = startsAt
+	}
+	if req.EndsAt != "" {
+		endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil {
+			return nil, fmt.Errorf("ends_at must be an RFC 3339 time")
+		}
+		scheduleReq.EndsAt = endsAt
+	}
+
+	return h.priceService.CreateSchedule(storeID, req.ProductId, scheduleReq, req.CreatedBy)
+}
+
+func (h *ProductGrpcHandler) CancelPriceSchedule(ctx context.Context, req *pb.CancelPriceScheduleRequest) (*pb.CancelPriceScheduleResponse, error) {
+	log.Printf("Cancel price schedule request: %s", req.ScheduleId)
+
+	// TODO: Validate token
+	schedule, err := h.priceService.CancelSchedule(storeFromContext(ctx), req.ProductId, req.ScheduleId)
+	if err != nil {
+		log.Printf("Cancel price schedule error: %v", err)
+		return &pb.CancelPriceScheduleResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CancelPriceScheduleResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Sale cancelled successfully",
+		},
+		Schedule: priceScheduleToProto(schedule),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListPriceSchedules(ctx context.Context, req *pb.ListPriceSchedulesRequest) (*pb.ListPriceSchedulesResponse, error) {
+	log.Printf("List price schedules request: %s", req.ProductId)
+
+	// TODO: Validate token
+	schedules, err := h.priceService.ListSchedules(storeFromContext(ctx), req.ProductId)
+	if err != nil {
+		log.Printf("List price schedules error: %v", err)
+		return &pb.ListPriceSchedulesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoSchedules := make([]*pb.PriceSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		protoSchedules = append(protoSchedules, priceScheduleToProto(schedule))
+	}
+
+	return &pb.ListPriceSchedulesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Price schedules retrieved successfully",
+		},
+		Schedules: protoSchedules,
+	}, nil
+}
+
+func (h *ProductGrpcHandler) SetProductPrices(ctx context.Context, req *pb.SetProductPricesRequest) (*pb.SetProductPricesResponse, error) {
+	log.Printf("Set product prices request: %s", req.ProductId)
+
+	prices := make([]models.Money, 0, len(req.Prices))
+	for _, price := range req.Prices {
+		prices = append(prices, *moneyFromProto(price))
+	}
+
+	// TODO: Validate token
+	product, err := h.priceService.SetPriceList(storeFromContext(ctx), req.ProductId, prices)
+	if err != nil {
+		log.Printf("Set product prices error: %v", err)
+		return &pb.SetProductPricesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.SetProductPricesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product prices updated successfully",
+		},
+		Product: h.productToProto(product),
+	}, nil
+}
+
+func priceScheduleToProto(schedule *models.PriceSchedule) *pb.PriceSchedule {
+	return &pb.PriceSchedule{
+		Id:        schedule.ID,
+		ProductId: schedule.ProductID,
+		SalePrice: moneyToProto(schedule.SalePrice),
+		StartsAt:  schedule.StartsAt.Format(time.RFC3339),
+		EndsAt:    schedule.EndsAt.Format(time.RFC3339),
+		Status:    schedule.Status,
+		CreatedBy: schedule.CreatedBy,
+		CreatedAt: schedule.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: schedule.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// moneyFromProto returns nil for an unset price
+func moneyFromProto(money *commonPb.Money) *models.Money {
+	if money == nil {
+		return nil
+	}
+	return &models.Money{
+		Currency: strings.ToUpper(strings.TrimSpace(money.CurrencyCode)),
+		Units:    money.Units,
+		Nanos:    money.Nanos,
+	}
+}
+
+func moneyToProto(money models.Money) *commonPb.Money {
+	return &commonPb.Money{
+		CurrencyCode: money.Currency,
+		Units:        money.Units,
+		Nanos:        money.Nanos,
+	}
+}
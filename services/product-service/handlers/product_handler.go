@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -13,33 +14,64 @@ import (
 
 type ProductGrpcHandler struct {
 	pb.UnimplementedProductServiceServer
-	productService service.ProductService
+	productService        service.ProductService
+	categoryService       service.CategoryService
+	variantService        service.VariantService
+	attributeService      service.AttributeService
+	imageService          service.ImageService
+	inventoryService      service.InventoryService
+	priceService          service.PriceService
+	reviewService         service.ReviewService
+	wishlistService       service.WishlistService
+	webhookService        service.WebhookService
+	watchService          service.WatchService
+	recommendationService service.RecommendationService
 }
 
-func NewProductGrpcHandler(productService service.ProductService) *ProductGrpcHandler {
+func NewProductGrpcHandler(productService service.ProductService, categoryService service.CategoryService, variantService service.VariantService, attributeService service.AttributeService, imageService service.ImageService, inventoryService service.InventoryService, priceService service.PriceService, reviewService service.ReviewService, wishlistService service.WishlistService, webhookService service.WebhookService, watchService service.WatchService, recommendationService service.RecommendationService) *ProductGrpcHandler {
 	return &ProductGrpcHandler{
-		productService: productService,
+		productService:        productService,
+		categoryService:       categoryService,
+		variantService:        variantService,
+		attributeService:      attributeService,
+		imageService:          imageService,
+		inventoryService:      inventoryService,
+		priceService:          priceService,
+		reviewService:         reviewService,
+		wishlistService:       wishlistService,
+		webhookService:        webhookService,
+		watchService:          watchService,
+		recommendationService: recommendationService,
 	}
 }
 
 func (h *ProductGrpcHandler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
 	log.Printf("Create product request: %s", req.Name)
 
-	// TODO: Validate token and get user info from auth service
-	// For now, we'll use a placeholder
-	createdBy := "system" // This should come from token validation
+	// TODO: Validate token; until then trust the gateway's user ID
+	createdBy := req.CreatedBy
+	if createdBy == "" {
+		createdBy = "system"
+	}
 
 	createReq := &models.CreateProductRequest{
 		Name:          req.Name,
 		Description:   req.Description,
-		Price:         req.Price,
 		StockQuantity: req.StockQuantity,
+		CategoryID:    req.CategoryId,
 		Category:      req.Category,
 		ImageURL:      req.ImageUrl,
 		SKU:           req.Sku,
+		Attributes:    req.Attributes,
+
+		ReorderThreshold: req.ReorderThreshold,
+		CompareAtPrice:   moneyFromProto(req.CompareAtPrice),
+	}
+	if price := moneyFromProto(req.Price); price != nil {
+		createReq.Price = *price
 	}
 
-	product, err := h.productService.CreateProduct(createReq, createdBy)
+	product, err := h.productService.CreateProduct(storeFromContext(ctx), createReq, createdBy)
 	if err != nil {
 		log.Printf("Create product error: %v", err)
 		return &pb.CreateProductResponse{
@@ -62,7 +94,7 @@ func (h *ProductGrpcHandler) CreateProduct(ctx context.Context, req *pb.CreatePr
 func (h *ProductGrpcHandler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
 	log.Printf("Get product request: %s", req.Id)
 
-	product, err := h.productService.GetProduct(req.Id)
+	product, err := h.productService.GetProduct(storeFromContext(ctx), req.Id)
 	if err != nil {
 		log.Printf("Get product error: %v", err)
 		return &pb.GetProductResponse{
@@ -73,12 +105,39 @@ func (h *ProductGrpcHandler) GetProduct(ctx context.Context, req *pb.GetProductR
 		}, nil
 	}
 
+	options, variants, err := h.variantService.GetProductVariants(storeFromContext(ctx), product.ID, false)
+	if err != nil {
+		log.Printf("Get product variants error: %v", err)
+		return &pb.GetProductResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	images, err := h.imageService.ListImages(storeFromContext(ctx), product.ID)
+	if err != nil {
+		log.Printf("Get product images error: %v", err)
+		return &pb.GetProductResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoProduct := h.productToProto(product)
+	protoProduct.Options = h.optionsToProto(options)
+	protoProduct.Variants = h.variantsToProto(variants)
+	protoProduct.Images = h.imagesToProto(images)
+
 	return &pb.GetProductResponse{
 		Response: &commonPb.Response{
 			Success: true,
 			Message: "Product retrieved successfully",
 		},
-		Product: h.productToProto(product),
+		Product: protoProduct,
 	}, nil
 }
 
@@ -89,27 +148,38 @@ func (h *ProductGrpcHandler) UpdateProduct(ctx context.Context, req *pb.UpdatePr
 	updateReq := &models.UpdateProductRequest{
 		Name:          req.Name,
 		Description:   req.Description,
-		Price:         req.Price,
+		Price:         moneyFromProto(req.Price),
 		StockQuantity: req.StockQuantity,
+		CategoryID:    req.CategoryId,
 		Category:      req.Category,
 		ImageURL:      req.ImageUrl,
 		SKU:           req.Sku,
-	}
+		IsActive:      req.IsActive,
+		Attributes:    req.Attributes,
+
+		ReorderThreshold:      req.ReorderThreshold,
+		ClearReorderThreshold: req.ClearReorderThreshold,
 
-	if req.IsActive {
-		isActive := req.IsActive
-		updateReq.IsActive = &isActive
+		CompareAtPrice:      moneyFromProto(req.CompareAtPrice),
+		ClearCompareAtPrice: req.ClearCompareAtPrice,
+
+		Version: req.Version,
 	}
 
-	product, err := h.productService.UpdateProduct(req.Id, updateReq)
+	product, err := h.productService.UpdateProduct(storeFromContext(ctx), req.Id, updateReq, req.UpdatedBy)
 	if err != nil {
 		log.Printf("Update product error: %v", err)
-		return &pb.UpdateProductResponse{
+		resp := &pb.UpdateProductResponse{
 			Response: &commonPb.Response{
 				Success: false,
 				Message: err.Error(),
 			},
-		}, nil
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			resp.Conflict = true
+			resp.Product = h.currentProduct(storeFromContext(ctx), req.Id)
+		}
+		return resp, nil
 	}
 
 	return &pb.UpdateProductResponse{
@@ -126,21 +196,26 @@ func (h *ProductGrpcHandler) DeleteProduct(ctx context.Context, req *pb.DeletePr
 
 	// TODO: Validate token
 
-	err := h.productService.DeleteProduct(req.Id)
+	err := h.productService.DeleteProduct(storeFromContext(ctx), req.Id, req.Version, req.DeletedBy)
 	if err != nil {
 		log.Printf("Delete product error: %v", err)
-		return &pb.DeleteProductResponse{
+		resp := &pb.DeleteProductResponse{
 			Response: &commonPb.Response{
 				Success: false,
 				Message: err.Error(),
 			},
-		}, nil
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			resp.Conflict = true
+			resp.Product = h.currentProduct(storeFromContext(ctx), req.Id)
+		}
+		return resp, nil
 	}
 
 	return &pb.DeleteProductResponse{
 		Response: &commonPb.Response{
 			Success: true,
-			Message: "Product deleted successfully",
+			Message: "Product moved to trash",
 		},
 	}, nil
 }
@@ -148,15 +223,25 @@ func (h *ProductGrpcHandler) DeleteProduct(ctx context.Context, req *pb.DeletePr
 func (h *ProductGrpcHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
 	log.Printf("List products request")
 
-	var filter *models.ProductFilter
-	if req.Category != "" || req.ActiveOnly {
-		filter = &models.ProductFilter{
-			Category: req.Category,
-		}
-		if req.ActiveOnly {
-			active := true
-			filter.IsActive = &active
-		}
+	attributeFilters, err := service.ParseAttributeFilters(req.AttributeFilters)
+	if err != nil {
+		return &pb.ListProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	filter := &models.ProductFilter{
+		StoreID:    storeFromContext(ctx),
+		CategoryID: req.CategoryId,
+		Category:   req.Category,
+		Attributes: attributeFilters,
+	}
+	if req.ActiveOnly {
+		active := true
+		filter.IsActive = &active
 	}
 
 	pagination := &models.PaginationRequest{
@@ -195,11 +280,24 @@ func (h *ProductGrpcHandler) ListProducts(ctx context.Context, req *pb.ListProdu
 func (h *ProductGrpcHandler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
 	log.Printf("Search products request: %s", req.Query)
 
+	attributeFilters, err := service.ParseAttributeFilters(req.AttributeFilters)
+	if err != nil {
+		return &pb.SearchProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
 	filter := &models.SearchFilter{
-		Query:    req.Query,
-		Category: req.Category,
-		MinPrice: req.MinPrice,
-		MaxPrice: req.MaxPrice,
+		StoreID:    storeFromContext(ctx),
+		Query:      req.Query,
+		CategoryID: req.CategoryId,
+		Category:   req.Category,
+		MinPrice:   moneyFromProto(req.MinPrice),
+		MaxPrice:   moneyFromProto(req.MaxPrice),
+		Attributes: attributeFilters,
 	}
 
 	pagination := &models.PaginationRequest{
@@ -238,7 +336,7 @@ func (h *ProductGrpcHandler) SearchProducts(ctx context.Context, req *pb.SearchP
 func (h *ProductGrpcHandler) GetCategories(ctx context.Context, req *pb.GetCategoriesRequest) (*pb.GetCategoriesResponse, error) {
 	log.Printf("Get categories request")
 
-	categories, err := h.productService.GetCategories()
+	categories, err := h.categoryService.GetCategories(storeFromContext(ctx))
 	if err != nil {
 		log.Printf("Get categories error: %v", err)
 		return &pb.GetCategoriesResponse{
@@ -271,12 +369,52 @@ func (h *ProductGrpcHandler) HealthCheck(ctx context.Context, req *commonPb.Heal
 	}, nil
 }
 
+// currentProduct loads the product for a conflict response; nil if that fails
+func (h *ProductGrpcHandler) currentProduct(storeID, id string) *pb.Product {
+	product, err := h.productService.GetProduct(storeID, id)
+	if err != nil {
+		log.Printf("Get current product error: %v", err)
+		return nil
+	}
+	return h.productToProto(product)
+}
+
 func (h *ProductGrpcHandler) productToProto(product *models.Product) *pb.Product {
+	var categoryID string
+	if product.CategoryID != nil {
+		categoryID = *product.CategoryID
+	}
+
+	attributes := make(map[string]string, len(product.Attributes))
+	for key, value := range product.Attributes {
+		attributes[key] = service.FormatAttributeValue(value)
+	}
+
+	var deletedAt string
+	if product.DeletedAt != nil {
+		deletedAt = product.DeletedAt.Format(time.RFC3339)
+	}
+
+	// Sales are applied here rather than stored so they start and end on time
+	var activeSale *pb.PriceSchedule
+	if product.ActiveSale != nil {
+		activeSale = priceScheduleToProto(product.ActiveSale)
+	}
+
+	var compareAtPrice *commonPb.Money
+	if product.CompareAtPrice != nil {
+		compareAtPrice = moneyToProto(*product.CompareAtPrice)
+	}
+	prices := make([]*commonPb.Money, 0, len(product.Prices))
+	for _, price := range product.Prices {
+		prices = append(prices, moneyToProto(price))
+	}
+
 	return &pb.Product{
 		Id:            product.ID,
 		Name:          product.Name,
 		Description:   product.Description,
-		Price:         product.Price,
+		Price:         moneyToProto(product.Price),
 		StockQuantity: product.StockQuantity,
 		Category:      product.Category,
 		ImageUrl:      product.ImageURL,
@@ -285,6 +423,24 @@ func (h *ProductGrpcHandler) productToProto(product *models.Product) *pb.Product
 		CreatedAt:     product.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:     product.UpdatedAt.Format(time.RFC3339),
 		CreatedBy:     product.CreatedBy,
+		CategoryId:    categoryID,
+		Attributes:    attributes,
+
+		ReservedQuantity:  product.ReservedQuantity,
+		AvailableQuantity: product.StockQuantity - product.ReservedQuantity,
+		ReorderThreshold:  product.ReorderThreshold,
+		Version:           product.Version,
+		DeletedAt:         deletedAt,
+
+		CompareAtPrice: compareAtPrice,
+		EffectivePrice: moneyToProto(product.EffectivePrice(time.Now())),
+		ActiveSale:     activeSale,
+		Prices:         prices,
+
+		AverageRating: product.AverageRating,
+		ReviewCount:   product.ReviewCount,
+
+		StoreId: product.StoreID,
 	}
 }
 
@@ -300,6 +456,10 @@ func (h *ProductGrpcHandler) categoryToProto(category *models.Category) *pb.Cate
 		Description: category.Description,
 		ParentId:    parentID,
 		IsActive:    category.IsActive,
+		Slug:        category.Slug,
+		CreatedAt:   category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   category.UpdatedAt.Format(time.RFC3339),
+		StoreId:     category.StoreID,
 	}
 }
 
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) RecordProductView(ctx context.Context, req *pb.RecordProductViewRequest) (*pb.RecordProductViewResponse, error) {
+	err := h.recommendationService.RecordView(storeFromContext(ctx), &models.ProductView{
+		ProductID: req.ProductId,
+		UserID:    req.UserId,
+		SessionID: req.SessionId,
+	})
+	if err != nil {
+		log.Printf("Record product view error: %v", err)
+		return &pb.RecordProductViewResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.RecordProductViewResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product view recorded",
+		},
+	}, nil
+}
+
+func (h *ProductGrpcHandler) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResponse, error) {
+	recommendations, err := h.recommendationService.GetRecommendations(storeFromContext(ctx), req.ProductId, req.UserId, req.Limit)
+	if err != nil {
+		log.Printf("Get recommendations error: %v", err)
+		return &pb.GetRecommendationsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoRecommendations := make([]*pb.Recommendation, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		protoRecommendations = append(protoRecommendations, &pb.Recommendation{
+			Product: h.productToProto(recommendation.Product),
+			Reason:  recommendation.Reason,
+		})
+	}
+
+	return &pb.GetRecommendationsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Recommendations retrieved successfully",
+		},
+		Recommendations: protoRecommendations,
+	}, nil
+}
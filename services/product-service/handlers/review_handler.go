@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) CreateProductReview(ctx context.Context, req *pb.CreateProductReviewRequest) (*pb.CreateProductReviewResponse, error) {
+	log.Printf("Create product review request: %s", req.ProductId)
+
+	// TODO: Validate token; until then trust the gateway's user ID
+	createReq := &models.CreateReviewRequest{
+		Rating: req.Rating,
+		Title:  req.Title,
+		Body:   req.Body,
+	}
+
+	review, err := h.reviewService.CreateReview(storeFromContext(ctx), req.ProductId, req.UserId, req.AuthorName, createReq)
+	if err != nil {
+		log.Printf("Create product review error: %v", err)
+		return &pb.CreateProductReviewResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreateProductReviewResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Review submitted for moderation",
+		},
+		Review: reviewToProto(review),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateProductReview(ctx context.Context, req *pb.UpdateProductReviewRequest) (*pb.UpdateProductReviewResponse, error) {
+	log.Printf("Update product review request: %s", req.Id)
+
+	// TODO: Validate token
+	updateReq := &models.UpdateReviewRequest{
+		Rating: req.Rating,
+		Title:  req.Title,
+		Body:   req.Body,
+	}
+
+	review, err := h.reviewService.UpdateReview(storeFromContext(ctx), req.Id, req.UserId, updateReq)
+	if err != nil {
+		log.Printf("Update product review error: %v", err)
+		return &pb.UpdateProductReviewResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateProductReviewResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Review updated and submitted for moderation",
+		},
+		Review: reviewToProto(review),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteProductReview(ctx context.Context, req *pb.DeleteProductReviewRequest) (*pb.DeleteProductReviewResponse, error) {
+	log.Printf("Delete product review request: %s", req.Id)
+
+	// TODO: Validate token
+	if err := h.reviewService.DeleteReview(storeFromContext(ctx), req.Id, req.UserId, req.Moderator); err != nil {
+		log.Printf("Delete product review error: %v", err)
+		return &pb.DeleteProductReviewResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.DeleteProductReviewResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Review deleted successfully",
+		},
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListProductReviews(ctx context.Context, req *pb.ListProductReviewsRequest) (*pb.ListProductReviewsResponse, error) {
+	log.Printf("List product reviews request: %s", req.ProductId)
+
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+		pagination.SortBy = req.Pagination.SortBy
+		pagination.SortOrder = req.Pagination.SortOrder
+	}
+
+	filter := &models.ReviewFilter{
+		StoreID:   storeFromContext(ctx),
+		ProductID: req.ProductId,
+		ViewerID:  req.ViewerId,
+		Rating:    req.Rating,
+	}
+	if req.Status != "" {
+		filter.Statuses = []string{req.Status}
+	}
+
+	reviews, paginationResp, err := h.reviewService.ListReviews(filter, req.Moderator, pagination)
+	if err != nil {
+		log.Printf("List product reviews error: %v", err)
+		return &pb.ListProductReviewsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoReviews := make([]*pb.ProductReview, 0, len(reviews))
+	for _, review := range reviews {
+		protoReviews = append(protoReviews, reviewToProto(review))
+	}
+
+	return &pb.ListProductReviewsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Reviews retrieved successfully",
+		},
+		Reviews:    protoReviews,
+		Pagination: h.paginationToProto(paginationResp),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ModerateProductReview(ctx context.Context, req *pb.ModerateProductReviewRequest) (*pb.ModerateProductReviewResponse, error) {
+	log.Printf("Moderate product review request: %s to %s", req.Id, req.Status)
+
+	// TODO: Validate token
+	review, err := h.reviewService.ModerateReview(storeFromContext(ctx), req.Id, req.Status, req.Note, req.ModeratedBy)
+	if err != nil {
+		log.Printf("Moderate product review error: %v", err)
+		return &pb.ModerateProductReviewResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ModerateProductReviewResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Review " + review.Status,
+		},
+		Review: reviewToProto(review),
+	}, nil
+}
+
+func reviewToProto(review *models.Review) *pb.ProductReview {
+	return &pb.ProductReview{
+		Id:             review.ID,
+		ProductId:      review.ProductID,
+		UserId:         review.UserID,
+		AuthorName:     review.AuthorName,
+		Rating:         review.Rating,
+		Title:          review.Title,
+		Body:           review.Body,
+		Status:         review.Status,
+		ModeratedBy:    review.ModeratedBy,
+		ModerationNote: review.ModerationNote,
+		CreatedAt:      review.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      review.UpdatedAt.Format(time.RFC3339),
+	}
+}
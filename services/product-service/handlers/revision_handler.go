@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/service"
+)
+
+func (h *ProductGrpcHandler) GetProductHistory(ctx context.Context, req *pb.GetProductHistoryRequest) (*pb.GetProductHistoryResponse, error) {
+	log.Printf("Get product history request: %s", req.ProductId)
+
+	// TODO: Validate token
+
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	revisions, paginationResp, err := h.productService.GetProductHistory(storeFromContext(ctx), req.ProductId, pagination)
+	if err != nil {
+		log.Printf("Get product history error: %v", err)
+		return &pb.GetProductHistoryResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoRevisions := make([]*pb.ProductRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		protoRevisions = append(protoRevisions, revisionToProto(revision))
+	}
+
+	return &pb.GetProductHistoryResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product history retrieved successfully",
+		},
+		Revisions:  protoRevisions,
+		Pagination: h.paginationToProto(paginationResp),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) RevertProductToRevision(ctx context.Context, req *pb.RevertProductToRevisionRequest) (*pb.RevertProductToRevisionResponse, error) {
+	log.Printf("Revert product request: %s to revision %s", req.ProductId, req.RevisionId)
+
+	// TODO: Validate token
+
+	product, err := h.productService.RevertProductToRevision(storeFromContext(ctx), req.ProductId, req.RevisionId, req.Version, req.RevertedBy)
+	if err != nil {
+		log.Printf("Revert product error: %v", err)
+		resp := &pb.RevertProductToRevisionResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			resp.Conflict = true
+			resp.Product = h.currentProduct(storeFromContext(ctx), req.ProductId)
+		}
+		return resp, nil
+	}
+
+	return &pb.RevertProductToRevisionResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product reverted successfully",
+		},
+		Product: h.productToProto(product),
+	}, nil
+}
+
+func revisionToProto(revision *models.ProductRevision) *pb.ProductRevision {
+	changes := make([]*pb.FieldChange, 0, len(revision.Changes))
+	for _, change := range revision.Changes {
+		changes = append(changes, &pb.FieldChange{
+			Field:  change.Field,
+			Before: string(change.Before),
+			After:  string(change.After),
+		})
+	}
+
+	var revertedTo string
+	if revision.RevertedTo != nil {
+		revertedTo = *revision.RevertedTo
+	}
+
+	return &pb.ProductRevision{
+		Id:         revision.ID,
+		ProductId:  revision.ProductID,
+		Version:    revision.Version,
+		Action:     revision.Action,
+		Actor:      revision.Actor,
+		Changes:    changes,
+		RevertedTo: revertedTo,
+		CreatedAt:  revision.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// storeMetadataKey carries the ID of the store a call is made for. The
+// gateway sets it on every call.
+const storeMetadataKey = "x-store-id"
+
+// storelessMethods aren't made for a store: health checks, and the trash
+// purge, which covers every store
+var storelessMethods = map[string]bool{
+	pb.ProductService_HealthCheck_FullMethodName:          true,
+	pb.ProductService_PurgeDeletedProducts_FullMethodName: true,
+}
+
+// storeFromContext returns the store the call was made for. The store
+// interceptors make sure every call that needs one has one.
+func storeFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(storeMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// StoreInterceptors reject calls that don't say which store they are for,
+// so none is quietly served from another store's data. With allowDefault
+// such calls are made for the default store instead, for callers from
+// before there were several.
+func StoreInterceptors(allowDefault bool) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := requireStore(ctx, info.FullMethod, allowDefault)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := requireStore(ss.Context(), info.FullMethod, allowDefault)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &storeStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+func requireStore(ctx context.Context, method string, allowDefault bool) (context.Context, error) {
+	if storelessMethods[method] || storeFromContext(ctx) != "" {
+		return ctx, nil
+	}
+	if !allowDefault {
+		return nil, status.Errorf(codes.InvalidArgument, "store is required: set %q in the call's metadata", storeMetadataKey)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(storeMetadataKey, models.DefaultStoreID)
+	return metadata.NewIncomingContext(ctx, md), nil
+}
+
+// storeStream is a server stream with the store set in its context
+type storeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *storeStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testStoreID = "5c1f0f6e-9a51-4a39-8a4e-0d6c3f0b2a11"
+
+// callWithStore runs the unary store interceptor in front of a handler that
+// reports the store it was called for
+func callWithStore(t *testing.T, allowDefault bool, method string, md metadata.MD) (string, error) {
+	t.Helper()
+	unary, _ := StoreInterceptors(allowDefault)
+
+	ctx := context.Background()
+	if md != nil {
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	var got string
+	_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = storeFromContext(ctx)
+		return nil, nil
+	})
+	return got, err
+}
+
+func TestStoreInterceptorRejectsCallsWithoutStore(t *testing.T) {
+	for name, md := range map[string]metadata.MD{
+		"NoMetadata": nil,
+		"NoStore":    metadata.Pairs("authorization", "Bearer x"),
+		"EmptyStore": metadata.Pairs(storeMetadataKey, ""),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, method := range []string{pb.ProductService_GetProduct_FullMethodName, pb.ProductService_ListWebhooks_FullMethodName} {
+				_, err := callWithStore(t, false, method, md)
+				if status.Code(err) != codes.InvalidArgument {
+					t.Errorf("%s: got %v, want InvalidArgument", method, err)
+				}
+			}
+		})
+	}
+}
+
+func TestStoreInterceptorPassesStoreOn(t *testing.T) {
+	for _, allowDefault := range []bool{false, true} {
+		got, err := callWithStore(t, allowDefault, pb.ProductService_GetProduct_FullMethodName, metadata.Pairs(storeMetadataKey, testStoreID))
+		if err != nil || got != testStoreID {
+			t.Errorf("allowDefault=%v: got %q, %v, want %q", allowDefault, got, err, testStoreID)
+		}
+	}
+}
+
+func TestStoreInterceptorDefaultsWhenAllowed(t *testing.T) {
+	got, err := callWithStore(t, true, pb.ProductService_GetProduct_FullMethodName, metadata.Pairs("authorization", "Bearer x"))
+	if err != nil || got != models.DefaultStoreID {
+		t.Errorf("got %q, %v, want the default store", got, err)
+	}
+}
+
+func TestStoreInterceptorLetsStorelessMethodsThrough(t *testing.T) {
+	for _, method := range []string{pb.ProductService_HealthCheck_FullMethodName, pb.ProductService_PurgeDeletedProducts_FullMethodName} {
+		got, err := callWithStore(t, false, method, nil)
+		if err != nil || got != "" {
+			t.Errorf("%s: got %q, %v, want no store and no error", method, got, err)
+		}
+	}
+}
+
+func TestStoreStreamInterceptor(t *testing.T) {
+	_, stream := StoreInterceptors(true)
+	info := &grpc.StreamServerInfo{FullMethod: pb.ProductService_ExportProducts_FullMethodName}
+
+	var got string
+	err := stream(nil, &storeStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		got = storeFromContext(ss.Context())
+		return nil
+	})
+	if err != nil || got != models.DefaultStoreID {
+		t.Errorf("got %q, %v, want the default store", got, err)
+	}
+
+	_, stream = StoreInterceptors(false)
+	err = stream(nil, &storeStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		t.Error("handler ran without a store")
+		return nil
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v, want InvalidArgument", err)
+	}
+}
+
+// storeRecorder is a product and webhook service that records the store
+// each call was made for
+type storeRecorder struct {
+	service.ProductService
+	service.WebhookService
+	stores []string
+}
+
+func (s *storeRecorder) GetProduct(storeID, id string) (*models.Product, error) {
+	s.stores = append(s.stores, storeID)
+	return nil, fmt.Errorf("product not found")
+}
+
+func (s *storeRecorder) ListWebhooks(storeID string) ([]*models.Webhook, error) {
+	s.stores = append(s.stores, storeID)
+	return nil, nil
+}
+
+func TestHandlersPassTheCallsStoreOn(t *testing.T) {
+	recorder := &storeRecorder{}
+	h := &ProductGrpcHandler{productService: recorder, webhookService: recorder}
+	unary, _ := StoreInterceptors(false)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(storeMetadataKey, testStoreID))
+
+	calls := map[string]func(ctx context.Context) error{
+		pb.ProductService_GetProduct_FullMethodName: func(ctx context.Context) error {
+			_, err := h.GetProduct(ctx, &pb.GetProductRequest{Id: "product-1"})
+			return err
+		},
+		pb.ProductService_ListWebhooks_FullMethodName: func(ctx context.Context) error {
+			_, err := h.ListWebhooks(ctx, &pb.ListWebhooksRequest{})
+			return err
+		},
+	}
+	for method, call := range calls {
+		recorder.stores = nil
+		_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, call(ctx)
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		if len(recorder.stores) != 1 || recorder.stores[0] != testStoreID {
+			t.Errorf("%s: service called for stores %q, want %q", method, recorder.stores, testStoreID)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductResponse, error) {
+	log.Printf("Restore product request: %s", req.Id)
+
+	// TODO: Validate token
+
+	product, err := h.productService.RestoreProduct(storeFromContext(ctx), req.Id, req.RestoredBy)
+	if err != nil {
+		log.Printf("Restore product error: %v", err)
+		return &pb.RestoreProductResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.RestoreProductResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product restored successfully",
+		},
+		Product: h.productToProto(product),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListDeletedProducts(ctx context.Context, req *pb.ListDeletedProductsRequest) (*pb.ListDeletedProductsResponse, error) {
+	log.Printf("List deleted products request")
+
+	// TODO: Validate token
+
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	products, paginationResp, err := h.productService.ListDeletedProducts(storeFromContext(ctx), req.CreatedBy, pagination)
+	if err != nil {
+		log.Printf("List deleted products error: %v", err)
+		return &pb.ListDeletedProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	var protoProducts []*pb.Product
+	for _, product := range products {
+		protoProducts = append(protoProducts, h.productToProto(product))
+	}
+
+	return &pb.ListDeletedProductsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Deleted products retrieved successfully",
+		},
+		Products:   protoProducts,
+		Pagination: h.paginationToProto(paginationResp),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error) {
+	// TODO: Validate token
+
+	purged, storageKeys, err := h.productService.PurgeDeletedProducts()
+	if err != nil {
+		log.Printf("Purge deleted products error: %v", err)
+		return &pb.PurgeDeletedProductsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if purged > 0 {
+		log.Printf("Purged %d products from the trash", purged)
+	}
+
+	return &pb.PurgeDeletedProductsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Trash purged successfully",
+		},
+		Purged:      int32(purged),
+		StorageKeys: storageKeys,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) SetProductOptions(ctx context.Context, req *pb.SetProductOptionsRequest) (*pb.SetProductOptionsResponse, error) {
+	log.Printf("Set product options request: %s", req.ProductId)
+
+	// TODO: Validate token
+	var options []*models.ProductOption
+	for _, option := range req.Options {
+		options = append(options, &models.ProductOption{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+
+	options, err := h.variantService.SetProductOptions(storeFromContext(ctx), req.ProductId, options)
+	if err != nil {
+		log.Printf("Set product options error: %v", err)
+		return &pb.SetProductOptionsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.SetProductOptionsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product options updated successfully",
+		},
+		Options: h.optionsToProto(options),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) CreateProductVariant(ctx context.Context, req *pb.CreateProductVariantRequest) (*pb.CreateProductVariantResponse, error) {
+	log.Printf("Create product variant request: %s", req.ProductId)
+
+	// TODO: Validate token
+	createReq := &models.CreateVariantRequest{
+		SKU:           req.Sku,
+		OptionValues:  req.OptionValues,
+		Price:         moneyFromProto(req.Price),
+		StockQuantity: req.StockQuantity,
+	}
+
+	variant, err := h.variantService.CreateVariant(storeFromContext(ctx), req.ProductId, createReq)
+	if err != nil {
+		log.Printf("Create product variant error: %v", err)
+		return &pb.CreateProductVariantResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreateProductVariantResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Variant created successfully",
+		},
+		Variant: h.variantToProto(variant),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateProductVariant(ctx context.Context, req *pb.UpdateProductVariantRequest) (*pb.UpdateProductVariantResponse, error) {
+	log.Printf("Update product variant request: %s", req.Id)
+
+	// TODO: Validate token
+	updateReq := &models.UpdateVariantRequest{
+		SKU:           req.Sku,
+		OptionValues:  req.OptionValues,
+		Price:         moneyFromProto(req.Price),
+		ClearPrice:    req.ClearPrice,
+		StockQuantity: req.StockQuantity,
+		IsActive:      req.IsActive,
+	}
+
+	variant, err := h.variantService.UpdateVariant(storeFromContext(ctx), req.Id, updateReq)
+	if err != nil {
+		log.Printf("Update product variant error: %v", err)
+		return &pb.UpdateProductVariantResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateProductVariantResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Variant updated successfully",
+		},
+		Variant: h.variantToProto(variant),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteProductVariant(ctx context.Context, req *pb.DeleteProductVariantRequest) (*pb.DeleteProductVariantResponse, error) {
+	log.Printf("Delete product variant request: %s", req.Id)
+
+	// TODO: Validate token
+	if err := h.variantService.DeleteVariant(storeFromContext(ctx), req.Id); err != nil {
+		log.Printf("Delete product variant error: %v", err)
+		return &pb.DeleteProductVariantResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.DeleteProductVariantResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Variant deleted successfully",
+		},
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListProductVariants(ctx context.Context, req *pb.ListProductVariantsRequest) (*pb.ListProductVariantsResponse, error) {
+	log.Printf("List product variants request: %s", req.ProductId)
+
+	options, variants, err := h.variantService.GetProductVariants(storeFromContext(ctx), req.ProductId, req.ActiveOnly)
+	if err != nil {
+		log.Printf("List product variants error: %v", err)
+		return &pb.ListProductVariantsResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ListProductVariantsResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Variants retrieved successfully",
+		},
+		Options:  h.optionsToProto(options),
+		Variants: h.variantsToProto(variants),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) optionsToProto(options []*models.ProductOption) []*pb.ProductOption {
+	var protoOptions []*pb.ProductOption
+	for _, option := range options {
+		protoOptions = append(protoOptions, &pb.ProductOption{
+			Id:       option.ID,
+			Name:     option.Name,
+			Values:   option.Values,
+			Position: option.Position,
+		})
+	}
+	return protoOptions
+}
+
+func (h *ProductGrpcHandler) variantsToProto(variants []*models.ProductVariant) []*pb.ProductVariant {
+	var protoVariants []*pb.ProductVariant
+	for _, variant := range variants {
+		protoVariants = append(protoVariants, h.variantToProto(variant))
+	}
+	return protoVariants
+}
+
+func (h *ProductGrpcHandler) variantToProto(variant *models.ProductVariant) *pb.ProductVariant {
+	var price *commonPb.Money
+	if variant.Price != nil {
+		price = moneyToProto(*variant.Price)
+	}
+
+	return &pb.ProductVariant{
+		Id:             variant.ID,
+		ProductId:      variant.ProductID,
+		Sku:            variant.SKU,
+		OptionValues:   variant.OptionValues,
+		Price:          price,
+		EffectivePrice: moneyToProto(variant.EffectivePrice),
+		StockQuantity:  variant.StockQuantity,
+		IsActive:       variant.IsActive,
+		CreatedAt:      variant.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      variant.UpdatedAt.Format(time.RFC3339),
+
+		ReservedQuantity:  variant.ReservedQuantity,
+		AvailableQuantity: variant.StockQuantity - variant.ReservedQuantity,
+	}
+}
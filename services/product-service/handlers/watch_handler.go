@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"log"
+	"time"
+
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WatchProducts streams product changes until the caller goes away. A
+// caller that can't keep up gets an error and should reconnect.
+func (h *ProductGrpcHandler) WatchProducts(req *pb.WatchProductsRequest, stream grpc.ServerStreamingServer[pb.ProductChange]) error {
+	log.Printf("Watch products request: %d categories, %d products", len(req.CategoryIds), len(req.ProductIds))
+
+	watcher, err := h.watchService.Watch(stream.Context(), &models.ProductWatchFilter{
+		StoreID:     storeFromContext(stream.Context()),
+		CategoryIDs: req.CategoryIds,
+		ProductIDs:  req.ProductIds,
+	})
+	if err != nil {
+		log.Printf("Watch products error: %v", err)
+		return err
+	}
+
+	// Headers tell the caller the watch is open before any change arrives
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for change := range watcher.Changes {
+		if err := stream.Send(h.productChangeToProto(change)); err != nil {
+			return err
+		}
+	}
+
+	return watcher.Err()
+}
+
+func (h *ProductGrpcHandler) productChangeToProto(change *models.ProductChange) *pb.ProductChange {
+	protoChange := &pb.ProductChange{
+		EventId:    change.EventID,
+		Type:       change.Type,
+		ProductId:  change.ProductID,
+		Removed:    change.Removed,
+		OccurredAt: change.OccurredAt.Format(time.RFC3339),
+	}
+	if change.Product != nil {
+		protoChange.Product = h.productToProto(change.Product)
+	}
+	return protoChange
+}
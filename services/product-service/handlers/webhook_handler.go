@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	log.Printf("Create webhook request: %s", req.Url)
+
+	// TODO: Validate token; until then trust the gateway's admin check
+	createReq := &models.CreateWebhookRequest{
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(storeFromContext(ctx), createReq, req.CreatedBy)
+	if err != nil {
+		log.Printf("Create webhook error: %v", err)
+		return &pb.CreateWebhookResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.CreateWebhookResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Webhook created; keep the secret, it isn't shown again",
+		},
+		Webhook: webhookToProto(webhook),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	log.Printf("Update webhook request: %s", req.Id)
+
+	// TODO: Validate token
+	updateReq := &models.UpdateWebhookRequest{
+		URL:          req.Url,
+		EventTypes:   req.EventTypes,
+		IsActive:     req.IsActive,
+		RotateSecret: req.RotateSecret,
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(storeFromContext(ctx), req.Id, updateReq)
+	if err != nil {
+		log.Printf("Update webhook error: %v", err)
+		return &pb.UpdateWebhookResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.UpdateWebhookResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Webhook updated successfully",
+		},
+		Webhook: webhookToProto(webhook),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	log.Printf("Delete webhook request: %s", req.Id)
+
+	// TODO: Validate token
+	if err := h.webhookService.DeleteWebhook(storeFromContext(ctx), req.Id); err != nil {
+		log.Printf("Delete webhook error: %v", err)
+		return &pb.DeleteWebhookResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.DeleteWebhookResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Webhook deleted successfully",
+		},
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	log.Printf("List webhooks request")
+
+	// TODO: Validate token
+	webhooks, err := h.webhookService.ListWebhooks(storeFromContext(ctx))
+	if err != nil {
+		log.Printf("List webhooks error: %v", err)
+		return &pb.ListWebhooksResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoWebhooks := make([]*pb.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		protoWebhooks = append(protoWebhooks, webhookToProto(webhook))
+	}
+
+	return &pb.ListWebhooksResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Webhooks retrieved successfully",
+		},
+		Webhooks: protoWebhooks,
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	log.Printf("List webhook deliveries request: %s", req.WebhookId)
+
+	// TODO: Validate token
+	pagination := &models.PaginationRequest{}
+	if req.Pagination != nil {
+		pagination.Page = req.Pagination.Page
+		pagination.Limit = req.Pagination.Limit
+	}
+
+	filter := &models.WebhookDeliveryFilter{
+		StoreID:   storeFromContext(ctx),
+		WebhookID: req.WebhookId,
+		Status:    req.Status,
+	}
+
+	deliveries, paginationResp, err := h.webhookService.ListDeliveries(filter, pagination)
+	if err != nil {
+		log.Printf("List webhook deliveries error: %v", err)
+		return &pb.ListWebhookDeliveriesResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	protoDeliveries := make([]*pb.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		protoDeliveries = append(protoDeliveries, deliveryToProto(delivery))
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Webhook deliveries retrieved successfully",
+		},
+		Deliveries: protoDeliveries,
+		Pagination: h.paginationToProto(paginationResp),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) RetryWebhookDelivery(ctx context.Context, req *pb.RetryWebhookDeliveryRequest) (*pb.RetryWebhookDeliveryResponse, error) {
+	log.Printf("Retry webhook delivery request: %s", req.Id)
+
+	// TODO: Validate token
+	delivery, err := h.webhookService.RetryDelivery(storeFromContext(ctx), req.Id)
+	if err != nil {
+		log.Printf("Retry webhook delivery error: %v", err)
+		return &pb.RetryWebhookDeliveryResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.RetryWebhookDeliveryResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Delivery queued for another try",
+		},
+		Delivery: deliveryToProto(delivery),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) SendTestWebhook(ctx context.Context, req *pb.SendTestWebhookRequest) (*pb.SendTestWebhookResponse, error) {
+	log.Printf("Send test webhook request: %s", req.Id)
+
+	// TODO: Validate token
+	delivery, err := h.webhookService.SendTestEvent(ctx, storeFromContext(ctx), req.Id)
+	if err != nil {
+		log.Printf("Send test webhook error: %v", err)
+		return &pb.SendTestWebhookResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	// The test itself failing is still a result worth returning
+	message := "Test event delivered"
+	if delivery.Status != models.DeliverySucceeded {
+		message = "Test event failed: " + delivery.LastError
+	}
+
+	return &pb.SendTestWebhookResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: message,
+		},
+		Delivery: deliveryToProto(delivery),
+	}, nil
+}
+
+func webhookToProto(webhook *models.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:         webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		Secret:     webhook.Secret,
+		IsActive:   webhook.IsActive,
+		CreatedBy:  webhook.CreatedBy,
+		CreatedAt:  webhook.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  webhook.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func deliveryToProto(delivery *models.WebhookDelivery) *pb.WebhookDelivery {
+	protoDelivery := &pb.WebhookDelivery{
+		Id:           delivery.ID,
+		WebhookId:    delivery.WebhookID,
+		EventId:      delivery.EventID,
+		EventType:    delivery.EventType,
+		Status:       delivery.Status,
+		AttemptCount: delivery.AttemptCount,
+		ResponseCode: delivery.ResponseCode,
+		LastError:    delivery.LastError,
+		CreatedAt:    delivery.CreatedAt.Format(time.RFC3339),
+	}
+	if delivery.NextAttemptAt != nil {
+		protoDelivery.NextAttemptAt = delivery.NextAttemptAt.Format(time.RFC3339)
+	}
+	if delivery.DeliveredAt != nil {
+		protoDelivery.DeliveredAt = delivery.DeliveredAt.Format(time.RFC3339)
+	}
+	for _, attempt := range delivery.Attempts {
+		protoDelivery.Attempts = append(protoDelivery.Attempts, &pb.WebhookAttempt{
+			ResponseCode: attempt.ResponseCode,
+			Error:        attempt.Error,
+			DurationMs:   int32(attempt.Duration.Milliseconds()),
+			AttemptedAt:  attempt.AttemptedAt.Format(time.RFC3339),
+		})
+	}
+	return protoDelivery
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	commonPb "github.com/martbul/playground_microservices/services/product-service/genproto/common"
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (h *ProductGrpcHandler) GetWishlist(ctx context.Context, req *pb.GetWishlistRequest) (*pb.GetWishlistResponse, error) {
+	// TODO: Validate token; until then trust the gateway's user ID
+	wishlist, err := h.wishlistService.GetWishlist(storeFromContext(ctx), req.UserId)
+	if err != nil {
+		log.Printf("Get wishlist error: %v", err)
+		return &pb.GetWishlistResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.GetWishlistResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Wishlist retrieved successfully",
+		},
+		Wishlist: h.wishlistToProto(wishlist),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) AddToWishlist(ctx context.Context, req *pb.AddToWishlistRequest) (*pb.AddToWishlistResponse, error) {
+	log.Printf("Add to wishlist request: %s", req.ProductId)
+
+	// TODO: Validate token
+	wishlist, err := h.wishlistService.AddToWishlist(storeFromContext(ctx), req.UserId, req.ProductId)
+	if err != nil {
+		log.Printf("Add to wishlist error: %v", err)
+		return &pb.AddToWishlistResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.AddToWishlistResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product saved to your wishlist",
+		},
+		Wishlist: h.wishlistToProto(wishlist),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) RemoveFromWishlist(ctx context.Context, req *pb.RemoveFromWishlistRequest) (*pb.RemoveFromWishlistResponse, error) {
+	log.Printf("Remove from wishlist request: %s", req.ProductId)
+
+	// TODO: Validate token
+	wishlist, err := h.wishlistService.RemoveFromWishlist(storeFromContext(ctx), req.UserId, req.ProductId)
+	if err != nil {
+		log.Printf("Remove from wishlist error: %v", err)
+		return &pb.RemoveFromWishlistResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.RemoveFromWishlistResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Product removed from your wishlist",
+		},
+		Wishlist: h.wishlistToProto(wishlist),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReorderWishlist(ctx context.Context, req *pb.ReorderWishlistRequest) (*pb.ReorderWishlistResponse, error) {
+	log.Printf("Reorder wishlist request: %d products", len(req.ProductIds))
+
+	// TODO: Validate token
+	wishlist, err := h.wishlistService.ReorderWishlist(storeFromContext(ctx), req.UserId, req.ProductIds)
+	if err != nil {
+		log.Printf("Reorder wishlist error: %v", err)
+		return &pb.ReorderWishlistResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.ReorderWishlistResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Wishlist reordered successfully",
+		},
+		Wishlist: h.wishlistToProto(wishlist),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ShareWishlist(ctx context.Context, req *pb.ShareWishlistRequest) (*pb.ShareWishlistResponse, error) {
+	log.Printf("Share wishlist request: shared=%t", req.Shared)
+
+	// TODO: Validate token
+	wishlist, err := h.wishlistService.ShareWishlist(storeFromContext(ctx), req.UserId, req.Shared)
+	if err != nil {
+		log.Printf("Share wishlist error: %v", err)
+		return &pb.ShareWishlistResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	message := "Wishlist is no longer shared"
+	if req.Shared {
+		message = "Wishlist shared successfully"
+	}
+	return &pb.ShareWishlistResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: message,
+		},
+		Wishlist: h.wishlistToProto(wishlist),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) GetSharedWishlist(ctx context.Context, req *pb.GetSharedWishlistRequest) (*pb.GetSharedWishlistResponse, error) {
+	wishlist, err := h.wishlistService.GetSharedWishlist(storeFromContext(ctx), req.ShareToken)
+	if err != nil {
+		log.Printf("Get shared wishlist error: %v", err)
+		return &pb.GetSharedWishlistResponse{
+			Response: &commonPb.Response{
+				Success: false,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &pb.GetSharedWishlistResponse{
+		Response: &commonPb.Response{
+			Success: true,
+			Message: "Wishlist retrieved successfully",
+		},
+		Wishlist: h.wishlistToProto(wishlist),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) wishlistToProto(wishlist *models.Wishlist) *pb.Wishlist {
+	protoWishlist := &pb.Wishlist{
+		UserId:     wishlist.UserID,
+		Items:      make([]*pb.WishlistItem, 0, len(wishlist.Items)),
+		ShareToken: wishlist.ShareToken,
+	}
+
+	for _, item := range wishlist.Items {
+		protoItem := &pb.WishlistItem{
+			ProductId: item.ProductID,
+			Position:  item.Position,
+			Available: item.Available,
+			InStock:   item.InStock,
+			AddedAt:   item.AddedAt.Format(time.RFC3339),
+		}
+		if item.Product != nil {
+			protoItem.Product = h.productToProto(item.Product)
+		}
+		protoWishlist.Items = append(protoWishlist.Items, protoItem)
+	}
+
+	return protoWishlist
+}
@@ -1,14 +1,19 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	pb "github.com/martbul/playground_microservices/services/product-service/genproto/product"
 	"github.com/martbul/playground_microservices/services/product-service/config"
+	"github.com/martbul/playground_microservices/services/product-service/events"
 	"github.com/martbul/playground_microservices/services/product-service/handlers"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/notifier"
 	"github.com/martbul/playground_microservices/services/product-service/repository"
 	"github.com/martbul/playground_microservices/services/product-service/service"
 
@@ -19,6 +24,9 @@ import (
 func main() {
 	// Load configuration
 	cfg := config.Load()
+	if !models.ValidCurrency(cfg.DefaultCurrency) {
+		log.Fatalf("Unsupported default currency %q", cfg.DefaultCurrency)
+	}
 
 	// Connect to database
 	db, err := sql.Open("postgres", cfg.DatabaseURL)
@@ -33,21 +41,80 @@ func main() {
 	}
 
 	// Run migrations
-	if err := runMigrations(db); err != nil {
+	if err := repository.Migrate(db); err != nil {
 		log.Fatal("Failed to run migrations:", err)
 	}
 
-	// Initialize repository
+	// Initialize repositories
 	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	variantRepo := repository.NewVariantRepository(db)
+	attributeRepo := repository.NewAttributeRepository(db)
+	imageRepo := repository.NewImageRepository(db)
+	inventoryRepo := repository.NewInventoryRepository(db)
+	revisionRepo := repository.NewRevisionRepository(db)
+	priceRepo := repository.NewPriceRepository(db)
+	reviewRepo := repository.NewReviewRepository(db)
+	wishlistRepo := repository.NewWishlistRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	recommendationRepo := repository.NewRecommendationRepository(db)
+
+	lowStockNotifier, err := notifier.New(cfg)
+	if err != nil {
+		log.Fatal("Failed to configure low stock notifiers:", err)
+	}
 
-	// Initialize service
-	productService := service.NewProductService(productRepo)
+	eventBus, err := events.New(cfg, db)
+	if err != nil {
+		log.Fatal("Failed to configure event bus:", err)
+	}
+	defer eventBus.Close()
+
+	// Initialize services
+	inventoryService := service.NewInventoryService(inventoryRepo, lowStockNotifier, cfg.ReservationTTL)
+	productService := service.NewProductService(productRepo, categoryRepo, attributeRepo, revisionRepo, inventoryService, cfg.TrashRetention, cfg.DefaultCurrency)
+	categoryService := service.NewCategoryService(categoryRepo)
+	variantService := service.NewVariantService(variantRepo, productRepo, inventoryService)
+	attributeService := service.NewAttributeService(attributeRepo, categoryRepo)
+	imageService := service.NewImageService(imageRepo, productRepo)
+	priceService := service.NewPriceService(priceRepo, productRepo)
+	reviewService := service.NewReviewService(reviewRepo, productRepo)
+	wishlistService := service.NewWishlistService(wishlistRepo, productRepo)
+	outboxRelay := service.NewOutboxRelay(outboxRepo, eventBus, cfg.OutboxRetention)
+	webhookService := service.NewWebhookService(webhookRepo, productRepo, cfg.WebhookTimeout, cfg.WebhookMaxAttempts)
+	watchService := service.NewWatchService(productRepo, categoryRepo)
+	recommendationService := service.NewRecommendationService(recommendationRepo, productRepo)
+
+	// Expired reservations already stop holding stock; this just marks them
+	go expireReservations(inventoryService, cfg.ReservationSweepInterval)
+
+	// Sales apply by their time window; this keeps schedule statuses current
+	go advancePriceSchedules(priceService, cfg.PriceScheduleSweepInterval)
+
+	// Events are committed with their changes; this publishes them
+	go relayEvents(outboxRelay, cfg.OutboxPollInterval)
+
+	// Webhooks hear about events from the bus like any other consumer
+	if err := eventBus.Subscribe(context.Background(), webhookService.HandleEvent, service.WebhookEventTypes...); err != nil {
+		log.Fatal("Failed to subscribe webhooks to events:", err)
+	}
+	go deliverWebhooks(webhookService, cfg.WebhookSweepInterval)
+
+	// Every instance hears every event, so its own watchers see them all
+	if err := eventBus.Subscribe(context.Background(), watchService.HandleEvent, service.WatchEventTypes...); err != nil {
+		log.Fatal("Failed to subscribe product watchers to events:", err)
+	}
+
+	// Recommendations are scored in batches from recorded product views
+	go computeRecommendations(recommendationService, cfg.RecommendationInterval, cfg.RecommendationWindow)
 
 	// Initialize handler
-	productHandler := handlers.NewProductGrpcHandler(productService)
+	productHandler := handlers.NewProductGrpcHandler(productService, categoryService, variantService, attributeService, imageService, inventoryService, priceService, reviewService, wishlistService, webhookService, watchService, recommendationService)
 
 	// Create gRPC server
-	server := grpc.NewServer()
+	storeUnary, storeStream := handlers.StoreInterceptors(cfg.AllowDefaultStore)
+	server := grpc.NewServer(grpc.UnaryInterceptor(storeUnary), grpc.StreamInterceptor(storeStream))
 	pb.RegisterProductServiceServer(server, productHandler)
 
 	// Start listening
@@ -62,49 +129,96 @@ func main() {
 	}
 }
 
-func runMigrations(db *sql.DB) error {
-	query := `
-	CREATE TABLE IF NOT EXISTS categories (
-		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-		name VARCHAR(255) NOT NULL,
-		description TEXT,
-		parent_id UUID REFERENCES categories(id),
-		is_active BOOLEAN DEFAULT true,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE TABLE IF NOT EXISTS products (
-		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-		name VARCHAR(255) NOT NULL,
-		description TEXT,
-		price DECIMAL(10,2) NOT NULL,
-		stock_quantity INTEGER DEFAULT 0,
-		category VARCHAR(255),
-		image_url VARCHAR(500),
-		sku VARCHAR(100) UNIQUE,
-		is_active BOOLEAN DEFAULT true,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		created_by VARCHAR(255)
-	);
-
-	INSERT INTO categories (name, description) VALUES 
-		('Electronics', 'Electronic devices and accessories'),
-		('Clothing', 'Apparel and fashion items'),
-		('Books', 'Books and educational materials'),
-		('Sports', 'Sports and fitness equipment'),
-		('Home', 'Home and garden products')
-	ON CONFLICT DO NOTHING;
-
-	CREATE INDEX IF NOT EXISTS idx_products_name ON products(name);
-	CREATE INDEX IF NOT EXISTS idx_products_category ON products(category);
-	CREATE INDEX IF NOT EXISTS idx_products_sku ON products(sku);
-	CREATE INDEX IF NOT EXISTS idx_products_active ON products(is_active);
-	CREATE INDEX IF NOT EXISTS idx_categories_name ON categories(name);
-	CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories(parent_id);
-	`
-
-	_, err := db.Exec(query)
-	return err
+func expireReservations(inventoryService service.InventoryService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		expired, err := inventoryService.ExpireReservations()
+		if err != nil {
+			log.Printf("Failed to expire reservations: %v", err)
+			continue
+		}
+		if expired > 0 {
+			log.Printf("Expired %d stock reservations", expired)
+		}
+	}
+}
+
+func advancePriceSchedules(priceService service.PriceService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		started, ended, err := priceService.AdvanceSchedules()
+		if err != nil {
+			log.Printf("Failed to advance price schedules: %v", err)
+			continue
+		}
+		if started > 0 || ended > 0 {
+			log.Printf("Price schedules: %d sales started, %d ended", started, ended)
+		}
+	}
+}
+
+func relayEvents(outboxRelay service.OutboxRelay, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	cleanup := time.NewTicker(time.Hour)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := outboxRelay.Relay(context.Background()); err != nil {
+				log.Printf("Failed to relay events: %v", err)
+			}
+		case <-cleanup.C:
+			deleted, err := outboxRelay.Cleanup()
+			if err != nil {
+				log.Printf("Failed to clean up published events: %v", err)
+				continue
+			}
+			if deleted > 0 {
+				log.Printf("Deleted %d published events", deleted)
+			}
+		}
+	}
+}
+
+func deliverWebhooks(webhookService service.WebhookService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Keep going while full batches come back, so a backlog drains
+		for {
+			sent, err := webhookService.DeliverDue(context.Background())
+			if err != nil {
+				log.Printf("Failed to deliver webhooks: %v", err)
+				break
+			}
+			if sent == 0 {
+				break
+			}
+		}
+	}
+}
+
+// computeRecommendations runs once at startup, so a fresh instance doesn't
+// wait a whole interval for scores, and then on every tick
+func computeRecommendations(recommendationService service.RecommendationService, interval, window time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		computed, err := recommendationService.Recompute(window)
+		if err != nil {
+			log.Printf("Failed to compute recommendations: %v", err)
+		} else if computed {
+			log.Printf("Recommendations computed")
+		}
+		<-ticker.C
+	}
 }
@@ -0,0 +1,30 @@
+package models
+
+// Attribute types a category schema can declare
+const (
+	AttributeTypeString = "string"
+	AttributeTypeNumber = "number"
+	AttributeTypeBool   = "bool"
+	AttributeTypeEnum   = "enum"
+)
+
+// AttributeDefinition declares a typed custom attribute for products in a
+// category. Definitions are inherited by subcategories.
+type AttributeDefinition struct {
+	ID         string   `json:"id" db:"id"`
+	CategoryID string   `json:"category_id" db:"category_id"`
+	Key        string   `json:"key" db:"key"`
+	Label      string   `json:"label" db:"label"`
+	Type       string   `json:"type" db:"type"`
+	EnumValues []string `json:"enum_values" db:"enum_values"`
+	Required   bool     `json:"required" db:"required"`
+	Position   int32    `json:"position" db:"position"`
+}
+
+// AttributeFilter is a parsed filter such as attr.ram_gb>=16. Value holds a
+// string, float64 or bool.
+type AttributeFilter struct {
+	Key      string
+	Operator string
+	Value    interface{}
+}
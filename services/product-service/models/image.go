@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// ProductImage is an uploaded image. The blobs themselves live in the
+// gateway's blob store; StorageKeys lists them so they can be removed.
+type ProductImage struct {
+	ID          string            `json:"id" db:"id"`
+	ProductID   string            `json:"product_id" db:"product_id"`
+	URL         string            `json:"url" db:"url"`
+	ContentType string            `json:"content_type" db:"content_type"`
+	SizeBytes   int64             `json:"size_bytes" db:"size_bytes"`
+	Width       int32             `json:"width" db:"width"`
+	Height      int32             `json:"height" db:"height"`
+	Position    int32             `json:"position" db:"position"`
+	Thumbnails  map[string]string `json:"thumbnails" db:"thumbnails"`
+	StorageKeys []string          `json:"storage_keys" db:"storage_keys"`
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+}
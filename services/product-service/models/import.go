@@ -0,0 +1,48 @@
+package models
+
+// MovementImport is the ledger reason for stock set by a bulk import
+const MovementImport = "import"
+
+type ImportOptions struct {
+	StoreID   string
+	CreatedBy string
+	DryRun    bool
+	Upsert    bool
+	BatchSize int
+}
+
+// ImportRow is one product read from an import file. Row is its line number
+// in that file so errors can point back to it.
+type ImportRow struct {
+	Row     int32
+	Product *CreateProductRequest
+}
+
+type ImportRowError struct {
+	Row     int32  `json:"row"`
+	SKU     string `json:"sku"`
+	Message string `json:"message"`
+}
+
+type ImportResult struct {
+	TotalRows int32             `json:"total_rows"`
+	Created   int32             `json:"created"`
+	Updated   int32             `json:"updated"`
+	Failed    int32             `json:"failed"`
+	Errors    []*ImportRowError `json:"errors"`
+	DryRun    bool              `json:"dry_run"`
+}
+
+// ImportItem is a validated row ready to be written. Update is set when the
+// row replaces an existing product with the same SKU, and Revision when the
+// write should be recorded in the product's history. The repository fills in
+// Err when the row could not be written and Change when its stock moved.
+type ImportItem struct {
+	Row        int32
+	Product    *Product
+	Update     bool
+	ImportedBy string
+	Revision   *ProductRevision
+	Err        error
+	Change     *StockChange
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"time"
+)
+
+// Reservation statuses; only active reservations hold stock
+const (
+	ReservationActive    = "active"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// Stock movement reasons recorded by the service itself
+const (
+	MovementInitial   = "initial"
+	MovementManualSet = "manual_set"
+	MovementSale      = "sale"
+)
+
+// StockMovement is one ledger entry. VariantID is nil for product-level stock.
+type StockMovement struct {
+	ID            string    `json:"id" db:"id"`
+	ProductID     string    `json:"product_id" db:"product_id"`
+	VariantID     *string   `json:"variant_id" db:"variant_id"`
+	Delta         int32     `json:"delta" db:"delta"`
+	QuantityAfter int32     `json:"quantity_after" db:"quantity_after"`
+	Reason        string    `json:"reason" db:"reason"`
+	Reference     string    `json:"reference" db:"reference"`
+	ReservationID *string   `json:"reservation_id" db:"reservation_id"`
+	CreatedBy     string    `json:"created_by" db:"created_by"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+// StockLevel is the stock of a product or variant at one point in time
+type StockLevel struct {
+	StockQuantity    int32 `json:"stock_quantity"`
+	ReservedQuantity int32 `json:"reserved_quantity"`
+}
+
+// Available is the stock that can still be reserved or sold
+func (l StockLevel) Available() int32 {
+	return l.StockQuantity - l.ReservedQuantity
+}
+
+// StockChange describes one stock row before and after an operation, with
+// enough of the product to report on it
+type StockChange struct {
+	ProductID        string
+	VariantID        *string
+	ProductName      string
+	SKU              string
+	CreatedBy        string
+	ReorderThreshold *int32
+	Before           StockLevel
+	After            StockLevel
+}
+
+// CrossedThreshold reports whether available stock dropped from above the
+// reorder threshold to at or below it
+func (c *StockChange) CrossedThreshold() bool {
+	if c.ReorderThreshold == nil {
+		return false
+	}
+	threshold := *c.ReorderThreshold
+	return c.Before.Available() > threshold && c.After.Available() <= threshold
+}
+
+// LowStockItem is a product or variant at or below its reorder threshold
+type LowStockItem struct {
+	ProductID         string  `json:"product_id"`
+	ProductName       string  `json:"product_name"`
+	SKU               string  `json:"sku"`
+	VariantID         *string `json:"variant_id,omitempty"`
+	StockQuantity     int32   `json:"stock_quantity"`
+	ReservedQuantity  int32   `json:"reserved_quantity"`
+	AvailableQuantity int32   `json:"available_quantity"`
+	ReorderThreshold  int32   `json:"reorder_threshold"`
+	CreatedBy         string  `json:"created_by"`
+}
+
+// LowStockEvent is emitted when a stock change crosses the reorder threshold
+type LowStockEvent struct {
+	LowStockItem
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type ReservationItem struct {
+	ProductID string  `json:"product_id" db:"product_id"`
+	VariantID *string `json:"variant_id" db:"variant_id"`
+	Quantity  int32   `json:"quantity" db:"quantity"`
+}
+
+// StockReservation holds stock for a cart or order until it is committed,
+// released or expires
+type StockReservation struct {
+	ID        string             `json:"id" db:"id"`
+	Reference string             `json:"reference" db:"reference"`
+	Status    string             `json:"status" db:"status"`
+	Items     []*ReservationItem `json:"items"`
+	ExpiresAt time.Time          `json:"expires_at" db:"expires_at"`
+	CreatedAt time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+type AdjustStockRequest struct {
+	StoreID   string
+	ProductID string
+	VariantID string
+	Delta     int32
+	Reason    string
+	Reference string
+	CreatedBy string
+}
+
+type ReserveStockRequest struct {
+	StoreID   string
+	Reference string
+	Items     []*ReservationItem
+	TTL       time.Duration
+}
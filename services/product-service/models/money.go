@@ -0,0 +1,207 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const nanosPerUnit = 1000000000
+
+// currencyDigits is the number of minor-unit digits of each supported
+// ISO 4217 currency
+var currencyDigits = map[string]int{
+	"AUD": 2, "BGN": 2, "BRL": 2, "CAD": 2, "CHF": 2, "CNY": 2, "CZK": 2,
+	"DKK": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "INR": 2, "MXN": 2,
+	"NOK": 2, "NZD": 2, "PLN": 2, "RON": 2, "SEK": 2, "SGD": 2, "TRY": 2,
+	"USD": 2, "ZAR": 2,
+	"CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "VND": 0,
+	"BHD": 3, "JOD": 3, "KWD": 3, "OMR": 3, "TND": 3,
+}
+
+// ValidCurrency reports whether code is a supported currency code
+func ValidCurrency(code string) bool {
+	_, ok := currencyDigits[code]
+	return ok
+}
+
+// Money is an exact amount: Units whole units plus Nanos billionths of a
+// unit in Currency. Units and Nanos never have opposite signs.
+type Money struct {
+	Currency string
+	Units    int64
+	Nanos    int32
+}
+
+// ParseMoney reads a decimal amount such as "12.50" without going through
+// floating point
+func ParseMoney(amount, currency string) (Money, error) {
+	text := strings.TrimSpace(amount)
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "-"), "+")
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	if whole == "" && fraction == "" || !digitsOnly(whole) || !digitsOnly(fraction) {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > 9 {
+		return Money{}, fmt.Errorf("amount %q has too many decimal places", amount)
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		units, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return Money{}, fmt.Errorf("amount %q is out of range", amount)
+		}
+	}
+	var nanos int64
+	if fraction != "" {
+		nanos, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+	}
+
+	if negative {
+		units, nanos = -units, -nanos
+	}
+	return Money{Currency: strings.ToUpper(currency), Units: units, Nanos: int32(nanos)}, nil
+}
+
+func digitsOnly(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the currency and that the amount fits the currency's
+// minor unit, so USD has at most two decimal places
+func (m Money) Validate() error {
+	digits, ok := currencyDigits[m.Currency]
+	if !ok {
+		return fmt.Errorf("unsupported currency %q", m.Currency)
+	}
+	if m.Nanos <= -nanosPerUnit || m.Nanos >= nanosPerUnit || (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return fmt.Errorf("invalid amount")
+	}
+	if m.Nanos%int32(math.Pow10(9-digits)) != 0 {
+		return fmt.Errorf("%s amounts have at most %d decimal places", m.Currency, digits)
+	}
+	return nil
+}
+
+// Sign returns -1, 0 or 1
+func (m Money) Sign() int {
+	switch {
+	case m.Units > 0 || m.Nanos > 0:
+		return 1
+	case m.Units < 0 || m.Nanos < 0:
+		return -1
+	}
+	return 0
+}
+
+// Cmp compares the amounts of two values in the same currency
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.Units != other.Units:
+		if m.Units < other.Units {
+			return -1
+		}
+		return 1
+	case m.Nanos < other.Nanos:
+		return -1
+	case m.Nanos > other.Nanos:
+		return 1
+	}
+	return 0
+}
+
+// Amount formats the amount as a decimal with at least the currency's
+// number of decimal places, e.g. "12.50"
+func (m Money) Amount() string {
+	digits, ok := currencyDigits[m.Currency]
+	if !ok {
+		digits = 2
+	}
+
+	units, nanos := m.Units, int64(m.Nanos)
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	if len(fraction) < digits {
+		fraction += strings.Repeat("0", digits-len(fraction))
+	}
+	if fraction == "" {
+		return sign + strconv.FormatUint(uint64(units), 10)
+	}
+	return sign + strconv.FormatUint(uint64(units), 10) + "." + fraction
+}
+
+// String formats the value as "12.50 USD"
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount()
+	}
+	return m.Amount() + " " + m.Currency
+}
+
+// MarshalJSON encodes the value as a string, e.g. "12.50 USD", so revision
+// snapshots keep the exact amount
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON reads "12.50 USD" as well as the plain numbers older
+// revisions stored; those leave Currency empty for the caller to fill in
+func (m *Money) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	}
+
+	amount, currency, _ := strings.Cut(strings.TrimSpace(text), " ")
+	parsed, err := ParseMoney(amount, currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value stores the amount in a DECIMAL column; the currency has its own column
+func (m Money) Value() (driver.Value, error) {
+	return m.Amount(), nil
+}
+
+// Scan reads a DECIMAL column into the amount, leaving Currency as it is
+func (m *Money) Scan(src interface{}) error {
+	var text string
+	switch v := src.(type) {
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+
+	parsed, err := ParseMoney(text, m.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
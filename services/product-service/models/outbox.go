@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// OutboxEvent is a domain event written in the same transaction as the
+// change it describes, waiting for the relay to publish it
+type OutboxEvent struct {
+	ID          int64
+	EventID     string
+	Type        string
+	Version     int32
+	AggregateID string
+	Payload     []byte
+	Attempts    int32
+	CreatedAt   time.Time
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+)
+
+// Price schedule statuses. The status is kept up to date by a background
+// job; whether a sale applies is decided by its time window, not the status.
+const (
+	PriceScheduleScheduled = "scheduled"
+	PriceScheduleActive    = "active"
+	PriceScheduleEnded     = "ended"
+	PriceScheduleCancelled = "cancelled"
+)
+
+// PriceSchedule puts a product on sale at SalePrice from StartsAt until EndsAt
+type PriceSchedule struct {
+	ID        string    `json:"id" db:"id"`
+	ProductID string    `json:"product_id" db:"product_id"`
+	SalePrice Money     `json:"sale_price" db:"sale_price"`
+	StartsAt  time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt    time.Time `json:"ends_at" db:"ends_at"`
+	Status    string    `json:"status" db:"status"`
+	CreatedBy string    `json:"created_by" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Running reports whether the sale applies at the given time
+func (s *PriceSchedule) Running(at time.Time) bool {
+	return s.Status != PriceScheduleCancelled && !at.Before(s.StartsAt) && at.Before(s.EndsAt)
+}
+
+// CreatePriceScheduleRequest schedules a sale; a zero StartsAt means now
+type CreatePriceScheduleRequest struct {
+	SalePrice Money     `json:"sale_price" validate:"required"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at" validate:"required"`
+}
+
+// EffectivePrice is what the product sells for at the given time: the sale
+// price while its sale runs, otherwise the regular price
+func (p *Product) EffectivePrice(at time.Time) Money {
+	if p.ActiveSale != nil && p.ActiveSale.Running(at) {
+		return p.ActiveSale.SalePrice
+	}
+	return p.Price
+}
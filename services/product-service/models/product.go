@@ -4,12 +4,17 @@ import (
 	"time"
 )
 
+// DefaultStoreID is the store that existed before there were several, and
+// the one calls that don't name a store are made for
+const DefaultStoreID = "00000000-0000-0000-0000-000000000001"
+
 type Product struct {
 	ID            string    `json:"id" db:"id"`
 	Name          string    `json:"name" db:"name"`
 	Description   string    `json:"description" db:"description"`
-	Price         float64   `json:"price" db:"price"`
+	Price         Money     `json:"price" db:"price"`
 	StockQuantity int32     `json:"stock_quantity" db:"stock_quantity"`
+	CategoryID    *string   `json:"category_id" db:"category_id"`
 	Category      string    `json:"category" db:"category"`
 	ImageURL      string    `json:"image_url" db:"image_url"`
 	SKU           string    `json:"sku" db:"sku"`
@@ -17,53 +22,152 @@ type Product struct {
 	CreatedAt     time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
 	CreatedBy     string    `json:"created_by" db:"created_by"`
+
+	// Attributes holds the custom attributes typed per the category schema
+	Attributes map[string]interface{} `json:"attributes" db:"attributes"`
+
+	// ReservedQuantity is held by active reservations and not available to sell
+	ReservedQuantity int32 `json:"reserved_quantity" db:"-"`
+	// ReorderThreshold enables low-stock alerts; nil means none
+	ReorderThreshold *int32 `json:"reorder_threshold" db:"reorder_threshold"`
+	// Version goes up with every edit; stock movements don't change it
+	Version int32 `json:"version" db:"version"`
+	// DeletedAt is set while the product is in the trash
+	DeletedAt *time.Time `json:"deleted_at" db:"deleted_at"`
+
+	// CompareAtPrice is the original price shown struck through; nil means none
+	CompareAtPrice *Money `json:"compare_at_price" db:"compare_at_price"`
+	// ActiveSale is the sale running when the product was read, if any
+	ActiveSale *PriceSchedule `json:"active_sale" db:"-"`
+
+	// Currency is the ISO 4217 code of every base price of the product:
+	// price, compare-at, sale and variant prices
+	Currency string `json:"currency" db:"currency"`
+	// Prices fixes the price in other currencies, ordered by currency
+	Prices []Money `json:"prices" db:"-"`
+
+	// AverageRating and ReviewCount summarize the approved reviews; they are
+	// kept up to date as reviews change
+	AverageRating float64 `json:"average_rating" db:"average_rating"`
+	ReviewCount   int32   `json:"review_count" db:"review_count"`
+
+	// StoreID is the store selling the product
+	StoreID string `json:"store_id" db:"store_id"`
 }
 
 type Category struct {
 	ID          string    `json:"id" db:"id"`
 	Name        string    `json:"name" db:"name"`
+	Slug        string    `json:"slug" db:"slug"`
 	Description string    `json:"description" db:"description"`
 	ParentID    *string   `json:"parent_id" db:"parent_id"`
 	IsActive    bool      `json:"is_active" db:"is_active"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	StoreID     string    `json:"store_id" db:"store_id"`
+}
+
+// CategoryNode is a category together with its subcategories
+type CategoryNode struct {
+	Category *Category       `json:"category"`
+	Children []*CategoryNode `json:"children"`
+}
+
+type CreateCategoryRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	ParentID    string `json:"parent_id"`
+	Slug        string `json:"slug"`
+}
+
+type UpdateCategoryRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Slug        string `json:"slug"`
+	IsActive    *bool  `json:"is_active"`
 }
 
 type CreateProductRequest struct {
-	Name          string  `json:"name" validate:"required"`
-	Description   string  `json:"description"`
-	Price         float64 `json:"price" validate:"required,min=0"`
-	StockQuantity int32   `json:"stock_quantity" validate:"min=0"`
-	Category      string  `json:"category"`
-	ImageURL      string  `json:"image_url"`
-	SKU           string  `json:"sku"`
+	Name          string `json:"name" validate:"required"`
+	Description   string `json:"description"`
+	Price         Money  `json:"price" validate:"required"`
+	StockQuantity int32  `json:"stock_quantity" validate:"min=0"`
+	CategoryID    string `json:"category_id"`
+	Category      string `json:"category"`
+	ImageURL      string `json:"image_url"`
+	SKU           string `json:"sku"`
+
+	Attributes       map[string]string `json:"attributes"`
+	ReorderThreshold *int32            `json:"reorder_threshold" validate:"omitempty,min=0"`
+	CompareAtPrice   *Money            `json:"compare_at_price"`
 }
 
+// UpdateProductRequest changes only the fields that are set; a set field is
+// applied even when it holds an empty or zero value.
 type UpdateProductRequest struct {
-	Name          string  `json:"name"`
-	Description   string  `json:"description"`
-	Price         float64 `json:"price" validate:"min=0"`
-	StockQuantity int32   `json:"stock_quantity" validate:"min=0"`
-	Category      string  `json:"category"`
-	ImageURL      string  `json:"image_url"`
-	SKU           string  `json:"sku"`
+	Name          *string `json:"name"`
+	Description   *string `json:"description"`
+	Price         *Money  `json:"price"`
+	StockQuantity *int32  `json:"stock_quantity" validate:"omitempty,min=0"`
+	CategoryID    *string `json:"category_id"`
+	Category      *string `json:"category"`
+	ImageURL      *string `json:"image_url"`
+	SKU           *string `json:"sku"`
 	IsActive      *bool   `json:"is_active"`
+
+	// Attributes are merged into the existing ones; an empty value removes the key
+	Attributes map[string]string `json:"attributes"`
+
+	ReorderThreshold      *int32 `json:"reorder_threshold" validate:"omitempty,min=0"`
+	ClearReorderThreshold bool   `json:"clear_reorder_threshold"`
+
+	CompareAtPrice      *Money `json:"compare_at_price"`
+	ClearCompareAtPrice bool   `json:"clear_compare_at_price"`
+
+	// Version is the version the edit was based on
+	Version int32 `json:"version" validate:"required"`
 }
 
+// ProductPatch is one item of a batch update; nil fields are left alone.
+// With Version set, the item only applies if the product is still at that
+// version. Err is set when the item failed and Change when its stock moved;
+// Product holds the updated product on success, or the current one when
+// the item was a version conflict.
+type ProductPatch struct {
+	ID            string
+	Price         *Money
+	StockQuantity *int32
+	IsActive      *bool
+	Version       *int32
+
+	Err     error
+	Change  *StockChange
+	Product *Product
+}
+
+// ProductFilter narrows List results. CategoryID matches the category and all
+// of its descendants; Category is a slug or name the service resolves to an ID.
+// Price bounds only match products priced in the bound's currency.
 type ProductFilter struct {
-	Category  string
-	MinPrice  float64
-	MaxPrice  float64
-	IsActive  *bool
-	CreatedBy string
+	StoreID    string
+	CategoryID string
+	Category   string
+	MinPrice   *Money
+	MaxPrice   *Money
+	IsActive   *bool
+	CreatedBy  string
+	Attributes []AttributeFilter
 }
 
 type SearchFilter struct {
-	Query     string
-	Category  string
-	MinPrice  float64
-	MaxPrice  float64
-	IsActive  *bool
+	StoreID    string
+	Query      string
+	CategoryID string
+	Category   string
+	MinPrice   *Money
+	MaxPrice   *Money
+	IsActive   *bool
+	Attributes []AttributeFilter
 }
 
 type PaginationRequest struct {
@@ -80,4 +184,4 @@ type PaginationResponse struct {
 	TotalCount int64
 	HasNext    bool
 	HasPrev    bool
-}
\ No newline at end of file
+}
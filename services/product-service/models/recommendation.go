@@ -0,0 +1,32 @@
+package models
+
+// Why a product was recommended
+const (
+	RecommendationAlsoViewed        = "also_viewed"
+	RecommendationPopularInCategory = "popular_in_category"
+	RecommendationPopular           = "popular"
+)
+
+// ProductView is someone looking at a product. Signed-in viewers are known
+// by UserID, guests by SessionID; a view with neither is anonymous.
+type ProductView struct {
+	ProductID string `json:"product_id"`
+	UserID    string `json:"user_id"`
+	SessionID string `json:"session_id"`
+}
+
+// Viewer identifies who viewed, so views by one person can be grouped
+func (v *ProductView) Viewer() string {
+	switch {
+	case v.UserID != "":
+		return "user:" + v.UserID
+	case v.SessionID != "":
+		return "session:" + v.SessionID
+	}
+	return ""
+}
+
+type Recommendation struct {
+	Product *Product `json:"product"`
+	Reason  string   `json:"reason"`
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// Review statuses. Reviews start out pending and only approved ones are
+// shown publicly and counted in the product's rating.
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// Review is one user's review of a product; a user reviews a product once
+type Review struct {
+	ID             string    `json:"id" db:"id"`
+	ProductID      string    `json:"product_id" db:"product_id"`
+	UserID         string    `json:"user_id" db:"user_id"`
+	AuthorName     string    `json:"author_name" db:"author_name"`
+	Rating         int32     `json:"rating" db:"rating"`
+	Title          string    `json:"title" db:"title"`
+	Body           string    `json:"body" db:"body"`
+	Status         string    `json:"status" db:"status"`
+	ModeratedBy    string    `json:"moderated_by" db:"moderated_by"`
+	ModerationNote string    `json:"moderation_note" db:"moderation_note"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type CreateReviewRequest struct {
+	Rating int32  `json:"rating" validate:"required"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+type UpdateReviewRequest struct {
+	Rating int32  `json:"rating" validate:"required"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// ReviewFilter selects reviews to list. An empty ProductID lists reviews of
+// every product in the store. ViewerID adds that user's own reviews whatever their status.
+type ReviewFilter struct {
+	StoreID   string
+	ProductID string
+	Statuses  []string
+	ViewerID  string
+	Rating    int32
+}
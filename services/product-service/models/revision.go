@@ -0,0 +1,139 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Revision actions
+const (
+	RevisionCreate  = "create"
+	RevisionUpdate  = "update"
+	RevisionDelete  = "delete"
+	RevisionRestore = "restore"
+	RevisionRevert  = "revert"
+)
+
+// ProductRevision records one change to a product. Snapshot holds the
+// product's fields as they were after the change, which is what a revert
+// goes back to. Stock is not tracked here; the stock ledger covers it.
+type ProductRevision struct {
+	ID         string
+	ProductID  string
+	Version    int32
+	Action     string
+	Actor      string
+	Changes    []FieldChange
+	Snapshot   *ProductSnapshot
+	RevertedTo *string
+	CreatedAt  time.Time
+}
+
+// FieldChange is one field's value before and after a change, as JSON so
+// the values keep their type. Before is empty when the product was created.
+type FieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// ProductSnapshot is the part of a product that revisions track
+type ProductSnapshot struct {
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description"`
+	Price            Money                  `json:"price"`
+	CategoryID       *string                `json:"category_id"`
+	ImageURL         string                 `json:"image_url"`
+	SKU              string                 `json:"sku"`
+	IsActive         bool                   `json:"is_active"`
+	Attributes       map[string]interface{} `json:"attributes"`
+	ReorderThreshold *int32                 `json:"reorder_threshold"`
+	CompareAtPrice   *Money                 `json:"compare_at_price"`
+}
+
+func (p *Product) Snapshot() *ProductSnapshot {
+	attributes := make(map[string]interface{}, len(p.Attributes))
+	for key, value := range p.Attributes {
+		attributes[key] = value
+	}
+
+	return &ProductSnapshot{
+		Name:             p.Name,
+		Description:      p.Description,
+		Price:            p.Price,
+		CategoryID:       p.CategoryID,
+		ImageURL:         p.ImageURL,
+		SKU:              p.SKU,
+		IsActive:         p.IsActive,
+		Attributes:       attributes,
+		ReorderThreshold: p.ReorderThreshold,
+		CompareAtPrice:   p.CompareAtPrice,
+	}
+}
+
+// Diff lists the fields that differ from before, in a fixed order. With a
+// nil before, as for a new product, it lists every field that has a value.
+// Attributes are compared key by key and reported as "attributes.<key>".
+func (s *ProductSnapshot) Diff(before *ProductSnapshot) []FieldChange {
+	created := before == nil
+	if created {
+		before = &ProductSnapshot{}
+	}
+
+	var changes []FieldChange
+	add := func(field string, old, new interface{}) {
+		newJSON := snapshotValue(new)
+		if created {
+			if newJSON != nil && string(newJSON) != `""` {
+				changes = append(changes, FieldChange{Field: field, After: newJSON})
+			}
+			return
+		}
+		oldJSON := snapshotValue(old)
+		if !bytes.Equal(oldJSON, newJSON) {
+			changes = append(changes, FieldChange{Field: field, Before: oldJSON, After: newJSON})
+		}
+	}
+
+	add("name", before.Name, s.Name)
+	add("description", before.Description, s.Description)
+	add("price", before.Price, s.Price)
+	add("category_id", before.CategoryID, s.CategoryID)
+	add("image_url", before.ImageURL, s.ImageURL)
+	add("sku", before.SKU, s.SKU)
+	add("is_active", before.IsActive, s.IsActive)
+	add("reorder_threshold", before.ReorderThreshold, s.ReorderThreshold)
+	add("compare_at_price", before.CompareAtPrice, s.CompareAtPrice)
+
+	for _, key := range sortedKeys(before.Attributes, s.Attributes) {
+		add("attributes."+key, before.Attributes[key], s.Attributes[key])
+	}
+
+	return changes
+}
+
+// snapshotValue encodes a field for a FieldChange; nil encodes as nothing
+func snapshotValue(value interface{}) json.RawMessage {
+	data, err := json.Marshal(value)
+	if err != nil || string(data) == "null" {
+		return nil
+	}
+	return data
+}
+
+func sortedKeys(maps ...map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
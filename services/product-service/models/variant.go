@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// ProductOption is a dimension a product varies along, e.g. "Size" with
+// values S, M and L
+type ProductOption struct {
+	ID        string   `json:"id" db:"id"`
+	ProductID string   `json:"product_id" db:"product_id"`
+	Name      string   `json:"name" db:"name"`
+	Values    []string `json:"values" db:"option_values"`
+	Position  int32    `json:"position" db:"position"`
+}
+
+// ProductVariant is one combination of option values with its own SKU and
+// stock. A nil Price means the product price applies.
+type ProductVariant struct {
+	ID             string            `json:"id" db:"id"`
+	ProductID      string            `json:"product_id" db:"product_id"`
+	SKU            string            `json:"sku" db:"sku"`
+	OptionValues   map[string]string `json:"option_values" db:"option_values"`
+	Price          *Money            `json:"price" db:"price"`
+	EffectivePrice Money             `json:"effective_price" db:"-"`
+	StockQuantity  int32             `json:"stock_quantity" db:"stock_quantity"`
+	IsActive       bool              `json:"is_active" db:"is_active"`
+	CreatedAt      time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at" db:"updated_at"`
+
+	// ReservedQuantity is held by active reservations and not available to sell
+	ReservedQuantity int32 `json:"reserved_quantity" db:"-"`
+}
+
+type CreateVariantRequest struct {
+	SKU           string            `json:"sku"`
+	OptionValues  map[string]string `json:"option_values" validate:"required"`
+	Price         *Money            `json:"price"`
+	StockQuantity int32             `json:"stock_quantity" validate:"min=0"`
+}
+
+// UpdateVariantRequest leaves nil fields unchanged; ClearPrice drops the
+// price override so the variant falls back to the product price
+type UpdateVariantRequest struct {
+	SKU           *string           `json:"sku"`
+	OptionValues  map[string]string `json:"option_values"`
+	Price         *Money            `json:"price"`
+	ClearPrice    bool              `json:"clear_price"`
+	StockQuantity *int32            `json:"stock_quantity" validate:"omitempty,min=0"`
+	IsActive      *bool             `json:"is_active"`
+}
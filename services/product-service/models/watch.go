@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ProductWatchFilter picks the products a watcher is sent changes for. An
+// empty filter watches every product of the store; otherwise a change
+// matching either list is sent.
+type ProductWatchFilter struct {
+	StoreID     string
+	CategoryIDs []string
+	ProductIDs  []string
+}
+
+// ProductChange is one change sent to watchers. Product is the state after
+// the change and is nil when Removed is set, i.e. the product was deleted,
+// deactivated or moved out of the categories watched.
+type ProductChange struct {
+	EventID    string
+	Type       string
+	ProductID  string
+	Product    *Product
+	Removed    bool
+	OccurredAt time.Time
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+)
+
+// Webhook delivery statuses. A delivery is pending until it succeeds or
+// runs out of attempts; dead deliveries are the dead-letter queue.
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// Webhook is a partner endpoint subscribed to catalog events. No event
+// types means every event.
+type Webhook struct {
+	ID         string    `json:"id" db:"id"`
+	StoreID    string    `json:"store_id" db:"store_id"`
+	URL        string    `json:"url" db:"url"`
+	EventTypes []string  `json:"event_types" db:"event_types"`
+	Secret     string    `json:"-" db:"secret"`
+	IsActive   bool      `json:"is_active" db:"is_active"`
+	CreatedBy  string    `json:"created_by" db:"created_by"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" validate:"required"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"secret"`
+}
+
+type UpdateWebhookRequest struct {
+	URL          string   `json:"url" validate:"required"`
+	EventTypes   []string `json:"event_types"`
+	IsActive     bool     `json:"is_active"`
+	RotateSecret bool     `json:"rotate_secret"`
+}
+
+// WebhookDelivery is one event sent to one webhook. Payload is the exact
+// body sent, so every attempt is signed over the same bytes.
+type WebhookDelivery struct {
+	ID            string            `json:"id" db:"id"`
+	WebhookID     string            `json:"webhook_id" db:"webhook_id"`
+	EventID       string            `json:"event_id" db:"event_id"`
+	EventType     string            `json:"event_type" db:"event_type"`
+	Payload       []byte            `json:"-" db:"payload"`
+	Status        string            `json:"status" db:"status"`
+	AttemptCount  int32             `json:"attempt_count" db:"attempt_count"`
+	ResponseCode  int32             `json:"response_code" db:"response_code"`
+	LastError     string            `json:"last_error" db:"last_error"`
+	NextAttemptAt *time.Time        `json:"next_attempt_at" db:"next_attempt_at"`
+	DeliveredAt   *time.Time        `json:"delivered_at" db:"delivered_at"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+	Attempts      []*WebhookAttempt `json:"attempts"`
+
+	// Where to send it, filled in when the delivery is claimed
+	URL    string `json:"-"`
+	Secret string `json:"-"`
+}
+
+// WebhookAttempt is one try at a delivery. ResponseCode is 0 when the
+// endpoint couldn't be reached.
+type WebhookAttempt struct {
+	DeliveryID   string        `json:"delivery_id" db:"delivery_id"`
+	ResponseCode int32         `json:"response_code" db:"response_code"`
+	Error        string        `json:"error" db:"error"`
+	Duration     time.Duration `json:"duration" db:"duration_ms"`
+	AttemptedAt  time.Time     `json:"attempted_at" db:"attempted_at"`
+}
+
+// Succeeded reports whether the endpoint accepted the delivery
+func (a *WebhookAttempt) Succeeded() bool {
+	return a.Error == "" && a.ResponseCode >= 200 && a.ResponseCode < 300
+}
+
+// WebhookDeliveryFilter selects deliveries for the delivery log
+type WebhookDeliveryFilter struct {
+	StoreID   string
+	WebhookID string
+	Status    string
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// WishlistItem is a product a user saved for later. Available is false once
+// the product is deactivated or deleted; Product is nil once it is deleted.
+type WishlistItem struct {
+	ProductID string    `json:"product_id" db:"product_id"`
+	Product   *Product  `json:"product"`
+	Position  int32     `json:"position" db:"position"`
+	Available bool      `json:"available" db:"-"`
+	InStock   bool      `json:"in_stock" db:"-"`
+	AddedAt   time.Time `json:"added_at" db:"created_at"`
+}
+
+// Wishlist is a user's saved products in their chosen order. ShareToken is
+// set while anyone with the link can view it.
+type Wishlist struct {
+	UserID     string          `json:"user_id" db:"user_id"`
+	Items      []*WishlistItem `json:"items"`
+	ShareToken string          `json:"share_token" db:"share_token"`
+}
@@ -0,0 +1,57 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// Email sends a plain-text message per event over SMTP
+type Email struct {
+	addr string
+	auth smtp.Auth
+	from string
+	to   []string
+}
+
+func NewEmail(host, port, user, password, from string, to []string) *Email {
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, password, host)
+	}
+	if from == "" {
+		from = user
+	}
+	return &Email{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+		to:   to,
+	}
+}
+
+func (e *Email) NotifyLowStock(ctx context.Context, event *models.LowStockEvent) error {
+	name := event.ProductName
+	if event.SKU != "" {
+		name += " (" + event.SKU + ")"
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", e.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(e.to, ", "))
+	fmt.Fprintf(&msg, "Subject: Low stock: %s\r\n", strings.NewReplacer("\r", "", "\n", "").Replace(name))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s is running low.\r\n\r\n", name)
+	fmt.Fprintf(&msg, "Available: %d\r\nIn stock: %d\r\nReserved: %d\r\nReorder threshold: %d\r\n",
+		event.AvailableQuantity, event.StockQuantity, event.ReservedQuantity, event.ReorderThreshold)
+
+	// net/smtp has no context support; the caller runs this off the request path
+	if err := smtp.SendMail(e.addr, e.auth, e.from, e.to, []byte(msg.String())); err != nil {
+		return fmt.Errorf("failed to send low stock email: %w", err)
+	}
+	return nil
+}
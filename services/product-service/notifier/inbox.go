@@ -0,0 +1,53 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pb "github.com/martbul/playground_microservices/services/product-service/genproto/notification"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Inbox sends each event to the product's creator through
+// notification-service, which puts it in their in-app inbox and whatever
+// other channels it has
+type Inbox struct {
+	client pb.NotificationServiceClient
+}
+
+func NewInbox(address string) (*Inbox, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to notification service: %w", err)
+	}
+	return &Inbox{client: pb.NewNotificationServiceClient(conn)}, nil
+}
+
+func (i *Inbox) NotifyLowStock(ctx context.Context, event *models.LowStockEvent) error {
+	if event.CreatedBy == "" {
+		return nil
+	}
+
+	resp, err := i.client.SendNotification(ctx, &pb.SendNotificationRequest{
+		Type:   "low_stock",
+		UserId: event.CreatedBy,
+		Data: map[string]string{
+			"product_id":   event.ProductID,
+			"product_name": event.ProductName,
+			"sku":          event.SKU,
+			"available":    strconv.Itoa(int(event.AvailableQuantity)),
+			"threshold":    strconv.Itoa(int(event.ReorderThreshold)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send low stock notification: %w", err)
+	}
+	if !resp.Response.Success {
+		return fmt.Errorf("failed to send low stock notification: %s", resp.Response.Message)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/config"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// Notifier delivers low-stock events to whoever restocks
+type Notifier interface {
+	NotifyLowStock(ctx context.Context, event *models.LowStockEvent) error
+}
+
+// New builds the notifiers named in cfg.LowStockNotifiers
+func New(cfg *config.Config) (Notifier, error) {
+	var notifiers Multi
+	for _, kind := range cfg.LowStockNotifiers {
+		switch strings.ToLower(strings.TrimSpace(kind)) {
+		case "":
+		case "log":
+			notifiers = append(notifiers, Log{})
+		case "webhook":
+			if cfg.LowStockWebhookURL == "" {
+				return nil, fmt.Errorf("LOW_STOCK_WEBHOOK_URL is required for the webhook notifier")
+			}
+			notifiers = append(notifiers, NewWebhook(cfg.LowStockWebhookURL, cfg.LowStockWebhookSecret))
+		case "email":
+			if cfg.SMTPHost == "" || len(cfg.LowStockEmailTo) == 0 {
+				return nil, fmt.Errorf("SMTP_HOST and LOW_STOCK_EMAIL_TO are required for the email notifier")
+			}
+			notifiers = append(notifiers, NewEmail(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.LowStockEmailFrom, cfg.LowStockEmailTo))
+		case "inbox":
+			if cfg.NotificationService == "" {
+				return nil, fmt.Errorf("NOTIFICATION_SERVICE is required for the inbox notifier")
+			}
+			inbox, err := NewInbox(cfg.NotificationService)
+			if err != nil {
+				return nil, err
+			}
+			notifiers = append(notifiers, inbox)
+		default:
+			return nil, fmt.Errorf("unknown low stock notifier %q", kind)
+		}
+	}
+	return notifiers, nil
+}
+
+// Multi sends each event to every notifier, even when some of them fail
+type Multi []Notifier
+
+func (m Multi) NotifyLowStock(ctx context.Context, event *models.LowStockEvent) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyLowStock(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Log writes events to the service log
+type Log struct{}
+
+func (Log) NotifyLowStock(ctx context.Context, event *models.LowStockEvent) error {
+	log.Printf("Low stock: %s (%s) has %d available, reorder threshold %d", event.ProductName, event.SKU, event.AvailableQuantity, event.ReorderThreshold)
+	return nil
+}
@@ -0,0 +1,63 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// Webhook POSTs each event as JSON. When a secret is set the body is signed
+// with HMAC-SHA256 in the X-Signature-256 header.
+type Webhook struct {
+	url        string
+	secret     string
+	httpClient *http.Client
+}
+
+func NewWebhook(url, secret string) *Webhook {
+	return &Webhook{
+		url:        url,
+		secret:     secret,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (w *Webhook) NotifyLowStock(ctx context.Context, event *models.LowStockEvent) error {
+	body, err := json.Marshal(struct {
+		Type string `json:"type"`
+		*models.LowStockEvent
+	}{"low_stock", event})
+	if err != nil {
+		return fmt.Errorf("failed to encode low stock event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.secret != "" {
+		mac := hmac.New(sha256.New, []byte(w.secret))
+		mac.Write(body)
+		req.Header.Set("X-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call low stock webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("low stock webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+type AttributeRepository interface {
+	ListByCategory(categoryID string) ([]*models.AttributeDefinition, error)
+	ListInherited(categoryID string) ([]*models.AttributeDefinition, error)
+	Replace(categoryID string, definitions []*models.AttributeDefinition) error
+}
+
+type attributeRepository struct {
+	db *sql.DB
+}
+
+func NewAttributeRepository(db *sql.DB) AttributeRepository {
+	return &attributeRepository{db: db}
+}
+
+// ListByCategory returns only the definitions declared on the category itself
+func (r *attributeRepository) ListByCategory(categoryID string) ([]*models.AttributeDefinition, error) {
+	query := `
+		SELECT id, category_id, key, label, type, enum_values, required, position
+		FROM category_attributes
+		WHERE category_id = $1
+		ORDER BY position, key
+	`
+
+	return r.queryDefinitions(query, categoryID)
+}
+
+// ListInherited returns the definitions of the category and all of its
+// ancestors. Where a key is declared more than once the nearest category wins.
+func (r *attributeRepository) ListInherited(categoryID string) ([]*models.AttributeDefinition, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth FROM categories WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1 FROM categories c JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT id, category_id, key, label, type, enum_values, required, position
+		FROM (
+			SELECT DISTINCT ON (ca.key) ca.*, a.depth
+			FROM category_attributes ca
+			JOIN ancestors a ON a.id = ca.category_id
+			ORDER BY ca.key, a.depth
+		) nearest
+		ORDER BY depth DESC, position, key
+	`
+
+	return r.queryDefinitions(query, categoryID)
+}
+
+// Replace swaps the category's own definitions in one transaction
+func (r *attributeRepository) Replace(categoryID string, definitions []*models.AttributeDefinition) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM category_attributes WHERE category_id = $1`, categoryID); err != nil {
+		return fmt.Errorf("failed to clear category attributes: %w", err)
+	}
+
+	query := `
+		INSERT INTO category_attributes (category_id, key, label, type, enum_values, required, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	for _, definition := range definitions {
+		enumValues, err := json.Marshal(definition.EnumValues)
+		if err != nil {
+			return fmt.Errorf("failed to encode enum values: %w", err)
+		}
+
+		definition.CategoryID = categoryID
+		err = tx.QueryRow(
+			query,
+			categoryID,
+			definition.Key,
+			definition.Label,
+			definition.Type,
+			enumValues,
+			definition.Required,
+			definition.Position,
+		).Scan(&definition.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create category attribute: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit category attributes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *attributeRepository) queryDefinitions(query string, args ...interface{}) ([]*models.AttributeDefinition, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category attributes: %w", err)
+	}
+	defer rows.Close()
+
+	var definitions []*models.AttributeDefinition
+	for rows.Next() {
+		definition := &models.AttributeDefinition{}
+		var enumValues []byte
+		err := rows.Scan(
+			&definition.ID,
+			&definition.CategoryID,
+			&definition.Key,
+			&definition.Label,
+			&definition.Type,
+			&enumValues,
+			&definition.Required,
+			&definition.Position,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category attribute: %w", err)
+		}
+		if err := json.Unmarshal(enumValues, &definition.EnumValues); err != nil {
+			return nil, fmt.Errorf("failed to decode enum values: %w", err)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
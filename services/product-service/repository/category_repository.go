@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// ErrCategoryCycle is returned when a move would make a category its own ancestor
+var ErrCategoryCycle = errors.New("category cannot be moved under itself or one of its subcategories")
+
+// CategoryRepository reads and writes the categories of one store at a time;
+// Create and Update use the store the category belongs to
+type CategoryRepository interface {
+	Create(category *models.Category) error
+	GetByID(storeID, id string) (*models.Category, error)
+	GetBySlug(storeID, slug string) (*models.Category, error)
+	GetByName(storeID, name string) (*models.Category, error)
+	Update(category *models.Category) error
+	Delete(storeID, id string) error
+	Move(storeID, id string, parentID *string) error
+	List(storeID string, includeInactive bool) ([]*models.Category, error)
+	ListSubtree(storeID, rootID string, includeInactive bool) ([]*models.Category, error)
+	SlugExists(storeID, slug, excludeID string) (bool, error)
+	CountChildren(storeID, id string) (int, error)
+	CountProducts(storeID, id string) (int, error)
+}
+
+type categoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+const categorySelect = `
+	SELECT id, name, slug, COALESCE(description, ''), parent_id, is_active, created_at, updated_at, store_id
+	FROM categories
+`
+
+func scanCategory(row rowScanner) (*models.Category, error) {
+	category := &models.Category{}
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Slug,
+		&category.Description,
+		&category.ParentID,
+		&category.IsActive,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.StoreID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (r *categoryRepository) Create(category *models.Category) error {
+	query := `
+		INSERT INTO categories (name, slug, description, parent_id, is_active, store_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(
+		query,
+		category.Name,
+		category.Slug,
+		category.Description,
+		category.ParentID,
+		category.IsActive,
+		category.StoreID,
+	).Scan(&category.ID, &category.CreatedAt, &category.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) GetByID(storeID, id string) (*models.Category, error) {
+	category, err := scanCategory(r.db.QueryRow(categorySelect+` WHERE id = $1 AND store_id = $2`, id, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get category by ID: %w", err)
+	}
+
+	return category, nil
+}
+
+func (r *categoryRepository) GetBySlug(storeID, slug string) (*models.Category, error) {
+	category, err := scanCategory(r.db.QueryRow(categorySelect+` WHERE slug = $1 AND store_id = $2`, slug, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get category by slug: %w", err)
+	}
+
+	return category, nil
+}
+
+// GetByName returns the shallowest category with the given name, since names
+// are only unique among siblings
+func (r *categoryRepository) GetByName(storeID, name string) (*models.Category, error) {
+	query := categorySelect + ` WHERE LOWER(name) = LOWER($1) AND store_id = $2 ORDER BY parent_id NULLS FIRST, created_at LIMIT 1`
+
+	category, err := scanCategory(r.db.QueryRow(query, name, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get category by name: %w", err)
+	}
+
+	return category, nil
+}
+
+func (r *categoryRepository) Update(category *models.Category) error {
+	query := `
+		UPDATE categories
+		SET name = $1, slug = $2, description = $3, is_active = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5 AND store_id = $6
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		query,
+		category.Name,
+		category.Slug,
+		category.Description,
+		category.IsActive,
+		category.ID,
+		category.StoreID,
+	).Scan(&category.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) Delete(storeID, id string) error {
+	result, err := r.db.Exec(`DELETE FROM categories WHERE id = $1 AND store_id = $2`, id, storeID)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("category not found")
+	}
+
+	return nil
+}
+
+// Move re-parents a category. The cycle check and the update run under a
+// transaction-scoped advisory lock so two concurrent moves cannot together
+// build a cycle that neither would have created alone.
+func (r *categoryRepository) Move(storeID, id string, parentID *string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('categories_tree'))`); err != nil {
+		return fmt.Errorf("failed to lock category tree: %w", err)
+	}
+
+	if parentID != nil {
+		// Walk up from the new parent; reaching the moved category means a cycle
+		query := `
+			WITH RECURSIVE ancestors AS (
+				SELECT id, parent_id FROM categories WHERE id = $1
+				UNION ALL
+				SELECT c.id, c.parent_id FROM categories c JOIN ancestors a ON c.id = a.parent_id
+			)
+			SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $2)
+		`
+
+		var cycle bool
+		if err := tx.QueryRow(query, *parentID, id).Scan(&cycle); err != nil {
+			return fmt.Errorf("failed to check category ancestry: %w", err)
+		}
+		if cycle {
+			return ErrCategoryCycle
+		}
+	}
+
+	result, err := tx.Exec(`UPDATE categories SET parent_id = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND store_id = $3`, parentID, id, storeID)
+	if err != nil {
+		return fmt.Errorf("failed to move category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("category not found")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit category move: %w", err)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) List(storeID string, includeInactive bool) ([]*models.Category, error) {
+	query := categorySelect + ` WHERE store_id = $1`
+	if !includeInactive {
+		query += ` AND is_active = true`
+	}
+	query += ` ORDER BY name`
+
+	return r.queryCategories(query, storeID)
+}
+
+// ListSubtree returns the root category followed by all of its descendants
+func (r *categoryRepository) ListSubtree(storeID, rootID string, includeInactive bool) ([]*models.Category, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT * FROM categories WHERE id = $1 AND store_id = $2
+			UNION ALL
+			SELECT c.* FROM categories c JOIN subtree s ON c.parent_id = s.id AND c.store_id = s.store_id
+		)
+		SELECT id, name, slug, COALESCE(description, ''), parent_id, is_active, created_at, updated_at, store_id
+		FROM subtree
+	`
+	if !includeInactive {
+		query += ` WHERE is_active = true`
+	}
+	query += ` ORDER BY name`
+
+	return r.queryCategories(query, rootID, storeID)
+}
+
+func (r *categoryRepository) queryCategories(query string, args ...interface{}) ([]*models.Category, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*models.Category
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+// SlugExists reports whether another category in the store has the slug;
+// slugs only need to be unique within a store
+func (r *categoryRepository) SlugExists(storeID, slug, excludeID string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM categories WHERE slug = $1 AND store_id = $2 AND ($3 = '' OR id::text <> $3))`
+
+	if err := r.db.QueryRow(query, slug, storeID, excludeID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check category slug: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *categoryRepository) CountChildren(storeID, id string) (int, error) {
+	var count int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM categories WHERE parent_id = $1 AND store_id = $2`, id, storeID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count subcategories: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *categoryRepository) CountProducts(storeID, id string) (int, error) {
+	var count int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM products WHERE category_id = $1 AND store_id = $2`, id, storeID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count category products: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+type ImageRepository interface {
+	Create(image *models.ProductImage) error
+	GetByID(id string) (*models.ProductImage, error)
+	Delete(id string) error
+	ListByProduct(productID string) ([]*models.ProductImage, error)
+	Reorder(productID string, imageIDs []string) error
+}
+
+type imageRepository struct {
+	db *sql.DB
+}
+
+func NewImageRepository(db *sql.DB) ImageRepository {
+	return &imageRepository{db: db}
+}
+
+const imageSelect = `
+	SELECT id, product_id, url, content_type, size_bytes, width, height, position, thumbnails, storage_keys, created_at
+	FROM product_images
+`
+
+func scanImage(row rowScanner) (*models.ProductImage, error) {
+	image := &models.ProductImage{}
+	var thumbnails, storageKeys []byte
+
+	err := row.Scan(
+		&image.ID,
+		&image.ProductID,
+		&image.URL,
+		&image.ContentType,
+		&image.SizeBytes,
+		&image.Width,
+		&image.Height,
+		&image.Position,
+		&thumbnails,
+		&storageKeys,
+		&image.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(thumbnails, &image.Thumbnails); err != nil {
+		return nil, fmt.Errorf("failed to decode thumbnails: %w", err)
+	}
+	if err := json.Unmarshal(storageKeys, &image.StorageKeys); err != nil {
+		return nil, fmt.Errorf("failed to decode storage keys: %w", err)
+	}
+
+	return image, nil
+}
+
+// Create appends the image after the product's existing images
+func (r *imageRepository) Create(image *models.ProductImage) error {
+	thumbnails, err := json.Marshal(image.Thumbnails)
+	if err != nil {
+		return fmt.Errorf("failed to encode thumbnails: %w", err)
+	}
+	storageKeys, err := json.Marshal(image.StorageKeys)
+	if err != nil {
+		return fmt.Errorf("failed to encode storage keys: %w", err)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the product row so concurrent uploads get distinct positions
+	if _, err := tx.Exec(`SELECT id FROM products WHERE id = $1 FOR UPDATE`, image.ProductID); err != nil {
+		return fmt.Errorf("failed to lock product: %w", err)
+	}
+
+	query := `
+		INSERT INTO product_images (product_id, url, content_type, size_bytes, width, height, position, thumbnails, storage_keys)
+		VALUES ($1, $2, $3, $4, $5, $6,
+		        (SELECT COALESCE(MAX(position) + 1, 0) FROM product_images WHERE product_id = $1),
+		        $7, $8)
+		RETURNING id, position, created_at
+	`
+
+	err = tx.QueryRow(
+		query,
+		image.ProductID,
+		image.URL,
+		image.ContentType,
+		image.SizeBytes,
+		image.Width,
+		image.Height,
+		thumbnails,
+		storageKeys,
+	).Scan(&image.ID, &image.Position, &image.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create product image: %w", err)
+	}
+
+	if err := syncPrimaryImage(tx, image.ProductID, ""); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product image: %w", err)
+	}
+
+	return nil
+}
+
+func (r *imageRepository) GetByID(id string) (*models.ProductImage, error) {
+	image, err := scanImage(r.db.QueryRow(imageSelect+` WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get product image: %w", err)
+	}
+
+	return image, nil
+}
+
+func (r *imageRepository) Delete(id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var productID, url string
+	err = tx.QueryRow(`DELETE FROM product_images WHERE id = $1 RETURNING product_id, url`, id).Scan(&productID, &url)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("product image not found")
+		}
+		return fmt.Errorf("failed to delete product image: %w", err)
+	}
+
+	if err := syncPrimaryImage(tx, productID, url); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product image deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (r *imageRepository) ListByProduct(productID string) ([]*models.ProductImage, error) {
+	rows, err := r.db.Query(imageSelect+` WHERE product_id = $1 ORDER BY position, created_at`, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+	defer rows.Close()
+
+	var images []*models.ProductImage
+	for rows.Next() {
+		image, err := scanImage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product image: %w", err)
+		}
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
+// Reorder assigns positions in the order given; the caller checks that
+// imageIDs covers exactly the product's images
+func (r *imageRepository) Reorder(productID string, imageIDs []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for position, id := range imageIDs {
+		result, err := tx.Exec(`UPDATE product_images SET position = $1 WHERE id = $2 AND product_id = $3`, position, id, productID)
+		if err != nil {
+			return fmt.Errorf("failed to reorder product images: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("image %s does not belong to this product", id)
+		}
+	}
+
+	if err := syncPrimaryImage(tx, productID, ""); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit image order: %w", err)
+	}
+
+	return nil
+}
+
+// syncPrimaryImage points products.image_url at the first uploaded image.
+// Once the last upload is gone a URL that pointed at removedURL is cleared;
+// products without uploads otherwise keep a URL entered by hand.
+func syncPrimaryImage(tx *sql.Tx, productID, removedURL string) error {
+	query := `
+		UPDATE products
+		SET image_url = COALESCE(
+		        (SELECT url FROM product_images WHERE product_id = $1 ORDER BY position, created_at LIMIT 1),
+		        CASE WHEN image_url = $2 THEN '' ELSE image_url END
+		    ),
+		    version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+
+	if _, err := tx.Exec(query, productID, removedURL); err != nil {
+		return fmt.Errorf("failed to update primary image: %w", err)
+	}
+
+	return nil
+}
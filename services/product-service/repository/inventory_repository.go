@@ -0,0 +1,741 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/martbul/playground_microservices/services/product-service/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// ErrInsufficientStock is returned when a reservation or adjustment needs more
+// stock than is available
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+type InventoryRepository interface {
+	Adjust(req *models.AdjustStockRequest) (*models.StockMovement, *models.StockChange, error)
+	SetStock(productID string, variantID *string, quantity int32, reason, createdBy string) (*models.StockChange, error)
+	Reserve(req *models.ReserveStockRequest) (*models.StockReservation, []*models.StockChange, error)
+	GetReservation(storeID, id string) (*models.StockReservation, error)
+	Commit(storeID, id string) (*models.StockReservation, error)
+	Release(storeID, id string) (*models.StockReservation, error)
+	ExpireReservations() (int64, error)
+	ListMovements(storeID, productID string, variantID *string, pagination *models.PaginationRequest) ([]*models.StockMovement, *models.PaginationResponse, error)
+	ListLowStock(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.LowStockItem, *models.PaginationResponse, error)
+}
+
+// queryer is satisfied by both *sql.DB and *sql.Tx
+type queryer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type inventoryRepository struct {
+	db *sql.DB
+}
+
+func NewInventoryRepository(db *sql.DB) InventoryRepository {
+	return &inventoryRepository{db: db}
+}
+
+// reservedQuantityQuery sums the active, unexpired holds on a product or
+// variant. Expired reservations stop counting immediately, whether or not the
+// sweeper has marked them yet.
+const reservedQuantityQuery = `
+	SELECT COALESCE(SUM(i.quantity), 0)
+	FROM stock_reservation_items i
+	JOIN stock_reservations r ON r.id = i.reservation_id
+	WHERE i.product_id = $1 AND i.variant_id IS NOT DISTINCT FROM $2
+	  AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP
+`
+
+const reservationSelect = `
+	SELECT id, reference, status, expires_at, created_at, updated_at, expires_at <= CURRENT_TIMESTAMP
+	FROM stock_reservations
+`
+
+const movementSelect = `
+	SELECT id, product_id, variant_id, delta, quantity_after, reason, reference, reservation_id, created_by, created_at
+	FROM stock_movements
+`
+
+// inStore fails with "product not found" unless the product belongs to the
+// store
+func inStore(q queryer, storeID, productID string) error {
+	var exists bool
+	err := q.QueryRow(`SELECT EXISTS (SELECT 1 FROM products WHERE id = $1 AND store_id = $2)`, productID, storeID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check product store: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("product not found")
+	}
+	return nil
+}
+
+// lockStock locks the row holding the stock of a product or variant for the
+// rest of the transaction. The returned change has Before and After both set
+// to the current level; callers update After. With forSale, products in the
+// trash and inactive products or variants are treated as missing, since no
+// one can buy them.
+func lockStock(tx *sql.Tx, productID string, variantID *string, forSale bool) (*models.StockChange, error) {
+	change := &models.StockChange{ProductID: productID, VariantID: variantID}
+	level := &change.Before
+
+	if variantID == nil {
+		query := `
+			SELECT p.stock_quantity, p.name, COALESCE(p.sku, ''), COALESCE(p.created_by, ''), p.reorder_threshold
+			FROM products p
+			WHERE p.id = $1 AND (NOT $2 OR (p.deleted_at IS NULL AND p.is_active = true))
+			FOR UPDATE
+		`
+		err := tx.QueryRow(query, productID, forSale).Scan(&level.StockQuantity, &change.ProductName, &change.SKU, &change.CreatedBy, &change.ReorderThreshold)
+		if err == sql.ErrNoRows {
+			if forSale {
+				return nil, fmt.Errorf("product %s is not for sale", productID)
+			}
+			return nil, fmt.Errorf("product not found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock product stock: %w", err)
+		}
+	} else {
+		query := `
+			SELECT v.stock_quantity, p.name, v.sku, COALESCE(p.created_by, ''), p.reorder_threshold
+			FROM product_variants v
+			JOIN products p ON p.id = v.product_id
+			WHERE v.id = $1 AND v.product_id = $2
+			  AND (NOT $3 OR (p.deleted_at IS NULL AND p.is_active = true AND v.is_active = true))
+			FOR UPDATE OF v
+		`
+		err := tx.QueryRow(query, *variantID, productID, forSale).Scan(&level.StockQuantity, &change.ProductName, &change.SKU, &change.CreatedBy, &change.ReorderThreshold)
+		if err == sql.ErrNoRows {
+			if forSale {
+				return nil, fmt.Errorf("variant %s is not for sale", *variantID)
+			}
+			return nil, fmt.Errorf("variant not found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock variant stock: %w", err)
+		}
+	}
+
+	// Read after taking the lock so reservations committed by whoever held
+	// it before us are visible
+	if err := tx.QueryRow(reservedQuantityQuery, productID, variantID).Scan(&level.ReservedQuantity); err != nil {
+		return nil, fmt.Errorf("failed to get reserved stock: %w", err)
+	}
+
+	change.After = change.Before
+	return change, nil
+}
+
+func writeStock(tx *sql.Tx, productID string, variantID *string, quantity int32) error {
+	var err error
+	if variantID == nil {
+		_, err = tx.Exec(`UPDATE products SET stock_quantity = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`, quantity, productID)
+	} else {
+		_, err = tx.Exec(`UPDATE product_variants SET stock_quantity = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`, quantity, *variantID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update stock: %w", err)
+	}
+	return nil
+}
+
+func insertMovement(tx *sql.Tx, movement *models.StockMovement) error {
+	query := `
+		INSERT INTO stock_movements (product_id, variant_id, delta, quantity_after, reason, reference, reservation_id, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+
+	err := tx.QueryRow(
+		query,
+		movement.ProductID,
+		movement.VariantID,
+		movement.Delta,
+		movement.QuantityAfter,
+		movement.Reason,
+		movement.Reference,
+		movement.ReservationID,
+		movement.CreatedBy,
+	).Scan(&movement.ID, &movement.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to record stock movement: %w", err)
+	}
+
+	return recordEvent(tx, events.StockChanged(movement))
+}
+
+func (r *inventoryRepository) Adjust(req *models.AdjustStockRequest) (*models.StockMovement, *models.StockChange, error) {
+	var variantID *string
+	if req.VariantID != "" {
+		variantID = &req.VariantID
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := inStore(tx, req.StoreID, req.ProductID); err != nil {
+		return nil, nil, err
+	}
+	change, err := lockStock(tx, req.ProductID, variantID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	level := change.Before
+
+	after := level.StockQuantity + req.Delta
+	if after < 0 {
+		return nil, nil, fmt.Errorf("%w: cannot remove %d, only %d in stock", ErrInsufficientStock, -req.Delta, level.StockQuantity)
+	}
+	if req.Delta < 0 && after < level.ReservedQuantity {
+		return nil, nil, fmt.Errorf("%w: cannot remove %d, %d of %d in stock are reserved", ErrInsufficientStock, -req.Delta, level.ReservedQuantity, level.StockQuantity)
+	}
+
+	if err := writeStock(tx, req.ProductID, variantID, after); err != nil {
+		return nil, nil, err
+	}
+
+	movement := &models.StockMovement{
+		ProductID:     req.ProductID,
+		VariantID:     variantID,
+		Delta:         req.Delta,
+		QuantityAfter: after,
+		Reason:        req.Reason,
+		Reference:     req.Reference,
+		CreatedBy:     req.CreatedBy,
+	}
+	if err := insertMovement(tx, movement); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit stock adjustment: %w", err)
+	}
+
+	change.After.StockQuantity = after
+	return movement, change, nil
+}
+
+// SetStock overwrites on-hand stock, recording the difference in the ledger.
+// Stock cannot be set below what is currently reserved.
+func (r *inventoryRepository) SetStock(productID string, variantID *string, quantity int32, reason, createdBy string) (*models.StockChange, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	change, err := setStock(tx, productID, variantID, quantity, reason, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit stock update: %w", err)
+	}
+
+	return change, nil
+}
+
+// setStock is SetStock within the caller's transaction
+func setStock(tx *sql.Tx, productID string, variantID *string, quantity int32, reason, createdBy string) (*models.StockChange, error) {
+	change, err := lockStock(tx, productID, variantID, false)
+	if err != nil {
+		return nil, err
+	}
+	level := change.Before
+
+	delta := quantity - level.StockQuantity
+	if delta == 0 {
+		return change, nil
+	}
+	if quantity < level.ReservedQuantity {
+		return nil, fmt.Errorf("%w: cannot set stock to %d, %d units are reserved", ErrInsufficientStock, quantity, level.ReservedQuantity)
+	}
+
+	if err := writeStock(tx, productID, variantID, quantity); err != nil {
+		return nil, err
+	}
+
+	movement := &models.StockMovement{
+		ProductID:     productID,
+		VariantID:     variantID,
+		Delta:         delta,
+		QuantityAfter: quantity,
+		Reason:        reason,
+		CreatedBy:     createdBy,
+	}
+	if err := insertMovement(tx, movement); err != nil {
+		return nil, err
+	}
+
+	change.After.StockQuantity = quantity
+	return change, nil
+}
+
+// Reserve holds stock for every item or, if any of them is short, for none.
+// Items are locked in a fixed order so concurrent reservations touching the
+// same products cannot deadlock.
+func (r *inventoryRepository) Reserve(req *models.ReserveStockRequest) (*models.StockReservation, []*models.StockChange, error) {
+	items := mergeReservationItems(req.Items)
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reservation := &models.StockReservation{Reference: req.Reference}
+	query := `
+		INSERT INTO stock_reservations (store_id, reference, status, expires_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP + make_interval(secs => $4))
+		RETURNING id, status, expires_at, created_at, updated_at
+	`
+	err = tx.QueryRow(query, req.StoreID, req.Reference, models.ReservationActive, req.TTL.Seconds()).Scan(
+		&reservation.ID,
+		&reservation.Status,
+		&reservation.ExpiresAt,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create reservation: %w", err)
+	}
+
+	var changes []*models.StockChange
+	for _, item := range items {
+		if err := inStore(tx, req.StoreID, item.ProductID); err != nil {
+			return nil, nil, err
+		}
+		change, err := lockStock(tx, item.ProductID, item.VariantID, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		if item.VariantID == nil {
+			// Products sold by variant are stocked per variant
+			var hasVariants bool
+			if err := tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM product_variants WHERE product_id = $1)`, item.ProductID).Scan(&hasVariants); err != nil {
+				return nil, nil, fmt.Errorf("failed to check product variants: %w", err)
+			}
+			if hasVariants {
+				return nil, nil, fmt.Errorf("product %s has variants; a variant ID is required", item.ProductID)
+			}
+		}
+		if change.Before.Available() < item.Quantity {
+			return nil, nil, fmt.Errorf("%w for %s: %d available, %d requested", ErrInsufficientStock, stockName(item), change.Before.Available(), item.Quantity)
+		}
+
+		_, err = tx.Exec(
+			`INSERT INTO stock_reservation_items (reservation_id, product_id, variant_id, quantity) VALUES ($1, $2, $3, $4)`,
+			reservation.ID, item.ProductID, item.VariantID, item.Quantity,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to reserve stock: %w", err)
+		}
+
+		change.After.ReservedQuantity += item.Quantity
+		changes = append(changes, change)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
+	reservation.Items = items
+	return reservation, changes, nil
+}
+
+func (r *inventoryRepository) GetReservation(storeID, id string) (*models.StockReservation, error) {
+	reservation, _, err := scanReservation(r.db.QueryRow(reservationSelect+` WHERE id = $1 AND store_id = $2`, id, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	reservation.Items, err = listReservationItems(r.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// Commit removes the reserved items from on-hand stock and closes the
+// reservation. Committing twice is a no-op so retries are safe.
+func (r *inventoryRepository) Commit(storeID, id string) (*models.StockReservation, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reservation, expired, err := lockReservation(tx, storeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Items, err = listReservationItems(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case reservation.Status == models.ReservationCommitted:
+		return reservation, nil
+	case reservation.Status != models.ReservationActive:
+		return nil, fmt.Errorf("reservation is %s", reservation.Status)
+	case expired:
+		return nil, fmt.Errorf("reservation has expired")
+	}
+
+	// Available stock is unchanged here: the items leave on-hand stock and
+	// the reservation together
+	for _, item := range reservation.Items {
+		change, err := lockStock(tx, item.ProductID, item.VariantID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		after := change.Before.StockQuantity - item.Quantity
+		if after < 0 {
+			return nil, fmt.Errorf("%w for %s: %d in stock, %d reserved", ErrInsufficientStock, stockName(item), change.Before.StockQuantity, item.Quantity)
+		}
+		if err := writeStock(tx, item.ProductID, item.VariantID, after); err != nil {
+			return nil, err
+		}
+
+		movement := &models.StockMovement{
+			ProductID:     item.ProductID,
+			VariantID:     item.VariantID,
+			Delta:         -item.Quantity,
+			QuantityAfter: after,
+			Reason:        models.MovementSale,
+			Reference:     reservation.Reference,
+			ReservationID: &reservation.ID,
+		}
+		if err := insertMovement(tx, movement); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := setReservationStatus(tx, reservation, models.ReservationCommitted); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// Release returns the held stock. Releasing a reservation that was already
+// released or has expired succeeds without changes.
+func (r *inventoryRepository) Release(storeID, id string) (*models.StockReservation, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reservation, _, err := lockReservation(tx, storeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Items, err = listReservationItems(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch reservation.Status {
+	case models.ReservationCommitted:
+		return nil, fmt.Errorf("reservation is already committed")
+	case models.ReservationActive:
+		if err := setReservationStatus(tx, reservation, models.ReservationReleased); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation release: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// ExpireReservations marks active reservations past their expiry as expired
+func (r *inventoryRepository) ExpireReservations() (int64, error) {
+	query := `
+		UPDATE stock_reservations
+		SET status = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE status = $2 AND expires_at <= CURRENT_TIMESTAMP
+	`
+
+	result, err := r.db.Exec(query, models.ReservationExpired, models.ReservationActive)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire reservations: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *inventoryRepository) ListMovements(storeID, productID string, variantID *string, pagination *models.PaginationRequest) ([]*models.StockMovement, *models.PaginationResponse, error) {
+	if err := inStore(r.db, storeID, productID); err != nil {
+		return nil, nil, err
+	}
+
+	where := ` WHERE product_id = $1`
+	args := []interface{}{productID}
+	if variantID != nil {
+		where += ` AND variant_id = $2`
+		args = append(args, *variantID)
+	}
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM stock_movements`+where, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count stock movements: %w", err)
+	}
+
+	query := movementSelect + where + fmt.Sprintf(" ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list stock movements: %w", err)
+	}
+	defer rows.Close()
+
+	var movements []*models.StockMovement
+	for rows.Next() {
+		movement := &models.StockMovement{}
+		err := rows.Scan(
+			&movement.ID,
+			&movement.ProductID,
+			&movement.VariantID,
+			&movement.Delta,
+			&movement.QuantityAfter,
+			&movement.Reason,
+			&movement.Reference,
+			&movement.ReservationID,
+			&movement.CreatedBy,
+			&movement.CreatedAt,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan stock movement: %w", err)
+		}
+		movements = append(movements, movement)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return movements, paginationResponse, nil
+}
+
+// lowStockQuery lists every active stock row with a threshold: products
+// without variants, and the variants of those that have them
+const lowStockQuery = `
+	WITH stock AS (
+		SELECT p.store_id, p.id AS product_id, p.name, COALESCE(p.sku, '') AS sku, NULL::uuid AS variant_id,
+		       p.stock_quantity, p.reorder_threshold, COALESCE(p.created_by, '') AS created_by,
+		       (SELECT COALESCE(SUM(i.quantity), 0)
+		        FROM stock_reservation_items i
+		        JOIN stock_reservations r ON r.id = i.reservation_id
+		        WHERE i.product_id = p.id AND i.variant_id IS NULL
+		          AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP) AS reserved
+		FROM products p
+		WHERE p.reorder_threshold IS NOT NULL AND p.is_active = true AND p.deleted_at IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM product_variants v WHERE v.product_id = p.id)
+		UNION ALL
+		SELECT p.store_id, p.id, p.name, v.sku, v.id,
+		       v.stock_quantity, p.reorder_threshold, COALESCE(p.created_by, ''),
+		       (SELECT COALESCE(SUM(i.quantity), 0)
+		        FROM stock_reservation_items i
+		        JOIN stock_reservations r ON r.id = i.reservation_id
+		        WHERE i.product_id = v.product_id AND i.variant_id = v.id
+		          AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP)
+		FROM product_variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE p.reorder_threshold IS NOT NULL AND p.is_active = true AND p.deleted_at IS NULL AND v.is_active = true
+	)
+`
+
+func (r *inventoryRepository) ListLowStock(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.LowStockItem, *models.PaginationResponse, error) {
+	where := ` WHERE store_id = $1 AND stock_quantity - reserved <= reorder_threshold AND ($2 = '' OR created_by = $2)`
+
+	var totalCount int64
+	if err := r.db.QueryRow(lowStockQuery+`SELECT COUNT(*) FROM stock`+where, storeID, createdBy).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count low stock products: %w", err)
+	}
+
+	query := lowStockQuery + `
+		SELECT product_id, name, sku, variant_id, stock_quantity, reserved, reorder_threshold, created_by
+		FROM stock` + where + `
+		ORDER BY stock_quantity - reserved, name
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(query, storeID, createdBy, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list low stock products: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.LowStockItem
+	for rows.Next() {
+		item := &models.LowStockItem{}
+		err := rows.Scan(
+			&item.ProductID,
+			&item.ProductName,
+			&item.SKU,
+			&item.VariantID,
+			&item.StockQuantity,
+			&item.ReservedQuantity,
+			&item.ReorderThreshold,
+			&item.CreatedBy,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan low stock product: %w", err)
+		}
+		item.AvailableQuantity = item.StockQuantity - item.ReservedQuantity
+		items = append(items, item)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return items, paginationResponse, nil
+}
+
+func scanReservation(row rowScanner) (*models.StockReservation, bool, error) {
+	reservation := &models.StockReservation{}
+	var expired bool
+	err := row.Scan(
+		&reservation.ID,
+		&reservation.Reference,
+		&reservation.Status,
+		&reservation.ExpiresAt,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+		&expired,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return reservation, expired, nil
+}
+
+// lockReservation loads a reservation of the store FOR UPDATE and reports
+// whether it is past its expiry
+func lockReservation(tx *sql.Tx, storeID, id string) (*models.StockReservation, bool, error) {
+	reservation, expired, err := scanReservation(tx.QueryRow(reservationSelect+` WHERE id = $1 AND store_id = $2 FOR UPDATE`, id, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, fmt.Errorf("reservation not found")
+		}
+		return nil, false, fmt.Errorf("failed to get reservation: %w", err)
+	}
+	return reservation, expired, nil
+}
+
+func setReservationStatus(tx *sql.Tx, reservation *models.StockReservation, status string) error {
+	query := `UPDATE stock_reservations SET status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 RETURNING updated_at`
+	if err := tx.QueryRow(query, status, reservation.ID).Scan(&reservation.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to update reservation: %w", err)
+	}
+	reservation.Status = status
+	return nil
+}
+
+// listReservationItems returns the items in lock order
+func listReservationItems(q queryer, reservationID string) ([]*models.ReservationItem, error) {
+	query := `
+		SELECT product_id, variant_id, quantity
+		FROM stock_reservation_items
+		WHERE reservation_id = $1
+		ORDER BY product_id, variant_id NULLS FIRST
+	`
+
+	rows, err := q.Query(query, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.ReservationItem
+	for rows.Next() {
+		item := &models.ReservationItem{}
+		if err := rows.Scan(&item.ProductID, &item.VariantID, &item.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan reservation item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// mergeReservationItems sums duplicate lines and sorts the result the same
+// way listReservationItems does
+func mergeReservationItems(items []*models.ReservationItem) []*models.ReservationItem {
+	byKey := make(map[string]*models.ReservationItem)
+	var merged []*models.ReservationItem
+	for _, item := range items {
+		key := item.ProductID + "/" + stockVariantKey(item.VariantID)
+		if existing, ok := byKey[key]; ok {
+			existing.Quantity += item.Quantity
+			continue
+		}
+		copied := *item
+		byKey[key] = &copied
+		merged = append(merged, &copied)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].ProductID != merged[j].ProductID {
+			return merged[i].ProductID < merged[j].ProductID
+		}
+		return stockVariantKey(merged[i].VariantID) < stockVariantKey(merged[j].VariantID)
+	})
+	return merged
+}
+
+func stockVariantKey(variantID *string) string {
+	if variantID == nil {
+		return ""
+	}
+	return *variantID
+}
+
+func stockName(item *models.ReservationItem) string {
+	if item.VariantID != nil {
+		return "variant " + *item.VariantID
+	}
+	return "product " + item.ProductID
+}
@@ -0,0 +1,442 @@
+package repository
+
+import "database/sql"
+
+// Migrate brings the database schema up to date. It runs on every start,
+// so every statement has to be safe to run again.
+func Migrate(db *sql.DB) error {
+	query := `
+	CREATE TABLE IF NOT EXISTS categories (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name VARCHAR(255) NOT NULL,
+		store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+		slug VARCHAR(255) NOT NULL,
+		description TEXT,
+		parent_id UUID REFERENCES categories(id),
+		is_active BOOLEAN DEFAULT true,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS products (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name VARCHAR(255) NOT NULL,
+		description TEXT,
+		price DECIMAL(10,2) NOT NULL,
+		stock_quantity INTEGER DEFAULT 0,
+		category_id UUID REFERENCES categories(id),
+		image_url VARCHAR(500),
+		store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+		sku VARCHAR(100),
+		is_active BOOLEAN DEFAULT true,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		created_by VARCHAR(255)
+	);
+
+	-- Older deployments re-seeded the default categories on every start and
+	-- had no slugs; collapse those duplicates and backfill unique slugs once
+	DO $$
+	BEGIN
+		IF NOT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_name = 'categories' AND column_name = 'slug'
+		) THEN
+			DELETE FROM categories a
+			USING categories b
+			WHERE a.name = b.name
+			  AND a.parent_id IS NOT DISTINCT FROM b.parent_id
+			  AND (a.created_at, a.id) > (b.created_at, b.id)
+			  AND NOT EXISTS (SELECT 1 FROM categories c WHERE c.parent_id = a.id);
+
+			ALTER TABLE categories ADD COLUMN slug VARCHAR(255);
+
+			UPDATE categories c
+			SET slug = s.base || CASE WHEN s.rn > 1 THEN '-' || s.rn ELSE '' END
+			FROM (
+				SELECT id, base, ROW_NUMBER() OVER (PARTITION BY base ORDER BY created_at, id) AS rn
+				FROM (
+					SELECT id, created_at,
+					       COALESCE(NULLIF(TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')), ''), 'category') AS base
+					FROM categories
+				) named
+			) s
+			WHERE c.id = s.id;
+
+			ALTER TABLE categories ALTER COLUMN slug SET NOT NULL;
+		END IF;
+	END $$;
+
+	-- Products and categories belong to a store. Rows from before there were
+	-- stores go to the default one, and SKUs and category slugs only have to
+	-- be unique within their store.
+	ALTER TABLE categories ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+	DROP INDEX IF EXISTS idx_categories_slug;
+	ALTER TABLE products DROP CONSTRAINT IF EXISTS products_sku_key;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_store_slug ON categories(store_id, slug);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_products_store_sku ON products(store_id, sku);
+
+	-- Link products to categories by id instead of by free-text name
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories(id);
+	DO $$
+	BEGIN
+		IF EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_name = 'products' AND column_name = 'category'
+		) THEN
+			-- Free-text categories with no matching row become new root categories
+			INSERT INTO categories (name, slug)
+			SELECT name, CASE
+			           WHEN EXISTS (SELECT 1 FROM categories c WHERE c.slug = base) THEN base || '-' || LEFT(MD5(LOWER(name)), 6)
+			           ELSE base
+			       END
+			FROM (
+				SELECT DISTINCT ON (LOWER(category)) category AS name,
+				       COALESCE(NULLIF(TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(category), '[^a-z0-9]+', '-', 'g')), ''), 'category') AS base
+				FROM products
+				WHERE category IS NOT NULL AND category <> ''
+				  AND NOT EXISTS (SELECT 1 FROM categories c WHERE LOWER(c.name) = LOWER(products.category))
+			) legacy;
+
+			UPDATE products p
+			SET category_id = (
+				SELECT c.id FROM categories c
+				WHERE LOWER(c.name) = LOWER(p.category)
+				ORDER BY c.parent_id NULLS FIRST, c.created_at
+				LIMIT 1
+			)
+			WHERE p.category_id IS NULL AND p.category IS NOT NULL AND p.category <> '';
+
+			DROP INDEX IF EXISTS idx_products_category;
+			ALTER TABLE products DROP COLUMN category;
+		END IF;
+	END $$;
+
+	-- Options (e.g. Size: S, M, L) and the variants built from their values
+	CREATE TABLE IF NOT EXISTS product_options (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		name VARCHAR(100) NOT NULL,
+		option_values JSONB NOT NULL DEFAULT '[]',
+		position INTEGER NOT NULL DEFAULT 0,
+		UNIQUE (product_id, name)
+	);
+
+	CREATE TABLE IF NOT EXISTS product_variants (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		store_id UUID NOT NULL,
+		sku VARCHAR(100) NOT NULL,
+		option_values JSONB NOT NULL DEFAULT '{}',
+		price DECIMAL(10,2),
+		stock_quantity INTEGER NOT NULL DEFAULT 0,
+		is_active BOOLEAN DEFAULT true,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (product_id, option_values)
+	);
+
+	-- Variant SKUs, like product SKUs, only have to be unique within a store;
+	-- variants carry their product's store so an index can enforce that
+	ALTER TABLE product_variants ADD COLUMN IF NOT EXISTS store_id UUID;
+	UPDATE product_variants v SET store_id = p.store_id FROM products p WHERE p.id = v.product_id AND v.store_id IS NULL;
+	ALTER TABLE product_variants ALTER COLUMN store_id SET NOT NULL;
+	ALTER TABLE product_variants DROP CONSTRAINT IF EXISTS product_variants_sku_key;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_store_sku ON product_variants(store_id, sku);
+
+	-- Typed custom attributes declared per category and stored on products
+	CREATE TABLE IF NOT EXISTS category_attributes (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+		key VARCHAR(64) NOT NULL,
+		label VARCHAR(255) NOT NULL,
+		type VARCHAR(20) NOT NULL CHECK (type IN ('string', 'number', 'bool', 'enum')),
+		enum_values JSONB NOT NULL DEFAULT '[]',
+		required BOOLEAN NOT NULL DEFAULT false,
+		position INTEGER NOT NULL DEFAULT 0,
+		UNIQUE (category_id, key)
+	);
+
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+	-- Uploaded images; the blobs live in the gateway's blob store
+	CREATE TABLE IF NOT EXISTS product_images (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		url VARCHAR(500) NOT NULL,
+		content_type VARCHAR(100) NOT NULL,
+		size_bytes BIGINT NOT NULL DEFAULT 0,
+		width INTEGER NOT NULL DEFAULT 0,
+		height INTEGER NOT NULL DEFAULT 0,
+		position INTEGER NOT NULL DEFAULT 0,
+		thumbnails JSONB NOT NULL DEFAULT '{}',
+		storage_keys JSONB NOT NULL DEFAULT '[]',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Stock ledger and time-limited holds on stock for carts and orders
+	CREATE TABLE IF NOT EXISTS stock_movements (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE,
+		delta INTEGER NOT NULL,
+		quantity_after INTEGER NOT NULL,
+		reason VARCHAR(50) NOT NULL,
+		reference VARCHAR(255) NOT NULL DEFAULT '',
+		reservation_id UUID,
+		created_by VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS stock_reservations (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+		reference VARCHAR(255) NOT NULL DEFAULT '',
+		status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'committed', 'released', 'expired')),
+		expires_at TIMESTAMP NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Reservations are made in a store and can only be committed or released
+	-- there; those from before there were stores were in the default one
+	ALTER TABLE stock_reservations ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+	CREATE TABLE IF NOT EXISTS stock_reservation_items (
+		reservation_id UUID NOT NULL REFERENCES stock_reservations(id) ON DELETE CASCADE,
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE,
+		quantity INTEGER NOT NULL CHECK (quantity > 0)
+	);
+
+	-- Low-stock alerts fire when available stock drops to the threshold
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS reorder_threshold INTEGER CHECK (reorder_threshold >= 0);
+
+	-- Bumped on every edit so stale updates and deletes can be rejected
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+	-- Deleted products go to the trash and are purged after the retention period
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+	CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products(deleted_at) WHERE deleted_at IS NOT NULL;
+
+	-- Change history; revisions go when the product is purged
+	CREATE TABLE IF NOT EXISTS product_revisions (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		version INTEGER NOT NULL,
+		action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore', 'revert')),
+		actor VARCHAR(255) NOT NULL DEFAULT '',
+		changes JSONB NOT NULL DEFAULT '[]',
+		snapshot JSONB NOT NULL,
+		reverted_to UUID,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Original price shown struck through next to the selling price
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS compare_at_price DECIMAL(10,2) CHECK (compare_at_price > 0);
+
+	-- Time-boxed sale prices; a running sale overrides the price when products
+	-- are read. Times come from users in any zone, so they keep theirs.
+	CREATE TABLE IF NOT EXISTS price_schedules (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		sale_price DECIMAL(10,2) NOT NULL CHECK (sale_price > 0),
+		starts_at TIMESTAMPTZ NOT NULL,
+		ends_at TIMESTAMPTZ NOT NULL,
+		status VARCHAR(20) NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'active', 'ended', 'cancelled')),
+		created_by VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		CHECK (ends_at > starts_at)
+	);
+
+	-- Prices are exact amounts in the product's currency. Amounts get a third
+	-- decimal place for currencies such as KWD.
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+	ALTER TABLE price_schedules ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+	DO $$
+	BEGIN
+		IF EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_name = 'products' AND column_name = 'price' AND numeric_scale = 2
+		) THEN
+			ALTER TABLE products ALTER COLUMN price TYPE DECIMAL(12,3), ALTER COLUMN compare_at_price TYPE DECIMAL(12,3);
+			ALTER TABLE product_variants ALTER COLUMN price TYPE DECIMAL(12,3);
+			ALTER TABLE price_schedules ALTER COLUMN sale_price TYPE DECIMAL(12,3);
+		END IF;
+	END $$;
+
+	-- Fixed prices in currencies other than the product's own
+	CREATE TABLE IF NOT EXISTS product_prices (
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		currency CHAR(3) NOT NULL,
+		amount DECIMAL(12,3) NOT NULL CHECK (amount >= 0),
+		PRIMARY KEY (product_id, currency)
+	);
+
+	-- One review per user per product. The product keeps a summary of its
+	-- approved reviews so lists can sort by it.
+	CREATE TABLE IF NOT EXISTS product_reviews (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		user_id VARCHAR(255) NOT NULL,
+		author_name VARCHAR(255) NOT NULL DEFAULT '',
+		rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+		title VARCHAR(200) NOT NULL DEFAULT '',
+		body TEXT NOT NULL DEFAULT '',
+		status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+		moderated_by VARCHAR(255) NOT NULL DEFAULT '',
+		moderation_note TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (product_id, user_id)
+	);
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS average_rating DECIMAL(3,2) NOT NULL DEFAULT 0;
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS review_count INTEGER NOT NULL DEFAULT 0;
+
+	-- Saved products stay on a wishlist while they are inactive or in the
+	-- trash, and go when the product is purged
+	CREATE TABLE IF NOT EXISTS wishlist_items (
+		user_id VARCHAR(255) NOT NULL,
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (user_id, product_id)
+	);
+
+	-- A wishlist is shared while it has a row here; the token is the link
+	CREATE TABLE IF NOT EXISTS wishlist_shares (
+		user_id VARCHAR(255) PRIMARY KEY,
+		share_token VARCHAR(64) NOT NULL UNIQUE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Domain events waiting to be published, written with the change they
+	-- describe; id orders them, event_id is what consumers deduplicate on
+	CREATE TABLE IF NOT EXISTS outbox_events (
+		id BIGSERIAL PRIMARY KEY,
+		event_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+		type VARCHAR(100) NOT NULL,
+		version INTEGER NOT NULL,
+		aggregate_id VARCHAR(255) NOT NULL,
+		payload BYTEA NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		last_error TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		published_at TIMESTAMP
+	);
+
+	-- event_types empty means every event
+	CREATE TABLE IF NOT EXISTS webhooks (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+		url TEXT NOT NULL,
+		event_types TEXT[] NOT NULL DEFAULT '{}',
+		secret VARCHAR(255) NOT NULL,
+		is_active BOOLEAN NOT NULL DEFAULT true,
+		created_by VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Webhooks belong to a store and only get its products' events. Webhooks
+	-- from before there were stores belong to the default one.
+	ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS store_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+	CREATE INDEX IF NOT EXISTS idx_webhooks_store ON webhooks(store_id);
+
+	-- One row per event per webhook; payload is the exact body sent.
+	-- next_attempt_at is only set while the delivery is pending.
+	CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+		event_id UUID NOT NULL,
+		event_type VARCHAR(100) NOT NULL,
+		payload BYTEA NOT NULL,
+		status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'dead')),
+		attempt_count INTEGER NOT NULL DEFAULT 0,
+		response_code INTEGER NOT NULL DEFAULT 0,
+		last_error TEXT NOT NULL DEFAULT '',
+		next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		delivered_at TIMESTAMP,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (webhook_id, event_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+		id BIGSERIAL PRIMARY KEY,
+		delivery_id UUID NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+		response_code INTEGER NOT NULL DEFAULT 0,
+		error TEXT NOT NULL DEFAULT '',
+		duration_ms INTEGER NOT NULL DEFAULT 0,
+		attempted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Product views for recommendations. viewer groups one person's views
+	-- ('user:<id>' or 'session:<id>', empty when anonymous); user_id is set
+	-- for signed-in viewers. Views older than the window are pruned.
+	CREATE TABLE IF NOT EXISTS product_views (
+		id BIGSERIAL PRIMARY KEY,
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		viewer VARCHAR(255) NOT NULL DEFAULT '',
+		user_id VARCHAR(255) NOT NULL DEFAULT '',
+		viewed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Recomputed in batches from product_views: products viewed by the same
+	-- people, and how much each product is viewed
+	CREATE TABLE IF NOT EXISTS product_cooccurrence (
+		product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		related_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+		viewers INTEGER NOT NULL,
+		score DOUBLE PRECISION NOT NULL,
+		PRIMARY KEY (product_id, related_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS product_popularity (
+		product_id UUID PRIMARY KEY REFERENCES products(id) ON DELETE CASCADE,
+		views INTEGER NOT NULL,
+		viewers INTEGER NOT NULL
+	);
+
+	INSERT INTO categories (name, slug, description) VALUES 
+		('Electronics', 'electronics', 'Electronic devices and accessories'),
+		('Clothing', 'clothing', 'Apparel and fashion items'),
+		('Books', 'books', 'Books and educational materials'),
+		('Sports', 'sports', 'Sports and fitness equipment'),
+		('Home', 'home', 'Home and garden products')
+	ON CONFLICT DO NOTHING;
+
+	CREATE INDEX IF NOT EXISTS idx_products_name ON products(name);
+	CREATE INDEX IF NOT EXISTS idx_products_category_id ON products(category_id);
+	CREATE INDEX IF NOT EXISTS idx_products_sku ON products(sku);
+	CREATE INDEX IF NOT EXISTS idx_products_store ON products(store_id);
+	CREATE INDEX IF NOT EXISTS idx_products_active ON products(is_active);
+	CREATE INDEX IF NOT EXISTS idx_categories_name ON categories(name);
+	CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories(parent_id);
+	CREATE INDEX IF NOT EXISTS idx_product_variants_product ON product_variants(product_id);
+	CREATE INDEX IF NOT EXISTS idx_products_attributes ON products USING GIN (attributes jsonb_path_ops);
+	CREATE INDEX IF NOT EXISTS idx_product_images_product ON product_images(product_id, position);
+	CREATE INDEX IF NOT EXISTS idx_stock_movements_product ON stock_movements(product_id, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_product_revisions_product ON product_revisions(product_id, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_price_schedules_product ON price_schedules(product_id, starts_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_product_reviews_product ON product_reviews(product_id, status, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_product_reviews_status ON product_reviews(status, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_wishlist_items_product ON wishlist_items(product_id);
+	CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+	CREATE INDEX IF NOT EXISTS idx_outbox_events_published ON outbox_events(published_at) WHERE published_at IS NOT NULL;
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created ON webhook_deliveries(created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook ON webhook_deliveries(webhook_id, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_delivery ON webhook_delivery_attempts(delivery_id);
+	CREATE INDEX IF NOT EXISTS idx_stock_reservations_active ON stock_reservations(expires_at) WHERE status = 'active';
+	CREATE INDEX IF NOT EXISTS idx_stock_reservation_items_reservation ON stock_reservation_items(reservation_id);
+	CREATE INDEX IF NOT EXISTS idx_stock_reservation_items_product ON stock_reservation_items(product_id, variant_id);
+	CREATE INDEX IF NOT EXISTS idx_product_views_viewer ON product_views(viewer, product_id, viewed_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_product_views_user ON product_views(user_id, viewed_at DESC) WHERE user_id <> '';
+	CREATE INDEX IF NOT EXISTS idx_product_views_viewed_at ON product_views(viewed_at);
+	`
+
+	_, err := db.Exec(query)
+	return err
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// ErrScheduleOverlap is returned when a new sale would run at the same time
+// as another sale for the product
+var ErrScheduleOverlap = errors.New("the product already has a sale scheduled during that time")
+
+type PriceRepository interface {
+	Create(schedule *models.PriceSchedule) error
+	GetByID(id string) (*models.PriceSchedule, error)
+	Cancel(id string) (*models.PriceSchedule, error)
+	ListByProduct(productID string) ([]*models.PriceSchedule, error)
+	Advance() (started int, ended int, err error)
+	ReplacePriceList(productID string, prices []models.Money) error
+}
+
+type priceRepository struct {
+	db *sql.DB
+}
+
+func NewPriceRepository(db *sql.DB) PriceRepository {
+	return &priceRepository{db: db}
+}
+
+const priceScheduleColumns = `id, product_id, sale_price, starts_at, ends_at, status, created_by, created_at, updated_at, currency`
+
+func scanPriceSchedule(row rowScanner) (*models.PriceSchedule, error) {
+	schedule := &models.PriceSchedule{}
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.ProductID,
+		&schedule.SalePrice,
+		&schedule.StartsAt,
+		&schedule.EndsAt,
+		&schedule.Status,
+		&schedule.CreatedBy,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+		&schedule.SalePrice.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// Create adds the schedule unless it overlaps another live schedule for the
+// product, in which case it returns ErrScheduleOverlap
+func (r *priceRepository) Create(schedule *models.PriceSchedule) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the product row so concurrent schedules can't both pass the overlap check
+	if _, err := tx.Exec(`SELECT id FROM products WHERE id = $1 FOR UPDATE`, schedule.ProductID); err != nil {
+		return fmt.Errorf("failed to lock product: %w", err)
+	}
+
+	var overlaps bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM price_schedules
+			WHERE product_id = $1 AND status <> 'cancelled'
+			  AND starts_at < $3 AND ends_at > $2
+		)
+	`, schedule.ProductID, schedule.StartsAt, schedule.EndsAt).Scan(&overlaps)
+	if err != nil {
+		return fmt.Errorf("failed to check overlapping schedules: %w", err)
+	}
+	if overlaps {
+		return ErrScheduleOverlap
+	}
+
+	query := `
+		INSERT INTO price_schedules (product_id, sale_price, currency, starts_at, ends_at, status, created_by)
+		VALUES ($1, $2, $3, $4, $5, CASE WHEN $4::timestamptz <= CURRENT_TIMESTAMP THEN 'active' ELSE 'scheduled' END, $6)
+		RETURNING ` + priceScheduleColumns
+
+	created, err := scanPriceSchedule(tx.QueryRow(
+		query,
+		schedule.ProductID,
+		schedule.SalePrice,
+		schedule.SalePrice.Currency,
+		schedule.StartsAt,
+		schedule.EndsAt,
+		schedule.CreatedBy,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to create price schedule: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit price schedule: %w", err)
+	}
+
+	*schedule = *created
+	return nil
+}
+
+func (r *priceRepository) GetByID(id string) (*models.PriceSchedule, error) {
+	schedule, err := scanPriceSchedule(r.db.QueryRow(`SELECT `+priceScheduleColumns+` FROM price_schedules WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get price schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// Cancel stops a schedule that hasn't ended yet. It returns nil when there
+// is no such schedule.
+func (r *priceRepository) Cancel(id string) (*models.PriceSchedule, error) {
+	query := `
+		UPDATE price_schedules
+		SET status = 'cancelled', updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status IN ('scheduled', 'active') AND ends_at > CURRENT_TIMESTAMP
+		RETURNING ` + priceScheduleColumns
+
+	schedule, err := scanPriceSchedule(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to cancel price schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// ListByProduct lists a product's schedules, latest first
+func (r *priceRepository) ListByProduct(productID string) ([]*models.PriceSchedule, error) {
+	rows, err := r.db.Query(`SELECT `+priceScheduleColumns+` FROM price_schedules WHERE product_id = $1 ORDER BY starts_at DESC`, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list price schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []*models.PriceSchedule
+	for rows.Next() {
+		schedule, err := scanPriceSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan price schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list price schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// Advance moves schedules whose window has opened to active and those whose
+// window has closed to ended
+func (r *priceRepository) Advance() (int, int, error) {
+	result, err := r.db.Exec(`
+		UPDATE price_schedules
+		SET status = 'ended', updated_at = CURRENT_TIMESTAMP
+		WHERE status IN ('scheduled', 'active') AND ends_at <= CURRENT_TIMESTAMP
+	`)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to end price schedules: %w", err)
+	}
+	ended, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to end price schedules: %w", err)
+	}
+
+	result, err = r.db.Exec(`
+		UPDATE price_schedules
+		SET status = 'active', updated_at = CURRENT_TIMESTAMP
+		WHERE status = 'scheduled' AND starts_at <= CURRENT_TIMESTAMP
+	`)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to start price schedules: %w", err)
+	}
+	started, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to start price schedules: %w", err)
+	}
+
+	return int(started), int(ended), nil
+}
+
+// ReplacePriceList swaps the product's whole price list in one transaction
+func (r *priceRepository) ReplacePriceList(productID string, prices []models.Money) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM product_prices WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to clear price list: %w", err)
+	}
+
+	for _, price := range prices {
+		_, err := tx.Exec(`INSERT INTO product_prices (product_id, currency, amount) VALUES ($1, $2, $3)`, productID, price.Currency, price)
+		if err != nil {
+			return fmt.Errorf("failed to add %s price: %w", price.Currency, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit price list: %w", err)
+	}
+
+	return nil
+}
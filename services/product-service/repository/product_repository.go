@@ -2,80 +2,447 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/product-service/events"
 	"github.com/martbul/playground_microservices/services/product-service/models"
 )
 
+// ErrVersionConflict is returned when a product was changed since the version
+// an update or delete was based on
+var ErrVersionConflict = errors.New("product was changed by someone else")
+
+// Create, Update, Delete and Restore record the given revision in the same
+// transaction, filling in its product ID and the product's new version.
+// Update takes a nil revision when nothing tracked changed, and sets the
+// stock through the ledger in the same transaction when stockQuantity is
+// given, returning the stock change.
+//
+// Every read and write is limited to one store: given by storeID, by the
+// filter, or by the store of the product being written. Only PurgeDeleted
+// and StoreOf work across stores.
 type ProductRepository interface {
-	Create(product *models.Product) error
-	GetByID(id string) (*models.Product, error)
-	GetBySKU(sku string) (*models.Product, error)
-	Update(product *models.Product) error
-	Delete(id string) error
+	Create(product *models.Product, revision *models.ProductRevision) error
+	GetByID(storeID, id string) (*models.Product, error)
+	GetBySKU(storeID, sku string) (*models.Product, error)
+	StoreOf(id string) (string, error)
+	Update(product *models.Product, revision *models.ProductRevision, stockQuantity *int32, updatedBy string) (*models.StockChange, error)
+	Delete(storeID, id string, version int32, revision *models.ProductRevision) error
+	Restore(storeID, id string, revision *models.ProductRevision) error
+	ListDeleted(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error)
+	PurgeDeleted(before time.Time) (int, []string, error)
 	List(filter *models.ProductFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error)
 	Search(filter *models.SearchFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error)
-	GetCategories() ([]*models.Category, error)
+	ListByIDs(storeID string, ids []string) ([]*models.Product, error)
+	ListBySKUs(storeID string, skus []string) ([]*models.Product, error)
+	BatchUpdate(storeID string, patches []*models.ProductPatch, updatedBy string) error
+	ImportBatch(items []*models.ImportItem) error
+	Export(filter *models.ProductFilter, fn func(*models.Product) error) error
 }
 
-type productRepository struct {
-	db *sql.DB
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
 }
 
-func NewProductRepository(db *sql.DB) ProductRepository {
-	return &productRepository{db: db}
+const productSelect = `
+	SELECT p.id, p.name, p.description, p.price, p.stock_quantity, p.category_id, COALESCE(c.name, ''),
+	       p.image_url, p.sku, p.is_active, p.created_at, p.updated_at, p.created_by, p.attributes, p.reorder_threshold, p.version, p.deleted_at,
+	       (SELECT COALESCE(SUM(i.quantity), 0)
+	        FROM stock_reservation_items i
+	        JOIN stock_reservations r ON r.id = i.reservation_id
+	        WHERE i.product_id = p.id AND i.variant_id IS NULL
+	          AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP),
+	       p.compare_at_price, sale.id, sale.sale_price, sale.starts_at, sale.ends_at, sale.status,
+	       sale.created_by, sale.created_at, sale.updated_at, p.currency,
+	       (SELECT COALESCE(jsonb_agg(jsonb_build_array(pp.currency, pp.amount::text) ORDER BY pp.currency), '[]')
+	        FROM product_prices pp WHERE pp.product_id = p.id),
+	       p.average_rating, p.review_count, p.store_id
+	FROM products p
+	LEFT JOIN categories c ON c.id = p.category_id
+	LEFT JOIN LATERAL (
+		SELECT s.id, s.sale_price, s.starts_at, s.ends_at, s.status, s.created_by, s.created_at, s.updated_at
+		FROM price_schedules s
+		WHERE ` + runningSaleCondition + `
+		ORDER BY s.starts_at DESC
+		LIMIT 1
+	) sale ON true
+`
+
+// runningSaleCondition matches price schedules s whose sale applies to
+// product p right now. Schedules can't overlap, so there is at most one.
+const runningSaleCondition = `s.product_id = p.id AND s.status <> 'cancelled'
+		  AND s.starts_at <= CURRENT_TIMESTAMP AND s.ends_at > CURRENT_TIMESTAMP`
+
+// effectivePrice is the price product p sells for right now, for filtering
+// and sorting. It stands alone so count queries without the sale join can use it.
+const effectivePrice = `COALESCE((SELECT s.sale_price FROM price_schedules s WHERE ` + runningSaleCondition + `
+		ORDER BY s.starts_at DESC LIMIT 1), p.price)`
+
+// categorySubtreeCondition matches products in the given category or any of its descendants
+const categorySubtreeCondition = `p.category_id IN (
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM categories WHERE id = $%d
+			UNION ALL
+			SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT id FROM subtree
+	)`
+
+// productSortColumns whitelists the columns ListProducts can be sorted by
+var productSortColumns = map[string]string{
+	"name":           "p.name",
+	"price":          effectivePrice,
+	"stock_quantity": "p.stock_quantity",
+	"created_at":     "p.created_at",
+	"updated_at":     "p.updated_at",
+	"average_rating": "p.average_rating",
+	"review_count":   "p.review_count",
+}
+
+func scanProduct(row rowScanner) (*models.Product, error) {
+	product := &models.Product{}
+	var attributes, prices []byte
+	var sale struct {
+		ID, Status, CreatedBy, SalePrice       sql.NullString
+		StartsAt, EndsAt, CreatedAt, UpdatedAt sql.NullTime
+	}
+	err := row.Scan(
+		&product.ID,
+		&product.Name,
+		&product.Description,
+		&product.Price,
+		&product.StockQuantity,
+		&product.CategoryID,
+		&product.Category,
+		&product.ImageURL,
+		&product.SKU,
+		&product.IsActive,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+		&product.CreatedBy,
+		&attributes,
+		&product.ReorderThreshold,
+		&product.Version,
+		&product.DeletedAt,
+		&product.ReservedQuantity,
+		&product.CompareAtPrice,
+		&sale.ID,
+		&sale.SalePrice,
+		&sale.StartsAt,
+		&sale.EndsAt,
+		&sale.Status,
+		&sale.CreatedBy,
+		&sale.CreatedAt,
+		&sale.UpdatedAt,
+		&product.Currency,
+		&prices,
+		&product.AverageRating,
+		&product.ReviewCount,
+		&product.StoreID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Price.Currency = product.Currency
+	if product.CompareAtPrice != nil {
+		product.CompareAtPrice.Currency = product.Currency
+	}
+	if product.Prices, err = decodePriceList(prices); err != nil {
+		return nil, err
+	}
+
+	if sale.ID.Valid {
+		salePrice, err := models.ParseMoney(sale.SalePrice.String, product.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode sale price: %w", err)
+		}
+		product.ActiveSale = &models.PriceSchedule{
+			ID:        sale.ID.String,
+			ProductID: product.ID,
+			SalePrice: salePrice,
+			StartsAt:  sale.StartsAt.Time,
+			EndsAt:    sale.EndsAt.Time,
+			Status:    sale.Status.String,
+			CreatedBy: sale.CreatedBy.String,
+			CreatedAt: sale.CreatedAt.Time,
+			UpdatedAt: sale.UpdatedAt.Time,
+		}
+	}
+	if err := json.Unmarshal(attributes, &product.Attributes); err != nil {
+		return nil, fmt.Errorf("failed to decode product attributes: %w", err)
+	}
+	return product, nil
+}
+
+// decodePriceList reads the [currency, amount] pairs productSelect
+// aggregates from product_prices
+func decodePriceList(data []byte) ([]models.Money, error) {
+	var pairs [][2]string
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return nil, fmt.Errorf("failed to decode price list: %w", err)
+	}
+
+	prices := make([]models.Money, 0, len(pairs))
+	for _, pair := range pairs {
+		price, err := models.ParseMoney(pair[1], pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode price list: %w", err)
+		}
+		prices = append(prices, price)
+	}
+	return prices, nil
+}
+
+// encodeAttributes marshals product attributes for the JSONB column
+func encodeAttributes(attributes map[string]interface{}) ([]byte, error) {
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+	return json.Marshal(attributes)
+}
+
+// attributeConditions turns attribute filters into WHERE conditions.
+// Equality uses @> so it can be served by the GIN index on p.attributes;
+// range comparisons go through a jsonpath predicate. Keys are validated by
+// the service before they reach here.
+func attributeConditions(filters []models.AttributeFilter, argIndex int) ([]string, []interface{}, error) {
+	var conditions []string
+	var args []interface{}
+
+	for _, filter := range filters {
+		switch filter.Operator {
+		case "=", "!=":
+			containment, err := json.Marshal(map[string]interface{}{filter.Key: filter.Value})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to encode attribute filter: %w", err)
+			}
+			condition := fmt.Sprintf("p.attributes @> $%d::jsonb", argIndex)
+			if filter.Operator == "!=" {
+				condition = "NOT " + condition
+			}
+			conditions = append(conditions, condition)
+			args = append(args, string(containment))
+		case ">", ">=", "<", "<=":
+			number, ok := filter.Value.(float64)
+			if !ok {
+				return nil, nil, fmt.Errorf("attribute %s: %s needs a numeric value", filter.Key, filter.Operator)
+			}
+			conditions = append(conditions, fmt.Sprintf("p.attributes @? $%d::jsonpath", argIndex))
+			args = append(args, fmt.Sprintf(`$."%s" ? (@ %s %s)`, filter.Key, filter.Operator, strconv.FormatFloat(number, 'f', -1, 64)))
+		default:
+			return nil, nil, fmt.Errorf("unsupported attribute operator %q", filter.Operator)
+		}
+		argIndex++
+	}
+
+	return conditions, args, nil
 }
 
-func (r *productRepository) Create(product *models.Product) error {
+func insertProduct(q queryer, product *models.Product) error {
+	// The opening stock goes into the ledger in the same statement
 	query := `
-		INSERT INTO products (name, description, price, stock_quantity, category, image_url, sku, is_active, created_by)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING id, created_at, updated_at
+		WITH inserted AS (
+			INSERT INTO products (name, description, price, stock_quantity, category_id, image_url, sku, is_active, created_by, attributes, reorder_threshold, compare_at_price, currency, store_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			RETURNING id, stock_quantity, created_by, created_at, updated_at, version
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity_after, reason, created_by)
+			SELECT id, stock_quantity, stock_quantity, 'initial', COALESCE(created_by, '') FROM inserted WHERE stock_quantity > 0
+		)
+		SELECT id, created_at, updated_at, version FROM inserted
 	`
 
-	err := r.db.QueryRow(
+	attributes, err := encodeAttributes(product.Attributes)
+	if err != nil {
+		return fmt.Errorf("failed to encode product attributes: %w", err)
+	}
+
+	err = q.QueryRow(
 		query,
 		product.Name,
 		product.Description,
 		product.Price,
 		product.StockQuantity,
-		product.Category,
+		product.CategoryID,
 		product.ImageURL,
 		product.SKU,
 		product.IsActive,
 		product.CreatedBy,
-	).Scan(&product.ID, &product.CreatedAt, &product.UpdatedAt)
+		attributes,
+		product.ReorderThreshold,
+		product.CompareAtPrice,
+		product.Currency,
+		product.StoreID,
+	).Scan(&product.ID, &product.CreatedAt, &product.UpdatedAt, &product.Version)
 
 	if err != nil {
 		return fmt.Errorf("failed to create product: %w", err)
 	}
 
+	if product.StockQuantity > 0 {
+		return recordEvent(q, events.StockChanged(&models.StockMovement{
+			ProductID:     product.ID,
+			Delta:         product.StockQuantity,
+			QuantityAfter: product.StockQuantity,
+			Reason:        models.MovementInitial,
+			CreatedBy:     product.CreatedBy,
+		}))
+	}
+
 	return nil
 }
 
-func (r *productRepository) GetByID(id string) (*models.Product, error) {
-	product := &models.Product{}
+// updateProduct writes everything but stock, which only changes through the
+// ledger, if the product is still at product.Version and moves it to the
+// next version; otherwise it returns ErrVersionConflict.
+func updateProduct(q queryer, product *models.Product) error {
 	query := `
-		SELECT id, name, description, price, stock_quantity, category, image_url, sku, is_active, created_at, updated_at, created_by
-		FROM products WHERE id = $1
+		UPDATE products 
+		SET name = $1, description = $2, price = $3, category_id = $4,
+		    image_url = $5, sku = $6, is_active = $7, attributes = $8, reorder_threshold = $9,
+		    compare_at_price = $10, version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $11 AND version = $12 AND store_id = $13
+		RETURNING updated_at, version
 	`
 
-	err := r.db.QueryRow(query, id).Scan(
-		&product.ID,
-		&product.Name,
-		&product.Description,
-		&product.Price,
-		&product.StockQuantity,
-		&product.Category,
-		&product.ImageURL,
-		&product.SKU,
-		&product.IsActive,
-		&product.CreatedAt,
-		&product.UpdatedAt,
-		&product.CreatedBy,
-	)
+	attributes, err := encodeAttributes(product.Attributes)
+	if err != nil {
+		return fmt.Errorf("failed to encode product attributes: %w", err)
+	}
 
+	err = q.QueryRow(
+		query,
+		product.Name,
+		product.Description,
+		product.Price,
+		product.CategoryID,
+		product.ImageURL,
+		product.SKU,
+		product.IsActive,
+		attributes,
+		product.ReorderThreshold,
+		product.CompareAtPrice,
+		product.ID,
+		product.Version,
+		product.StoreID,
+	).Scan(&product.UpdatedAt, &product.Version)
+
+	if err == sql.ErrNoRows {
+		return ErrVersionConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	return nil
+}
+
+// productFilterConditions turns a ProductFilter into WHERE conditions and
+// their arguments, numbered from $1
+func productFilterConditions(filter *models.ProductFilter) ([]string, []interface{}, error) {
+	if filter == nil || filter.StoreID == "" {
+		return nil, nil, fmt.Errorf("store is required")
+	}
+
+	conditions := []string{"p.deleted_at IS NULL", "p.store_id = $1"}
+	args := []interface{}{filter.StoreID}
+	argIndex := 2
+
+	if filter.CategoryID != "" {
+		conditions = append(conditions, fmt.Sprintf(categorySubtreeCondition, argIndex))
+		args = append(args, filter.CategoryID)
+		argIndex++
+	}
+
+	if filter.MinPrice != nil {
+		conditions = append(conditions, fmt.Sprintf("p.currency = $%d AND %s >= $%d", argIndex, effectivePrice, argIndex+1))
+		args = append(args, filter.MinPrice.Currency, *filter.MinPrice)
+		argIndex += 2
+	}
+
+	if filter.MaxPrice != nil {
+		conditions = append(conditions, fmt.Sprintf("p.currency = $%d AND %s <= $%d", argIndex, effectivePrice, argIndex+1))
+		args = append(args, filter.MaxPrice.Currency, *filter.MaxPrice)
+		argIndex += 2
+	}
+
+	if filter.IsActive != nil {
+		conditions = append(conditions, fmt.Sprintf("p.is_active = $%d", argIndex))
+		args = append(args, *filter.IsActive)
+		argIndex++
+	}
+
+	if filter.CreatedBy != "" {
+		conditions = append(conditions, fmt.Sprintf("p.created_by = $%d", argIndex))
+		args = append(args, filter.CreatedBy)
+		argIndex++
+	}
+
+	attrConditions, attrArgs, err := attributeConditions(filter.Attributes, argIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+	conditions = append(conditions, attrConditions...)
+	args = append(args, attrArgs...)
+
+	return conditions, args, nil
+}
+
+type productRepository struct {
+	db *sql.DB
+}
+
+func NewProductRepository(db *sql.DB) ProductRepository {
+	return &productRepository{db: db}
+}
+
+func (r *productRepository) Create(product *models.Product, revision *models.ProductRevision) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertProduct(tx, product); err != nil {
+		return err
+	}
+	if err := recordRevision(tx, revision, product.ID, product.Version); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product: %w", err)
+	}
+
+	return nil
+}
+
+// recordRevision writes the revision, if any, for a product now at version,
+// and the event announcing the change
+func recordRevision(q queryer, revision *models.ProductRevision, productID string, version int32) error {
+	if revision == nil {
+		return nil
+	}
+	revision.ProductID = productID
+	revision.Version = version
+	if err := insertRevision(q, revision); err != nil {
+		return err
+	}
+	return recordEvent(q, events.ProductChanged(revision))
+}
+
+func (r *productRepository) GetByID(storeID, id string) (*models.Product, error) {
+	query := productSelect + ` WHERE p.id = $1 AND p.store_id = $2 AND p.deleted_at IS NULL`
+
+	product, err := scanProduct(r.db.QueryRow(query, id, storeID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -86,28 +453,26 @@ func (r *productRepository) GetByID(id string) (*models.Product, error) {
 	return product, nil
 }
 
-func (r *productRepository) GetBySKU(sku string) (*models.Product, error) {
-	product := &models.Product{}
-	query := `
-		SELECT id, name, description, price, stock_quantity, category, image_url, sku, is_active, created_at, updated_at, created_by
-		FROM products WHERE sku = $1
-	`
+// StoreOf returns the store a product belongs to, trash included, or "" if
+// there is no such product. Events only carry the product ID, so this is
+// how their consumers find the store.
+func (r *productRepository) StoreOf(id string) (string, error) {
+	var storeID string
+	err := r.db.QueryRow(`SELECT store_id FROM products WHERE id = $1`, id).Scan(&storeID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get product store: %w", err)
+	}
+	return storeID, nil
+}
 
-	err := r.db.QueryRow(query, sku).Scan(
-		&product.ID,
-		&product.Name,
-		&product.Description,
-		&product.Price,
-		&product.StockQuantity,
-		&product.Category,
-		&product.ImageURL,
-		&product.SKU,
-		&product.IsActive,
-		&product.CreatedAt,
-		&product.UpdatedAt,
-		&product.CreatedBy,
-	)
+func (r *productRepository) GetBySKU(storeID, sku string) (*models.Product, error) {
+	// Products in the trash still hold their SKU, so they are included
+	query := productSelect + ` WHERE p.sku = $1 AND p.store_id = $2`
 
+	product, err := scanProduct(r.db.QueryRow(query, sku, storeID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -118,41 +483,96 @@ func (r *productRepository) GetBySKU(sku string) (*models.Product, error) {
 	return product, nil
 }
 
-func (r *productRepository) Update(product *models.Product) error {
+func (r *productRepository) Update(product *models.Product, revision *models.ProductRevision, stockQuantity *int32, updatedBy string) (*models.StockChange, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := updateProduct(tx, product); err != nil {
+		return nil, err
+	}
+	if err := recordRevision(tx, revision, product.ID, product.Version); err != nil {
+		return nil, err
+	}
+
+	var change *models.StockChange
+	if stockQuantity != nil {
+		if change, err = setStock(tx, product.ID, nil, *stockQuantity, models.MovementManualSet, updatedBy); err != nil {
+			return nil, err
+		}
+		product.StockQuantity = change.After.StockQuantity
+		product.ReservedQuantity = change.After.ReservedQuantity
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit product update: %w", err)
+	}
+
+	return change, nil
+}
+
+// Delete moves the product to the trash; PurgeDeleted removes it for good
+func (r *productRepository) Delete(storeID, id string, version int32, revision *models.ProductRevision) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		UPDATE products 
-		SET name = $1, description = $2, price = $3, stock_quantity = $4, category = $5, 
-		    image_url = $6, sku = $7, is_active = $8, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $9
-		RETURNING updated_at
+		UPDATE products
+		SET deleted_at = CURRENT_TIMESTAMP, version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND version = $2 AND store_id = $3 AND deleted_at IS NULL
+		RETURNING version
 	`
 
-	err := r.db.QueryRow(
-		query,
-		product.Name,
-		product.Description,
-		product.Price,
-		product.StockQuantity,
-		product.Category,
-		product.ImageURL,
-		product.SKU,
-		product.IsActive,
-		product.ID,
-	).Scan(&product.UpdatedAt)
-
+	var newVersion int32
+	err = tx.QueryRow(query, id, version, storeID).Scan(&newVersion)
+	if err == sql.ErrNoRows {
+		var exists bool
+		if err := tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM products WHERE id = $1 AND store_id = $2 AND deleted_at IS NULL)`, id, storeID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check product: %w", err)
+		}
+		if exists {
+			return ErrVersionConflict
+		}
+		return fmt.Errorf("product not found")
+	}
 	if err != nil {
-		return fmt.Errorf("failed to update product: %w", err)
+		return fmt.Errorf("failed to delete product: %w", err)
+	}
+
+	if err := recordRevision(tx, revision, id, newVersion); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product delete: %w", err)
 	}
 
 	return nil
 }
 
-func (r *productRepository) Delete(id string) error {
-	query := `DELETE FROM products WHERE id = $1`
+// Restore takes the product out of the trash. The revision's snapshot is
+// filled in from the restored product.
+func (r *productRepository) Restore(storeID, id string, revision *models.ProductRevision) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE products
+		SET deleted_at = NULL, version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND store_id = $2 AND deleted_at IS NOT NULL
+	`
 
-	result, err := r.db.Exec(query, id)
+	result, err := tx.Exec(query, id, storeID)
 	if err != nil {
-		return fmt.Errorf("failed to delete product: %w", err)
+		return fmt.Errorf("failed to restore product: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -161,80 +581,169 @@ func (r *productRepository) Delete(id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product not found")
+		return fmt.Errorf("product not found in trash")
+	}
+
+	if revision != nil {
+		product, err := scanProduct(tx.QueryRow(productSelect+` WHERE p.id = $1`, id))
+		if err != nil {
+			return fmt.Errorf("failed to get restored product: %w", err)
+		}
+		revision.Snapshot = product.Snapshot()
+		if err := recordRevision(tx, revision, id, product.Version); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product restore: %w", err)
 	}
 
 	return nil
 }
 
-func (r *productRepository) List(filter *models.ProductFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error) {
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
+// ListDeleted lists the trash, most recently deleted first
+func (r *productRepository) ListDeleted(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error) {
+	where := ` WHERE p.deleted_at IS NOT NULL AND p.store_id = $1 AND ($2 = '' OR p.created_by = $2)`
 
-	baseQuery := `
-		SELECT id, name, description, price, stock_quantity, category, image_url, sku, is_active, created_at, updated_at, created_by
-		FROM products
-	`
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM products p`+where, storeID, createdBy).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count deleted products: %w", err)
+	}
 
-	if filter != nil {
-		if filter.Category != "" {
-			conditions = append(conditions, fmt.Sprintf("category = $%d", argIndex))
-			args = append(args, filter.Category)
-			argIndex++
-		}
+	query := productSelect + where + ` ORDER BY p.deleted_at DESC LIMIT $3 OFFSET $4`
 
-		if filter.MinPrice > 0 {
-			conditions = append(conditions, fmt.Sprintf("price >= $%d", argIndex))
-			args = append(args, filter.MinPrice)
-			argIndex++
-		}
+	rows, err := r.db.Query(query, storeID, createdBy, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list deleted products: %w", err)
+	}
 
-		if filter.MaxPrice > 0 {
-			conditions = append(conditions, fmt.Sprintf("price <= $%d", argIndex))
-			args = append(args, filter.MaxPrice)
-			argIndex++
-		}
+	products, err := scanProducts(rows)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list deleted products: %w", err)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return products, paginationResponse, nil
+}
+
+// PurgeDeleted permanently removes products deleted before the given time,
+// along with their variants, images and stock history. It returns how many
+// were removed and the storage keys of their images, whose blobs are not
+// stored here.
+func (r *productRepository) PurgeDeleted(before time.Time) (int, []string, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-		if filter.IsActive != nil {
-			conditions = append(conditions, fmt.Sprintf("is_active = $%d", argIndex))
-			args = append(args, *filter.IsActive)
-			argIndex++
+	// Lock the expired products first so a concurrent restore can't slip in
+	// between reading their images and deleting them
+	rows, err := tx.Query(`
+		SELECT id FROM products
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1
+		FOR UPDATE
+	`, before)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to find expired products: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, nil, fmt.Errorf("failed to scan expired product: %w", err)
 		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to find expired products: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil, nil
+	}
 
-		if filter.CreatedBy != "" {
-			conditions = append(conditions, fmt.Sprintf("created_by = $%d", argIndex))
-			args = append(args, filter.CreatedBy)
-			argIndex++
+	rows, err = tx.Query(`SELECT storage_keys FROM product_images WHERE product_id = ANY($1::uuid[])`, pq.Array(ids))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get purged images: %w", err)
+	}
+	var storageKeys []string
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			rows.Close()
+			return 0, nil, fmt.Errorf("failed to scan purged image: %w", err)
+		}
+		var keys []string
+		if err := json.Unmarshal(raw, &keys); err != nil {
+			rows.Close()
+			return 0, nil, fmt.Errorf("failed to decode image storage keys: %w", err)
 		}
+		storageKeys = append(storageKeys, keys...)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to get purged images: %w", err)
+	}
+
+	// Variants, images, reservations and stock movements go with the product
+	if _, err := tx.Exec(`DELETE FROM products WHERE id = ANY($1::uuid[])`, pq.Array(ids)); err != nil {
+		return 0, nil, fmt.Errorf("failed to purge products: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return 0, nil, fmt.Errorf("failed to commit purge: %w", err)
+	}
+
+	return len(ids), storageKeys, nil
+}
+
+func (r *productRepository) List(filter *models.ProductFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error) {
+	baseQuery := productSelect
+
+	conditions, args, err := productFilterConditions(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	argIndex := len(args) + 1
+
 	// Build WHERE clause
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
 	// Count total records
-	countQuery := "SELECT COUNT(*) FROM products"
+	countQuery := "SELECT COUNT(*) FROM products p"
 	if len(conditions) > 0 {
 		countQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
 	var totalCount int64
-	err := r.db.QueryRow(countQuery, args...).Scan(&totalCount)
+	err = r.db.QueryRow(countQuery, args...).Scan(&totalCount)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to count products: %w", err)
 	}
 
 	// Add sorting
-	if pagination.SortBy != "" {
+	if sortColumn, ok := productSortColumns[pagination.SortBy]; ok {
 		sortOrder := "ASC"
 		if pagination.SortOrder == "desc" {
 			sortOrder = "DESC"
 		}
-		baseQuery += fmt.Sprintf(" ORDER BY %s %s", pagination.SortBy, sortOrder)
+		baseQuery += fmt.Sprintf(" ORDER BY %s %s", sortColumn, sortOrder)
 	} else {
-		baseQuery += " ORDER BY created_at DESC"
+		baseQuery += " ORDER BY p.created_at DESC"
 	}
 
 	// Add pagination
@@ -249,21 +758,7 @@ func (r *productRepository) List(filter *models.ProductFilter, pagination *model
 
 	var products []*models.Product
 	for rows.Next() {
-		product := &models.Product{}
-		err := rows.Scan(
-			&product.ID,
-			&product.Name,
-			&product.Description,
-			&product.Price,
-			&product.StockQuantity,
-			&product.Category,
-			&product.ImageURL,
-			&product.SKU,
-			&product.IsActive,
-			&product.CreatedAt,
-			&product.UpdatedAt,
-			&product.CreatedBy,
-		)
+		product, err := scanProduct(rows)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to scan product: %w", err)
 		}
@@ -285,66 +780,75 @@ func (r *productRepository) List(filter *models.ProductFilter, pagination *model
 }
 
 func (r *productRepository) Search(filter *models.SearchFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error) {
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
+	if filter.StoreID == "" {
+		return nil, nil, fmt.Errorf("store is required")
+	}
 
-	baseQuery := `
-		SELECT id, name, description, price, stock_quantity, category, image_url, sku, is_active, created_at, updated_at, created_by
-		FROM products
-	`
+	conditions := []string{"p.deleted_at IS NULL", "p.store_id = $1"}
+	args := []interface{}{filter.StoreID}
+	argIndex := 2
+
+	baseQuery := productSelect
 
 	// Add search query condition
 	if filter.Query != "" {
-		conditions = append(conditions, fmt.Sprintf("(name ILIKE $%d OR description ILIKE $%d OR sku ILIKE $%d)", argIndex, argIndex, argIndex))
+		conditions = append(conditions, fmt.Sprintf("(p.name ILIKE $%d OR p.description ILIKE $%d OR p.sku ILIKE $%d)", argIndex, argIndex, argIndex))
 		searchTerm := "%" + filter.Query + "%"
 		args = append(args, searchTerm)
 		argIndex++
 	}
 
-	if filter.Category != "" {
-		conditions = append(conditions, fmt.Sprintf("category = $%d", argIndex))
-		args = append(args, filter.Category)
+	if filter.CategoryID != "" {
+		conditions = append(conditions, fmt.Sprintf(categorySubtreeCondition, argIndex))
+		args = append(args, filter.CategoryID)
 		argIndex++
 	}
 
-	if filter.MinPrice > 0 {
-		conditions = append(conditions, fmt.Sprintf("price >= $%d", argIndex))
-		args = append(args, filter.MinPrice)
-		argIndex++
+	if filter.MinPrice != nil {
+		conditions = append(conditions, fmt.Sprintf("p.currency = $%d AND %s >= $%d", argIndex, effectivePrice, argIndex+1))
+		args = append(args, filter.MinPrice.Currency, *filter.MinPrice)
+		argIndex += 2
 	}
 
-	if filter.MaxPrice > 0 {
-		conditions = append(conditions, fmt.Sprintf("price <= $%d", argIndex))
-		args = append(args, filter.MaxPrice)
-		argIndex++
+	if filter.MaxPrice != nil {
+		conditions = append(conditions, fmt.Sprintf("p.currency = $%d AND %s <= $%d", argIndex, effectivePrice, argIndex+1))
+		args = append(args, filter.MaxPrice.Currency, *filter.MaxPrice)
+		argIndex += 2
 	}
 
 	if filter.IsActive != nil {
-		conditions = append(conditions, fmt.Sprintf("is_active = $%d", argIndex))
+		conditions = append(conditions, fmt.Sprintf("p.is_active = $%d", argIndex))
 		args = append(args, *filter.IsActive)
 		argIndex++
 	}
 
+	attrConditions, attrArgs, err := attributeConditions(filter.Attributes, argIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+	conditions = append(conditions, attrConditions...)
+	args = append(args, attrArgs...)
+	argIndex += len(attrArgs)
+
 	// Build WHERE clause
 	if len(conditions) > 0 {
 		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
 	// Count total records
-	countQuery := "SELECT COUNT(*) FROM products"
+	countQuery := "SELECT COUNT(*) FROM products p"
 	if len(conditions) > 0 {
 		countQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
 	var totalCount int64
-	err := r.db.QueryRow(countQuery, args...).Scan(&totalCount)
+	err = r.db.QueryRow(countQuery, args...).Scan(&totalCount)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to count products: %w", err)
 	}
 
 	// Add sorting (relevance for search)
-	baseQuery += " ORDER BY created_at DESC"
+	baseQuery += " ORDER BY p.created_at DESC"
 
 	// Add pagination
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
@@ -358,21 +862,7 @@ func (r *productRepository) Search(filter *models.SearchFilter, pagination *mode
 
 	var products []*models.Product
 	for rows.Next() {
-		product := &models.Product{}
-		err := rows.Scan(
-			&product.ID,
-			&product.Name,
-			&product.Description,
-			&product.Price,
-			&product.StockQuantity,
-			&product.Category,
-			&product.ImageURL,
-			&product.SKU,
-			&product.IsActive,
-			&product.CreatedAt,
-			&product.UpdatedAt,
-			&product.CreatedBy,
-		)
+		product, err := scanProduct(rows)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to scan product: %w", err)
 		}
@@ -393,37 +883,233 @@ func (r *productRepository) Search(filter *models.SearchFilter, pagination *mode
 	return products, paginationResponse, nil
 }
 
-func (r *productRepository) GetCategories() ([]*models.Category, error) {
-	query := `
-		SELECT id, name, description, parent_id, is_active, created_at, updated_at
-		FROM categories
-		WHERE is_active = true
-		ORDER BY name
-	`
+func (r *productRepository) ListByIDs(storeID string, ids []string) ([]*models.Product, error) {
+	query := productSelect + ` WHERE p.id = ANY($1::uuid[]) AND p.store_id = $2 AND p.deleted_at IS NULL`
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.Query(query, pq.Array(ids), storeID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get categories: %w", err)
+		return nil, fmt.Errorf("failed to get products by ID: %w", err)
 	}
+	return scanProducts(rows)
+}
+
+// ListBySKUs includes products in the trash, since they still hold their SKU
+func (r *productRepository) ListBySKUs(storeID string, skus []string) ([]*models.Product, error) {
+	query := productSelect + ` WHERE p.sku = ANY($1) AND p.store_id = $2`
+
+	rows, err := r.db.Query(query, pq.Array(skus), storeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products by SKU: %w", err)
+	}
+	return scanProducts(rows)
+}
+
+func scanProducts(rows *sql.Rows) ([]*models.Product, error) {
 	defer rows.Close()
 
-	var categories []*models.Category
+	var products []*models.Product
 	for rows.Next() {
-		category := &models.Category{}
-		err := rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Description,
-			&category.ParentID,
-			&category.IsActive,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product: %w", err)
+		}
+		products = append(products, product)
+	}
+
+	return products, rows.Err()
+}
+
+// BatchUpdate applies patches in one transaction. Patches that already carry
+// an error are skipped; the rest each run under a savepoint so one failing
+// patch is reported in its Err without undoing the others.
+func (r *productRepository) BatchUpdate(storeID string, patches []*models.ProductPatch, updatedBy string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, patch := range patches {
+		if patch.Err != nil {
+			continue
+		}
+
+		if _, err := tx.Exec(`SAVEPOINT batch_item`); err != nil {
+			return fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		if patch.Err = applyPatch(tx, storeID, patch, updatedBy); patch.Err != nil {
+			if _, err := tx.Exec(`ROLLBACK TO SAVEPOINT batch_item`); err != nil {
+				return fmt.Errorf("failed to roll back %s: %w", patch.ID, err)
+			}
+			continue
+		}
+
+		if _, err := tx.Exec(`RELEASE SAVEPOINT batch_item`); err != nil {
+			return fmt.Errorf("failed to release savepoint: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit batch update: %w", err)
+	}
+
+	return nil
+}
+
+func applyPatch(tx *sql.Tx, storeID string, patch *models.ProductPatch, updatedBy string) error {
+	product, err := scanProduct(tx.QueryRow(productSelect+` WHERE p.id = $1 AND p.store_id = $2 AND p.deleted_at IS NULL FOR UPDATE OF p`, patch.ID, storeID))
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("product not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+	if patch.Version != nil && *patch.Version != product.Version {
+		return ErrVersionConflict
+	}
+
+	if patch.Price != nil || patch.IsActive != nil {
+		before := product.Snapshot()
+		if patch.Price != nil {
+			if patch.Price.Currency == "" {
+				patch.Price.Currency = product.Currency
+			}
+			if patch.Price.Currency != product.Currency {
+				return fmt.Errorf("price must be in %s, the product's currency", product.Currency)
+			}
+			if err := patch.Price.Validate(); err != nil {
+				return fmt.Errorf("price: %w", err)
+			}
+			product.Price = *patch.Price
+		}
+		if patch.IsActive != nil {
+			product.IsActive = *patch.IsActive
+		}
+
+		query := `
+			UPDATE products
+			SET price = $1, is_active = $2, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $3
+			RETURNING version
+		`
+		if err := tx.QueryRow(query, product.Price, product.IsActive, patch.ID).Scan(&product.Version); err != nil {
+			return fmt.Errorf("failed to update product: %w", err)
+		}
+
+		after := product.Snapshot()
+		if changes := after.Diff(before); len(changes) > 0 {
+			revision := &models.ProductRevision{
+				Action:   models.RevisionUpdate,
+				Actor:    updatedBy,
+				Changes:  changes,
+				Snapshot: after,
+			}
+			if err := recordRevision(tx, revision, patch.ID, product.Version); err != nil {
+				return err
+			}
+		}
+	}
+
+	if patch.StockQuantity != nil {
+		change, err := setStock(tx, patch.ID, nil, *patch.StockQuantity, models.MovementManualSet, updatedBy)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan category: %w", err)
+			return err
 		}
-		categories = append(categories, category)
+		patch.Change = change
 	}
 
-	return categories, nil
-}	
\ No newline at end of file
+	return nil
+}
+
+// ImportBatch writes a batch of imported products in one transaction. Each
+// row runs under its own savepoint, so a row that fails is reported in its
+// Err without losing the rest of the batch.
+func (r *productRepository) ImportBatch(items []*models.ImportItem) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, item := range items {
+		if _, err := tx.Exec(`SAVEPOINT import_row`); err != nil {
+			return fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		if item.Err = importItem(tx, item); item.Err != nil {
+			if _, err := tx.Exec(`ROLLBACK TO SAVEPOINT import_row`); err != nil {
+				return fmt.Errorf("failed to roll back row %d: %w", item.Row, err)
+			}
+			continue
+		}
+
+		if _, err := tx.Exec(`RELEASE SAVEPOINT import_row`); err != nil {
+			return fmt.Errorf("failed to release savepoint: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit import batch: %w", err)
+	}
+
+	return nil
+}
+
+func importItem(tx *sql.Tx, item *models.ImportItem) error {
+	product := item.Product
+	if !item.Update {
+		if err := insertProduct(tx, product); err != nil {
+			return err
+		}
+		return recordRevision(tx, item.Revision, product.ID, product.Version)
+	}
+
+	if err := updateProduct(tx, product); err != nil {
+		return err
+	}
+	if err := recordRevision(tx, item.Revision, product.ID, product.Version); err != nil {
+		return err
+	}
+
+	change, err := setStock(tx, product.ID, nil, product.StockQuantity, models.MovementImport, item.ImportedBy)
+	if err != nil {
+		return err
+	}
+	item.Change = change
+	product.ReservedQuantity = change.After.ReservedQuantity
+	return nil
+}
+
+// Export calls fn for every product matching the filter, oldest first,
+// without loading the whole catalog into memory
+func (r *productRepository) Export(filter *models.ProductFilter, fn func(*models.Product) error) error {
+	conditions, args, err := productFilterConditions(filter)
+	if err != nil {
+		return err
+	}
+
+	query := productSelect
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY p.created_at, p.id"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to export products: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan product: %w", err)
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
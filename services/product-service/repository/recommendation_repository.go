@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// RecommendationRepository stores product views and the scores computed
+// from them. Reads only ever return active products that aren't in the
+// trash, and only products of the store asked for.
+type RecommendationRepository interface {
+	RecordView(storeID string, view *models.ProductView, dedupeWindow time.Duration) error
+	Recompute(since time.Time, maxPerViewer, maxRelated int) (bool, error)
+	Related(storeID string, productIDs, excludeIDs []string, limit int) ([]string, error)
+	PopularInCategories(storeID string, categoryIDs, excludeIDs []string, limit int) ([]string, error)
+	Popular(storeID string, excludeIDs []string, limit int) ([]string, error)
+	RecentlyViewed(storeID, userID string, limit int) ([]string, error)
+}
+
+type recommendationRepository struct {
+	db *sql.DB
+}
+
+func NewRecommendationRepository(db *sql.DB) RecommendationRepository {
+	return &recommendationRepository{db: db}
+}
+
+// RecordView stores a view, unless the same viewer already viewed the
+// product within dedupeWindow, so reloading a page doesn't count twice.
+// Views of another store's products are dropped.
+func (r *recommendationRepository) RecordView(storeID string, view *models.ProductView, dedupeWindow time.Duration) error {
+	_, err := r.db.Exec(`
+		INSERT INTO product_views (product_id, viewer, user_id)
+		SELECT $1::uuid, $2::varchar, $3::varchar
+		WHERE EXISTS (SELECT 1 FROM products WHERE id = $1::uuid AND store_id = $5::uuid)
+		  AND ($2::varchar = '' OR NOT EXISTS (
+			SELECT 1 FROM product_views
+			WHERE viewer = $2::varchar AND product_id = $1::uuid
+			  AND viewed_at > CURRENT_TIMESTAMP - make_interval(secs => $4::float8)
+		  ))
+	`, view.ProductID, view.Viewer(), view.UserID, dedupeWindow.Seconds(), storeID)
+	if err != nil {
+		return fmt.Errorf("failed to record product view: %w", err)
+	}
+	return nil
+}
+
+// Recompute rebuilds co-occurrence and popularity from the views since the
+// given time, and drops older views. Two products co-occur when one viewer
+// viewed both, counting each viewer's maxPerViewer most recent products;
+// the score divides the shared viewers by the geometric mean of each
+// product's viewers, so merely popular products don't crowd out related
+// ones. Each product keeps its maxRelated best. It reports false without
+// doing anything when another instance is already recomputing.
+func (r *recommendationRepository) Recompute(since time.Time, maxPerViewer, maxRelated int) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRow(`SELECT pg_try_advisory_xact_lock(hashtext('product_recommendations'))`).Scan(&locked); err != nil {
+		return false, fmt.Errorf("failed to lock recommendations: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(`DELETE FROM product_views WHERE viewed_at < $1`, since); err != nil {
+		return false, fmt.Errorf("failed to prune product views: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM product_cooccurrence`); err != nil {
+		return false, fmt.Errorf("failed to clear co-occurrence: %w", err)
+	}
+	_, err = tx.Exec(`
+		WITH viewed AS (
+			SELECT viewer, product_id, MAX(viewed_at) AS last_viewed_at
+			FROM product_views
+			WHERE viewer <> ''
+			GROUP BY viewer, product_id
+		), recent AS (
+			SELECT viewer, product_id
+			FROM (
+				SELECT viewer, product_id,
+				       ROW_NUMBER() OVER (PARTITION BY viewer ORDER BY last_viewed_at DESC) AS n
+				FROM viewed
+			) v
+			WHERE n <= $1
+		), viewers AS (
+			SELECT product_id, COUNT(*) AS viewers FROM recent GROUP BY product_id
+		), pairs AS (
+			SELECT a.product_id, b.product_id AS related_id, COUNT(*) AS viewers
+			FROM recent a
+			JOIN recent b ON b.viewer = a.viewer AND b.product_id <> a.product_id
+			GROUP BY a.product_id, b.product_id
+		), scored AS (
+			SELECT p.product_id, p.related_id, p.viewers,
+			       p.viewers / SQRT(va.viewers::float8 * vb.viewers) AS score
+			FROM pairs p
+			JOIN viewers va ON va.product_id = p.product_id
+			JOIN viewers vb ON vb.product_id = p.related_id
+		)
+		INSERT INTO product_cooccurrence (product_id, related_id, viewers, score)
+		SELECT product_id, related_id, viewers, score
+		FROM (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY product_id ORDER BY score DESC, viewers DESC, related_id) AS n
+			FROM scored
+		) s
+		WHERE n <= $2
+	`, maxPerViewer, maxRelated)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute co-occurrence: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM product_popularity`); err != nil {
+		return false, fmt.Errorf("failed to clear popularity: %w", err)
+	}
+	_, err = tx.Exec(`
+		INSERT INTO product_popularity (product_id, views, viewers)
+		SELECT product_id, COUNT(*), COUNT(DISTINCT NULLIF(viewer, ''))
+		FROM product_views
+		GROUP BY product_id
+	`)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute popularity: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit recommendations: %w", err)
+	}
+	return true, nil
+}
+
+// Related returns the products most often viewed together with any of the
+// given ones, adding up their scores, best first
+func (r *recommendationRepository) Related(storeID string, productIDs, excludeIDs []string, limit int) ([]string, error) {
+	return r.queryIDs(`
+		SELECT c.related_id
+		FROM product_cooccurrence c
+		JOIN products p ON p.id = c.related_id
+		WHERE c.product_id = ANY($1::uuid[]) AND NOT c.related_id = ANY($2::uuid[])
+		  AND p.store_id = $4 AND p.is_active AND p.deleted_at IS NULL
+		GROUP BY c.related_id
+		ORDER BY SUM(c.score) DESC, SUM(c.viewers) DESC, c.related_id
+		LIMIT $3
+	`, pq.Array(productIDs), pq.Array(excludeIDs), limit, storeID)
+}
+
+// PopularInCategories returns the most viewed products in the categories,
+// then the newest ones that haven't been viewed
+func (r *recommendationRepository) PopularInCategories(storeID string, categoryIDs, excludeIDs []string, limit int) ([]string, error) {
+	return r.queryIDs(`
+		SELECT p.id
+		FROM products p
+		LEFT JOIN product_popularity pp ON pp.product_id = p.id
+		WHERE p.category_id = ANY($1::uuid[]) AND NOT p.id = ANY($2::uuid[])
+		  AND p.store_id = $4 AND p.is_active AND p.deleted_at IS NULL
+		ORDER BY pp.views DESC NULLS LAST, p.created_at DESC, p.id
+		LIMIT $3
+	`, pq.Array(categoryIDs), pq.Array(excludeIDs), limit, storeID)
+}
+
+// Popular returns the most viewed products, then the newest ones that
+// haven't been viewed, so a new store still has something to show
+func (r *recommendationRepository) Popular(storeID string, excludeIDs []string, limit int) ([]string, error) {
+	return r.queryIDs(`
+		SELECT p.id
+		FROM products p
+		LEFT JOIN product_popularity pp ON pp.product_id = p.id
+		WHERE NOT p.id = ANY($1::uuid[]) AND p.store_id = $3
+		  AND p.is_active AND p.deleted_at IS NULL
+		ORDER BY pp.views DESC NULLS LAST, p.created_at DESC, p.id
+		LIMIT $2
+	`, pq.Array(excludeIDs), limit, storeID)
+}
+
+// RecentlyViewed returns the products of the store the user viewed, latest
+// first
+func (r *recommendationRepository) RecentlyViewed(storeID, userID string, limit int) ([]string, error) {
+	return r.queryIDs(`
+		SELECT v.product_id
+		FROM product_views v
+		JOIN products p ON p.id = v.product_id
+		WHERE v.user_id = $1 AND p.store_id = $3
+		GROUP BY v.product_id
+		ORDER BY MAX(v.viewed_at) DESC
+		LIMIT $2
+	`, userID, limit, storeID)
+}
+
+func (r *recommendationRepository) queryIDs(query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommendations: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan recommendation: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get recommendations: %w", err)
+	}
+	return ids, nil
+}
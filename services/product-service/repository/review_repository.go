@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// ErrDuplicateReview is returned when a user reviews a product they have
+// already reviewed
+var ErrDuplicateReview = errors.New("you have already reviewed this product")
+
+// ReviewRepository stores product reviews. Every write also refreshes the
+// product's rating summary in the same transaction.
+type ReviewRepository interface {
+	Create(review *models.Review) error
+	GetByID(storeID, id string) (*models.Review, error)
+	Update(review *models.Review) error
+	Delete(id string) error
+	List(filter *models.ReviewFilter, pagination *models.PaginationRequest) ([]*models.Review, *models.PaginationResponse, error)
+}
+
+type reviewRepository struct {
+	db *sql.DB
+}
+
+func NewReviewRepository(db *sql.DB) ReviewRepository {
+	return &reviewRepository{db: db}
+}
+
+const reviewColumns = `id, product_id, user_id, author_name, rating, title, body, status, moderated_by, moderation_note, created_at, updated_at`
+
+// reviewSortColumns whitelists the columns reviews can be sorted by
+var reviewSortColumns = map[string]string{
+	"created_at": "created_at",
+	"rating":     "rating",
+}
+
+func scanReview(row rowScanner) (*models.Review, error) {
+	review := &models.Review{}
+	err := row.Scan(
+		&review.ID,
+		&review.ProductID,
+		&review.UserID,
+		&review.AuthorName,
+		&review.Rating,
+		&review.Title,
+		&review.Body,
+		&review.Status,
+		&review.ModeratedBy,
+		&review.ModerationNote,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// refreshRating recomputes the product's rating summary from its approved
+// reviews. It doesn't count as an edit, so the version stays the same.
+func refreshRating(tx *sql.Tx, productID string) error {
+	_, err := tx.Exec(`
+		UPDATE products p
+		SET average_rating = COALESCE(r.average, 0), review_count = r.count
+		FROM (
+			SELECT ROUND(AVG(rating), 2) AS average, COUNT(*) AS count
+			FROM product_reviews
+			WHERE product_id = $1 AND status = 'approved'
+		) r
+		WHERE p.id = $1
+	`, productID)
+	if err != nil {
+		return fmt.Errorf("failed to update product rating: %w", err)
+	}
+	return nil
+}
+
+// Create adds the review, returning ErrDuplicateReview if the user has
+// already reviewed the product
+func (r *reviewRepository) Create(review *models.Review) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO product_reviews (product_id, user_id, author_name, rating, title, body, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + reviewColumns
+
+	created, err := scanReview(tx.QueryRow(
+		query,
+		review.ProductID,
+		review.UserID,
+		review.AuthorName,
+		review.Rating,
+		review.Title,
+		review.Body,
+		review.Status,
+	))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrDuplicateReview
+		}
+		return fmt.Errorf("failed to create review: %w", err)
+	}
+
+	if err := refreshRating(tx, review.ProductID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit review: %w", err)
+	}
+
+	*review = *created
+	return nil
+}
+
+// GetByID finds a review of one of the store's products
+func (r *reviewRepository) GetByID(storeID, id string) (*models.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM product_reviews
+		WHERE id = $1 AND product_id IN (SELECT id FROM products WHERE store_id = $2)`
+	review, err := scanReview(r.db.QueryRow(query, id, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get review: %w", err)
+	}
+
+	return review, nil
+}
+
+// Update saves the review's content and moderation state
+func (r *reviewRepository) Update(review *models.Review) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE product_reviews
+		SET rating = $1, title = $2, body = $3, status = $4, moderated_by = $5, moderation_note = $6,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $7
+		RETURNING ` + reviewColumns
+
+	updated, err := scanReview(tx.QueryRow(
+		query,
+		review.Rating,
+		review.Title,
+		review.Body,
+		review.Status,
+		review.ModeratedBy,
+		review.ModerationNote,
+		review.ID,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("review not found")
+		}
+		return fmt.Errorf("failed to update review: %w", err)
+	}
+
+	if err := refreshRating(tx, updated.ProductID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit review: %w", err)
+	}
+
+	*review = *updated
+	return nil
+}
+
+func (r *reviewRepository) Delete(id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var productID string
+	err = tx.QueryRow(`DELETE FROM product_reviews WHERE id = $1 RETURNING product_id`, id).Scan(&productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("review not found")
+		}
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
+
+	if err := refreshRating(tx, productID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit review deletion: %w", err)
+	}
+
+	return nil
+}
+
+// List pages through reviews, newest first unless the pagination sorts by
+// rating
+func (r *reviewRepository) List(filter *models.ReviewFilter, pagination *models.PaginationRequest) ([]*models.Review, *models.PaginationResponse, error) {
+	if filter.StoreID == "" {
+		return nil, nil, fmt.Errorf("store is required")
+	}
+	conditions := []string{"product_id IN (SELECT id FROM products WHERE store_id = $1)"}
+	args := []interface{}{filter.StoreID}
+	argIndex := 2
+
+	if filter.ProductID != "" {
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", argIndex))
+		args = append(args, filter.ProductID)
+		argIndex++
+	}
+	if filter.Rating > 0 {
+		conditions = append(conditions, fmt.Sprintf("rating = $%d", argIndex))
+		args = append(args, filter.Rating)
+		argIndex++
+	}
+	viewerIndex := 0
+	if len(filter.Statuses) > 0 {
+		visible := fmt.Sprintf("status = ANY($%d)", argIndex)
+		args = append(args, pq.Array(filter.Statuses))
+		argIndex++
+		if filter.ViewerID != "" {
+			viewerIndex = argIndex
+			visible = fmt.Sprintf("(%s OR user_id = $%d)", visible, viewerIndex)
+			args = append(args, filter.ViewerID)
+			argIndex++
+		}
+		conditions = append(conditions, visible)
+	}
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM product_reviews`+where, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	orderBy := "created_at DESC"
+	if sortColumn, ok := reviewSortColumns[pagination.SortBy]; ok {
+		sortOrder := "DESC"
+		if pagination.SortOrder == "asc" {
+			sortOrder = "ASC"
+		}
+		orderBy = fmt.Sprintf("%s %s, created_at DESC", sortColumn, sortOrder)
+	}
+	// The viewer's own review comes first so they can always find it
+	if viewerIndex > 0 {
+		orderBy = fmt.Sprintf("(user_id = $%d) DESC, %s", viewerIndex, orderBy)
+	}
+	orderBy = " ORDER BY " + orderBy
+
+	query := `SELECT ` + reviewColumns + ` FROM product_reviews` + where + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*models.Review
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list reviews: %w", err)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return reviews, paginationResponse, nil
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// RevisionRepository reads the product change history. Revisions are
+// written by the product repository in the same transaction as the change.
+type RevisionRepository interface {
+	GetByID(id string) (*models.ProductRevision, error)
+	ListByProduct(storeID, productID string, pagination *models.PaginationRequest) ([]*models.ProductRevision, *models.PaginationResponse, error)
+}
+
+type revisionRepository struct {
+	db *sql.DB
+}
+
+func NewRevisionRepository(db *sql.DB) RevisionRepository {
+	return &revisionRepository{db: db}
+}
+
+const revisionSelect = `
+	SELECT id, product_id, version, action, actor, changes, snapshot, reverted_to, created_at
+	FROM product_revisions
+`
+
+func scanRevision(row rowScanner) (*models.ProductRevision, error) {
+	revision := &models.ProductRevision{}
+	var changes, snapshot []byte
+
+	err := row.Scan(
+		&revision.ID,
+		&revision.ProductID,
+		&revision.Version,
+		&revision.Action,
+		&revision.Actor,
+		&changes,
+		&snapshot,
+		&revision.RevertedTo,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(changes, &revision.Changes); err != nil {
+		return nil, fmt.Errorf("failed to decode revision changes: %w", err)
+	}
+	if err := json.Unmarshal(snapshot, &revision.Snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode revision snapshot: %w", err)
+	}
+
+	return revision, nil
+}
+
+// insertRevision records a change made in the same transaction
+func insertRevision(q queryer, revision *models.ProductRevision) error {
+	changes := revision.Changes
+	if changes == nil {
+		changes = []models.FieldChange{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision changes: %w", err)
+	}
+	snapshotJSON, err := json.Marshal(revision.Snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision snapshot: %w", err)
+	}
+
+	query := `
+		INSERT INTO product_revisions (product_id, version, action, actor, changes, snapshot, reverted_to)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	err = q.QueryRow(
+		query,
+		revision.ProductID,
+		revision.Version,
+		revision.Action,
+		revision.Actor,
+		changesJSON,
+		snapshotJSON,
+		revision.RevertedTo,
+	).Scan(&revision.ID, &revision.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record product revision: %w", err)
+	}
+
+	return nil
+}
+
+func (r *revisionRepository) GetByID(id string) (*models.ProductRevision, error) {
+	revision, err := scanRevision(r.db.QueryRow(revisionSelect+` WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// ListByProduct lists a product's revisions, newest first. A product of
+// another store has none.
+func (r *revisionRepository) ListByProduct(storeID, productID string, pagination *models.PaginationRequest) ([]*models.ProductRevision, *models.PaginationResponse, error) {
+	where := ` WHERE product_id = $1 AND EXISTS (SELECT 1 FROM products p WHERE p.id = product_id AND p.store_id = $2)`
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM product_revisions`+where, productID, storeID).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count revisions: %w", err)
+	}
+
+	query := revisionSelect + where + ` ORDER BY created_at DESC, version DESC LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.Query(query, productID, storeID, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*models.ProductRevision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return revisions, paginationResponse, nil
+}
@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// openTestDB connects to TEST_DATABASE_URL and brings its schema up to
+// date; tests that need a database are skipped without one
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := Migrate(db); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+func newTestUUID(t *testing.T) string {
+	t.Helper()
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("failed to generate UUID: %v", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// storeFixture is what one store of the isolation tests holds. Both stores
+// use the same SKUs and slugs, which only have to be unique per store.
+type storeFixture struct {
+	storeID string
+	root    *models.Category
+	child   *models.Category
+	product *models.Product
+	variant *models.ProductVariant
+}
+
+func seedStore(t *testing.T, db *sql.DB, run string) *storeFixture {
+	t.Helper()
+	f := &storeFixture{storeID: newTestUUID(t)}
+	storeID := f.storeID
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM stock_reservations WHERE store_id = $1`, storeID)
+		db.Exec(`DELETE FROM products WHERE store_id = $1`, storeID)
+		db.Exec(`DELETE FROM categories WHERE store_id = $1 AND parent_id IS NOT NULL`, storeID)
+		db.Exec(`DELETE FROM categories WHERE store_id = $1`, storeID)
+	})
+
+	categories := NewCategoryRepository(db)
+	f.root = &models.Category{Name: "Isolation " + run, Slug: "isolation-" + run, IsActive: true, StoreID: storeID}
+	if err := categories.Create(f.root); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+	f.child = &models.Category{Name: "Child " + run, Slug: "isolation-child-" + run, ParentID: &f.root.ID, IsActive: true, StoreID: storeID}
+	if err := categories.Create(f.child); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+
+	price, err := models.ParseMoney("10.00", "USD")
+	if err != nil {
+		t.Fatalf("ParseMoney: %v", err)
+	}
+	f.product = &models.Product{
+		Name:          "Isolation product " + run,
+		Price:         price,
+		StockQuantity: 10,
+		CategoryID:    &f.child.ID,
+		SKU:           "ISO-" + run,
+		IsActive:      true,
+		CreatedBy:     "isolation-test",
+		Currency:      "USD",
+		StoreID:       storeID,
+	}
+	if err := NewProductRepository(db).Create(f.product, nil); err != nil {
+		t.Fatalf("create product: %v", err)
+	}
+
+	variants := NewVariantRepository(db)
+	if err := variants.ReplaceOptions(f.product.ID, []*models.ProductOption{{Name: "Color", Values: []string{"Red"}}}); err != nil {
+		t.Fatalf("set options: %v", err)
+	}
+	f.variant = &models.ProductVariant{
+		ProductID:     f.product.ID,
+		SKU:           "ISO-" + run + "-RED",
+		OptionValues:  map[string]string{"Color": "Red"},
+		StockQuantity: 5,
+		IsActive:      true,
+	}
+	if err := variants.Create(f.variant); err != nil {
+		t.Fatalf("create variant: %v", err)
+	}
+
+	return f
+}
+
+func productIDs(products []*models.Product) map[string]bool {
+	ids := make(map[string]bool)
+	for _, product := range products {
+		ids[product.ID] = true
+	}
+	return ids
+}
+
+// TestStoreIsolation checks that nothing read or written for one store
+// touches another's data. It needs a database; set TEST_DATABASE_URL to
+// run it.
+func TestStoreIsolation(t *testing.T) {
+	db := openTestDB(t)
+	run := strings.ToLower(newTestUUID(t)[:8])
+	a, b := seedStore(t, db, run), seedStore(t, db, run)
+
+	products := NewProductRepository(db)
+	page := &models.PaginationRequest{Page: 1, Limit: 100}
+
+	t.Run("GetByID", func(t *testing.T) {
+		product, err := products.GetByID(a.storeID, b.product.ID)
+		if err != nil || product != nil {
+			t.Errorf("got %v, %v for another store's product, want nothing", product, err)
+		}
+		product, err = products.GetByID(a.storeID, a.product.ID)
+		if err != nil || product == nil || product.StoreID != a.storeID {
+			t.Errorf("got %v, %v for the store's own product", product, err)
+		}
+	})
+
+	t.Run("GetBySKU", func(t *testing.T) {
+		for _, f := range []*storeFixture{a, b} {
+			product, err := products.GetBySKU(f.storeID, f.product.SKU)
+			if err != nil || product == nil || product.ID != f.product.ID {
+				t.Errorf("SKU %s in store %s: got %v, %v, want %s", f.product.SKU, f.storeID, product, err, f.product.ID)
+			}
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		listed, _, err := products.List(&models.ProductFilter{StoreID: a.storeID, CreatedBy: "isolation-test"}, page)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if ids := productIDs(listed); !ids[a.product.ID] || ids[b.product.ID] {
+			t.Errorf("List returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		found, _, err := products.Search(&models.SearchFilter{StoreID: a.storeID, Query: "Isolation product " + run}, page)
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		if ids := productIDs(found); len(ids) != 1 || !ids[a.product.ID] {
+			t.Errorf("Search returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("ListByIDs", func(t *testing.T) {
+		listed, err := products.ListByIDs(a.storeID, []string{a.product.ID, b.product.ID})
+		if err != nil {
+			t.Fatalf("ListByIDs: %v", err)
+		}
+		if ids := productIDs(listed); len(ids) != 1 || !ids[a.product.ID] {
+			t.Errorf("ListByIDs returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("ListBySKUs", func(t *testing.T) {
+		listed, err := products.ListBySKUs(a.storeID, []string{a.product.SKU})
+		if err != nil {
+			t.Fatalf("ListBySKUs: %v", err)
+		}
+		if ids := productIDs(listed); len(ids) != 1 || !ids[a.product.ID] {
+			t.Errorf("ListBySKUs returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("BatchUpdate", func(t *testing.T) {
+		price, _ := models.ParseMoney("1.00", "USD")
+		patch := &models.ProductPatch{ID: b.product.ID, Price: &price}
+		if err := products.BatchUpdate(a.storeID, []*models.ProductPatch{patch}, "isolation-test"); err != nil {
+			t.Fatalf("BatchUpdate: %v", err)
+		}
+		if patch.Err == nil {
+			t.Error("patching another store's product succeeded")
+		}
+		product, err := products.GetByID(b.storeID, b.product.ID)
+		if err != nil || product == nil || product.Price.Cmp(b.product.Price) != 0 {
+			t.Errorf("store B's product is now %v, %v, want it unchanged", product, err)
+		}
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		var exported []*models.Product
+		err := products.Export(&models.ProductFilter{StoreID: a.storeID, CreatedBy: "isolation-test"}, func(product *models.Product) error {
+			exported = append(exported, product)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Export: %v", err)
+		}
+		if ids := productIDs(exported); !ids[a.product.ID] || ids[b.product.ID] {
+			t.Errorf("Export returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("Categories", func(t *testing.T) {
+		categories := NewCategoryRepository(db)
+
+		listed, err := categories.List(a.storeID, true)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		for _, category := range listed {
+			if category.StoreID != a.storeID {
+				t.Errorf("List returned category %s of store %s", category.ID, category.StoreID)
+			}
+		}
+
+		subtree, err := categories.ListSubtree(a.storeID, b.root.ID, true)
+		if err != nil || len(subtree) != 0 {
+			t.Errorf("ListSubtree of another store's root returned %d categories, %v", len(subtree), err)
+		}
+		subtree, err = categories.ListSubtree(a.storeID, a.root.ID, true)
+		if err != nil || len(subtree) != 2 {
+			t.Errorf("ListSubtree of the store's own root returned %d categories, %v, want 2", len(subtree), err)
+		}
+
+		if category, err := categories.GetBySlug(a.storeID, b.root.Slug); err != nil || category == nil || category.ID != a.root.ID {
+			t.Errorf("GetBySlug returned %v, %v, want store A's category", category, err)
+		}
+		if count, err := categories.CountProducts(a.storeID, b.root.ID); err != nil || count != 0 {
+			t.Errorf("CountProducts of another store's category returned %d, %v", count, err)
+		}
+	})
+
+	t.Run("Variants", func(t *testing.T) {
+		variants := NewVariantRepository(db)
+
+		// Both stores hold the same variant SKU
+		if exists, err := variants.SKUExists(a.storeID, a.variant.SKU, ""); err != nil || !exists {
+			t.Errorf("SKUExists in store A returned %v, %v, want true", exists, err)
+		}
+		if exists, err := variants.SKUExists(newTestUUID(t), a.variant.SKU, ""); err != nil || exists {
+			t.Errorf("SKUExists in an empty store returned %v, %v, want false", exists, err)
+		}
+		if exists, err := variants.SKUExists(newTestUUID(t), a.product.SKU, ""); err != nil || exists {
+			t.Errorf("SKUExists of a product SKU in an empty store returned %v, %v, want false", exists, err)
+		}
+	})
+
+	t.Run("Reservations", func(t *testing.T) {
+		inventory := NewInventoryRepository(db)
+		item := func(f *storeFixture) *models.ReservationItem {
+			return &models.ReservationItem{ProductID: f.product.ID, VariantID: &f.variant.ID, Quantity: 1}
+		}
+
+		_, _, err := inventory.Reserve(&models.ReserveStockRequest{
+			StoreID: a.storeID, Reference: "isolation", TTL: time.Minute, Items: []*models.ReservationItem{item(b)},
+		})
+		if err == nil {
+			t.Error("reserving another store's stock succeeded")
+		}
+
+		reservation, _, err := inventory.Reserve(&models.ReserveStockRequest{
+			StoreID: a.storeID, Reference: "isolation", TTL: time.Minute, Items: []*models.ReservationItem{item(a)},
+		})
+		if err != nil {
+			t.Fatalf("Reserve: %v", err)
+		}
+
+		if got, err := inventory.GetReservation(b.storeID, reservation.ID); err != nil || got != nil {
+			t.Errorf("GetReservation from another store returned %v, %v, want nothing", got, err)
+		}
+		if _, err := inventory.Commit(b.storeID, reservation.ID); err == nil {
+			t.Error("committing from another store succeeded")
+		}
+		if _, err := inventory.Release(b.storeID, reservation.ID); err == nil {
+			t.Error("releasing from another store succeeded")
+		}
+
+		got, err := inventory.GetReservation(a.storeID, reservation.ID)
+		if err != nil || got == nil || got.Status != models.ReservationActive {
+			t.Fatalf("reservation is %v, %v, want it still active", got, err)
+		}
+		if _, err := inventory.Release(a.storeID, reservation.ID); err != nil {
+			t.Errorf("Release in the reservation's own store: %v", err)
+		}
+	})
+
+	t.Run("Wishlists", func(t *testing.T) {
+		wishlists := NewWishlistRepository(db)
+		userID := newTestUUID(t)
+		t.Cleanup(func() { db.Exec(`DELETE FROM wishlist_items WHERE user_id = $1`, userID) })
+
+		// Each store's wishlist has room for one product; store A's doesn't
+		// take store B's place
+		for _, f := range []*storeFixture{a, b} {
+			if _, err := wishlists.Add(userID, f.product.ID, 1); err != nil {
+				t.Fatalf("Add in store %s: %v", f.storeID, err)
+			}
+		}
+
+		wishlist, err := wishlists.Get(a.storeID, userID)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if len(wishlist.Items) != 1 || wishlist.Items[0].ProductID != a.product.ID {
+			t.Errorf("store A's wishlist holds %d items, want only its own product", len(wishlist.Items))
+		}
+
+		if removed, err := wishlists.Remove(a.storeID, userID, b.product.ID); err != nil || removed {
+			t.Errorf("removing another store's product returned %v, %v", removed, err)
+		}
+		if wishlist, err := wishlists.Get(b.storeID, userID); err != nil || len(wishlist.Items) != 1 {
+			t.Errorf("store B's wishlist is %v, %v, want its product still there", wishlist, err)
+		}
+	})
+}
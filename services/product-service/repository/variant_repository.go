@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+type VariantRepository interface {
+	ListOptions(productID string) ([]*models.ProductOption, error)
+	ReplaceOptions(productID string, options []*models.ProductOption) error
+	Create(variant *models.ProductVariant) error
+	GetByID(id string) (*models.ProductVariant, error)
+	Update(variant *models.ProductVariant) error
+	Delete(id string) error
+	ListByProduct(productID string, activeOnly bool) ([]*models.ProductVariant, error)
+	SKUExists(storeID, sku, excludeID string) (bool, error)
+}
+
+type variantRepository struct {
+	db *sql.DB
+}
+
+func NewVariantRepository(db *sql.DB) VariantRepository {
+	return &variantRepository{db: db}
+}
+
+const variantSelect = `
+	SELECT v.id, v.product_id, v.sku, v.option_values, v.price, v.stock_quantity, v.is_active, v.created_at, v.updated_at,
+	       (SELECT COALESCE(SUM(i.quantity), 0)
+	        FROM stock_reservation_items i
+	        JOIN stock_reservations r ON r.id = i.reservation_id
+	        WHERE i.product_id = v.product_id AND i.variant_id = v.id AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP),
+	       (SELECT p.currency FROM products p WHERE p.id = v.product_id)
+	FROM product_variants v
+`
+
+func scanVariant(row rowScanner) (*models.ProductVariant, error) {
+	variant := &models.ProductVariant{}
+	var optionValues []byte
+	var price sql.NullString
+	var currency string
+
+	err := row.Scan(
+		&variant.ID,
+		&variant.ProductID,
+		&variant.SKU,
+		&optionValues,
+		&price,
+		&variant.StockQuantity,
+		&variant.IsActive,
+		&variant.CreatedAt,
+		&variant.UpdatedAt,
+		&variant.ReservedQuantity,
+		&currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(optionValues, &variant.OptionValues); err != nil {
+		return nil, fmt.Errorf("failed to decode option values: %w", err)
+	}
+	if price.Valid {
+		amount, err := models.ParseMoney(price.String, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode variant price: %w", err)
+		}
+		variant.Price = &amount
+	}
+
+	return variant, nil
+}
+
+func (r *variantRepository) ListOptions(productID string) ([]*models.ProductOption, error) {
+	query := `
+		SELECT id, product_id, name, option_values, position
+		FROM product_options
+		WHERE product_id = $1
+		ORDER BY position, name
+	`
+
+	rows, err := r.db.Query(query, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product options: %w", err)
+	}
+	defer rows.Close()
+
+	var options []*models.ProductOption
+	for rows.Next() {
+		option := &models.ProductOption{}
+		var values []byte
+		if err := rows.Scan(&option.ID, &option.ProductID, &option.Name, &values, &option.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan product option: %w", err)
+		}
+		if err := json.Unmarshal(values, &option.Values); err != nil {
+			return nil, fmt.Errorf("failed to decode option values: %w", err)
+		}
+		options = append(options, option)
+	}
+
+	return options, nil
+}
+
+// ReplaceOptions swaps the product's whole option set in one transaction
+func (r *variantRepository) ReplaceOptions(productID string, options []*models.ProductOption) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM product_options WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to clear product options: %w", err)
+	}
+
+	query := `
+		INSERT INTO product_options (product_id, name, option_values, position)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	for _, option := range options {
+		values, err := json.Marshal(option.Values)
+		if err != nil {
+			return fmt.Errorf("failed to encode option values: %w", err)
+		}
+
+		option.ProductID = productID
+		if err := tx.QueryRow(query, productID, option.Name, values, option.Position).Scan(&option.ID); err != nil {
+			return fmt.Errorf("failed to create product option: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product options: %w", err)
+	}
+
+	return nil
+}
+
+func (r *variantRepository) Create(variant *models.ProductVariant) error {
+	optionValues, err := json.Marshal(variant.OptionValues)
+	if err != nil {
+		return fmt.Errorf("failed to encode option values: %w", err)
+	}
+
+	// The opening stock goes into the ledger in the same statement
+	query := `
+		WITH inserted AS (
+			INSERT INTO product_variants (product_id, store_id, sku, option_values, price, stock_quantity, is_active)
+			VALUES ($1, (SELECT store_id FROM products WHERE id = $1), $2, $3, $4, $5, $6)
+			RETURNING id, product_id, stock_quantity, created_at, updated_at
+		), movement AS (
+			INSERT INTO stock_movements (product_id, variant_id, delta, quantity_after, reason)
+			SELECT product_id, id, stock_quantity, stock_quantity, 'initial' FROM inserted WHERE stock_quantity > 0
+		)
+		SELECT id, created_at, updated_at FROM inserted
+	`
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(
+		query,
+		variant.ProductID,
+		variant.SKU,
+		optionValues,
+		variant.Price,
+		variant.StockQuantity,
+		variant.IsActive,
+	).Scan(&variant.ID, &variant.CreatedAt, &variant.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create variant: %w", err)
+	}
+
+	if variant.StockQuantity > 0 {
+		err := recordEvent(tx, events.StockChanged(&models.StockMovement{
+			ProductID:     variant.ProductID,
+			VariantID:     &variant.ID,
+			Delta:         variant.StockQuantity,
+			QuantityAfter: variant.StockQuantity,
+			Reason:        models.MovementInitial,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit variant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *variantRepository) GetByID(id string) (*models.ProductVariant, error) {
+	variant, err := scanVariant(r.db.QueryRow(variantSelect+` WHERE v.id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get variant by ID: %w", err)
+	}
+
+	return variant, nil
+}
+
+func (r *variantRepository) Update(variant *models.ProductVariant) error {
+	optionValues, err := json.Marshal(variant.OptionValues)
+	if err != nil {
+		return fmt.Errorf("failed to encode option values: %w", err)
+	}
+
+	query := `
+		UPDATE product_variants
+		SET sku = $1, option_values = $2, price = $3, is_active = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5
+		RETURNING updated_at
+	`
+
+	err = r.db.QueryRow(
+		query,
+		variant.SKU,
+		optionValues,
+		variant.Price,
+		variant.IsActive,
+		variant.ID,
+	).Scan(&variant.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to update variant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *variantRepository) Delete(id string) error {
+	result, err := r.db.Exec(`DELETE FROM product_variants WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete variant: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("variant not found")
+	}
+
+	return nil
+}
+
+func (r *variantRepository) ListByProduct(productID string, activeOnly bool) ([]*models.ProductVariant, error) {
+	query := variantSelect + ` WHERE v.product_id = $1`
+	if activeOnly {
+		query += ` AND v.is_active = true`
+	}
+	query += ` ORDER BY v.created_at, v.sku`
+
+	rows, err := r.db.Query(query, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variants: %w", err)
+	}
+	defer rows.Close()
+
+	var variants []*models.ProductVariant
+	for rows.Next() {
+		variant, err := scanVariant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan variant: %w", err)
+		}
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+// SKUExists checks variant and product SKUs in a store together, since both
+// are used to identify stock
+func (r *variantRepository) SKUExists(storeID, sku, excludeID string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM product_variants WHERE store_id = $1 AND sku = $2 AND ($3 = '' OR id::text <> $3))
+		    OR EXISTS (SELECT 1 FROM products WHERE store_id = $1 AND sku = $2)
+	`
+
+	var exists bool
+	if err := r.db.QueryRow(query, storeID, sku, excludeID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check variant SKU: %w", err)
+	}
+
+	return exists, nil
+}
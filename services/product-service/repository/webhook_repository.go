@@ -0,0 +1,473 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// WebhookRepository stores webhooks and their delivery log. Deliveries are
+// queued per webhook and event, so an event seen twice is sent once.
+// Webhooks belong to a store and only get that store's events; lookups by
+// store don't find other stores' webhooks or deliveries. ClaimDue and
+// RecordAttempt work across stores for the delivery job.
+type WebhookRepository interface {
+	Create(webhook *models.Webhook) error
+	GetByID(storeID, id string) (*models.Webhook, error)
+	Update(webhook *models.Webhook) error
+	Delete(storeID, id string) error
+	List(storeID string) ([]*models.Webhook, error)
+	Enqueue(storeID, eventID, eventType string, payload []byte) (int64, error)
+	CreateDelivery(delivery *models.WebhookDelivery) error
+	ClaimDue(limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	RecordAttempt(deliveryID string, attempt *models.WebhookAttempt, status string, retryIn time.Duration) error
+	GetDelivery(storeID, id string) (*models.WebhookDelivery, error)
+	ListDeliveries(filter *models.WebhookDeliveryFilter, pagination *models.PaginationRequest) ([]*models.WebhookDelivery, *models.PaginationResponse, error)
+	Requeue(storeID, id string) (bool, error)
+}
+
+type webhookRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+const webhookColumns = `id, store_id, url, event_types, secret, is_active, created_by, created_at, updated_at`
+
+// storeWebhooksCondition matches deliveries of the given store's webhooks
+const storeWebhooksCondition = `webhook_id IN (SELECT id FROM webhooks WHERE store_id = $%d)`
+
+const deliveryColumns = `id, webhook_id, event_id, event_type, status, attempt_count, response_code, last_error, next_attempt_at, delivered_at, created_at`
+
+func scanWebhook(row rowScanner) (*models.Webhook, error) {
+	webhook := &models.Webhook{}
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.StoreID,
+		&webhook.URL,
+		pq.Array(&webhook.EventTypes),
+		&webhook.Secret,
+		&webhook.IsActive,
+		&webhook.CreatedBy,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func scanDelivery(row rowScanner) (*models.WebhookDelivery, error) {
+	delivery := &models.WebhookDelivery{}
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&delivery.Status,
+		&delivery.AttemptCount,
+		&delivery.ResponseCode,
+		&delivery.LastError,
+		&delivery.NextAttemptAt,
+		&delivery.DeliveredAt,
+		&delivery.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *webhookRepository) Create(webhook *models.Webhook) error {
+	query := `
+		INSERT INTO webhooks (url, event_types, secret, is_active, created_by, store_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(
+		query,
+		webhook.URL,
+		pq.Array(webhook.EventTypes),
+		webhook.Secret,
+		webhook.IsActive,
+		webhook.CreatedBy,
+		webhook.StoreID,
+	).Scan(&webhook.ID, &webhook.CreatedAt, &webhook.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) GetByID(storeID, id string) (*models.Webhook, error) {
+	webhook, err := scanWebhook(r.db.QueryRow(`SELECT `+webhookColumns+` FROM webhooks WHERE id = $1 AND store_id = $2`, id, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *webhookRepository) Update(webhook *models.Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET url = $1, event_types = $2, secret = $3, is_active = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5 AND store_id = $6
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		query,
+		webhook.URL,
+		pq.Array(webhook.EventTypes),
+		webhook.Secret,
+		webhook.IsActive,
+		webhook.ID,
+		webhook.StoreID,
+	).Scan(&webhook.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("webhook not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the webhook along with its delivery log
+func (r *webhookRepository) Delete(storeID, id string) error {
+	result, err := r.db.Exec(`DELETE FROM webhooks WHERE id = $1 AND store_id = $2`, id, storeID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("webhook not found")
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) List(storeID string) ([]*models.Webhook, error) {
+	rows, err := r.db.Query(`SELECT `+webhookColumns+` FROM webhooks WHERE store_id = $1 ORDER BY created_at`, storeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*models.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Enqueue queues the event for every active webhook of the store subscribed
+// to its type and returns how many deliveries it queued
+func (r *webhookRepository) Enqueue(storeID, eventID, eventType string, payload []byte) (int64, error) {
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		SELECT id, $1, $2, $3
+		FROM webhooks
+		WHERE store_id = $4 AND is_active AND (cardinality(event_types) = 0 OR $2 = ANY(event_types))
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
+	`
+
+	result, err := r.db.Exec(query, eventID, eventType, payload, storeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+
+	queued, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return queued, nil
+}
+
+// CreateDelivery adds a delivery the caller sends itself; it is not picked
+// up by ClaimDue
+func (r *webhookRepository) CreateDelivery(delivery *models.WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, next_attempt_at)
+		VALUES ($1, $2, $3, $4, NULL)
+		RETURNING id, status, created_at
+	`
+
+	err := r.db.QueryRow(
+		query,
+		delivery.WebhookID,
+		delivery.EventID,
+		delivery.EventType,
+		delivery.Payload,
+	).Scan(&delivery.ID, &delivery.Status, &delivery.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDue takes up to limit pending deliveries that are due, oldest first,
+// with where to send them. Claimed deliveries aren't due again until the
+// lease is up, so a sender that dies mid-attempt only delays them.
+// Deliveries of paused webhooks wait until they are reactivated.
+func (r *webhookRepository) ClaimDue(limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = CURRENT_TIMESTAMP + $2 * INTERVAL '1 second'
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT due.id
+			FROM webhook_deliveries due
+			JOIN webhooks active ON active.id = due.webhook_id
+			WHERE due.status = 'pending' AND due.next_attempt_at <= CURRENT_TIMESTAMP AND active.is_active
+			ORDER BY due.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF due SKIP LOCKED
+		)
+		RETURNING d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.attempt_count, d.created_at, w.url, w.secret
+	`
+
+	rows, err := r.db.Query(query, limit, int64(lease/time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		delivery := &models.WebhookDelivery{Status: models.DeliveryPending}
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.EventID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.AttemptCount,
+			&delivery.CreatedAt,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// RecordAttempt logs an attempt and moves the delivery to status. A pending
+// delivery is tried again after retryIn.
+func (r *webhookRepository) RecordAttempt(deliveryID string, attempt *models.WebhookAttempt, status string, retryIn time.Duration) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
+		INSERT INTO webhook_delivery_attempts (delivery_id, response_code, error, duration_ms)
+		VALUES ($1, $2, $3, $4)
+		RETURNING attempted_at
+	`, deliveryID, attempt.ResponseCode, attempt.Error, attempt.Duration.Milliseconds()).Scan(&attempt.AttemptedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook attempt: %w", err)
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $1,
+		    attempt_count = attempt_count + 1,
+		    response_code = $2,
+		    last_error = $3,
+		    next_attempt_at = CASE WHEN $1 = 'pending' THEN CURRENT_TIMESTAMP + $4 * INTERVAL '1 second' END,
+		    delivered_at = CASE WHEN $1 = 'succeeded' THEN CURRENT_TIMESTAMP END
+		WHERE id = $5
+	`
+	if _, err := tx.Exec(query, status, attempt.ResponseCode, attempt.Error, int64(retryIn/time.Second), deliveryID); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook attempt: %w", err)
+	}
+
+	return nil
+}
+
+// GetDelivery returns a delivery of one of the store's webhooks with its
+// attempts
+func (r *webhookRepository) GetDelivery(storeID, id string) (*models.WebhookDelivery, error) {
+	delivery, err := scanDelivery(r.db.QueryRow(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE id = $1 AND `+fmt.Sprintf(storeWebhooksCondition, 2), id, storeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	if err := r.loadAttempts([]*models.WebhookDelivery{delivery}); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// ListDeliveries lists the deliveries of the store's webhooks newest first,
+// with their attempts
+func (r *webhookRepository) ListDeliveries(filter *models.WebhookDeliveryFilter, pagination *models.PaginationRequest) ([]*models.WebhookDelivery, *models.PaginationResponse, error) {
+	conditions := []string{fmt.Sprintf(storeWebhooksCondition, 1)}
+	args := []interface{}{filter.StoreID}
+	argIndex := 2
+
+	if filter.WebhookID != "" {
+		conditions = append(conditions, fmt.Sprintf("webhook_id = $%d", argIndex))
+		args = append(args, filter.WebhookID)
+		argIndex++
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, filter.Status)
+		argIndex++
+	}
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var totalCount int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM webhook_deliveries`+where, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries` + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	if err := r.loadAttempts(deliveries); err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := int32((totalCount + int64(pagination.Limit) - 1) / int64(pagination.Limit))
+	paginationResponse := &models.PaginationResponse{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+		HasNext:    pagination.Page < totalPages,
+		HasPrev:    pagination.Page > 1,
+	}
+
+	return deliveries, paginationResponse, nil
+}
+
+// loadAttempts attaches each delivery's attempts, oldest first
+func (r *webhookRepository) loadAttempts(deliveries []*models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*models.WebhookDelivery, len(deliveries))
+	ids := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		byID[delivery.ID] = delivery
+		ids = append(ids, delivery.ID)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT delivery_id, response_code, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+		WHERE delivery_id = ANY($1)
+		ORDER BY attempted_at, id
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get webhook attempts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attempt := &models.WebhookAttempt{}
+		var durationMs int64
+		if err := rows.Scan(&attempt.DeliveryID, &attempt.ResponseCode, &attempt.Error, &durationMs, &attempt.AttemptedAt); err != nil {
+			return fmt.Errorf("failed to scan webhook attempt: %w", err)
+		}
+		attempt.Duration = time.Duration(durationMs) * time.Millisecond
+		if delivery := byID[attempt.DeliveryID]; delivery != nil {
+			delivery.Attempts = append(delivery.Attempts, attempt)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to get webhook attempts: %w", err)
+	}
+
+	return nil
+}
+
+// Requeue puts a dead delivery of one of the store's webhooks back in the
+// queue with a fresh set of attempts, reporting false if it isn't dead
+func (r *webhookRepository) Requeue(storeID, id string) (bool, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'pending', attempt_count = 0, next_attempt_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'dead' AND ` + fmt.Sprintf(storeWebhooksCondition, 2)
+
+	result, err := r.db.Exec(query, id, storeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to requeue webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
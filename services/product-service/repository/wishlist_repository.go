@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// ErrWishlistFull is returned when adding to a wishlist that already holds
+// the most products it can
+var ErrWishlistFull = errors.New("your wishlist is full")
+
+// WishlistRepository stores users' saved products. Products are referenced,
+// not copied, so purging a product takes it off every wishlist. A user has
+// one wishlist, and each store sees only its own products on it.
+type WishlistRepository interface {
+	Get(storeID, userID string) (*models.Wishlist, error)
+	Add(userID, productID string, maxItems int) (bool, error)
+	Remove(storeID, userID, productID string) (bool, error)
+	Reorder(userID string, productIDs []string) error
+	SetShareToken(userID, token string) error
+	GetUserByShareToken(token string) (string, error)
+}
+
+type wishlistRepository struct {
+	db *sql.DB
+}
+
+func NewWishlistRepository(db *sql.DB) WishlistRepository {
+	return &wishlistRepository{db: db}
+}
+
+// wishlistItemSelect reads a user's items with whether each product can
+// still be bought. A product with active variants is in stock while any of
+// them has unreserved stock; one without goes by its own stock.
+const wishlistItemSelect = `
+	SELECT w.product_id, w.position, w.created_at,
+	       COALESCE(p.is_active, false) AND p.deleted_at IS NULL,
+	       CASE WHEN EXISTS (SELECT 1 FROM product_variants v WHERE v.product_id = p.id AND v.is_active)
+	       THEN EXISTS (
+	           SELECT 1 FROM product_variants v
+	           WHERE v.product_id = p.id AND v.is_active
+	             AND v.stock_quantity > (
+	                 SELECT COALESCE(SUM(i.quantity), 0)
+	                 FROM stock_reservation_items i
+	                 JOIN stock_reservations r ON r.id = i.reservation_id
+	                 WHERE i.product_id = p.id AND i.variant_id = v.id
+	                   AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP))
+	       ELSE COALESCE(p.stock_quantity, 0) > (
+	           SELECT COALESCE(SUM(i.quantity), 0)
+	           FROM stock_reservation_items i
+	           JOIN stock_reservations r ON r.id = i.reservation_id
+	           WHERE i.product_id = p.id AND i.variant_id IS NULL
+	             AND r.status = 'active' AND r.expires_at > CURRENT_TIMESTAMP)
+	       END
+	FROM wishlist_items w
+	JOIN products p ON p.id = w.product_id
+`
+
+// Get returns the user's wishlist of the store's products, without the
+// products themselves; an empty one if they have never saved anything
+func (r *wishlistRepository) Get(storeID, userID string) (*models.Wishlist, error) {
+	wishlist := &models.Wishlist{UserID: userID}
+
+	err := r.db.QueryRow(`SELECT share_token FROM wishlist_shares WHERE user_id = $1`, userID).Scan(&wishlist.ShareToken)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get wishlist share: %w", err)
+	}
+
+	rows, err := r.db.Query(wishlistItemSelect+` WHERE w.user_id = $1 AND p.store_id = $2 ORDER BY w.position, w.created_at`, userID, storeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &models.WishlistItem{}
+		if err := rows.Scan(&item.ProductID, &item.Position, &item.AddedAt, &item.Available, &item.InStock); err != nil {
+			return nil, fmt.Errorf("failed to scan wishlist item: %w", err)
+		}
+		wishlist.Items = append(wishlist.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// Add puts the product at the end of the wishlist. It reports false if the
+// product was already on it, and returns ErrWishlistFull if the wishlist
+// holds maxItems products of the product's store.
+func (r *wishlistRepository) Add(userID, productID string, maxItems int) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Adds to the same wishlist wait for each other, so two of them can't
+	// both take its last place. A row lock wouldn't do: an empty wishlist
+	// has no rows to lock.
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('wishlist:' || $1))`, userID); err != nil {
+		return false, fmt.Errorf("failed to lock wishlist: %w", err)
+	}
+
+	var saved bool
+	var count int
+	err = tx.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM wishlist_items WHERE user_id = $1 AND product_id = $2),
+		       (SELECT COUNT(*)
+		        FROM wishlist_items w
+		        JOIN products p ON p.id = w.product_id
+		        WHERE w.user_id = $1 AND p.store_id = (SELECT store_id FROM products WHERE id = $2))
+	`, userID, productID).Scan(&saved, &count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check wishlist: %w", err)
+	}
+	if saved {
+		return false, nil
+	}
+	if count >= maxItems {
+		return false, ErrWishlistFull
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO wishlist_items (user_id, product_id, position)
+		SELECT $1, $2, COALESCE(MAX(position) + 1, 0)
+		FROM wishlist_items
+		WHERE user_id = $1
+	`, userID, productID)
+	if err != nil {
+		return false, fmt.Errorf("failed to add to wishlist: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// Remove takes the product off the wishlist, reporting whether it was on it
+func (r *wishlistRepository) Remove(storeID, userID, productID string) (bool, error) {
+	query := `
+		DELETE FROM wishlist_items
+		WHERE user_id = $1 AND product_id = $2
+		  AND product_id IN (SELECT id FROM products WHERE store_id = $3)
+	`
+	result, err := r.db.Exec(query, userID, productID, storeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove from wishlist: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// Reorder assigns positions in the order given; the caller checks that
+// productIDs covers exactly the wishlist's products
+func (r *wishlistRepository) Reorder(userID string, productIDs []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for position, productID := range productIDs {
+		result, err := tx.Exec(`UPDATE wishlist_items SET position = $1 WHERE user_id = $2 AND product_id = $3`, position, userID, productID)
+		if err != nil {
+			return fmt.Errorf("failed to reorder wishlist: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("product %s is not on your wishlist", productID)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetShareToken replaces the wishlist's share token; an empty token stops
+// sharing it
+func (r *wishlistRepository) SetShareToken(userID, token string) error {
+	var err error
+	if token == "" {
+		_, err = r.db.Exec(`DELETE FROM wishlist_shares WHERE user_id = $1`, userID)
+	} else {
+		_, err = r.db.Exec(`
+			INSERT INTO wishlist_shares (user_id, share_token)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET share_token = EXCLUDED.share_token, created_at = CURRENT_TIMESTAMP
+		`, userID, token)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update wishlist share: %w", err)
+	}
+	return nil
+}
+
+// GetUserByShareToken returns whose wishlist the token shares, or "" if it
+// shares none
+func (r *wishlistRepository) GetUserByShareToken(token string) (string, error) {
+	var userID string
+	err := r.db.QueryRow(`SELECT user_id FROM wishlist_shares WHERE share_token = $1`, token).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get wishlist share: %w", err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+type AttributeService interface {
+	SetCategoryAttributes(storeID, categoryID string, definitions []*models.AttributeDefinition) ([]*models.AttributeDefinition, error)
+	GetCategoryAttributes(storeID, categoryID string, includeInherited bool) ([]*models.AttributeDefinition, error)
+}
+
+type attributeService struct {
+	attributeRepo repository.AttributeRepository
+	categoryRepo  repository.CategoryRepository
+}
+
+func NewAttributeService(attributeRepo repository.AttributeRepository, categoryRepo repository.CategoryRepository) AttributeService {
+	return &attributeService{
+		attributeRepo: attributeRepo,
+		categoryRepo:  categoryRepo,
+	}
+}
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+func (s *attributeService) SetCategoryAttributes(storeID, categoryID string, definitions []*models.AttributeDefinition) ([]*models.AttributeDefinition, error) {
+	category, err := s.getCategory(storeID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for i, definition := range definitions {
+		definition.Key = strings.TrimSpace(definition.Key)
+		if !attributeKeyPattern.MatchString(definition.Key) {
+			return nil, fmt.Errorf("invalid attribute key %q: use lowercase letters, digits and underscores", definition.Key)
+		}
+		if seen[definition.Key] {
+			return nil, fmt.Errorf("duplicate attribute key %q", definition.Key)
+		}
+		seen[definition.Key] = true
+
+		definition.Label = strings.TrimSpace(definition.Label)
+		if definition.Label == "" {
+			definition.Label = definition.Key
+		}
+
+		switch definition.Type {
+		case models.AttributeTypeString, models.AttributeTypeNumber, models.AttributeTypeBool:
+			definition.EnumValues = []string{}
+		case models.AttributeTypeEnum:
+			values, err := normalizeOptionValues(definition.Key, definition.EnumValues)
+			if err != nil {
+				return nil, err
+			}
+			definition.EnumValues = values
+		default:
+			return nil, fmt.Errorf("attribute %q has invalid type %q", definition.Key, definition.Type)
+		}
+
+		definition.Position = int32(i)
+	}
+
+	if err := s.attributeRepo.Replace(category.ID, definitions); err != nil {
+		return nil, fmt.Errorf("failed to set category attributes: %w", err)
+	}
+
+	return definitions, nil
+}
+
+func (s *attributeService) GetCategoryAttributes(storeID, categoryID string, includeInherited bool) ([]*models.AttributeDefinition, error) {
+	category, err := s.getCategory(storeID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []*models.AttributeDefinition
+	if includeInherited {
+		definitions, err = s.attributeRepo.ListInherited(category.ID)
+	} else {
+		definitions, err = s.attributeRepo.ListByCategory(category.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category attributes: %w", err)
+	}
+
+	return definitions, nil
+}
+
+func (s *attributeService) getCategory(storeID, categoryID string) (*models.Category, error) {
+	if categoryID == "" {
+		return nil, fmt.Errorf("category ID is required")
+	}
+
+	category, err := resolveCategory(s.categoryRepo, storeID, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	if category == nil {
+		return nil, fmt.Errorf("category not found")
+	}
+
+	return category, nil
+}
+
+// buildAttributes parses raw attribute values against the schema, rejecting
+// unknown keys, missing required attributes and values of the wrong type
+func buildAttributes(definitions []*models.AttributeDefinition, raw map[string]string) (map[string]interface{}, error) {
+	byKey := make(map[string]*models.AttributeDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	var unknown []string
+	for key := range raw {
+		if _, ok := byKey[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown attributes for this category: %s", strings.Join(unknown, ", "))
+	}
+
+	attributes := make(map[string]interface{}, len(raw))
+	for _, definition := range definitions {
+		value, ok := raw[definition.Key]
+		if !ok || strings.TrimSpace(value) == "" {
+			if definition.Required {
+				return nil, fmt.Errorf("attribute %q is required", definition.Key)
+			}
+			continue
+		}
+
+		parsed, err := parseAttributeValue(definition, strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		attributes[definition.Key] = parsed
+	}
+
+	return attributes, nil
+}
+
+func parseAttributeValue(definition *models.AttributeDefinition, value string) (interface{}, error) {
+	switch definition.Type {
+	case models.AttributeTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("attribute %q must be a number", definition.Key)
+		}
+		return number, nil
+	case models.AttributeTypeBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q must be true or false", definition.Key)
+		}
+		return b, nil
+	case models.AttributeTypeEnum:
+		for _, allowed := range definition.EnumValues {
+			if strings.EqualFold(allowed, value) {
+				return allowed, nil
+			}
+		}
+		return nil, fmt.Errorf("attribute %q must be one of: %s", definition.Key, strings.Join(definition.EnumValues, ", "))
+	default:
+		return value, nil
+	}
+}
+
+// FormatAttributeValue renders a stored attribute value as text
+func FormatAttributeValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+var attributeFilterPattern = regexp.MustCompile(`^(?:attr\.)?([a-z][a-z0-9_]*)\s*(>=|<=|!=|=|>|<)\s*(.+)$`)
+
+// ParseAttributeFilters parses filters such as attr.ram_gb>=16 or
+// attr.colour="red". Quoted values are always strings; otherwise true/false
+// become booleans and numeric values become numbers.
+func ParseAttributeFilters(filters []string) ([]models.AttributeFilter, error) {
+	var parsed []models.AttributeFilter
+
+	for _, raw := range filters {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		match := attributeFilterPattern.FindStringSubmatch(raw)
+		if match == nil {
+			return nil, fmt.Errorf("invalid attribute filter %q", raw)
+		}
+
+		filter := models.AttributeFilter{Key: match[1], Operator: match[2]}
+		value := strings.TrimSpace(match[3])
+
+		if unquoted, err := strconv.Unquote(value); err == nil && strings.HasPrefix(value, `"`) {
+			filter.Value = unquoted
+		} else if value == "true" || value == "false" {
+			filter.Value = value == "true"
+		} else if number, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+			filter.Value = number
+		} else {
+			filter.Value = value
+		}
+
+		switch filter.Operator {
+		case ">", ">=", "<", "<=":
+			if _, ok := filter.Value.(float64); !ok {
+				return nil, fmt.Errorf("attribute filter %q: %s needs a numeric value", raw, filter.Operator)
+			}
+		}
+
+		parsed = append(parsed, filter)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+const maxBatchSize = 100
+
+// BatchGetProducts looks products up by ID and SKU. Products come back in
+// request order without duplicates; every ID or SKU that matched nothing is
+// returned in notFound.
+func (s *productService) BatchGetProducts(storeID string, ids, skus []string) ([]*models.Product, []string, error) {
+	if len(ids)+len(skus) == 0 {
+		return nil, nil, fmt.Errorf("at least one ID or SKU is required")
+	}
+	if len(ids)+len(skus) > maxBatchSize {
+		return nil, nil, fmt.Errorf("at most %d IDs and SKUs can be requested at once", maxBatchSize)
+	}
+
+	var notFound []string
+
+	validIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if uuidPattern.MatchString(id) {
+			validIDs = append(validIDs, strings.ToLower(id))
+		}
+	}
+	byID := make(map[string]*models.Product)
+	if len(validIDs) > 0 {
+		found, err := s.productRepo.ListByIDs(storeID, validIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, product := range found {
+			byID[product.ID] = product
+		}
+	}
+
+	bySKU := make(map[string]*models.Product)
+	if len(skus) > 0 {
+		found, err := s.productRepo.ListBySKUs(storeID, skus)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, product := range found {
+			if product.DeletedAt == nil {
+				bySKU[product.SKU] = product
+			}
+		}
+	}
+
+	var products []*models.Product
+	seen := make(map[string]bool)
+	add := func(product *models.Product) {
+		if !seen[product.ID] {
+			seen[product.ID] = true
+			products = append(products, product)
+		}
+	}
+
+	for _, id := range ids {
+		if product, ok := byID[strings.ToLower(id)]; ok && uuidPattern.MatchString(id) {
+			add(product)
+		} else {
+			notFound = append(notFound, id)
+		}
+	}
+	for _, sku := range skus {
+		if product, ok := bySKU[sku]; ok {
+			add(product)
+		} else {
+			notFound = append(notFound, sku)
+		}
+	}
+
+	return products, notFound, nil
+}
+
+// BatchUpdateProducts applies the patches in one transaction. Problems with a
+// single patch are reported in its Err; only failures that stop the whole
+// batch are returned.
+func (s *productService) BatchUpdateProducts(storeID string, patches []*models.ProductPatch, updatedBy string) error {
+	if len(patches) == 0 {
+		return fmt.Errorf("at least one update is required")
+	}
+	if len(patches) > maxBatchSize {
+		return fmt.Errorf("at most %d products can be updated at once", maxBatchSize)
+	}
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	seen := make(map[string]bool)
+	valid := 0
+	for _, patch := range patches {
+		patch.ID = strings.ToLower(strings.TrimSpace(patch.ID))
+		patch.Err = validatePatch(patch, seen)
+		if patch.Err == nil {
+			valid++
+		}
+	}
+	if valid == 0 {
+		return nil
+	}
+
+	if err := s.productRepo.BatchUpdate(storeID, patches, updatedBy); err != nil {
+		return err
+	}
+
+	// Conflicts come back with the current product, as single updates do
+	var ids []string
+	var changes []*models.StockChange
+	for _, patch := range patches {
+		if patch.Err != nil && !errors.Is(patch.Err, ErrVersionConflict) {
+			continue
+		}
+		ids = append(ids, patch.ID)
+		if patch.Change != nil {
+			changes = append(changes, patch.Change)
+		}
+	}
+	s.inventoryService.NotifyLowStock(changes...)
+
+	updated, err := s.productRepo.ListByIDs(storeID, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*models.Product, len(updated))
+	for _, product := range updated {
+		byID[product.ID] = product
+	}
+	for _, patch := range patches {
+		if patch.Err == nil || errors.Is(patch.Err, ErrVersionConflict) {
+			patch.Product = byID[patch.ID]
+		}
+	}
+
+	return nil
+}
+
+func validatePatch(patch *models.ProductPatch, seen map[string]bool) error {
+	if !uuidPattern.MatchString(patch.ID) {
+		return fmt.Errorf("product not found")
+	}
+	if seen[patch.ID] {
+		return fmt.Errorf("product appears more than once in the batch")
+	}
+	seen[patch.ID] = true
+
+	if patch.Price == nil && patch.StockQuantity == nil && patch.IsActive == nil {
+		return fmt.Errorf("nothing to update")
+	}
+	if patch.Price != nil && patch.Price.Sign() < 0 {
+		return fmt.Errorf("price cannot be negative")
+	}
+	if patch.StockQuantity != nil && *patch.StockQuantity < 0 {
+		return fmt.Errorf("stock quantity cannot be negative")
+	}
+	if patch.Version != nil && *patch.Version <= 0 {
+		return fmt.Errorf("product version must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+type CategoryService interface {
+	GetCategories(storeID string) ([]*models.Category, error)
+	CreateCategory(storeID string, req *models.CreateCategoryRequest) (*models.Category, error)
+	UpdateCategory(storeID, id string, req *models.UpdateCategoryRequest) (*models.Category, error)
+	DeleteCategory(storeID, id string) error
+	MoveCategory(storeID, id, parentID string) (*models.Category, error)
+	GetCategoryTree(storeID, rootID string, includeInactive bool) ([]*models.CategoryNode, error)
+	ResolveCategory(storeID, ref string) (*models.Category, error)
+}
+
+type categoryService struct {
+	categoryRepo repository.CategoryRepository
+}
+
+func NewCategoryService(categoryRepo repository.CategoryRepository) CategoryService {
+	return &categoryService{
+		categoryRepo: categoryRepo,
+	}
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify lowercases the name and collapses everything else into single dashes
+func slugify(name string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func (s *categoryService) GetCategories(storeID string) ([]*models.Category, error) {
+	categories, err := s.categoryRepo.List(storeID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (s *categoryService) CreateCategory(storeID string, req *models.CreateCategoryRequest) (*models.Category, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("category name is required")
+	}
+
+	category := &models.Category{
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+		IsActive:    true,
+		StoreID:     storeID,
+	}
+
+	if req.ParentID != "" {
+		parent, err := s.categoryRepo.GetByID(storeID, req.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent category: %w", err)
+		}
+		if parent == nil {
+			return nil, fmt.Errorf("parent category not found")
+		}
+		category.ParentID = &parent.ID
+	}
+
+	slug, err := s.uniqueSlug(storeID, req.Slug, name, "")
+	if err != nil {
+		return nil, err
+	}
+	category.Slug = slug
+
+	if err := s.categoryRepo.Create(category); err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (s *categoryService) UpdateCategory(storeID, id string, req *models.UpdateCategoryRequest) (*models.Category, error) {
+	if id == "" {
+		return nil, fmt.Errorf("category ID is required")
+	}
+
+	category, err := s.categoryRepo.GetByID(storeID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	if category == nil {
+		return nil, fmt.Errorf("category not found")
+	}
+
+	// Update fields if provided
+	if name := strings.TrimSpace(req.Name); name != "" {
+		category.Name = name
+	}
+	if req.Description != "" {
+		category.Description = strings.TrimSpace(req.Description)
+	}
+	if req.Slug != "" && req.Slug != category.Slug {
+		slug := slugify(req.Slug)
+		if slug == "" {
+			return nil, fmt.Errorf("invalid category slug")
+		}
+		exists, err := s.categoryRepo.SlugExists(storeID, slug, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing slug: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("category slug already exists")
+		}
+		category.Slug = slug
+	}
+	if req.IsActive != nil {
+		category.IsActive = *req.IsActive
+	}
+
+	if err := s.categoryRepo.Update(category); err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (s *categoryService) DeleteCategory(storeID, id string) error {
+	if id == "" {
+		return fmt.Errorf("category ID is required")
+	}
+
+	category, err := s.categoryRepo.GetByID(storeID, id)
+	if err != nil {
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+	if category == nil {
+		return fmt.Errorf("category not found")
+	}
+
+	// Refuse to orphan subcategories or products; they must be moved first
+	children, err := s.categoryRepo.CountChildren(storeID, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return fmt.Errorf("category has %d subcategories; move or delete them first", children)
+	}
+
+	products, err := s.categoryRepo.CountProducts(storeID, id)
+	if err != nil {
+		return err
+	}
+	if products > 0 {
+		return fmt.Errorf("category has %d products; move them to another category first", products)
+	}
+
+	if err := s.categoryRepo.Delete(storeID, id); err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return nil
+}
+
+func (s *categoryService) MoveCategory(storeID, id, parentID string) (*models.Category, error) {
+	if id == "" {
+		return nil, fmt.Errorf("category ID is required")
+	}
+	if id == parentID {
+		return nil, repository.ErrCategoryCycle
+	}
+
+	category, err := s.categoryRepo.GetByID(storeID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	if category == nil {
+		return nil, fmt.Errorf("category not found")
+	}
+
+	var newParent *string
+	if parentID != "" {
+		parent, err := s.categoryRepo.GetByID(storeID, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent category: %w", err)
+		}
+		if parent == nil {
+			return nil, fmt.Errorf("parent category not found")
+		}
+		newParent = &parent.ID
+	}
+
+	if err := s.categoryRepo.Move(storeID, id, newParent); err != nil {
+		if errors.Is(err, repository.ErrCategoryCycle) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to move category: %w", err)
+	}
+
+	return s.categoryRepo.GetByID(storeID, id)
+}
+
+// GetCategoryTree returns the forest of categories, or the subtree under
+// rootID when one is given
+func (s *categoryService) GetCategoryTree(storeID, rootID string, includeInactive bool) ([]*models.CategoryNode, error) {
+	var categories []*models.Category
+	var err error
+
+	if rootID != "" {
+		categories, err = s.categoryRepo.ListSubtree(storeID, rootID, includeInactive)
+	} else {
+		categories, err = s.categoryRepo.List(storeID, includeInactive)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	nodes := make(map[string]*models.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &models.CategoryNode{Category: category}
+	}
+
+	// Categories arrive sorted by name, so children keep that order. A node
+	// whose parent was filtered out (inactive, or above rootID) becomes a root.
+	var roots []*models.CategoryNode
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if category.ID != rootID && category.ParentID != nil {
+			if parent, ok := nodes[*category.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	return roots, nil
+}
+
+// ResolveCategory finds a category of the store by ID, slug or name, in that order
+func (s *categoryService) ResolveCategory(storeID, ref string) (*models.Category, error) {
+	return resolveCategory(s.categoryRepo, storeID, ref)
+}
+
+func resolveCategory(categoryRepo repository.CategoryRepository, storeID, ref string) (*models.Category, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, nil
+	}
+
+	if uuidPattern.MatchString(ref) {
+		category, err := categoryRepo.GetByID(storeID, ref)
+		if err != nil || category != nil {
+			return category, err
+		}
+	}
+
+	category, err := categoryRepo.GetBySlug(storeID, strings.ToLower(ref))
+	if err != nil || category != nil {
+		return category, err
+	}
+
+	return categoryRepo.GetByName(storeID, ref)
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// uniqueSlug derives a slug from the requested slug or the name, appending
+// -2, -3, ... until it no longer collides with another category in the store
+func (s *categoryService) uniqueSlug(storeID, requested, name, excludeID string) (string, error) {
+	base := slugify(requested)
+	if base == "" {
+		base = slugify(name)
+	}
+	if base == "" {
+		return "", fmt.Errorf("invalid category slug")
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		exists, err := s.categoryRepo.SlugExists(storeID, slug, excludeID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check existing slug: %w", err)
+		}
+		if !exists {
+			return slug, nil
+		}
+		if requested != "" {
+			return "", fmt.Errorf("category slug already exists")
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
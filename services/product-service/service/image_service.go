@@ -0,0 +1,139 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+type ImageService interface {
+	AddImage(storeID, productID string, image *models.ProductImage) (*models.ProductImage, error)
+	ListImages(storeID, productID string) ([]*models.ProductImage, error)
+	DeleteImage(storeID, id string) (*models.ProductImage, error)
+	ReorderImages(storeID, productID string, imageIDs []string) ([]*models.ProductImage, error)
+}
+
+type imageService struct {
+	imageRepo   repository.ImageRepository
+	productRepo repository.ProductRepository
+}
+
+func NewImageService(imageRepo repository.ImageRepository, productRepo repository.ProductRepository) ImageService {
+	return &imageService{
+		imageRepo:   imageRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (s *imageService) AddImage(storeID, productID string, image *models.ProductImage) (*models.ProductImage, error) {
+	if err := s.checkProduct(storeID, productID); err != nil {
+		return nil, err
+	}
+	if image == nil || strings.TrimSpace(image.URL) == "" {
+		return nil, fmt.Errorf("image URL is required")
+	}
+	if !strings.HasPrefix(image.ContentType, "image/") {
+		return nil, fmt.Errorf("invalid image content type %q", image.ContentType)
+	}
+
+	image.ProductID = productID
+	if image.Thumbnails == nil {
+		image.Thumbnails = map[string]string{}
+	}
+	if image.StorageKeys == nil {
+		image.StorageKeys = []string{}
+	}
+
+	if err := s.imageRepo.Create(image); err != nil {
+		return nil, fmt.Errorf("failed to add product image: %w", err)
+	}
+
+	return image, nil
+}
+
+func (s *imageService) ListImages(storeID, productID string) ([]*models.ProductImage, error) {
+	if err := s.checkProduct(storeID, productID); err != nil {
+		return nil, err
+	}
+
+	images, err := s.imageRepo.ListByProduct(productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	return images, nil
+}
+
+func (s *imageService) DeleteImage(storeID, id string) (*models.ProductImage, error) {
+	if id == "" {
+		return nil, fmt.Errorf("image ID is required")
+	}
+
+	image, err := s.imageRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product image: %w", err)
+	}
+	if image == nil {
+		return nil, fmt.Errorf("product image not found")
+	}
+	// An image of another store's product is reported as missing
+	if err := s.checkProduct(storeID, image.ProductID); err != nil {
+		return nil, fmt.Errorf("product image not found")
+	}
+
+	if err := s.imageRepo.Delete(id); err != nil {
+		return nil, fmt.Errorf("failed to delete product image: %w", err)
+	}
+
+	return image, nil
+}
+
+func (s *imageService) ReorderImages(storeID, productID string, imageIDs []string) ([]*models.ProductImage, error) {
+	images, err := s.ListImages(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new order must name every image exactly once
+	existing := make(map[string]bool, len(images))
+	for _, image := range images {
+		existing[image.ID] = true
+	}
+	if len(imageIDs) != len(images) {
+		return nil, fmt.Errorf("image order must list all %d images of the product", len(images))
+	}
+	seen := make(map[string]bool, len(imageIDs))
+	for _, id := range imageIDs {
+		if !existing[id] {
+			return nil, fmt.Errorf("image %s does not belong to this product", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("image %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+
+	if err := s.imageRepo.Reorder(productID, imageIDs); err != nil {
+		return nil, fmt.Errorf("failed to reorder product images: %w", err)
+	}
+
+	return s.imageRepo.ListByProduct(productID)
+}
+
+func (s *imageService) checkProduct(storeID, productID string) error {
+	if productID == "" {
+		return fmt.Errorf("product ID is required")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, productID)
+	if err != nil {
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil {
+		return fmt.Errorf("product not found")
+	}
+
+	return nil
+}
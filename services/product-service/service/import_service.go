@@ -0,0 +1,254 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+const (
+	defaultImportBatchSize = 100
+	maxImportBatchSize     = 1000
+)
+
+// ProductImporter takes import rows one at a time and writes them in
+// batches. Row-level problems end up in the result; only failures that stop
+// the whole import are returned as errors.
+type ProductImporter interface {
+	Add(row *models.ImportRow) error
+	Finish() (*models.ImportResult, error)
+}
+
+type productImporter struct {
+	service *productService
+	opts    models.ImportOptions
+	pending []*models.ImportRow
+	// seen maps each SKU to the first row that used it
+	seen   map[string]int32
+	result *models.ImportResult
+}
+
+func (s *productService) NewImporter(opts models.ImportOptions) ProductImporter {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultImportBatchSize
+	}
+	if opts.BatchSize > maxImportBatchSize {
+		opts.BatchSize = maxImportBatchSize
+	}
+	if opts.CreatedBy == "" {
+		opts.CreatedBy = "system"
+	}
+
+	return &productImporter{
+		service: s,
+		opts:    opts,
+		seen:    make(map[string]int32),
+		result:  &models.ImportResult{DryRun: opts.DryRun},
+	}
+}
+
+func (i *productImporter) Add(row *models.ImportRow) error {
+	i.result.TotalRows++
+	i.pending = append(i.pending, row)
+	if len(i.pending) >= i.opts.BatchSize {
+		return i.flush()
+	}
+	return nil
+}
+
+func (i *productImporter) Finish() (*models.ImportResult, error) {
+	if err := i.flush(); err != nil {
+		return nil, err
+	}
+	return i.result, nil
+}
+
+func (i *productImporter) flush() error {
+	rows := i.pending
+	i.pending = nil
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// One lookup per batch instead of one per row
+	skus := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Product != nil {
+			skus = append(skus, strings.TrimSpace(row.Product.SKU))
+		}
+	}
+	existing, err := i.service.productRepo.ListBySKUs(i.opts.StoreID, skus)
+	if err != nil {
+		return err
+	}
+	bySKU := make(map[string]*models.Product, len(existing))
+	for _, product := range existing {
+		bySKU[product.SKU] = product
+	}
+
+	var items []*models.ImportItem
+	for _, row := range rows {
+		item, err := i.prepare(row, bySKU)
+		if err != nil {
+			i.fail(row.Row, rowSKU(row), err)
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if i.opts.DryRun || len(items) == 0 {
+		i.count(items)
+		return nil
+	}
+
+	if err := i.service.productRepo.ImportBatch(items); err != nil {
+		// The transaction is gone, so nothing in this batch was written
+		for _, item := range items {
+			i.fail(item.Row, item.Product.SKU, err)
+		}
+		return nil
+	}
+
+	var written []*models.ImportItem
+	var changes []*models.StockChange
+	for _, item := range items {
+		if item.Err != nil {
+			i.fail(item.Row, item.Product.SKU, item.Err)
+			continue
+		}
+		written = append(written, item)
+		if item.Change != nil {
+			changes = append(changes, item.Change)
+		}
+	}
+	i.count(written)
+	i.service.inventoryService.NotifyLowStock(changes...)
+
+	return nil
+}
+
+// prepare validates a row and turns it into a new product, or into an update
+// of the product that already has its SKU
+func (i *productImporter) prepare(row *models.ImportRow, bySKU map[string]*models.Product) (*models.ImportItem, error) {
+	req := row.Product
+	if req == nil {
+		return nil, fmt.Errorf("row is empty")
+	}
+
+	sku := strings.TrimSpace(req.SKU)
+	if sku == "" {
+		return nil, fmt.Errorf("SKU is required")
+	}
+	if first, ok := i.seen[sku]; ok {
+		return nil, fmt.Errorf("duplicate SKU, already used on row %d", first)
+	}
+	i.seen[sku] = row.Row
+
+	product, ok := bySKU[sku]
+	if ok && product.DeletedAt != nil {
+		return nil, fmt.Errorf("a product with this SKU is in the trash")
+	}
+
+	// An existing product keeps its currency
+	currency := i.service.productCurrency(req)
+	if ok {
+		currency = product.Currency
+	}
+	if err := validateCreateRequest(req, currency); err != nil {
+		return nil, err
+	}
+	if !ok {
+		product, err := i.service.newProduct(i.opts.StoreID, req, sku, i.opts.CreatedBy)
+		if err != nil {
+			return nil, err
+		}
+		revision := newRevision(models.RevisionCreate, i.opts.CreatedBy, nil, product.Snapshot())
+		return &models.ImportItem{Row: row.Row, Product: product, ImportedBy: i.opts.CreatedBy, Revision: revision}, nil
+	}
+	if !i.opts.Upsert {
+		return nil, fmt.Errorf("product with SKU already exists")
+	}
+	before := product.Snapshot()
+
+	product.Name = strings.TrimSpace(req.Name)
+	product.Description = strings.TrimSpace(req.Description)
+	product.Price = req.Price
+	product.StockQuantity = req.StockQuantity
+	if req.ImageURL != "" {
+		product.ImageURL = req.ImageURL
+	}
+	if req.ReorderThreshold != nil {
+		product.ReorderThreshold = req.ReorderThreshold
+	}
+	if req.CompareAtPrice != nil {
+		product.CompareAtPrice = req.CompareAtPrice
+	}
+
+	categoryChanged := false
+	if req.CategoryID != "" || req.Category != "" {
+		previousCategoryID := product.CategoryID
+		if err := i.service.assignCategory(product, req.CategoryID, req.Category); err != nil {
+			return nil, err
+		}
+		categoryChanged = previousCategoryID == nil || *previousCategoryID != *product.CategoryID
+	}
+	// A row describes the whole product, so its attributes replace the old ones
+	if len(req.Attributes) > 0 {
+		if err := i.service.applyAttributes(product, req.Attributes); err != nil {
+			return nil, err
+		}
+	} else if categoryChanged {
+		if err := i.service.mergeAttributes(product, nil, true); err != nil {
+			return nil, err
+		}
+	}
+
+	revision := newRevision(models.RevisionUpdate, i.opts.CreatedBy, before, product.Snapshot())
+	if len(revision.Changes) == 0 {
+		revision = nil
+	}
+
+	return &models.ImportItem{Row: row.Row, Product: product, Update: true, ImportedBy: i.opts.CreatedBy, Revision: revision}, nil
+}
+
+func (i *productImporter) count(items []*models.ImportItem) {
+	for _, item := range items {
+		if item.Update {
+			i.result.Updated++
+		} else {
+			i.result.Created++
+		}
+	}
+}
+
+func (i *productImporter) fail(row int32, sku string, err error) {
+	i.result.Failed++
+	i.result.Errors = append(i.result.Errors, &models.ImportRowError{
+		Row:     row,
+		SKU:     sku,
+		Message: err.Error(),
+	})
+}
+
+func rowSKU(row *models.ImportRow) string {
+	if row.Product == nil {
+		return ""
+	}
+	return strings.TrimSpace(row.Product.SKU)
+}
+
+func (s *productService) ExportProducts(filter *models.ProductFilter, fn func(*models.Product) error) error {
+	if filter == nil {
+		filter = &models.ProductFilter{}
+	}
+	if filter.CategoryID == "" && filter.Category != "" {
+		categoryID, err := s.resolveCategoryID(filter.StoreID, filter.Category)
+		if err != nil {
+			return err
+		}
+		filter.CategoryID = categoryID
+	}
+
+	return s.productRepo.Export(filter, fn)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/notifier"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+// maxReservationTTL caps how long a single reservation may hold stock
+const maxReservationTTL = 24 * time.Hour
+
+// notifyTimeout bounds a single low-stock notification
+const notifyTimeout = 30 * time.Second
+
+type InventoryService interface {
+	AdjustStock(req *models.AdjustStockRequest) (*models.StockMovement, *models.StockLevel, error)
+	SetStock(productID string, variantID *string, quantity int32) (*models.StockLevel, error)
+	ReserveStock(req *models.ReserveStockRequest) (*models.StockReservation, error)
+	CommitReservation(storeID, id string) (*models.StockReservation, error)
+	ReleaseReservation(storeID, id string) (*models.StockReservation, error)
+	ListStockMovements(storeID, productID, variantID string, pagination *models.PaginationRequest) ([]*models.StockMovement, *models.PaginationResponse, error)
+	ListLowStockProducts(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.LowStockItem, *models.PaginationResponse, error)
+	ExpireReservations() (int64, error)
+	NotifyLowStock(changes ...*models.StockChange)
+}
+
+type inventoryService struct {
+	inventoryRepo  repository.InventoryRepository
+	notifier       notifier.Notifier
+	reservationTTL time.Duration
+}
+
+func NewInventoryService(inventoryRepo repository.InventoryRepository, lowStockNotifier notifier.Notifier, reservationTTL time.Duration) InventoryService {
+	return &inventoryService{
+		inventoryRepo:  inventoryRepo,
+		notifier:       lowStockNotifier,
+		reservationTTL: reservationTTL,
+	}
+}
+
+func (s *inventoryService) AdjustStock(req *models.AdjustStockRequest) (*models.StockMovement, *models.StockLevel, error) {
+	productID, variantID, err := stockIDs(req.ProductID, req.VariantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Delta == 0 {
+		return nil, nil, fmt.Errorf("delta must not be zero")
+	}
+
+	reason := strings.ToLower(strings.TrimSpace(req.Reason))
+	if reason == "" {
+		return nil, nil, fmt.Errorf("reason is required")
+	}
+	if len(reason) > 50 {
+		return nil, nil, fmt.Errorf("reason must be at most 50 characters")
+	}
+
+	adjustReq := *req
+	adjustReq.ProductID = productID
+	adjustReq.VariantID = ""
+	if variantID != nil {
+		adjustReq.VariantID = *variantID
+	}
+	adjustReq.Reason = reason
+	adjustReq.Reference = strings.TrimSpace(req.Reference)
+
+	movement, change, err := s.inventoryRepo.Adjust(&adjustReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.NotifyLowStock(change)
+	return movement, &change.After, nil
+}
+
+// SetStock overwrites the stock of a product or variant, recording the
+// difference in the ledger
+func (s *inventoryService) SetStock(productID string, variantID *string, quantity int32) (*models.StockLevel, error) {
+	change, err := s.inventoryRepo.SetStock(productID, variantID, quantity, models.MovementManualSet, "")
+	if err != nil {
+		return nil, err
+	}
+	s.NotifyLowStock(change)
+	return &change.After, nil
+}
+
+func (s *inventoryService) ReserveStock(req *models.ReserveStockRequest) (*models.StockReservation, error) {
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("at least one item is required")
+	}
+
+	items := make([]*models.ReservationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		variant := ""
+		if item.VariantID != nil {
+			variant = *item.VariantID
+		}
+		productID, variantID, err := stockIDs(item.ProductID, variant)
+		if err != nil {
+			return nil, err
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("quantity must be positive")
+		}
+		items = append(items, &models.ReservationItem{
+			ProductID: productID,
+			VariantID: variantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	ttl := req.TTL
+	if ttl <= 0 {
+		ttl = s.reservationTTL
+	}
+	if ttl > maxReservationTTL {
+		return nil, fmt.Errorf("reservation TTL cannot exceed %s", maxReservationTTL)
+	}
+
+	reservation, changes, err := s.inventoryRepo.Reserve(&models.ReserveStockRequest{
+		StoreID:   req.StoreID,
+		Reference: strings.TrimSpace(req.Reference),
+		Items:     items,
+		TTL:       ttl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.NotifyLowStock(changes...)
+	return reservation, nil
+}
+
+func (s *inventoryService) CommitReservation(storeID, id string) (*models.StockReservation, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("reservation not found")
+	}
+	return s.inventoryRepo.Commit(storeID, strings.ToLower(id))
+}
+
+func (s *inventoryService) ReleaseReservation(storeID, id string) (*models.StockReservation, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("reservation not found")
+	}
+	return s.inventoryRepo.Release(storeID, strings.ToLower(id))
+}
+
+func (s *inventoryService) ListStockMovements(storeID, productID, variantID string, pagination *models.PaginationRequest) ([]*models.StockMovement, *models.PaginationResponse, error) {
+	product, variant, err := stockIDs(productID, variantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 || pagination.Limit > 100 {
+		pagination.Limit = 50
+	}
+
+	return s.inventoryRepo.ListMovements(storeID, product, variant, pagination)
+}
+
+func (s *inventoryService) ListLowStockProducts(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.LowStockItem, *models.PaginationResponse, error) {
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 || pagination.Limit > 100 {
+		pagination.Limit = 50
+	}
+
+	return s.inventoryRepo.ListLowStock(storeID, strings.TrimSpace(createdBy), pagination)
+}
+
+func (s *inventoryService) ExpireReservations() (int64, error) {
+	return s.inventoryRepo.ExpireReservations()
+}
+
+// NotifyLowStock sends an event for every change that took available stock
+// to or below its reorder threshold. Delivery happens in the background so a
+// slow webhook or mail server never holds up a stock operation.
+func (s *inventoryService) NotifyLowStock(changes ...*models.StockChange) {
+	if s.notifier == nil {
+		return
+	}
+
+	now := time.Now()
+	for _, change := range changes {
+		if !change.CrossedThreshold() {
+			continue
+		}
+
+		event := &models.LowStockEvent{
+			LowStockItem: models.LowStockItem{
+				ProductID:         change.ProductID,
+				ProductName:       change.ProductName,
+				SKU:               change.SKU,
+				VariantID:         change.VariantID,
+				StockQuantity:     change.After.StockQuantity,
+				ReservedQuantity:  change.After.ReservedQuantity,
+				AvailableQuantity: change.After.Available(),
+				ReorderThreshold:  *change.ReorderThreshold,
+				CreatedBy:         change.CreatedBy,
+			},
+			OccurredAt: now,
+		}
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+			defer cancel()
+			if err := s.notifier.NotifyLowStock(ctx, event); err != nil {
+				log.Printf("Failed to send low stock alert for %s: %v", event.ProductID, err)
+			}
+		}()
+	}
+}
+
+// stockIDs validates and normalizes the IDs identifying a stock row. UUIDs are
+// lowercased so reservation items sort the same way here as in Postgres.
+func stockIDs(productID, variantID string) (string, *string, error) {
+	if productID == "" {
+		return "", nil, fmt.Errorf("product ID is required")
+	}
+	if !uuidPattern.MatchString(productID) {
+		return "", nil, fmt.Errorf("product not found")
+	}
+	productID = strings.ToLower(productID)
+
+	if variantID == "" {
+		return productID, nil, nil
+	}
+	if !uuidPattern.MatchString(variantID) {
+		return "", nil, fmt.Errorf("variant not found")
+	}
+	variantID = strings.ToLower(variantID)
+	return productID, &variantID, nil
+}
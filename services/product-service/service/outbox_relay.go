@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+// outboxBatchSize is how many events one relay pass publishes at most
+const outboxBatchSize = 100
+
+// OutboxRelay publishes the events in the outbox to the bus, in the order
+// they were recorded
+type OutboxRelay interface {
+	// Relay publishes pending events until none are left or one fails
+	Relay(ctx context.Context) (int, error)
+	// Cleanup deletes events published longer ago than the retention
+	Cleanup() (int64, error)
+}
+
+type outboxRelay struct {
+	outboxRepo repository.OutboxRepository
+	bus        events.Bus
+	retention  time.Duration
+}
+
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, bus events.Bus, retention time.Duration) OutboxRelay {
+	return &outboxRelay{
+		outboxRepo: outboxRepo,
+		bus:        bus,
+		retention:  retention,
+	}
+}
+
+func (r *outboxRelay) Relay(ctx context.Context) (int, error) {
+	publish := func(event *models.OutboxEvent) error {
+		envelope := events.NewEnvelope(event.EventID, event.Type, event.Version, event.AggregateID, event.CreatedAt, event.Payload)
+		return r.bus.Publish(ctx, envelope)
+	}
+
+	total := 0
+	for {
+		published, err := r.outboxRepo.PublishPending(outboxBatchSize, publish)
+		total += published
+		if err != nil {
+			return total, err
+		}
+		if published < outboxBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (r *outboxRelay) Cleanup() (int64, error) {
+	if r.retention <= 0 {
+		return 0, fmt.Errorf("outbox retention must be positive")
+	}
+	return r.outboxRepo.DeletePublished(time.Now().Add(-r.retention))
+}
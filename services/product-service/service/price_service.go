@@ -0,0 +1,196 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+type PriceService interface {
+	CreateSchedule(storeID, productID string, req *models.CreatePriceScheduleRequest, createdBy string) (*models.PriceSchedule, error)
+	CancelSchedule(storeID, productID, scheduleID string) (*models.PriceSchedule, error)
+	ListSchedules(storeID, productID string) ([]*models.PriceSchedule, error)
+	AdvanceSchedules() (int, int, error)
+	SetPriceList(storeID, productID string, prices []models.Money) (*models.Product, error)
+}
+
+type priceService struct {
+	priceRepo   repository.PriceRepository
+	productRepo repository.ProductRepository
+}
+
+func NewPriceService(priceRepo repository.PriceRepository, productRepo repository.ProductRepository) PriceService {
+	return &priceService{
+		priceRepo:   priceRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (s *priceService) CreateSchedule(storeID, productID string, req *models.CreatePriceScheduleRequest, createdBy string) (*models.PriceSchedule, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkPrice("sale price", &req.SalePrice, product.Currency); err != nil {
+		return nil, err
+	}
+	if req.SalePrice.Sign() <= 0 {
+		return nil, fmt.Errorf("sale price must be greater than zero")
+	}
+	if req.SalePrice.Cmp(product.Price) >= 0 {
+		return nil, fmt.Errorf("sale price must be lower than the current price")
+	}
+
+	now := time.Now()
+	startsAt := req.StartsAt
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+	if req.EndsAt.IsZero() {
+		return nil, fmt.Errorf("end time is required")
+	}
+	if !req.EndsAt.After(startsAt) {
+		return nil, fmt.Errorf("sale must end after it starts")
+	}
+	if !req.EndsAt.After(now) {
+		return nil, fmt.Errorf("sale must end in the future")
+	}
+
+	if createdBy == "" {
+		createdBy = "system"
+	}
+
+	schedule := &models.PriceSchedule{
+		ProductID: product.ID,
+		SalePrice: req.SalePrice,
+		StartsAt:  startsAt,
+		EndsAt:    req.EndsAt,
+		CreatedBy: createdBy,
+	}
+	if err := s.priceRepo.Create(schedule); err != nil {
+		if errors.Is(err, repository.ErrScheduleOverlap) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create price schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *priceService) CancelSchedule(storeID, productID, scheduleID string) (*models.PriceSchedule, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if !uuidPattern.MatchString(scheduleID) {
+		return nil, fmt.Errorf("price schedule not found")
+	}
+
+	schedule, err := s.priceRepo.GetByID(scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil || schedule.ProductID != product.ID {
+		return nil, fmt.Errorf("price schedule not found")
+	}
+
+	cancelled, err := s.priceRepo.Cancel(scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled == nil {
+		return nil, fmt.Errorf("price schedule has already ended or been cancelled")
+	}
+
+	return cancelled, nil
+}
+
+func (s *priceService) ListSchedules(storeID, productID string) ([]*models.PriceSchedule, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.priceRepo.ListByProduct(product.ID)
+}
+
+// SetPriceList replaces the prices the product has in other currencies.
+// They are fixed amounts; sales only change the price in the product's own
+// currency.
+func (s *priceService) SetPriceList(storeID, productID string, prices []models.Money) (*models.Product, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for i := range prices {
+		price := &prices[i]
+		price.Currency = strings.ToUpper(strings.TrimSpace(price.Currency))
+		if price.Currency == product.Currency {
+			return nil, fmt.Errorf("%s is the product's own currency; change its price instead", price.Currency)
+		}
+		if seen[price.Currency] {
+			return nil, fmt.Errorf("duplicate price for %s", price.Currency)
+		}
+		seen[price.Currency] = true
+
+		if err := checkPrice("price", price, price.Currency); err != nil {
+			return nil, err
+		}
+		if price.Sign() < 0 {
+			return nil, fmt.Errorf("price cannot be negative")
+		}
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Currency < prices[j].Currency })
+
+	if err := s.priceRepo.ReplacePriceList(product.ID, prices); err != nil {
+		return nil, fmt.Errorf("failed to set price list: %w", err)
+	}
+
+	product.Prices = prices
+	return product, nil
+}
+
+// AdvanceSchedules brings schedule statuses up to date and returns how many
+// sales started and ended
+func (s *priceService) AdvanceSchedules() (int, int, error) {
+	return s.priceRepo.Advance()
+}
+
+// checkPrice fills in currency on a price sent without one and checks the
+// price is a valid amount in that currency
+func checkPrice(field string, price *models.Money, currency string) error {
+	if price.Currency == "" {
+		price.Currency = currency
+	}
+	if price.Currency != currency {
+		return fmt.Errorf("%s must be in %s, the product's currency", field, currency)
+	}
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
+
+func (s *priceService) getProduct(storeID, productID string) (*models.Product, error) {
+	if !uuidPattern.MatchString(productID) {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	return product, nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,44 +10,65 @@ import (
 	"github.com/martbul/playground_microservices/services/product-service/repository"
 )
 
+// ErrVersionConflict is returned by UpdateProduct and DeleteProduct when the
+// product changed after the version the caller had
+var ErrVersionConflict = repository.ErrVersionConflict
+
 type ProductService interface {
-	CreateProduct(req *models.CreateProductRequest, createdBy string) (*models.Product, error)
-	GetProduct(id string) (*models.Product, error)
-	UpdateProduct(id string, req *models.UpdateProductRequest) (*models.Product, error)
-	DeleteProduct(id string) error
+	CreateProduct(storeID string, req *models.CreateProductRequest, createdBy string) (*models.Product, error)
+	GetProduct(storeID, id string) (*models.Product, error)
+	UpdateProduct(storeID, id string, req *models.UpdateProductRequest, updatedBy string) (*models.Product, error)
+	DeleteProduct(storeID, id string, version int32, deletedBy string) error
+	RestoreProduct(storeID, id string, restoredBy string) (*models.Product, error)
+	ListDeletedProducts(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error)
+	PurgeDeletedProducts() (int, []string, error)
+	GetProductHistory(storeID, productID string, pagination *models.PaginationRequest) ([]*models.ProductRevision, *models.PaginationResponse, error)
+	RevertProductToRevision(storeID, productID, revisionID string, version int32, revertedBy string) (*models.Product, error)
 	ListProducts(filter *models.ProductFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error)
 	SearchProducts(filter *models.SearchFilter, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error)
-	GetCategories() ([]*models.Category, error)
+	NewImporter(opts models.ImportOptions) ProductImporter
+	ExportProducts(filter *models.ProductFilter, fn func(*models.Product) error) error
+	BatchGetProducts(storeID string, ids, skus []string) ([]*models.Product, []string, error)
+	BatchUpdateProducts(storeID string, patches []*models.ProductPatch, updatedBy string) error
 }
 
 type productService struct {
-	productRepo repository.ProductRepository
+	productRepo      repository.ProductRepository
+	categoryRepo     repository.CategoryRepository
+	attributeRepo    repository.AttributeRepository
+	revisionRepo     repository.RevisionRepository
+	inventoryService InventoryService
+	trashRetention   time.Duration
+	defaultCurrency  string
 }
 
-func NewProductService(productRepo repository.ProductRepository) ProductService {
+// NewProductService prices products created without a currency in defaultCurrency
+func NewProductService(productRepo repository.ProductRepository, categoryRepo repository.CategoryRepository, attributeRepo repository.AttributeRepository, revisionRepo repository.RevisionRepository, inventoryService InventoryService, trashRetention time.Duration, defaultCurrency string) ProductService {
 	return &productService{
-		productRepo: productRepo,
+		productRepo:      productRepo,
+		categoryRepo:     categoryRepo,
+		attributeRepo:    attributeRepo,
+		revisionRepo:     revisionRepo,
+		inventoryService: inventoryService,
+		trashRetention:   trashRetention,
+		defaultCurrency:  defaultCurrency,
 	}
 }
 
-func (s *productService) CreateProduct(req *models.CreateProductRequest, createdBy string) (*models.Product, error) {
-	// Validate required fields
-	if req.Name == "" {
-		return nil, fmt.Errorf("product name is required")
-	}
-	if req.Price < 0 {
-		return nil, fmt.Errorf("price cannot be negative")
-	}
-	if req.StockQuantity < 0 {
-		return nil, fmt.Errorf("stock quantity cannot be negative")
+func (s *productService) CreateProduct(storeID string, req *models.CreateProductRequest, createdBy string) (*models.Product, error) {
+	if err := validateCreateRequest(req, s.productCurrency(req)); err != nil {
+		return nil, err
 	}
 
 	// Check if SKU already exists (if provided)
 	if req.SKU != "" {
-		existingProduct, err := s.productRepo.GetBySKU(req.SKU)
+		existingProduct, err := s.productRepo.GetBySKU(storeID, req.SKU)
 		if err != nil {
 			return nil, fmt.Errorf("failed to check existing SKU: %w", err)
 		}
+		if existingProduct != nil && existingProduct.DeletedAt != nil {
+			return nil, fmt.Errorf("a product with this SKU is in the trash")
+		}
 		if existingProduct != nil {
 			return nil, fmt.Errorf("product with SKU already exists")
 		}
@@ -58,31 +80,25 @@ func (s *productService) CreateProduct(req *models.CreateProductRequest, created
 		sku = s.generateSKU(req.Name)
 	}
 
-	product := &models.Product{
-		Name:          strings.TrimSpace(req.Name),
-		Description:   strings.TrimSpace(req.Description),
-		Price:         req.Price,
-		StockQuantity: req.StockQuantity,
-		Category:      req.Category,
-		ImageURL:      req.ImageURL,
-		SKU:           sku,
-		IsActive:      true,
-		CreatedBy:     createdBy,
+	product, err := s.newProduct(storeID, req, sku, createdBy)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.productRepo.Create(product); err != nil {
+	revision := newRevision(models.RevisionCreate, createdBy, nil, product.Snapshot())
+	if err := s.productRepo.Create(product, revision); err != nil {
 		return nil, fmt.Errorf("failed to create product: %w", err)
 	}
 
 	return product, nil
 }
 
-func (s *productService) GetProduct(id string) (*models.Product, error) {
+func (s *productService) GetProduct(storeID, id string) (*models.Product, error) {
 	if id == "" {
 		return nil, fmt.Errorf("product ID is required")
 	}
 
-	product, err := s.productRepo.GetByID(id)
+	product, err := s.productRepo.GetByID(storeID, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
@@ -93,68 +109,155 @@ func (s *productService) GetProduct(id string) (*models.Product, error) {
 	return product, nil
 }
 
-func (s *productService) UpdateProduct(id string, req *models.UpdateProductRequest) (*models.Product, error) {
+func (s *productService) UpdateProduct(storeID, id string, req *models.UpdateProductRequest, updatedBy string) (*models.Product, error) {
 	if id == "" {
 		return nil, fmt.Errorf("product ID is required")
 	}
+	if req.Version <= 0 {
+		return nil, fmt.Errorf("product version is required")
+	}
 
 	// Get existing product
-	product, err := s.productRepo.GetByID(id)
+	product, err := s.productRepo.GetByID(storeID, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
 	if product == nil {
 		return nil, fmt.Errorf("product not found")
 	}
+	if product.Version != req.Version {
+		return nil, ErrVersionConflict
+	}
+	before := product.Snapshot()
 
-	// Update fields if provided
-	if req.Name != "" {
-		product.Name = strings.TrimSpace(req.Name)
+	// Update the fields that were sent
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("product name is required")
+		}
+		product.Name = name
 	}
-	if req.Description != "" {
-		product.Description = strings.TrimSpace(req.Description)
+	if req.Description != nil {
+		product.Description = strings.TrimSpace(*req.Description)
 	}
-	if req.Price >= 0 {
-		product.Price = req.Price
+	if req.Price != nil {
+		if err := checkPrice("price", req.Price, product.Currency); err != nil {
+			return nil, err
+		}
+		if req.Price.Sign() < 0 {
+			return nil, fmt.Errorf("price cannot be negative")
+		}
+		product.Price = *req.Price
 	}
-	if req.StockQuantity >= 0 {
-		product.StockQuantity = req.StockQuantity
+	if req.StockQuantity != nil && *req.StockQuantity < 0 {
+		return nil, fmt.Errorf("stock quantity cannot be negative")
 	}
-	if req.Category != "" {
-		product.Category = req.Category
+	categoryChanged := false
+	if req.CategoryID != nil || req.Category != nil {
+		var categoryID, categoryRef string
+		if req.CategoryID != nil {
+			categoryID = strings.TrimSpace(*req.CategoryID)
+		}
+		if req.Category != nil {
+			categoryRef = strings.TrimSpace(*req.Category)
+		}
+		if categoryID == "" && categoryRef == "" {
+			return nil, fmt.Errorf("category cannot be removed")
+		}
+
+		previousCategoryID := product.CategoryID
+		if err := s.assignCategory(product, categoryID, categoryRef); err != nil {
+			return nil, err
+		}
+		categoryChanged = previousCategoryID == nil || *previousCategoryID != *product.CategoryID
 	}
-	if req.ImageURL != "" {
-		product.ImageURL = req.ImageURL
+	if len(req.Attributes) > 0 || categoryChanged {
+		if err := s.mergeAttributes(product, req.Attributes, categoryChanged); err != nil {
+			return nil, err
+		}
 	}
-	if req.SKU != "" && req.SKU != product.SKU {
-		// Check if new SKU already exists
-		existingProduct, err := s.productRepo.GetBySKU(req.SKU)
-		if err != nil {
-			return nil, fmt.Errorf("failed to check existing SKU: %w", err)
+	if req.ImageURL != nil {
+		product.ImageURL = strings.TrimSpace(*req.ImageURL)
+	}
+	if req.SKU != nil {
+		sku := strings.TrimSpace(*req.SKU)
+		if sku == "" {
+			return nil, fmt.Errorf("SKU is required")
 		}
-		if existingProduct != nil && existingProduct.ID != id {
-			return nil, fmt.Errorf("SKU already exists")
+		if sku != product.SKU {
+			// Check if new SKU already exists
+			existingProduct, err := s.productRepo.GetBySKU(storeID, sku)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check existing SKU: %w", err)
+			}
+			if existingProduct != nil && existingProduct.ID != id {
+				if existingProduct.DeletedAt != nil {
+					return nil, fmt.Errorf("a product with this SKU is in the trash")
+				}
+				return nil, fmt.Errorf("SKU already exists")
+			}
+			product.SKU = sku
 		}
-		product.SKU = req.SKU
 	}
 	if req.IsActive != nil {
 		product.IsActive = *req.IsActive
 	}
+	if req.ClearReorderThreshold {
+		product.ReorderThreshold = nil
+	} else if req.ReorderThreshold != nil {
+		if *req.ReorderThreshold < 0 {
+			return nil, fmt.Errorf("reorder threshold cannot be negative")
+		}
+		product.ReorderThreshold = req.ReorderThreshold
+	}
+	if req.ClearCompareAtPrice {
+		product.CompareAtPrice = nil
+	} else if req.CompareAtPrice != nil {
+		if err := checkPrice("compare-at price", req.CompareAtPrice, product.Currency); err != nil {
+			return nil, err
+		}
+		if req.CompareAtPrice.Sign() <= 0 {
+			return nil, fmt.Errorf("compare-at price must be greater than zero")
+		}
+		product.CompareAtPrice = req.CompareAtPrice
+	}
 
-	if err := s.productRepo.Update(product); err != nil {
+	// The repository checks the version again in case of a concurrent edit
+	revision := newRevision(models.RevisionUpdate, updatedBy, before, product.Snapshot())
+	if len(revision.Changes) == 0 {
+		revision = nil
+	}
+	// Stock goes through the ledger rather than being overwritten in place,
+	// in the same transaction as the other fields
+	var stockQuantity *int32
+	if req.StockQuantity != nil && *req.StockQuantity != product.StockQuantity {
+		stockQuantity = req.StockQuantity
+	}
+	change, err := s.productRepo.Update(product, revision, stockQuantity, updatedBy)
+	if err != nil {
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to update product: %w", err)
 	}
+	if change != nil {
+		s.inventoryService.NotifyLowStock(change)
+	}
 
 	return product, nil
 }
 
-func (s *productService) DeleteProduct(id string) error {
+func (s *productService) DeleteProduct(storeID, id string, version int32, deletedBy string) error {
 	if id == "" {
 		return fmt.Errorf("product ID is required")
 	}
+	if version <= 0 {
+		return fmt.Errorf("product version is required")
+	}
 
 	// Check if product exists
-	product, err := s.productRepo.GetByID(id)
+	product, err := s.productRepo.GetByID(storeID, id)
 	if err != nil {
 		return fmt.Errorf("failed to get product: %w", err)
 	}
@@ -162,7 +265,15 @@ func (s *productService) DeleteProduct(id string) error {
 		return fmt.Errorf("product not found")
 	}
 
-	if err := s.productRepo.Delete(id); err != nil {
+	if product.Version != version {
+		return ErrVersionConflict
+	}
+
+	revision := newRevision(models.RevisionDelete, deletedBy, nil, product.Snapshot())
+	if err := s.productRepo.Delete(storeID, id, version, revision); err != nil {
+		if errors.Is(err, ErrVersionConflict) {
+			return err
+		}
 		return fmt.Errorf("failed to delete product: %w", err)
 	}
 
@@ -189,6 +300,14 @@ func (s *productService) ListProducts(filter *models.ProductFilter, pagination *
 		active := true
 		filter.IsActive = &active
 	}
+	if filter.CategoryID == "" && filter.Category != "" {
+		categoryID, err := s.resolveCategoryID(filter.StoreID, filter.Category)
+		if err != nil {
+			return nil, nil, err
+		}
+		filter.CategoryID = categoryID
+	}
+	s.defaultPriceBounds(filter.MinPrice, filter.MaxPrice)
 
 	products, paginationResp, err := s.productRepo.List(filter, pagination)
 	if err != nil {
@@ -218,6 +337,14 @@ func (s *productService) SearchProducts(filter *models.SearchFilter, pagination
 		active := true
 		filter.IsActive = &active
 	}
+	if filter.CategoryID == "" && filter.Category != "" {
+		categoryID, err := s.resolveCategoryID(filter.StoreID, filter.Category)
+		if err != nil {
+			return nil, nil, err
+		}
+		filter.CategoryID = categoryID
+	}
+	s.defaultPriceBounds(filter.MinPrice, filter.MaxPrice)
 
 	products, paginationResp, err := s.productRepo.Search(filter, pagination)
 	if err != nil {
@@ -227,13 +354,172 @@ func (s *productService) SearchProducts(filter *models.SearchFilter, pagination
 	return products, paginationResp, nil
 }
 
-func (s *productService) GetCategories() ([]*models.Category, error) {
-	categories, err := s.productRepo.GetCategories()
+// defaultPriceBounds puts price bounds sent without a currency in the
+// default one; the repository only matches a bound against products priced
+// in its currency
+func (s *productService) defaultPriceBounds(bounds ...*models.Money) {
+	for _, bound := range bounds {
+		if bound != nil && bound.Currency == "" {
+			bound.Currency = s.defaultCurrency
+		}
+	}
+}
+
+// productCurrency is the currency a new product is priced in
+func (s *productService) productCurrency(req *models.CreateProductRequest) string {
+	if req.Price.Currency != "" {
+		return req.Price.Currency
+	}
+	return s.defaultCurrency
+}
+
+// validateCreateRequest checks the request for a product priced in currency,
+// filling that in on prices sent without one
+func validateCreateRequest(req *models.CreateProductRequest, currency string) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("product name is required")
+	}
+	if err := checkPrice("price", &req.Price, currency); err != nil {
+		return err
+	}
+	if req.Price.Sign() < 0 {
+		return fmt.Errorf("price cannot be negative")
+	}
+	if req.StockQuantity < 0 {
+		return fmt.Errorf("stock quantity cannot be negative")
+	}
+	if req.ReorderThreshold != nil && *req.ReorderThreshold < 0 {
+		return fmt.Errorf("reorder threshold cannot be negative")
+	}
+	if req.CompareAtPrice != nil {
+		if err := checkPrice("compare-at price", req.CompareAtPrice, currency); err != nil {
+			return err
+		}
+		if req.CompareAtPrice.Sign() <= 0 {
+			return fmt.Errorf("compare-at price must be greater than zero")
+		}
+	}
+	return nil
+}
+
+// newProduct builds an active product of the store from a validated create request
+func (s *productService) newProduct(storeID string, req *models.CreateProductRequest, sku, createdBy string) (*models.Product, error) {
+	product := &models.Product{
+		StoreID:       storeID,
+		Name:          strings.TrimSpace(req.Name),
+		Description:   strings.TrimSpace(req.Description),
+		Price:         req.Price,
+		Currency:      req.Price.Currency,
+		StockQuantity: req.StockQuantity,
+		ImageURL:      req.ImageURL,
+		SKU:           sku,
+		IsActive:      true,
+		CreatedBy:     createdBy,
+
+		ReorderThreshold: req.ReorderThreshold,
+		CompareAtPrice:   req.CompareAtPrice,
+	}
+
+	if err := s.assignCategory(product, req.CategoryID, req.Category); err != nil {
+		return nil, err
+	}
+	if err := s.applyAttributes(product, req.Attributes); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// assignCategory links the product to a category of its store given by ID,
+// or by slug or name for callers that still send the old free-text category
+func (s *productService) assignCategory(product *models.Product, categoryID, categoryRef string) error {
+	ref := categoryID
+	if ref == "" {
+		ref = categoryRef
+	}
+	if ref == "" {
+		return nil
+	}
+
+	category, err := resolveCategory(s.categoryRepo, product.StoreID, ref)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get categories: %w", err)
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+	if category == nil {
+		return fmt.Errorf("category not found")
+	}
+
+	product.CategoryID = &category.ID
+	product.Category = category.Name
+	return nil
+}
+
+// applyAttributes validates raw attribute values against the product's
+// category schema, including attributes inherited from parent categories
+func (s *productService) applyAttributes(product *models.Product, raw map[string]string) error {
+	var definitions []*models.AttributeDefinition
+	if product.CategoryID != nil {
+		var err error
+		definitions, err = s.attributeRepo.ListInherited(*product.CategoryID)
+		if err != nil {
+			return fmt.Errorf("failed to get category attributes: %w", err)
+		}
+	} else if len(raw) > 0 {
+		return fmt.Errorf("attributes require the product to have a category")
+	}
+
+	attributes, err := buildAttributes(definitions, raw)
+	if err != nil {
+		return err
+	}
+
+	product.Attributes = attributes
+	return nil
+}
+
+// mergeAttributes applies an attribute update on top of the existing values.
+// When the category changed, attributes the new schema doesn't know are dropped.
+func (s *productService) mergeAttributes(product *models.Product, updates map[string]string, categoryChanged bool) error {
+	var known map[string]bool
+	if categoryChanged && product.CategoryID != nil {
+		definitions, err := s.attributeRepo.ListInherited(*product.CategoryID)
+		if err != nil {
+			return fmt.Errorf("failed to get category attributes: %w", err)
+		}
+		known = make(map[string]bool, len(definitions))
+		for _, definition := range definitions {
+			known[definition.Key] = true
+		}
+	}
+
+	raw := make(map[string]string, len(product.Attributes)+len(updates))
+	for key, value := range product.Attributes {
+		if categoryChanged && !known[key] {
+			continue
+		}
+		raw[key] = FormatAttributeValue(value)
+	}
+	for key, value := range updates {
+		if value == "" {
+			delete(raw, key)
+			continue
+		}
+		raw[key] = value
+	}
+
+	return s.applyAttributes(product, raw)
+}
+
+func (s *productService) resolveCategoryID(storeID, ref string) (string, error) {
+	category, err := resolveCategory(s.categoryRepo, storeID, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to get category: %w", err)
+	}
+	if category == nil {
+		return "", fmt.Errorf("category not found")
 	}
 
-	return categories, nil
+	return category.ID, nil
 }
 
 func (s *productService) generateSKU(productName string) string {
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+const (
+	defaultRecommendations = 8
+	maxRecommendations     = 50
+
+	// A viewer looking at a product again within this long isn't a new view
+	viewDedupeWindow = 30 * time.Minute
+
+	// How many of a user's latest views their recommendations start from
+	recentViewsForUser = 10
+
+	// Co-occurrence counts each viewer's latest views only, which bounds
+	// the pairs one busy session can add, and keeps each product's best
+	maxViewsPerViewer  = 50
+	maxRelatedProducts = 20
+)
+
+type RecommendationService interface {
+	RecordView(storeID string, view *models.ProductView) error
+	GetRecommendations(storeID, productID, userID string, limit int32) ([]*models.Recommendation, error)
+	Recompute(window time.Duration) (bool, error)
+}
+
+type recommendationService struct {
+	recommendationRepo repository.RecommendationRepository
+	productRepo        repository.ProductRepository
+}
+
+func NewRecommendationService(recommendationRepo repository.RecommendationRepository, productRepo repository.ProductRepository) RecommendationService {
+	return &recommendationService{
+		recommendationRepo: recommendationRepo,
+		productRepo:        productRepo,
+	}
+}
+
+func (s *recommendationService) RecordView(storeID string, view *models.ProductView) error {
+	if !uuidPattern.MatchString(view.ProductID) {
+		return fmt.Errorf("product not found")
+	}
+	view.ProductID = strings.ToLower(view.ProductID)
+	if len(view.UserID) > 255 || len(view.SessionID) > 200 {
+		return fmt.Errorf("viewer ID is too long")
+	}
+
+	return s.recommendationRepo.RecordView(storeID, view, viewDedupeWindow)
+}
+
+// GetRecommendations recommends products like productID, or for userID from
+// the products they viewed lately, or the most popular ones when neither is
+// given. Products viewed together come first, then popular ones from the
+// same categories, then popular ones from anywhere.
+func (s *recommendationService) GetRecommendations(storeID, productID, userID string, limit int32) ([]*models.Recommendation, error) {
+	if limit <= 0 {
+		limit = defaultRecommendations
+	}
+	if limit > maxRecommendations {
+		limit = maxRecommendations
+	}
+
+	sources := []string{}
+	categoryIDs := []string{}
+	switch {
+	case productID != "":
+		if !uuidPattern.MatchString(productID) {
+			return nil, fmt.Errorf("product not found")
+		}
+		product, err := s.productRepo.GetByID(storeID, strings.ToLower(productID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product: %w", err)
+		}
+		if product == nil || product.DeletedAt != nil {
+			return nil, fmt.Errorf("product not found")
+		}
+		sources = append(sources, product.ID)
+		if product.CategoryID != nil {
+			categoryIDs = append(categoryIDs, *product.CategoryID)
+		}
+	case userID != "":
+		viewed, err := s.recommendationRepo.RecentlyViewed(storeID, userID, recentViewsForUser)
+		if err != nil {
+			return nil, err
+		}
+		products, err := s.productRepo.ListByIDs(storeID, viewed)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, viewed...)
+		seen := make(map[string]bool)
+		for _, product := range products {
+			if product.CategoryID != nil && !seen[*product.CategoryID] {
+				seen[*product.CategoryID] = true
+				categoryIDs = append(categoryIDs, *product.CategoryID)
+			}
+		}
+	}
+
+	// What the recommendations are based on is never recommended back
+	exclude := append([]string{}, sources...)
+	var ids, reasons []string
+	add := func(found []string, reason string) {
+		for _, id := range found {
+			ids = append(ids, id)
+			reasons = append(reasons, reason)
+		}
+		exclude = append(exclude, found...)
+	}
+
+	if len(sources) > 0 {
+		related, err := s.recommendationRepo.Related(storeID, sources, exclude, int(limit))
+		if err != nil {
+			return nil, err
+		}
+		add(related, models.RecommendationAlsoViewed)
+	}
+	if len(categoryIDs) > 0 && len(ids) < int(limit) {
+		popular, err := s.recommendationRepo.PopularInCategories(storeID, categoryIDs, exclude, int(limit)-len(ids))
+		if err != nil {
+			return nil, err
+		}
+		add(popular, models.RecommendationPopularInCategory)
+	}
+	if len(ids) < int(limit) {
+		popular, err := s.recommendationRepo.Popular(storeID, exclude, int(limit)-len(ids))
+		if err != nil {
+			return nil, err
+		}
+		add(popular, models.RecommendationPopular)
+	}
+
+	return s.loadProducts(storeID, ids, reasons)
+}
+
+// loadProducts loads the recommended products in order, leaving out any
+// that were deactivated or deleted since they were picked
+func (s *recommendationService) loadProducts(storeID string, ids, reasons []string) ([]*models.Recommendation, error) {
+	if len(ids) == 0 {
+		return []*models.Recommendation{}, nil
+	}
+
+	products, err := s.productRepo.ListByIDs(storeID, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*models.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+
+	recommendations := make([]*models.Recommendation, 0, len(ids))
+	for i, id := range ids {
+		if product := byID[id]; product != nil && product.IsActive {
+			recommendations = append(recommendations, &models.Recommendation{Product: product, Reason: reasons[i]})
+		}
+	}
+	return recommendations, nil
+}
+
+// Recompute rebuilds the recommendation scores from the views within the
+// window, forgetting older ones
+func (s *recommendationService) Recompute(window time.Duration) (bool, error) {
+	return s.recommendationRepo.Recompute(time.Now().Add(-window), maxViewsPerViewer, maxRelatedProducts)
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+const (
+	maxReviewTitleLength = 200
+	maxReviewBodyLength  = 5000
+)
+
+type ReviewService interface {
+	CreateReview(storeID, productID, userID, authorName string, req *models.CreateReviewRequest) (*models.Review, error)
+	UpdateReview(storeID, reviewID, userID string, req *models.UpdateReviewRequest) (*models.Review, error)
+	DeleteReview(storeID, reviewID, userID string, moderator bool) error
+	ListReviews(filter *models.ReviewFilter, moderator bool, pagination *models.PaginationRequest) ([]*models.Review, *models.PaginationResponse, error)
+	ModerateReview(storeID, reviewID, status, note, moderatedBy string) (*models.Review, error)
+}
+
+type reviewService struct {
+	reviewRepo  repository.ReviewRepository
+	productRepo repository.ProductRepository
+}
+
+func NewReviewService(reviewRepo repository.ReviewRepository, productRepo repository.ProductRepository) ReviewService {
+	return &reviewService{
+		reviewRepo:  reviewRepo,
+		productRepo: productRepo,
+	}
+}
+
+// CreateReview adds a review that waits for moderation before it is shown
+func (s *reviewService) CreateReview(storeID, productID, userID, authorName string, req *models.CreateReviewRequest) (*models.Review, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	if !uuidPattern.MatchString(productID) {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	review := &models.Review{
+		ProductID:  product.ID,
+		UserID:     userID,
+		AuthorName: strings.TrimSpace(authorName),
+		Rating:     req.Rating,
+		Title:      strings.TrimSpace(req.Title),
+		Body:       strings.TrimSpace(req.Body),
+		Status:     models.ReviewPending,
+	}
+	if review.AuthorName == "" {
+		review.AuthorName = "Anonymous"
+	}
+	if err := validateReview(review); err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.Create(review); err != nil {
+		if errors.Is(err, repository.ErrDuplicateReview) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create review: %w", err)
+	}
+
+	return review, nil
+}
+
+// UpdateReview changes the author's own review. The new text hasn't been
+// moderated, so the review goes back to pending.
+func (s *reviewService) UpdateReview(storeID, reviewID, userID string, req *models.UpdateReviewRequest) (*models.Review, error) {
+	review, err := s.getReview(storeID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID != userID {
+		return nil, fmt.Errorf("only the review's author can edit it")
+	}
+
+	review.Rating = req.Rating
+	review.Title = strings.TrimSpace(req.Title)
+	review.Body = strings.TrimSpace(req.Body)
+	if err := validateReview(review); err != nil {
+		return nil, err
+	}
+
+	review.Status = models.ReviewPending
+	review.ModeratedBy = ""
+	review.ModerationNote = ""
+	if err := s.reviewRepo.Update(review); err != nil {
+		return nil, fmt.Errorf("failed to update review: %w", err)
+	}
+
+	return review, nil
+}
+
+// DeleteReview removes a review; authors can delete their own and
+// moderators any
+func (s *reviewService) DeleteReview(storeID, reviewID, userID string, moderator bool) error {
+	review, err := s.getReview(storeID, reviewID)
+	if err != nil {
+		return err
+	}
+	if !moderator && review.UserID != userID {
+		return fmt.Errorf("only the review's author can delete it")
+	}
+
+	return s.reviewRepo.Delete(review.ID)
+}
+
+// ListReviews lists a product's approved reviews and the viewer's own.
+// Moderators see every status, or the one the filter asks for, and can
+// leave out the product to list reviews of all products.
+func (s *reviewService) ListReviews(filter *models.ReviewFilter, moderator bool, pagination *models.PaginationRequest) ([]*models.Review, *models.PaginationResponse, error) {
+	if filter.ProductID != "" || !moderator {
+		if !uuidPattern.MatchString(filter.ProductID) {
+			return nil, nil, fmt.Errorf("product not found")
+		}
+		filter.ProductID = strings.ToLower(filter.ProductID)
+	}
+	if filter.Rating < 0 || filter.Rating > 5 {
+		return nil, nil, fmt.Errorf("rating filter must be between 1 and 5")
+	}
+
+	if moderator {
+		for _, status := range filter.Statuses {
+			if !validReviewStatus(status) {
+				return nil, nil, fmt.Errorf("invalid review status %q", status)
+			}
+		}
+		filter.ViewerID = ""
+	} else {
+		filter.Statuses = []string{models.ReviewApproved}
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 {
+		pagination.Limit = 10
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+
+	return s.reviewRepo.List(filter, pagination)
+}
+
+// ModerateReview approves or rejects a review. The note tells the author why
+// a review was rejected; approving clears it.
+func (s *reviewService) ModerateReview(storeID, reviewID, status, note, moderatedBy string) (*models.Review, error) {
+	if status != models.ReviewApproved && status != models.ReviewRejected {
+		return nil, fmt.Errorf("status must be %s or %s", models.ReviewApproved, models.ReviewRejected)
+	}
+
+	review, err := s.getReview(storeID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if moderatedBy == "" {
+		moderatedBy = "system"
+	}
+	review.Status = status
+	review.ModeratedBy = moderatedBy
+	review.ModerationNote = ""
+	if status == models.ReviewRejected {
+		review.ModerationNote = strings.TrimSpace(note)
+	}
+
+	if err := s.reviewRepo.Update(review); err != nil {
+		return nil, fmt.Errorf("failed to moderate review: %w", err)
+	}
+
+	return review, nil
+}
+
+func (s *reviewService) getReview(storeID, reviewID string) (*models.Review, error) {
+	if !uuidPattern.MatchString(reviewID) {
+		return nil, fmt.Errorf("review not found")
+	}
+
+	review, err := s.reviewRepo.GetByID(storeID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review == nil {
+		return nil, fmt.Errorf("review not found")
+	}
+
+	return review, nil
+}
+
+func validateReview(review *models.Review) error {
+	if review.Rating < 1 || review.Rating > 5 {
+		return fmt.Errorf("rating must be between 1 and 5")
+	}
+	if utf8.RuneCountInString(review.Title) > maxReviewTitleLength {
+		return fmt.Errorf("title must be at most %d characters", maxReviewTitleLength)
+	}
+	if utf8.RuneCountInString(review.Body) > maxReviewBodyLength {
+		return fmt.Errorf("review must be at most %d characters", maxReviewBodyLength)
+	}
+	return nil
+}
+
+func validReviewStatus(status string) bool {
+	switch status {
+	case models.ReviewPending, models.ReviewApproved, models.ReviewRejected:
+		return true
+	}
+	return false
+}
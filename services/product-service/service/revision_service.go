@@ -0,0 +1,144 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+// newRevision describes a change for the product history. Creates list every
+// field that was set; updates and reverts list what differs from before.
+func newRevision(action, actor string, before, after *models.ProductSnapshot) *models.ProductRevision {
+	if actor == "" {
+		actor = "system"
+	}
+
+	revision := &models.ProductRevision{
+		Action:   action,
+		Actor:    actor,
+		Snapshot: after,
+	}
+	switch action {
+	case models.RevisionCreate:
+		revision.Changes = after.Diff(nil)
+	case models.RevisionUpdate, models.RevisionRevert:
+		revision.Changes = after.Diff(before)
+	}
+
+	return revision
+}
+
+func (s *productService) GetProductHistory(storeID, productID string, pagination *models.PaginationRequest) ([]*models.ProductRevision, *models.PaginationResponse, error) {
+	if !uuidPattern.MatchString(productID) {
+		return nil, nil, fmt.Errorf("product not found")
+	}
+	productID = strings.ToLower(productID)
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 {
+		pagination.Limit = 20
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+
+	return s.revisionRepo.ListByProduct(storeID, productID, pagination)
+}
+
+// RevertProductToRevision puts the product's fields back the way they were
+// after the given revision. It is recorded as a new revision, so a revert can
+// itself be reverted. Stock is left alone.
+func (s *productService) RevertProductToRevision(storeID, productID, revisionID string, version int32, revertedBy string) (*models.Product, error) {
+	if !uuidPattern.MatchString(productID) {
+		return nil, fmt.Errorf("product not found")
+	}
+	if !uuidPattern.MatchString(revisionID) {
+		return nil, fmt.Errorf("revision not found")
+	}
+	if version <= 0 {
+		return nil, fmt.Errorf("product version is required")
+	}
+	productID = strings.ToLower(productID)
+
+	revision, err := s.revisionRepo.GetByID(revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil || revision.ProductID != productID {
+		return nil, fmt.Errorf("revision not found")
+	}
+	if revision.Snapshot == nil {
+		return nil, fmt.Errorf("revision has no product state to revert to")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil {
+		return nil, fmt.Errorf("product not found")
+	}
+	if product.Version != version {
+		return nil, ErrVersionConflict
+	}
+	before := product.Snapshot()
+
+	target := revision.Snapshot
+	// Snapshots taken before prices carried a currency hold bare amounts
+	if target.Price.Currency == "" {
+		target.Price.Currency = product.Currency
+	}
+	if target.CompareAtPrice != nil && target.CompareAtPrice.Currency == "" {
+		target.CompareAtPrice.Currency = product.Currency
+	}
+
+	product.Name = target.Name
+	product.Description = target.Description
+	product.Price = target.Price
+	product.ImageURL = target.ImageURL
+	product.IsActive = target.IsActive
+	product.ReorderThreshold = target.ReorderThreshold
+	product.CompareAtPrice = target.CompareAtPrice
+
+	if target.CategoryID == nil {
+		product.CategoryID = nil
+		product.Category = ""
+	} else if err := s.assignCategory(product, *target.CategoryID, ""); err != nil {
+		return nil, fmt.Errorf("cannot revert: the revision's category no longer exists")
+	}
+
+	if target.SKU != product.SKU {
+		existingProduct, err := s.productRepo.GetBySKU(storeID, target.SKU)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing SKU: %w", err)
+		}
+		if existingProduct != nil && existingProduct.ID != productID {
+			return nil, fmt.Errorf("cannot revert: SKU %s is now used by another product", target.SKU)
+		}
+		product.SKU = target.SKU
+	}
+
+	// Attributes are checked against the category schema as it is now
+	raw := make(map[string]string, len(target.Attributes))
+	for key, value := range target.Attributes {
+		raw[key] = FormatAttributeValue(value)
+	}
+	if err := s.applyAttributes(product, raw); err != nil {
+		return nil, fmt.Errorf("cannot revert: %w", err)
+	}
+
+	record := newRevision(models.RevisionRevert, revertedBy, before, product.Snapshot())
+	record.RevertedTo = &revision.ID
+	if _, err := s.productRepo.Update(product, record, nil, ""); err != nil {
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to revert product: %w", err)
+	}
+
+	return product, nil
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/notifier"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+// testServices are the services the isolation tests go through, backed by
+// the test database
+type testServices struct {
+	db         *sql.DB
+	products   ProductService
+	categories CategoryService
+	variants   VariantService
+	images     ImageService
+	inventory  InventoryService
+	wishlists  WishlistService
+}
+
+// newTestServices connects to TEST_DATABASE_URL and brings its schema up to
+// date; tests that need a database are skipped without one
+func newTestServices(t *testing.T) *testServices {
+	t.Helper()
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := repository.Migrate(db); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	inventory := NewInventoryService(repository.NewInventoryRepository(db), notifier.Log{}, 15*time.Minute)
+	return &testServices{
+		db:         db,
+		products:   NewProductService(productRepo, categoryRepo, repository.NewAttributeRepository(db), repository.NewRevisionRepository(db), inventory, 30*24*time.Hour, "USD"),
+		categories: NewCategoryService(categoryRepo),
+		variants:   NewVariantService(repository.NewVariantRepository(db), productRepo, inventory),
+		images:     NewImageService(repository.NewImageRepository(db), productRepo),
+		inventory:  inventory,
+		wishlists:  NewWishlistService(repository.NewWishlistRepository(db), productRepo),
+	}
+}
+
+func newTestUUID(t *testing.T) string {
+	t.Helper()
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("failed to generate UUID: %v", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// storeFixture is what one store of the isolation tests holds. Both stores
+// use the same SKUs and slugs, which only have to be unique per store.
+type storeFixture struct {
+	storeID string
+	root    *models.Category
+	product *models.Product
+	variant *models.ProductVariant
+	image   *models.ProductImage
+}
+
+func (s *testServices) seedStore(t *testing.T, run string) *storeFixture {
+	t.Helper()
+	f := &storeFixture{storeID: newTestUUID(t)}
+	storeID, db := f.storeID, s.db
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM stock_reservations WHERE store_id = $1`, storeID)
+		db.Exec(`DELETE FROM products WHERE store_id = $1`, storeID)
+		db.Exec(`DELETE FROM categories WHERE store_id = $1 AND parent_id IS NOT NULL`, storeID)
+		db.Exec(`DELETE FROM categories WHERE store_id = $1`, storeID)
+	})
+
+	var err error
+	f.root, err = s.categories.CreateCategory(storeID, &models.CreateCategoryRequest{Name: "Isolation " + run, Slug: "isolation-" + run})
+	if err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+	child, err := s.categories.CreateCategory(storeID, &models.CreateCategoryRequest{Name: "Child " + run, Slug: "isolation-child-" + run, ParentID: f.root.ID})
+	if err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+
+	price, err := models.ParseMoney("10.00", "USD")
+	if err != nil {
+		t.Fatalf("ParseMoney: %v", err)
+	}
+	f.product, err = s.products.CreateProduct(storeID, &models.CreateProductRequest{
+		Name:          "Isolation product " + run,
+		Price:         price,
+		StockQuantity: 10,
+		CategoryID:    child.ID,
+		SKU:           "ISO-" + run,
+	}, "isolation-test")
+	if err != nil {
+		t.Fatalf("create product: %v", err)
+	}
+
+	if _, err := s.variants.SetProductOptions(storeID, f.product.ID, []*models.ProductOption{{Name: "Color", Values: []string{"Red", "Blue"}}}); err != nil {
+		t.Fatalf("set options: %v", err)
+	}
+	f.variant, err = s.variants.CreateVariant(storeID, f.product.ID, &models.CreateVariantRequest{
+		SKU:           "ISO-" + run + "-RED",
+		OptionValues:  map[string]string{"Color": "Red"},
+		StockQuantity: 5,
+	})
+	if err != nil {
+		t.Fatalf("create variant: %v", err)
+	}
+
+	f.image, err = s.images.AddImage(storeID, f.product.ID, &models.ProductImage{URL: "https://media.test/" + run + ".png", ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("add image: %v", err)
+	}
+
+	return f
+}
+
+func productIDs(products []*models.Product) map[string]bool {
+	ids := make(map[string]bool)
+	for _, product := range products {
+		ids[product.ID] = true
+	}
+	return ids
+}
+
+// TestStoreIsolation checks that a store's products, categories, variants,
+// images, reservations and wishlist entries can't be seen or changed from
+// another store. It needs a database; set TEST_DATABASE_URL to run it.
+func TestStoreIsolation(t *testing.T) {
+	s := newTestServices(t)
+	run := strings.ToLower(newTestUUID(t)[:8])
+	a, b := s.seedStore(t, run), s.seedStore(t, run)
+	page := &models.PaginationRequest{Page: 1, Limit: 100}
+
+	t.Run("GetProduct", func(t *testing.T) {
+		if product, err := s.products.GetProduct(a.storeID, b.product.ID); err == nil {
+			t.Errorf("got store B's product %v from store A", product)
+		}
+		if product, err := s.products.GetProduct(a.storeID, a.product.ID); err != nil || product.ID != a.product.ID {
+			t.Errorf("got %v, %v for store A's own product", product, err)
+		}
+	})
+
+	t.Run("UpdateProduct", func(t *testing.T) {
+		name := "Renamed from store A"
+		if _, err := s.products.UpdateProduct(a.storeID, b.product.ID, &models.UpdateProductRequest{Name: &name}, "isolation-test"); err == nil {
+			t.Error("updating store B's product from store A succeeded")
+		}
+		if err := s.products.DeleteProduct(a.storeID, b.product.ID, b.product.Version, "isolation-test"); err == nil {
+			t.Error("deleting store B's product from store A succeeded")
+		}
+		if product, err := s.products.GetProduct(b.storeID, b.product.ID); err != nil || product.Name != b.product.Name {
+			t.Errorf("store B's product is now %v, %v, want it unchanged", product, err)
+		}
+	})
+
+	t.Run("ListProducts", func(t *testing.T) {
+		listed, _, err := s.products.ListProducts(&models.ProductFilter{StoreID: a.storeID, CreatedBy: "isolation-test"}, page)
+		if err != nil {
+			t.Fatalf("ListProducts: %v", err)
+		}
+		if ids := productIDs(listed); !ids[a.product.ID] || ids[b.product.ID] {
+			t.Errorf("ListProducts returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("SearchProducts", func(t *testing.T) {
+		found, _, err := s.products.SearchProducts(&models.SearchFilter{StoreID: a.storeID, Query: "Isolation product " + run}, page)
+		if err != nil {
+			t.Fatalf("SearchProducts: %v", err)
+		}
+		if ids := productIDs(found); len(ids) != 1 || !ids[a.product.ID] {
+			t.Errorf("SearchProducts returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("BatchGetProducts", func(t *testing.T) {
+		found, notFound, err := s.products.BatchGetProducts(a.storeID, []string{a.product.ID, b.product.ID}, []string{a.product.SKU})
+		if err != nil {
+			t.Fatalf("BatchGetProducts: %v", err)
+		}
+		if ids := productIDs(found); len(ids) != 1 || !ids[a.product.ID] {
+			t.Errorf("BatchGetProducts returned %v, want store A's product only", ids)
+		}
+		if len(notFound) != 1 || notFound[0] != b.product.ID {
+			t.Errorf("BatchGetProducts reported %v not found, want store B's product", notFound)
+		}
+	})
+
+	t.Run("BatchUpdateProducts", func(t *testing.T) {
+		price, _ := models.ParseMoney("1.00", "USD")
+		patch := &models.ProductPatch{ID: b.product.ID, Price: &price}
+		if err := s.products.BatchUpdateProducts(a.storeID, []*models.ProductPatch{patch}, "isolation-test"); err != nil {
+			t.Fatalf("BatchUpdateProducts: %v", err)
+		}
+		if patch.Err == nil {
+			t.Error("patching store B's product from store A succeeded")
+		}
+		if product, err := s.products.GetProduct(b.storeID, b.product.ID); err != nil || product.Price.Cmp(b.product.Price) != 0 {
+			t.Errorf("store B's product is now %v, %v, want its price unchanged", product, err)
+		}
+	})
+
+	t.Run("ExportProducts", func(t *testing.T) {
+		var exported []*models.Product
+		err := s.products.ExportProducts(&models.ProductFilter{StoreID: a.storeID, CreatedBy: "isolation-test"}, func(product *models.Product) error {
+			exported = append(exported, product)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ExportProducts: %v", err)
+		}
+		if ids := productIDs(exported); !ids[a.product.ID] || ids[b.product.ID] {
+			t.Errorf("ExportProducts returned %v, want store A's product only", ids)
+		}
+	})
+
+	t.Run("CategoryTree", func(t *testing.T) {
+		roots, err := s.categories.GetCategoryTree(a.storeID, "", true)
+		if err != nil {
+			t.Fatalf("GetCategoryTree: %v", err)
+		}
+		var walk func(nodes []*models.CategoryNode)
+		walk = func(nodes []*models.CategoryNode) {
+			for _, node := range nodes {
+				if node.Category.StoreID != a.storeID {
+					t.Errorf("store A's tree holds category %s of store %s", node.Category.ID, node.Category.StoreID)
+				}
+				walk(node.Children)
+			}
+		}
+		walk(roots)
+
+		roots, err = s.categories.GetCategoryTree(a.storeID, b.root.ID, true)
+		if err != nil || len(roots) != 0 {
+			t.Errorf("store A's tree under store B's root has %d roots, %v, want none", len(roots), err)
+		}
+
+		if _, err := s.categories.MoveCategory(a.storeID, a.root.ID, b.root.ID); err == nil {
+			t.Error("moving a category under another store's category succeeded")
+		}
+		leaf, err := s.categories.CreateCategory(b.storeID, &models.CreateCategoryRequest{Name: "Leaf " + run, ParentID: b.root.ID})
+		if err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+		if err := s.categories.DeleteCategory(a.storeID, leaf.ID); err == nil {
+			t.Error("deleting store B's category from store A succeeded")
+		}
+		if err := s.categories.DeleteCategory(b.storeID, leaf.ID); err != nil {
+			t.Errorf("deleting the category in its own store: %v", err)
+		}
+	})
+
+	t.Run("Variants", func(t *testing.T) {
+		if _, _, err := s.variants.GetProductVariants(a.storeID, b.product.ID, false); err == nil {
+			t.Error("listing store B's variants from store A succeeded")
+		}
+		if _, err := s.variants.CreateVariant(a.storeID, b.product.ID, &models.CreateVariantRequest{OptionValues: map[string]string{"Color": "Blue"}}); err == nil {
+			t.Error("adding a variant to store B's product from store A succeeded")
+		}
+		sku := "ISO-" + run + "-STOLEN"
+		if _, err := s.variants.UpdateVariant(a.storeID, b.variant.ID, &models.UpdateVariantRequest{SKU: &sku}); err == nil {
+			t.Error("updating store B's variant from store A succeeded")
+		}
+		if err := s.variants.DeleteVariant(a.storeID, b.variant.ID); err == nil {
+			t.Error("deleting store B's variant from store A succeeded")
+		}
+
+		// Store B's variant SKU doesn't block store A from using it too
+		_, variants, err := s.variants.GetProductVariants(b.storeID, b.product.ID, false)
+		if err != nil || len(variants) != 1 || variants[0].SKU != a.variant.SKU {
+			t.Errorf("store B's variants are %v, %v, want its one variant unchanged", variants, err)
+		}
+	})
+
+	t.Run("Images", func(t *testing.T) {
+		if _, err := s.images.ListImages(a.storeID, b.product.ID); err == nil {
+			t.Error("listing store B's images from store A succeeded")
+		}
+		if _, err := s.images.AddImage(a.storeID, b.product.ID, &models.ProductImage{URL: "https://media.test/stolen.png", ContentType: "image/png"}); err == nil {
+			t.Error("adding an image to store B's product from store A succeeded")
+		}
+		if _, err := s.images.DeleteImage(a.storeID, b.image.ID); err == nil {
+			t.Error("deleting store B's image from store A succeeded")
+		}
+		if _, err := s.images.ReorderImages(a.storeID, b.product.ID, []string{b.image.ID}); err == nil {
+			t.Error("reordering store B's images from store A succeeded")
+		}
+		if images, err := s.images.ListImages(b.storeID, b.product.ID); err != nil || len(images) != 1 {
+			t.Errorf("store B's images are %v, %v, want its one image", images, err)
+		}
+	})
+
+	t.Run("Reservations", func(t *testing.T) {
+		item := func(f *storeFixture) []*models.ReservationItem {
+			return []*models.ReservationItem{{ProductID: f.product.ID, VariantID: &f.variant.ID, Quantity: 1}}
+		}
+
+		if _, err := s.inventory.ReserveStock(&models.ReserveStockRequest{StoreID: a.storeID, Items: item(b)}); err == nil {
+			t.Error("reserving store B's stock from store A succeeded")
+		}
+
+		reservation, err := s.inventory.ReserveStock(&models.ReserveStockRequest{StoreID: b.storeID, Reference: "isolation", Items: item(b)})
+		if err != nil {
+			t.Fatalf("ReserveStock: %v", err)
+		}
+		if _, err := s.inventory.CommitReservation(a.storeID, reservation.ID); err == nil {
+			t.Error("committing store B's reservation from store A succeeded")
+		}
+		if _, err := s.inventory.ReleaseReservation(a.storeID, reservation.ID); err == nil {
+			t.Error("releasing store B's reservation from store A succeeded")
+		}
+
+		released, err := s.inventory.ReleaseReservation(b.storeID, reservation.ID)
+		if err != nil || released.Status != models.ReservationReleased {
+			t.Errorf("releasing in store B returned %v, %v, want it released", released, err)
+		}
+	})
+
+	t.Run("Wishlists", func(t *testing.T) {
+		userID := newTestUUID(t)
+		t.Cleanup(func() { s.db.Exec(`DELETE FROM wishlist_items WHERE user_id = $1`, userID) })
+
+		if _, err := s.wishlists.AddToWishlist(a.storeID, userID, b.product.ID); err == nil {
+			t.Error("saving store B's product to a wishlist in store A succeeded")
+		}
+		if _, err := s.wishlists.AddToWishlist(a.storeID, userID, a.product.ID); err != nil {
+			t.Fatalf("AddToWishlist: %v", err)
+		}
+		if _, err := s.wishlists.AddToWishlist(b.storeID, userID, b.product.ID); err != nil {
+			t.Fatalf("AddToWishlist: %v", err)
+		}
+
+		for _, f := range []*storeFixture{a, b} {
+			wishlist, err := s.wishlists.GetWishlist(f.storeID, userID)
+			if err != nil {
+				t.Fatalf("GetWishlist: %v", err)
+			}
+			if len(wishlist.Items) != 1 || wishlist.Items[0].ProductID != f.product.ID {
+				t.Errorf("wishlist in store %s holds %d items, want only the store's own product", f.storeID, len(wishlist.Items))
+			}
+		}
+
+		if _, err := s.wishlists.RemoveFromWishlist(a.storeID, userID, b.product.ID); err == nil {
+			t.Error("removing store B's product from the wishlist in store A succeeded")
+		}
+		if wishlist, err := s.wishlists.GetWishlist(b.storeID, userID); err != nil || len(wishlist.Items) != 1 {
+			t.Errorf("store B's wishlist is %v, %v, want its product still saved", wishlist, err)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+const storeProductID = "00000000-0000-4000-8000-0000000000a1"
+
+// storeWishlists is a wishlist repository that records what was saved
+type storeWishlists struct {
+	repository.WishlistRepository
+	added []string
+}
+
+func (r *storeWishlists) Get(storeID, userID string) (*models.Wishlist, error) {
+	return &models.Wishlist{UserID: userID}, nil
+}
+
+func (r *storeWishlists) Add(userID, productID string, maxItems int) (bool, error) {
+	r.added = append(r.added, productID)
+	return true, nil
+}
+
+// storeWebhooks is a webhook repository that records the store each event
+// was queued for
+type storeWebhooks struct {
+	repository.WebhookRepository
+	queuedFor []string
+}
+
+func (r *storeWebhooks) Enqueue(storeID, eventID, eventType string, payload []byte) (int64, error) {
+	r.queuedFor = append(r.queuedFor, storeID)
+	return 1, nil
+}
+
+func storeProducts() *watchProducts {
+	return &watchProducts{products: map[string]*models.Product{
+		storeProductID: watchProduct(storeProductID, rootCategory),
+	}}
+}
+
+func TestProductsOfOtherStoresAreNotFound(t *testing.T) {
+	s := NewProductService(storeProducts(), &watchCategories{}, nil, nil, nil, 0, "USD")
+
+	if _, err := s.GetProduct(watchStore, storeProductID); err != nil {
+		t.Fatalf("GetProduct in the product's store: %v", err)
+	}
+	if _, err := s.GetProduct(models.DefaultStoreID, storeProductID); err == nil || err.Error() != "product not found" {
+		t.Errorf("GetProduct in another store: got %v, want product not found", err)
+	}
+}
+
+func TestWishlistsOnlySaveProductsOfTheStore(t *testing.T) {
+	wishlists := &storeWishlists{}
+	s := NewWishlistService(wishlists, storeProducts())
+
+	if _, err := s.AddToWishlist(models.DefaultStoreID, "user-1", storeProductID); err == nil {
+		t.Error("saved a product from another store")
+	}
+	if len(wishlists.added) != 0 {
+		t.Fatalf("got %v saved, want nothing", wishlists.added)
+	}
+
+	if _, err := s.AddToWishlist(watchStore, "user-1", storeProductID); err != nil {
+		t.Fatalf("AddToWishlist in the product's store: %v", err)
+	}
+	if len(wishlists.added) != 1 {
+		t.Errorf("got %v saved, want the product", wishlists.added)
+	}
+}
+
+func TestWebhookEventsGoToTheProductsStore(t *testing.T) {
+	webhooks := &storeWebhooks{}
+	s := NewWebhookService(webhooks, storeProducts(), 0, 1)
+	ctx := context.Background()
+
+	if err := s.HandleEvent(ctx, productUpdated(t, storeProductID, "")); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	// A product purged since has no store, so its event goes nowhere
+	if err := s.HandleEvent(ctx, productUpdated(t, "00000000-0000-4000-8000-0000000000a2", "")); err != nil {
+		t.Fatalf("HandleEvent for a purged product: %v", err)
+	}
+
+	if len(webhooks.queuedFor) != 1 || webhooks.queuedFor[0] != watchStore {
+		t.Errorf("events queued for stores %q, want only %q", webhooks.queuedFor, watchStore)
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+)
+
+func (s *productService) RestoreProduct(storeID, id string, restoredBy string) (*models.Product, error) {
+	if id == "" {
+		return nil, fmt.Errorf("product ID is required")
+	}
+
+	// The repository fills in the snapshot from the restored product
+	revision := newRevision(models.RevisionRestore, restoredBy, nil, nil)
+	if err := s.productRepo.Restore(storeID, id, revision); err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.GetByID(storeID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restored product: %w", err)
+	}
+	return product, nil
+}
+
+func (s *productService) ListDeletedProducts(storeID, createdBy string, pagination *models.PaginationRequest) ([]*models.Product, *models.PaginationResponse, error) {
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 {
+		pagination.Limit = 10
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+
+	return s.productRepo.ListDeleted(storeID, createdBy, pagination)
+}
+
+// PurgeDeletedProducts removes products of every store that have been in the
+// trash longer than the retention period and returns the storage keys of
+// their images
+func (s *productService) PurgeDeletedProducts() (int, []string, error) {
+	if s.trashRetention <= 0 {
+		return 0, nil, nil
+	}
+	return s.productRepo.PurgeDeleted(time.Now().Add(-s.trashRetention))
+}
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+type VariantService interface {
+	GetProductVariants(storeID, productID string, activeOnly bool) ([]*models.ProductOption, []*models.ProductVariant, error)
+	SetProductOptions(storeID, productID string, options []*models.ProductOption) ([]*models.ProductOption, error)
+	CreateVariant(storeID, productID string, req *models.CreateVariantRequest) (*models.ProductVariant, error)
+	UpdateVariant(storeID, id string, req *models.UpdateVariantRequest) (*models.ProductVariant, error)
+	DeleteVariant(storeID, id string) error
+}
+
+type variantService struct {
+	variantRepo      repository.VariantRepository
+	productRepo      repository.ProductRepository
+	inventoryService InventoryService
+}
+
+func NewVariantService(variantRepo repository.VariantRepository, productRepo repository.ProductRepository, inventoryService InventoryService) VariantService {
+	return &variantService{
+		variantRepo:      variantRepo,
+		productRepo:      productRepo,
+		inventoryService: inventoryService,
+	}
+}
+
+func (s *variantService) GetProductVariants(storeID, productID string, activeOnly bool) ([]*models.ProductOption, []*models.ProductVariant, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options, err := s.variantRepo.ListOptions(product.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get product options: %w", err)
+	}
+
+	variants, err := s.variantRepo.ListByProduct(product.ID, activeOnly)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get variants: %w", err)
+	}
+
+	for _, variant := range variants {
+		setEffectivePrice(variant, product)
+	}
+
+	return options, variants, nil
+}
+
+func (s *variantService) SetProductOptions(storeID, productID string, options []*models.ProductOption) ([]*models.ProductOption, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for i, option := range options {
+		option.Name = strings.TrimSpace(option.Name)
+		if option.Name == "" {
+			return nil, fmt.Errorf("option name is required")
+		}
+		if seen[strings.ToLower(option.Name)] {
+			return nil, fmt.Errorf("duplicate option %q", option.Name)
+		}
+		seen[strings.ToLower(option.Name)] = true
+
+		values, err := normalizeOptionValues(option.Name, option.Values)
+		if err != nil {
+			return nil, err
+		}
+		option.Values = values
+		option.Position = int32(i)
+	}
+
+	// Existing variants must still describe a valid combination under the new options
+	variants, err := s.variantRepo.ListByProduct(product.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variants: %w", err)
+	}
+	for _, variant := range variants {
+		if _, err := matchOptionValues(options, variant.OptionValues); err != nil {
+			return nil, fmt.Errorf("variant %s no longer matches the options (%v); update or delete it first", variant.SKU, err)
+		}
+	}
+
+	if err := s.variantRepo.ReplaceOptions(product.ID, options); err != nil {
+		return nil, fmt.Errorf("failed to set product options: %w", err)
+	}
+
+	return options, nil
+}
+
+func (s *variantService) CreateVariant(storeID, productID string, req *models.CreateVariantRequest) (*models.ProductVariant, error) {
+	product, err := s.getProduct(storeID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Price != nil {
+		if err := checkPrice("price", req.Price, product.Currency); err != nil {
+			return nil, err
+		}
+		if req.Price.Sign() < 0 {
+			return nil, fmt.Errorf("price cannot be negative")
+		}
+	}
+	if req.StockQuantity < 0 {
+		return nil, fmt.Errorf("stock quantity cannot be negative")
+	}
+
+	options, err := s.variantRepo.ListOptions(product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product options: %w", err)
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("product has no options; set its options before adding variants")
+	}
+
+	optionValues, err := matchOptionValues(options, req.OptionValues)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDuplicateCombination(product.ID, "", optionValues); err != nil {
+		return nil, err
+	}
+
+	sku := strings.TrimSpace(req.SKU)
+	if sku == "" {
+		sku = variantSKU(product.SKU, options, optionValues)
+	}
+	if err := s.checkSKU(product.StoreID, sku, ""); err != nil {
+		return nil, err
+	}
+
+	variant := &models.ProductVariant{
+		ProductID:     product.ID,
+		SKU:           sku,
+		OptionValues:  optionValues,
+		Price:         req.Price,
+		StockQuantity: req.StockQuantity,
+		IsActive:      true,
+	}
+
+	if err := s.variantRepo.Create(variant); err != nil {
+		return nil, fmt.Errorf("failed to create variant: %w", err)
+	}
+
+	setEffectivePrice(variant, product)
+	return variant, nil
+}
+
+func (s *variantService) UpdateVariant(storeID, id string, req *models.UpdateVariantRequest) (*models.ProductVariant, error) {
+	variant, product, err := s.getVariant(storeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.OptionValues) > 0 {
+		options, err := s.variantRepo.ListOptions(product.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product options: %w", err)
+		}
+		optionValues, err := matchOptionValues(options, req.OptionValues)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkDuplicateCombination(product.ID, variant.ID, optionValues); err != nil {
+			return nil, err
+		}
+		variant.OptionValues = optionValues
+	}
+
+	if req.SKU != nil {
+		sku := strings.TrimSpace(*req.SKU)
+		if sku == "" {
+			return nil, fmt.Errorf("variant SKU cannot be empty")
+		}
+		if sku != variant.SKU {
+			if err := s.checkSKU(product.StoreID, sku, variant.ID); err != nil {
+				return nil, err
+			}
+			variant.SKU = sku
+		}
+	}
+
+	if req.ClearPrice {
+		variant.Price = nil
+	} else if req.Price != nil {
+		if err := checkPrice("price", req.Price, product.Currency); err != nil {
+			return nil, err
+		}
+		if req.Price.Sign() < 0 {
+			return nil, fmt.Errorf("price cannot be negative")
+		}
+		variant.Price = req.Price
+	}
+
+	if req.StockQuantity != nil && *req.StockQuantity < 0 {
+		return nil, fmt.Errorf("stock quantity cannot be negative")
+	}
+
+	if req.IsActive != nil {
+		variant.IsActive = *req.IsActive
+	}
+
+	if err := s.variantRepo.Update(variant); err != nil {
+		return nil, fmt.Errorf("failed to update variant: %w", err)
+	}
+
+	// Stock goes through the ledger rather than being overwritten in place
+	if req.StockQuantity != nil {
+		level, err := s.inventoryService.SetStock(variant.ProductID, &variant.ID, *req.StockQuantity)
+		if err != nil {
+			return nil, err
+		}
+		variant.StockQuantity = level.StockQuantity
+		variant.ReservedQuantity = level.ReservedQuantity
+	}
+
+	setEffectivePrice(variant, product)
+	return variant, nil
+}
+
+func (s *variantService) DeleteVariant(storeID, id string) error {
+	if _, _, err := s.getVariant(storeID, id); err != nil {
+		return err
+	}
+
+	if err := s.variantRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete variant: %w", err)
+	}
+
+	return nil
+}
+
+// getVariant finds a variant along with its product. A variant of another
+// store's product is reported as missing.
+func (s *variantService) getVariant(storeID, id string) (*models.ProductVariant, *models.Product, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("variant ID is required")
+	}
+
+	variant, err := s.variantRepo.GetByID(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get variant: %w", err)
+	}
+	if variant == nil {
+		return nil, nil, fmt.Errorf("variant not found")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, variant.ProductID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil {
+		return nil, nil, fmt.Errorf("variant not found")
+	}
+
+	return variant, product, nil
+}
+
+func (s *variantService) getProduct(storeID, productID string) (*models.Product, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("product ID is required")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	return product, nil
+}
+
+func (s *variantService) checkSKU(storeID, sku, excludeID string) error {
+	exists, err := s.variantRepo.SKUExists(storeID, sku, excludeID)
+	if err != nil {
+		return fmt.Errorf("failed to check existing SKU: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("variant with SKU already exists")
+	}
+
+	return nil
+}
+
+func (s *variantService) checkDuplicateCombination(productID, excludeID string, optionValues map[string]string) error {
+	variants, err := s.variantRepo.ListByProduct(productID, false)
+	if err != nil {
+		return fmt.Errorf("failed to get variants: %w", err)
+	}
+
+	for _, existing := range variants {
+		if existing.ID != excludeID && sameOptionValues(existing.OptionValues, optionValues) {
+			return fmt.Errorf("a variant with these options already exists (SKU %s)", existing.SKU)
+		}
+	}
+
+	return nil
+}
+
+func setEffectivePrice(variant *models.ProductVariant, product *models.Product) {
+	variant.EffectivePrice = product.Price
+	if variant.Price != nil {
+		variant.EffectivePrice = *variant.Price
+	}
+}
+
+// normalizeOptionValues trims values and rejects empty or duplicate ones
+func normalizeOptionValues(optionName string, values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("option %q needs at least one value", optionName)
+	}
+
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("option %q has an empty value", optionName)
+		}
+		if seen[strings.ToLower(value)] {
+			return nil, fmt.Errorf("option %q has duplicate value %q", optionName, value)
+		}
+		seen[strings.ToLower(value)] = true
+		normalized = append(normalized, value)
+	}
+
+	return normalized, nil
+}
+
+// matchOptionValues checks that values names exactly one allowed value for
+// every option, matching case-insensitively, and returns them spelled the way
+// the options define them
+func matchOptionValues(options []*models.ProductOption, values map[string]string) (map[string]string, error) {
+	given := make(map[string]string, len(values))
+	for name, value := range values {
+		given[strings.ToLower(strings.TrimSpace(name))] = strings.TrimSpace(value)
+	}
+
+	matched := make(map[string]string, len(options))
+	for _, option := range options {
+		value, ok := given[strings.ToLower(option.Name)]
+		if !ok || value == "" {
+			return nil, fmt.Errorf("missing value for option %q", option.Name)
+		}
+		delete(given, strings.ToLower(option.Name))
+
+		found := false
+		for _, allowed := range option.Values {
+			if strings.EqualFold(allowed, value) {
+				matched[option.Name] = allowed
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%q is not a valid value for option %q", value, option.Name)
+		}
+	}
+
+	if len(given) > 0 {
+		unknown := make([]string, 0, len(given))
+		for name := range given {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown options: %s", strings.Join(unknown, ", "))
+	}
+
+	return matched, nil
+}
+
+func sameOptionValues(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if b[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+var nonSKUChars = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// variantSKU appends the option values, in option order, to the product SKU
+func variantSKU(productSKU string, options []*models.ProductOption, values map[string]string) string {
+	parts := []string{productSKU}
+	for _, option := range options {
+		part := strings.Trim(nonSKUChars.ReplaceAllString(strings.ToUpper(values[option.Name]), "-"), "-")
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
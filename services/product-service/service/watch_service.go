@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/events"
+	eventsPb "github.com/martbul/playground_microservices/services/product-service/genproto/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+	"google.golang.org/protobuf/proto"
+)
+
+// WatchEventTypes are the events that reach product watchers
+var WatchEventTypes = []string{
+	events.TypeProductCreated,
+	events.TypeProductUpdated,
+	events.TypeProductDeleted,
+	events.TypeProductRestored,
+	events.TypeStockChanged,
+}
+
+// ErrWatcherTooSlow ends a watch whose changes piled up unread. The watcher
+// should reconnect and reload what it shows.
+var ErrWatcherTooSlow = errors.New("watcher fell behind; reconnect to resume")
+
+const (
+	// How many changes wait for a watcher before it is dropped
+	watchBufferSize = 64
+	// Filters longer than this are refused
+	watchMaxFilterIDs = 200
+)
+
+type WatchService interface {
+	// Watch sends the changes matching filter on the returned channel until
+	// ctx is done or the watcher falls behind; either way the channel is
+	// closed, and Err tells which
+	Watch(ctx context.Context, filter *models.ProductWatchFilter) (*ProductWatcher, error)
+	// HandleEvent sends an event from the bus to the watchers it matches
+	HandleEvent(ctx context.Context, envelope *eventsPb.Envelope) error
+}
+
+// ProductWatcher is one open watch
+type ProductWatcher struct {
+	Changes <-chan *models.ProductChange
+
+	changes     chan *models.ProductChange
+	storeID     string
+	categoryIDs map[string]bool
+	productIDs  map[string]bool
+	err         error
+}
+
+// Err is ErrWatcherTooSlow if the watcher was dropped, nil otherwise. It is
+// only set once Changes is closed.
+func (w *ProductWatcher) Err() error {
+	return w.err
+}
+
+func (w *ProductWatcher) matches(storeID, productID, categoryID string) bool {
+	if w.storeID != storeID {
+		return false
+	}
+	if len(w.categoryIDs) == 0 && len(w.productIDs) == 0 {
+		return true
+	}
+	return w.productIDs[productID] || (categoryID != "" && w.categoryIDs[categoryID])
+}
+
+type watchService struct {
+	productRepo  repository.ProductRepository
+	categoryRepo repository.CategoryRepository
+
+	mu       sync.Mutex
+	watchers map[*ProductWatcher]struct{}
+}
+
+func NewWatchService(productRepo repository.ProductRepository, categoryRepo repository.CategoryRepository) WatchService {
+	return &watchService{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		watchers:     make(map[*ProductWatcher]struct{}),
+	}
+}
+
+func (s *watchService) Watch(ctx context.Context, filter *models.ProductWatchFilter) (*ProductWatcher, error) {
+	if len(filter.CategoryIDs)+len(filter.ProductIDs) > watchMaxFilterIDs {
+		return nil, fmt.Errorf("at most %d category and product IDs can be watched", watchMaxFilterIDs)
+	}
+
+	categoryIDs, err := s.withSubcategories(filter.StoreID, filter.CategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(chan *models.ProductChange, watchBufferSize)
+	watcher := &ProductWatcher{
+		Changes:     changes,
+		changes:     changes,
+		storeID:     filter.StoreID,
+		categoryIDs: categoryIDs,
+		productIDs:  toSet(filter.ProductIDs),
+	}
+
+	s.mu.Lock()
+	s.watchers[watcher] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.remove(watcher, nil)
+	}()
+
+	return watcher, nil
+}
+
+// HandleEvent loads the product once however many watchers want it. Sends
+// never block the bus: a watcher whose buffer is full is dropped instead.
+func (s *watchService) HandleEvent(ctx context.Context, envelope *eventsPb.Envelope) error {
+	message := events.NewPayload(envelope.Type)
+	if message == nil {
+		return nil
+	}
+	if err := proto.Unmarshal(envelope.Payload, message); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", envelope.Type, err)
+	}
+
+	change := &models.ProductChange{
+		EventID:   envelope.Id,
+		Type:      envelope.Type,
+		ProductID: envelope.AggregateId,
+	}
+	if occurredAt, err := time.Parse(time.RFC3339Nano, envelope.OccurredAt); err == nil {
+		change.OccurredAt = occurredAt
+	}
+
+	if !s.anyWatching() {
+		return nil
+	}
+	// Deleted products are still in the trash, so every event's product can
+	// be found
+	storeID, err := s.productRepo.StoreOf(change.ProductID)
+	if err != nil {
+		return err
+	}
+	if storeID == "" {
+		return nil
+	}
+
+	var categoryID, previousCategoryID string
+	if deleted, ok := message.(*eventsPb.ProductDeleted); ok {
+		// The product is gone from the catalog, so go by the event alone
+		categoryID = deleted.CategoryId
+		change.Removed = true
+	} else {
+		if updated, ok := message.(*eventsPb.ProductUpdated); ok {
+			previousCategoryID = updated.PreviousCategoryId
+		}
+		product, err := s.productRepo.GetByID(storeID, change.ProductID)
+		if err != nil {
+			return err
+		}
+		if product == nil || !product.IsActive {
+			change.Removed = true
+		} else {
+			change.Product = product
+		}
+		if product != nil && product.CategoryID != nil {
+			categoryID = *product.CategoryID
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for watcher := range s.watchers {
+		sent := change
+		switch {
+		case watcher.matches(storeID, change.ProductID, categoryID):
+		case previousCategoryID != "" && watcher.matches(storeID, change.ProductID, previousCategoryID):
+			// The product moved out of the categories the watcher shows
+			sent = &models.ProductChange{
+				EventID:    change.EventID,
+				Type:       change.Type,
+				ProductID:  change.ProductID,
+				Removed:    true,
+				OccurredAt: change.OccurredAt,
+			}
+		default:
+			continue
+		}
+		select {
+		case watcher.changes <- sent:
+		default:
+			s.removeLocked(watcher, ErrWatcherTooSlow)
+		}
+	}
+
+	return nil
+}
+
+func (s *watchService) anyWatching() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.watchers) > 0
+}
+
+func (s *watchService) remove(watcher *ProductWatcher, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeLocked(watcher, err)
+}
+
+func (s *watchService) removeLocked(watcher *ProductWatcher, err error) {
+	if _, ok := s.watchers[watcher]; !ok {
+		return
+	}
+	delete(s.watchers, watcher)
+	watcher.err = err
+	close(watcher.changes)
+}
+
+// withSubcategories is the set of the categories and all of their
+// descendants, as ListProducts goes by them. Categories added later aren't
+// in it; the watcher reloads when it reconnects.
+func (s *watchService) withSubcategories(storeID string, categoryIDs []string) (map[string]bool, error) {
+	set := toSet(categoryIDs)
+	for _, categoryID := range categoryIDs {
+		if !uuidPattern.MatchString(categoryID) {
+			continue
+		}
+		subtree, err := s.categoryRepo.ListSubtree(storeID, categoryID, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range subtree {
+			set[category.ID] = true
+		}
+	}
+	return set, nil
+}
+
+func toSet(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		if value != "" {
+			set[value] = true
+		}
+	}
+	return set
+}
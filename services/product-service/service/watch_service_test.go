@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/martbul/playground_microservices/services/product-service/events"
+	eventsPb "github.com/martbul/playground_microservices/services/product-service/genproto/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	watchStore    = "00000000-0000-4000-8000-00000000000a"
+	rootCategory  = "00000000-0000-4000-8000-0000000000c1"
+	childCategory = "00000000-0000-4000-8000-0000000000c2"
+	otherCategory = "00000000-0000-4000-8000-0000000000c3"
+)
+
+// watchProducts is a product repository holding a few products of one store
+type watchProducts struct {
+	repository.ProductRepository
+	products map[string]*models.Product
+}
+
+func (r *watchProducts) StoreOf(id string) (string, error) {
+	if product, ok := r.products[id]; ok {
+		return product.StoreID, nil
+	}
+	return "", nil
+}
+
+func (r *watchProducts) GetByID(storeID, id string) (*models.Product, error) {
+	if product, ok := r.products[id]; ok && product.StoreID == storeID {
+		return product, nil
+	}
+	return nil, nil
+}
+
+// watchCategories is a category tree of a root with one child, and a
+// category outside it
+type watchCategories struct {
+	repository.CategoryRepository
+}
+
+func (r *watchCategories) ListSubtree(storeID, rootID string, includeInactive bool) ([]*models.Category, error) {
+	if storeID != watchStore {
+		return nil, nil
+	}
+	switch rootID {
+	case rootCategory:
+		return []*models.Category{{ID: rootCategory}, {ID: childCategory}}, nil
+	case childCategory, otherCategory:
+		return []*models.Category{{ID: rootID}}, nil
+	}
+	return nil, nil
+}
+
+func watchProduct(id, categoryID string) *models.Product {
+	return &models.Product{ID: id, StoreID: watchStore, CategoryID: &categoryID, IsActive: true}
+}
+
+func productUpdated(t *testing.T, productID, previousCategoryID string) *eventsPb.Envelope {
+	t.Helper()
+	payload, err := proto.Marshal(&eventsPb.ProductUpdated{
+		Product:            &eventsPb.ProductState{Id: productID},
+		ChangedFields:      []string{"category_id"},
+		PreviousCategoryId: previousCategoryID,
+	})
+	if err != nil {
+		t.Fatalf("failed to encode event: %v", err)
+	}
+	return &eventsPb.Envelope{Id: productID + "-event", Type: events.TypeProductUpdated, AggregateId: productID, Payload: payload}
+}
+
+// received drains the changes waiting for the watcher
+func received(watcher *ProductWatcher) map[string]*models.ProductChange {
+	changes := make(map[string]*models.ProductChange)
+	for {
+		select {
+		case change := <-watcher.Changes:
+			changes[change.ProductID] = change
+		default:
+			return changes
+		}
+	}
+}
+
+func TestWatchCategoryCoversSubcategories(t *testing.T) {
+	products := &watchProducts{products: map[string]*models.Product{
+		"in-root":  watchProduct("in-root", rootCategory),
+		"in-child": watchProduct("in-child", childCategory),
+		"outside":  watchProduct("outside", otherCategory),
+	}}
+	s := NewWatchService(products, &watchCategories{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher, err := s.Watch(ctx, &models.ProductWatchFilter{StoreID: watchStore, CategoryIDs: []string{rootCategory}})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	for id := range products.products {
+		if err := s.HandleEvent(ctx, productUpdated(t, id, "")); err != nil {
+			t.Fatalf("HandleEvent: %v", err)
+		}
+	}
+
+	changes := received(watcher)
+	for _, id := range []string{"in-root", "in-child"} {
+		if change := changes[id]; change == nil || change.Removed || change.Product == nil {
+			t.Errorf("change to %s: got %+v, want the product", id, change)
+		}
+	}
+	if change := changes["outside"]; change != nil {
+		t.Errorf("got a change to a product outside the watched categories: %+v", change)
+	}
+}
+
+func TestWatchSendsProductsMovedOutAsRemoved(t *testing.T) {
+	products := &watchProducts{products: map[string]*models.Product{
+		"moved-out": watchProduct("moved-out", otherCategory),
+		"moved-in":  watchProduct("moved-in", childCategory),
+	}}
+	s := NewWatchService(products, &watchCategories{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	byCategory, err := s.Watch(ctx, &models.ProductWatchFilter{StoreID: watchStore, CategoryIDs: []string{rootCategory}})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	everything, err := s.Watch(ctx, &models.ProductWatchFilter{StoreID: watchStore})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	if err := s.HandleEvent(ctx, productUpdated(t, "moved-out", childCategory)); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if err := s.HandleEvent(ctx, productUpdated(t, "moved-in", otherCategory)); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	changes := received(byCategory)
+	if change := changes["moved-out"]; change == nil || !change.Removed || change.Product != nil {
+		t.Errorf("product moved out of the watched categories: got %+v, want it removed", change)
+	}
+	if change := changes["moved-in"]; change == nil || change.Removed {
+		t.Errorf("product moved into the watched categories: got %+v, want the product", change)
+	}
+
+	// Watchers without a category filter still see where the product went
+	if change := received(everything)["moved-out"]; change == nil || change.Removed {
+		t.Errorf("unfiltered watcher: got %+v, want the product", change)
+	}
+}
+
+func TestWatchIgnoresOtherStores(t *testing.T) {
+	products := &watchProducts{products: map[string]*models.Product{
+		"in-child": watchProduct("in-child", childCategory),
+	}}
+	s := NewWatchService(products, &watchCategories{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher, err := s.Watch(ctx, &models.ProductWatchFilter{StoreID: models.DefaultStoreID, CategoryIDs: []string{rootCategory}})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if err := s.HandleEvent(ctx, productUpdated(t, "in-child", "")); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if changes := received(watcher); len(changes) != 0 {
+		t.Errorf("got changes from another store: %v", changes)
+	}
+}
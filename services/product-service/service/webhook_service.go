@@ -0,0 +1,480 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/martbul/playground_microservices/services/product-service/events"
+	eventsPb "github.com/martbul/playground_microservices/services/product-service/genproto/events"
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// WebhookEventTypes are the events webhooks can subscribe to
+var WebhookEventTypes = []string{
+	events.TypeProductCreated,
+	events.TypeProductUpdated,
+	events.TypeProductDeleted,
+	events.TypeProductRestored,
+	events.TypeStockChanged,
+}
+
+// webhookTestEvent is the type of the events SendTestEvent sends
+const webhookTestEvent = "webhook.test"
+
+const (
+	// How many deliveries one pass sends at most, all at once
+	webhookBatchSize = 20
+	// The first retry waits this long, and each one after that twice as long
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = 6 * time.Hour
+	// How much of a response body is read before it is thrown away
+	webhookMaxResponseBody = 64 << 10
+)
+
+// WebhookService manages a store's webhooks; each gets the events of its own
+// store's products only
+type WebhookService interface {
+	CreateWebhook(storeID string, req *models.CreateWebhookRequest, createdBy string) (*models.Webhook, error)
+	UpdateWebhook(storeID, id string, req *models.UpdateWebhookRequest) (*models.Webhook, error)
+	DeleteWebhook(storeID, id string) error
+	ListWebhooks(storeID string) ([]*models.Webhook, error)
+	ListDeliveries(filter *models.WebhookDeliveryFilter, pagination *models.PaginationRequest) ([]*models.WebhookDelivery, *models.PaginationResponse, error)
+	RetryDelivery(storeID, id string) (*models.WebhookDelivery, error)
+	SendTestEvent(ctx context.Context, storeID, id string) (*models.WebhookDelivery, error)
+	// HandleEvent queues an event from the bus for the webhooks of the
+	// product's store subscribed to it
+	HandleEvent(ctx context.Context, envelope *eventsPb.Envelope) error
+	// DeliverDue sends the deliveries that are due and returns how many it sent
+	DeliverDue(ctx context.Context) (int, error)
+}
+
+type webhookService struct {
+	webhookRepo repository.WebhookRepository
+	productRepo repository.ProductRepository
+	httpClient  *http.Client
+	maxAttempts int32
+}
+
+func NewWebhookService(webhookRepo repository.WebhookRepository, productRepo repository.ProductRepository, timeout time.Duration, maxAttempts int) WebhookService {
+	return &webhookService{
+		webhookRepo: webhookRepo,
+		productRepo: productRepo,
+		httpClient:  &http.Client{Timeout: timeout},
+		maxAttempts: int32(maxAttempts),
+	}
+}
+
+// webhookPayload is the body of every webhook request. Data is the event's
+// payload in its protobuf JSON form, with snake_case field names.
+type webhookPayload struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int32           `json:"version"`
+	OccurredAt string          `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// CreateWebhook subscribes a URL to the given event types, or to all of
+// them if none are given. Without a secret one is generated; either way it
+// is only returned now.
+func (s *webhookService) CreateWebhook(storeID string, req *models.CreateWebhookRequest, createdBy string) (*models.Webhook, error) {
+	webhook := &models.Webhook{
+		StoreID:   storeID,
+		URL:       strings.TrimSpace(req.URL),
+		Secret:    req.Secret,
+		IsActive:  true,
+		CreatedBy: createdBy,
+	}
+
+	eventTypes, err := validateWebhook(webhook.URL, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	webhook.EventTypes = eventTypes
+
+	if webhook.Secret == "" {
+		if webhook.Secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	} else if len(webhook.Secret) < 16 {
+		return nil, fmt.Errorf("webhook secret must be at least 16 characters")
+	}
+
+	if err := s.webhookRepo.Create(webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// UpdateWebhook replaces a webhook's settings. Rotating the secret signs
+// everything sent from now on, including retries, with the new one.
+func (s *webhookService) UpdateWebhook(storeID, id string, req *models.UpdateWebhookRequest) (*models.Webhook, error) {
+	webhook, err := s.getWebhook(storeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookURL := strings.TrimSpace(req.URL)
+	eventTypes, err := validateWebhook(webhookURL, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.URL = webhookURL
+	webhook.EventTypes = eventTypes
+	webhook.IsActive = req.IsActive
+	if req.RotateSecret {
+		if webhook.Secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.webhookRepo.Update(webhook); err != nil {
+		return nil, err
+	}
+
+	if !req.RotateSecret {
+		webhook.Secret = ""
+	}
+	return webhook, nil
+}
+
+func (s *webhookService) DeleteWebhook(storeID, id string) error {
+	if !uuidPattern.MatchString(id) {
+		return fmt.Errorf("webhook not found")
+	}
+	return s.webhookRepo.Delete(storeID, strings.ToLower(id))
+}
+
+func (s *webhookService) ListWebhooks(storeID string) ([]*models.Webhook, error) {
+	webhooks, err := s.webhookRepo.List(storeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+	return webhooks, nil
+}
+
+// ListDeliveries lists the delivery log of the filter's store, optionally
+// for one webhook and in one status; status dead lists the dead-letter queue
+func (s *webhookService) ListDeliveries(filter *models.WebhookDeliveryFilter, pagination *models.PaginationRequest) ([]*models.WebhookDelivery, *models.PaginationResponse, error) {
+	if filter.WebhookID != "" {
+		if !uuidPattern.MatchString(filter.WebhookID) {
+			return nil, nil, fmt.Errorf("webhook not found")
+		}
+		filter.WebhookID = strings.ToLower(filter.WebhookID)
+	}
+	switch filter.Status {
+	case "", models.DeliveryPending, models.DeliverySucceeded, models.DeliveryDead:
+	default:
+		return nil, nil, fmt.Errorf("invalid delivery status %q", filter.Status)
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 {
+		pagination.Limit = 20
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+
+	return s.webhookRepo.ListDeliveries(filter, pagination)
+}
+
+// RetryDelivery takes a delivery out of the dead-letter queue; it is sent
+// again on the next pass
+func (s *webhookService) RetryDelivery(storeID, id string) (*models.WebhookDelivery, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("delivery not found")
+	}
+	id = strings.ToLower(id)
+
+	requeued, err := s.webhookRepo.Requeue(storeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !requeued {
+		delivery, err := s.webhookRepo.GetDelivery(storeID, id)
+		if err != nil {
+			return nil, err
+		}
+		if delivery == nil {
+			return nil, fmt.Errorf("delivery not found")
+		}
+		return nil, fmt.Errorf("only dead deliveries can be retried; this one is %s", delivery.Status)
+	}
+
+	return s.webhookRepo.GetDelivery(storeID, id)
+}
+
+// SendTestEvent sends a webhook.test event and waits for the result. It is
+// tried once and logged like any other delivery; paused webhooks can be
+// tested too.
+func (s *webhookService) SendTestEvent(ctx context.Context, storeID, id string) (*models.WebhookDelivery, error) {
+	webhook, err := s.getWebhook(storeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventID, err := newEventID()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(map[string]string{"webhook_id": webhook.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode test event: %w", err)
+	}
+	payload, err := json.Marshal(webhookPayload{
+		ID:         eventID,
+		Type:       webhookTestEvent,
+		Version:    1,
+		OccurredAt: time.Now().UTC().Format(time.RFC3339Nano),
+		Data:       data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode test event: %w", err)
+	}
+
+	delivery := &models.WebhookDelivery{
+		WebhookID: webhook.ID,
+		EventID:   eventID,
+		EventType: webhookTestEvent,
+		Payload:   payload,
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+	}
+	if err := s.webhookRepo.CreateDelivery(delivery); err != nil {
+		return nil, err
+	}
+
+	attempt := s.send(ctx, delivery)
+	status := models.DeliverySucceeded
+	if !attempt.Succeeded() {
+		status = models.DeliveryDead
+	}
+	if err := s.webhookRepo.RecordAttempt(delivery.ID, attempt, status, 0); err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepo.GetDelivery(storeID, delivery.ID)
+}
+
+func (s *webhookService) HandleEvent(ctx context.Context, envelope *eventsPb.Envelope) error {
+	message := events.NewPayload(envelope.Type)
+	if message == nil {
+		return nil
+	}
+
+	// Every event is about a product, which is still in the trash when it
+	// is deleted; one that has since been purged has no store to go to
+	storeID, err := s.productRepo.StoreOf(envelope.AggregateId)
+	if err != nil {
+		return err
+	}
+	if storeID == "" {
+		return nil
+	}
+
+	if err := proto.Unmarshal(envelope.Payload, message); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", envelope.Type, err)
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", envelope.Type, err)
+	}
+	payload, err := json.Marshal(webhookPayload{
+		ID:         envelope.Id,
+		Type:       envelope.Type,
+		Version:    envelope.Version,
+		OccurredAt: envelope.OccurredAt,
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", envelope.Type, err)
+	}
+
+	_, err = s.webhookRepo.Enqueue(storeID, envelope.Id, envelope.Type, payload)
+	return err
+}
+
+func (s *webhookService) DeliverDue(ctx context.Context) (int, error) {
+	// The lease outlasts an attempt, so nobody else picks these up meanwhile
+	deliveries, err := s.webhookRepo.ClaimDue(webhookBatchSize, s.httpClient.Timeout+time.Minute)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(deliveries))
+	for i, delivery := range deliveries {
+		wg.Add(1)
+		go func(i int, delivery *models.WebhookDelivery) {
+			defer wg.Done()
+			errs[i] = s.attempt(ctx, delivery)
+		}(i, delivery)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return len(deliveries), err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// attempt sends a claimed delivery and schedules the next try if it fails.
+// Once it has failed maxAttempts times it goes to the dead-letter queue.
+func (s *webhookService) attempt(ctx context.Context, delivery *models.WebhookDelivery) error {
+	attempt := s.send(ctx, delivery)
+
+	status := models.DeliverySucceeded
+	var retryIn time.Duration
+	if !attempt.Succeeded() {
+		status = models.DeliveryPending
+		retryIn = webhookBackoff(delivery.AttemptCount + 1)
+		if delivery.AttemptCount+1 >= s.maxAttempts {
+			status = models.DeliveryDead
+		}
+	}
+
+	return s.webhookRepo.RecordAttempt(delivery.ID, attempt, status, retryIn)
+}
+
+// send POSTs the delivery's payload, signed the same way as the low stock
+// webhook: HMAC-SHA256 of the body in the X-Signature-256 header
+func (s *webhookService) send(ctx context.Context, delivery *models.WebhookDelivery) *models.WebhookAttempt {
+	attempt := &models.WebhookAttempt{DeliveryID: delivery.ID}
+	started := time.Now()
+	defer func() { attempt.Duration = time.Since(started) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = fmt.Sprintf("invalid request: %v", err)
+		return attempt
+	}
+
+	mac := hmac.New(sha256.New, []byte(delivery.Secret))
+	mac.Write(delivery.Payload)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "microstore-webhooks/1")
+	req.Header.Set("X-Webhook-Event", delivery.EventType)
+	req.Header.Set("X-Webhook-Delivery", delivery.ID)
+	req.Header.Set("X-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+	// Reading the body lets the connection be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, webhookMaxResponseBody))
+
+	attempt.ResponseCode = int32(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		attempt.Error = fmt.Sprintf("endpoint returned status %d", resp.StatusCode)
+	}
+	return attempt
+}
+
+func (s *webhookService) getWebhook(storeID, id string) (*models.Webhook, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("webhook not found")
+	}
+
+	webhook, err := s.webhookRepo.GetByID(storeID, strings.ToLower(id))
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, fmt.Errorf("webhook not found")
+	}
+	return webhook, nil
+}
+
+// validateWebhook checks the URL and returns the event types without
+// duplicates
+func validateWebhook(rawURL string, eventTypes []string) ([]string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("webhook URL must be an absolute http or https URL")
+	}
+
+	seen := make(map[string]bool, len(eventTypes))
+	types := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !validWebhookEventType(eventType) {
+			return nil, fmt.Errorf("unknown event type %q; webhooks can subscribe to %s", eventType, strings.Join(WebhookEventTypes, ", "))
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			types = append(types, eventType)
+		}
+	}
+	return types, nil
+}
+
+func validWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookBackoff is how long to wait after the given number of failed
+// attempts
+func webhookBackoff(failures int32) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := int32(1); i < failures && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookMaxBackoff {
+		backoff = webhookMaxBackoff
+	}
+	return backoff
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// newEventID makes a random (version 4) UUID for events that don't come
+// from the outbox
+func newEventID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate event ID: %w", err)
+	}
+	buf[6] = buf[6]&0x0f | 0x40
+	buf[8] = buf[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buf[0:4], buf[4:6], buf[6:8], buf[8:10], buf[10:]), nil
+}
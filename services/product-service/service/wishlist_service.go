@@ -0,0 +1,223 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/martbul/playground_microservices/services/product-service/models"
+	"github.com/martbul/playground_microservices/services/product-service/repository"
+)
+
+// maxWishlistItems keeps a wishlist within what one product lookup loads
+const maxWishlistItems = maxBatchSize
+
+type WishlistService interface {
+	GetWishlist(storeID, userID string) (*models.Wishlist, error)
+	AddToWishlist(storeID, userID, productID string) (*models.Wishlist, error)
+	RemoveFromWishlist(storeID, userID, productID string) (*models.Wishlist, error)
+	ReorderWishlist(storeID, userID string, productIDs []string) (*models.Wishlist, error)
+	ShareWishlist(storeID, userID string, shared bool) (*models.Wishlist, error)
+	GetSharedWishlist(storeID, shareToken string) (*models.Wishlist, error)
+}
+
+type wishlistService struct {
+	wishlistRepo repository.WishlistRepository
+	productRepo  repository.ProductRepository
+}
+
+func NewWishlistService(wishlistRepo repository.WishlistRepository, productRepo repository.ProductRepository) WishlistService {
+	return &wishlistService{
+		wishlistRepo: wishlistRepo,
+		productRepo:  productRepo,
+	}
+}
+
+// GetWishlist returns the user's saved products with the ones that can no
+// longer be bought flagged
+func (s *wishlistService) GetWishlist(storeID, userID string) (*models.Wishlist, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	wishlist, err := s.wishlistRepo.Get(storeID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadProducts(storeID, wishlist); err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+// AddToWishlist saves a product at the end of the wishlist; saving one that
+// is already on it leaves it where it is
+func (s *wishlistService) AddToWishlist(storeID, userID, productID string) (*models.Wishlist, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	if !uuidPattern.MatchString(productID) {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	product, err := s.productRepo.GetByID(storeID, strings.ToLower(productID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if product == nil || !product.IsActive {
+		return nil, fmt.Errorf("product not found")
+	}
+
+	if _, err := s.wishlistRepo.Add(userID, product.ID, maxWishlistItems); err != nil {
+		if errors.Is(err, repository.ErrWishlistFull) {
+			return nil, fmt.Errorf("%w: remove something before saving more than %d products", err, maxWishlistItems)
+		}
+		return nil, err
+	}
+
+	return s.GetWishlist(storeID, userID)
+}
+
+func (s *wishlistService) RemoveFromWishlist(storeID, userID, productID string) (*models.Wishlist, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	if !uuidPattern.MatchString(productID) {
+		return nil, fmt.Errorf("product is not on your wishlist")
+	}
+
+	removed, err := s.wishlistRepo.Remove(storeID, userID, strings.ToLower(productID))
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, fmt.Errorf("product is not on your wishlist")
+	}
+
+	return s.GetWishlist(storeID, userID)
+}
+
+// ReorderWishlist puts the saved products in the given order, which must
+// name each of them exactly once. Products saved in other stores keep their
+// places.
+func (s *wishlistService) ReorderWishlist(storeID, userID string, productIDs []string) (*models.Wishlist, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	wishlist, err := s.wishlistRepo.Get(storeID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make(map[string]bool, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		saved[item.ProductID] = true
+	}
+	if len(productIDs) != len(wishlist.Items) {
+		return nil, fmt.Errorf("wishlist order must list all %d saved products", len(wishlist.Items))
+	}
+	ordered := make([]string, 0, len(productIDs))
+	seen := make(map[string]bool, len(productIDs))
+	for _, id := range productIDs {
+		id = strings.ToLower(id)
+		if !saved[id] {
+			return nil, fmt.Errorf("product %s is not on your wishlist", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("product %s is listed more than once", id)
+		}
+		seen[id] = true
+		ordered = append(ordered, id)
+	}
+
+	if err := s.wishlistRepo.Reorder(userID, ordered); err != nil {
+		return nil, err
+	}
+
+	return s.GetWishlist(storeID, userID)
+}
+
+// ShareWishlist turns the share link on or off. Turning it on always makes a
+// new link, so an old one that was passed around stops working.
+func (s *wishlistService) ShareWishlist(storeID, userID string, shared bool) (*models.Wishlist, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	token := ""
+	if shared {
+		var err error
+		if token, err = newShareToken(); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.wishlistRepo.SetShareToken(userID, token); err != nil {
+		return nil, err
+	}
+
+	return s.GetWishlist(storeID, userID)
+}
+
+// GetSharedWishlist returns the wishlist the token shares, without its
+// owner's ID or the link itself
+func (s *wishlistService) GetSharedWishlist(storeID, shareToken string) (*models.Wishlist, error) {
+	if shareToken == "" {
+		return nil, fmt.Errorf("wishlist not found")
+	}
+
+	userID, err := s.wishlistRepo.GetUserByShareToken(shareToken)
+	if err != nil {
+		return nil, err
+	}
+	if userID == "" {
+		return nil, fmt.Errorf("wishlist not found")
+	}
+
+	wishlist, err := s.GetWishlist(storeID, userID)
+	if err != nil {
+		return nil, err
+	}
+	wishlist.UserID = ""
+	wishlist.ShareToken = ""
+
+	return wishlist, nil
+}
+
+// loadProducts attaches the saved products. Deleted products aren't loaded
+// and stay on the wishlist, flagged unavailable, until they are purged.
+func (s *wishlistService) loadProducts(storeID string, wishlist *models.Wishlist) error {
+	if len(wishlist.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		ids = append(ids, item.ProductID)
+	}
+
+	products, err := s.productRepo.ListByIDs(storeID, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*models.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+
+	for _, item := range wishlist.Items {
+		item.Product = byID[item.ProductID]
+	}
+	return nil
+}
+
+func newShareToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}